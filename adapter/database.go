@@ -2,58 +2,328 @@
 package adapter
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
 )
 
+// PostgreSQL's statements which can't be run in a transaction block.
+var nonTransactionalPattern = regexp.MustCompile(`(?is)^\s*(?:CREATE\s+(?:UNIQUE\s+)?INDEX\s+CONCURRENTLY|DROP\s+INDEX\s+CONCURRENTLY|REINDEX\s.*\bCONCURRENTLY|ALTER\s+TYPE\s.*\sADD\s+VALUE|VACUUM|ALTER\s+SYSTEM)\b`)
+
 type Config struct {
 	DbName   string
 	User     string
 	Password string
 	Host     string
 	Port     int
+
+	// MySQL's AUTO_INCREMENT table option, which is updated by inserts, is dumped only when it's managed.
+	DumpAutoIncrement bool
+
+	// MySQL's ALTER TABLE of a table whose data and indexes have LargeTableSize bytes or more is run by gh-ost
+	// with GhostOptions if Ghost is enabled, or pt-online-schema-change with PtOscOptions if PtOsc is enabled.
+	Ghost          bool
+	GhostOptions   []string
+	PtOsc          bool
+	PtOscOptions   []string
+	LargeTableSize int64
+}
+
+// How RunDDLs runs DDLs
+type RunConfig struct {
+	Transactional bool          // Run DDLs in a transaction, except ones which can't be run in it
+	LockRetries   int           // Retry a DDL failed by a lock timeout at most this number of times
+	RetryInterval time.Duration // Wait before the first retry, which is doubled for each retry
+}
+
+// A dumped object like a table. Kind is one of ObjectKinds.
+type Object struct {
+	Kind string
+	Name string
+	DDL  string
+}
+
+// Kinds of objects in the order of DumpObjects.
+var ObjectKinds = []string{"schema", "extension", "server", "type", "sequence", "function", "table", "view", "trigger"}
+
+// A database which runs some DDLs out of its connection, like MySQL running ALTER TABLE of a large table by gh-ost.
+type ExternalDDLRunner interface {
+	IsExternalDDL(ddl string) (bool, error)
+	RunExternalDDL(ddl string) error
 }
 
 // Abstraction layer for multiple kinds of databases
 type Database interface {
+	SchemaNames() ([]string, error)
+	DumpSchemaDDL(schema string) (string, error)
+	ExtensionNames() ([]string, error)
+	DumpExtensionDDL(extension string) (string, error)
+	ForeignServerNames() ([]string, error)
+	DumpForeignServerDDL(server string) (string, error)
+	TypeNames() ([]string, error)
+	DumpTypeDDL(typ string) (string, error)
+	SequenceNames() ([]string, error)
+	DumpSequenceDDL(sequence string) (string, error)
+	FunctionNames() ([]string, error)
+	DumpFunctionDDL(function string) (string, error)
 	TableNames() ([]string, error)
 	DumpTableDDL(table string) (string, error)
+	ViewNames() ([]string, error)
+	DumpViewDDL(view string) (string, error)
+	TriggerNames() ([]string, error)
+	DumpTriggerDDL(trigger string) (string, error)
+	IsLockTimeout(err error) bool
 	DB() *sql.DB
 	Close() error
 }
 
 func DumpDDLs(d Database) (string, error) {
+	objects, err := DumpObjects(d)
+	if err != nil {
+		return "", err
+	}
+	return JoinObjects(objects), nil
+}
+
+// Join DDLs of objects in the format of DumpDDLs.
+func JoinObjects(objects []Object) string {
 	ddls := []string{}
+	for _, object := range objects {
+		ddls = append(ddls, object.DDL)
+	}
+	return strings.Join(ddls, ";\n\n")
+}
+
+// Dump objects in the order to create them, since they may depend on ones dumped earlier.
+func DumpObjects(d Database) ([]Object, error) {
+	objects := []Object{}
+
+	// Schemas are dumped first, since any other objects may belong to them.
+	schemaNames, err := d.SchemaNames()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, schemaName := range schemaNames {
+		ddl, err := d.DumpSchemaDDL(schemaName)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, Object{Kind: "schema", Name: schemaName, DDL: ddl})
+	}
+
+	// Extensions are dumped next, since their types and functions may be used by any other objects.
+	extensionNames, err := d.ExtensionNames()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, extensionName := range extensionNames {
+		ddl, err := d.DumpExtensionDDL(extensionName)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, Object{Kind: "extension", Name: extensionName, DDL: ddl})
+	}
+
+	// Foreign servers are dumped after extensions, which give their foreign data wrappers.
+	serverNames, err := d.ForeignServerNames()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, serverName := range serverNames {
+		ddl, err := d.DumpForeignServerDDL(serverName)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, Object{Kind: "server", Name: serverName, DDL: ddl})
+	}
+
+	// Types are dumped, since functions and columns may use them.
+	typeNames, err := d.TypeNames()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, typeName := range typeNames {
+		ddl, err := d.DumpTypeDDL(typeName)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, Object{Kind: "type", Name: typeName, DDL: ddl})
+	}
+
+	// Sequences are dumped before functions and tables, since functions and defaults of columns may refer to them.
+	sequenceNames, err := d.SequenceNames()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sequenceName := range sequenceNames {
+		ddl, err := d.DumpSequenceDDL(sequenceName)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, Object{Kind: "sequence", Name: sequenceName, DDL: ddl})
+	}
+
+	// Functions are dumped before tables, views and triggers, since they may refer to them.
+	functionNames, err := d.FunctionNames()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, functionName := range functionNames {
+		ddl, err := d.DumpFunctionDDL(functionName)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, Object{Kind: "function", Name: functionName, DDL: ddl})
+	}
+
 	tableNames, err := d.TableNames()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	for _, tableName := range tableNames {
 		ddl, err := d.DumpTableDDL(tableName)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
-		ddls = append(ddls, ddl)
+		objects = append(objects, Object{Kind: "table", Name: tableName, DDL: ddl})
 	}
-	return strings.Join(ddls, ";\n\n"), nil
+
+	// Views are dumped after tables, since they refer to tables.
+	viewNames, err := d.ViewNames()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, viewName := range viewNames {
+		ddl, err := d.DumpViewDDL(viewName)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, Object{Kind: "view", Name: viewName, DDL: ddl})
+	}
+
+	// Triggers are dumped after tables and views, since they refer to them.
+	triggerNames, err := d.TriggerNames()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, triggerName := range triggerNames {
+		ddl, err := d.DumpTriggerDDL(triggerName)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, Object{Kind: "trigger", Name: triggerName, DDL: ddl})
+	}
+	return objects, nil
 }
 
-func RunDDLs(d Database, ddls []string) error {
-	transaction, err := d.DB().Begin()
+// Run DDLs on a single connection. They're run in a transaction if it's configured, so that PostgreSQL doesn't
+// change anything on a failure. A statement which can't be run in a transaction, or is run by an ExternalDDLRunner,
+// is run after committing DDLs before it, and a transaction is begun again for DDLs after it.
+func RunDDLs(d Database, ddls []string, config RunConfig) error {
+	ctx := context.Background()
+	conn, err := d.DB().Conn(ctx)
 	if err != nil {
 		return err
 	}
+	defer conn.Close()
+
+	var transaction *sql.Tx
+	runner, hasRunner := d.(ExternalDDLRunner)
 	fmt.Println("-- Apply --")
 	for _, ddl := range ddls {
 		fmt.Printf("%s;\n", ddl)
-		if _, err := transaction.Exec(ddl); err != nil {
-			transaction.Rollback()
+		external := false
+		if hasRunner {
+			if external, err = runner.IsExternalDDL(ddl); err != nil {
+				if transaction != nil {
+					transaction.Rollback()
+				}
+				return err
+			}
+		}
+
+		if config.Transactional && !external && !nonTransactionalPattern.MatchString(ddl) {
+			if transaction == nil {
+				if transaction, err = conn.BeginTx(ctx, nil); err != nil {
+					return err
+				}
+			}
+			if err := execDDL(ctx, d, transaction, ddl, true, config); err != nil {
+				transaction.Rollback()
+				return err
+			}
+			continue
+		}
+
+		if transaction != nil {
+			if err := transaction.Commit(); err != nil {
+				return err
+			}
+			transaction = nil
+		}
+		if external {
+			err = runner.RunExternalDDL(ddl)
+		} else {
+			err = execDDL(ctx, d, conn, ddl, false, config)
+		}
+		if err != nil {
 			return err
 		}
 	}
-	transaction.Commit()
+	if transaction != nil {
+		return transaction.Commit()
+	}
 	return nil
 }
+
+// *sql.Tx or *sql.Conn
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// Run the DDL, and retry it after an interval when it fails by a lock timeout. In a transaction, it's rolled back
+// to a savepoint before retrying, since PostgreSQL doesn't run anything in a transaction after a failure.
+func execDDL(ctx context.Context, d Database, e execer, ddl string, inTransaction bool, config RunConfig) error {
+	interval := config.RetryInterval
+	for retry := 0; ; retry++ {
+		if inTransaction && config.LockRetries > 0 {
+			if _, err := e.ExecContext(ctx, "SAVEPOINT sqldef_retry"); err != nil {
+				return err
+			}
+		}
+		_, err := e.ExecContext(ctx, ddl)
+		if err == nil || retry >= config.LockRetries || !d.IsLockTimeout(err) {
+			return err
+		}
+
+		if inTransaction {
+			if _, err := e.ExecContext(ctx, "ROLLBACK TO SAVEPOINT sqldef_retry"); err != nil {
+				return err
+			}
+		}
+		fmt.Printf("-- Retrying in %s: %s --\n", interval, err)
+		time.Sleep(interval)
+		interval *= 2
+	}
+}
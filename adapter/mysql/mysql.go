@@ -3,11 +3,25 @@ package mysql
 import (
 	"database/sql"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
 
 	driver "github.com/go-sql-driver/mysql"
 	"github.com/k0kubun/sqldef/adapter"
 )
 
+var autoIncrementPattern = regexp.MustCompile(` AUTO_INCREMENT=\d+`)
+var alterTablePattern = regexp.MustCompile("(?s)^ALTER TABLE (`[^`]+`|[\\w$]+) (.+)$")
+
+// ALTER TABLE which is not run by online schema change tools. Renames don't copy the table, while gh-ost and
+// pt-online-schema-change reject renaming the table, and changing foreign keys needs their extra options.
+var renameOnlyPattern = regexp.MustCompile("(?is)^(RENAME (TO|AS|COLUMN) .+|RENAME (INDEX|KEY) [^,]+ TO [^,]+(, RENAME (INDEX|KEY) [^,]+ TO [^,]+)*)$")
+var foreignKeyPattern = regexp.MustCompile(`(?i)\bFOREIGN KEY\b`)
+
 type MysqlDatabase struct {
 	config adapter.Config
 	db     *sql.DB
@@ -25,8 +39,102 @@ func NewDatabase(config adapter.Config) (adapter.Database, error) {
 	}, nil
 }
 
+// MySQL's schema is a database, which is not managed.
+func (d *MysqlDatabase) SchemaNames() ([]string, error) {
+	return []string{}, nil
+}
+
+func (d *MysqlDatabase) DumpSchemaDDL(schema string) (string, error) {
+	return "", fmt.Errorf("schema '%s' is not supported", schema)
+}
+
+// Extensions are not supported.
+func (d *MysqlDatabase) ExtensionNames() ([]string, error) {
+	return []string{}, nil
+}
+
+func (d *MysqlDatabase) DumpExtensionDDL(extension string) (string, error) {
+	return "", fmt.Errorf("extension '%s' is not supported", extension)
+}
+
+// Foreign servers are not supported.
+func (d *MysqlDatabase) ForeignServerNames() ([]string, error) {
+	return []string{}, nil
+}
+
+func (d *MysqlDatabase) DumpForeignServerDDL(server string) (string, error) {
+	return "", fmt.Errorf("foreign server '%s' is not supported", server)
+}
+
+// User-defined types are not supported.
+func (d *MysqlDatabase) TypeNames() ([]string, error) {
+	return []string{}, nil
+}
+
+func (d *MysqlDatabase) DumpTypeDDL(typ string) (string, error) {
+	return "", fmt.Errorf("type '%s' is not supported", typ)
+}
+
+// Sequences are not supported.
+func (d *MysqlDatabase) SequenceNames() ([]string, error) {
+	return []string{}, nil
+}
+
+func (d *MysqlDatabase) DumpSequenceDDL(sequence string) (string, error) {
+	return "", fmt.Errorf("sequence '%s' is not supported", sequence)
+}
+
+// Stored routines are named like `FUNCTION name` or `PROCEDURE name`, since functions and procedures have
+// separate namespaces.
+func (d *MysqlDatabase) FunctionNames() ([]string, error) {
+	rows, err := d.db.Query("select routine_type, routine_name from information_schema.routines where routine_schema = database() order by routine_type, routine_name;")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	routines := []string{}
+	for rows.Next() {
+		var routineType, routineName string
+		if err := rows.Scan(&routineType, &routineName); err != nil {
+			return nil, err
+		}
+		routines = append(routines, routineType+" "+routineName)
+	}
+	return routines, nil
+}
+
+func (d *MysqlDatabase) DumpFunctionDDL(routine string) (string, error) {
+	var name, sqlMode, ddl, charset, collation, dbCollation string
+	parts := strings.SplitN(routine, " ", 2)
+	sql := fmt.Sprintf("show create %s %s;", parts[0], quoteIdentifier(parts[1]))
+
+	err := d.db.QueryRow(sql).Scan(&name, &sqlMode, &ddl, &charset, &collation, &dbCollation)
+	if err != nil {
+		return "", err
+	}
+
+	// Ignore DEFINER, which is not managed.
+	re := regexp.MustCompile("^CREATE DEFINER=[^ ]+ (FUNCTION|PROCEDURE) ")
+	return re.ReplaceAllString(ddl, "CREATE $1 "), nil
+}
+
+// Tables are sorted by their names, so that exported schemas are compared regardless of the catalog order.
 func (d *MysqlDatabase) TableNames() ([]string, error) {
-	rows, err := d.db.Query("show tables")
+	tables, err := d.showFullTables("BASE TABLE")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(tables)
+	return tables, nil
+}
+
+func (d *MysqlDatabase) ViewNames() ([]string, error) {
+	return d.showFullTables("VIEW")
+}
+
+func (d *MysqlDatabase) showFullTables(tableType string) ([]string, error) {
+	rows, err := d.db.Query("show full tables where Table_type = ?", tableType)
 	if err != nil {
 		return nil, err
 	}
@@ -34,8 +142,8 @@ func (d *MysqlDatabase) TableNames() ([]string, error) {
 
 	tables := []string{}
 	for rows.Next() {
-		var table string
-		if err := rows.Scan(&table); err != nil {
+		var table, rowType string
+		if err := rows.Scan(&table, &rowType); err != nil {
 			return nil, err
 		}
 		tables = append(tables, table)
@@ -45,14 +153,178 @@ func (d *MysqlDatabase) TableNames() ([]string, error) {
 
 func (d *MysqlDatabase) DumpTableDDL(table string) (string, error) {
 	var ddl string
-	sql := fmt.Sprintf("show create table %s;", table) // TODO: escape table name
+	sql := fmt.Sprintf("show create table %s;", quoteIdentifier(table))
 
 	err := d.db.QueryRow(sql).Scan(&table, &ddl)
 	if err != nil {
 		return "", err
 	}
 
-	return ddl, nil
+	// SHOW CREATE TABLE omits COLLATE when it's the default one of the charset. Show it to compare it with a desired one.
+	var collation string
+	err = d.db.QueryRow(
+		"select table_collation from information_schema.tables where table_schema = database() and table_name = ?;", table,
+	).Scan(&collation)
+	if err != nil {
+		return "", err
+	}
+
+	if !d.config.DumpAutoIncrement {
+		ddl = autoIncrementPattern.ReplaceAllLiteralString(ddl, "")
+	}
+	return appendTableCollation(ddl, collation), nil
+}
+
+func (d *MysqlDatabase) DumpViewDDL(view string) (string, error) {
+	var ddl, charset, collation string
+	sql := fmt.Sprintf("show create view %s;", quoteIdentifier(view))
+
+	err := d.db.QueryRow(sql).Scan(&view, &ddl, &charset, &collation)
+	if err != nil {
+		return "", err
+	}
+
+	// Ignore ALGORITHM, DEFINER and SQL SECURITY, which are not managed.
+	re := regexp.MustCompile("^CREATE (ALGORITHM=[^ ]+ )?(DEFINER=[^ ]+ )?(SQL SECURITY [^ ]+ )?VIEW ")
+	return re.ReplaceAllLiteralString(ddl, "CREATE VIEW "), nil
+}
+
+func (d *MysqlDatabase) TriggerNames() ([]string, error) {
+	rows, err := d.db.Query("select trigger_name from information_schema.triggers where trigger_schema = database() order by trigger_name;")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	triggers := []string{}
+	for rows.Next() {
+		var trigger string
+		if err := rows.Scan(&trigger); err != nil {
+			return nil, err
+		}
+		triggers = append(triggers, trigger)
+	}
+	return triggers, nil
+}
+
+// SHOW CREATE TRIGGER has DEFINER, which is not managed. Build CREATE TRIGGER from information_schema instead.
+func (d *MysqlDatabase) DumpTriggerDDL(trigger string) (string, error) {
+	var timing, event, table, orientation, body string
+	err := d.db.QueryRow(
+		"select action_timing, event_manipulation, event_object_table, action_orientation, action_statement "+
+			"from information_schema.triggers where trigger_schema = database() and trigger_name = ?;", trigger,
+	).Scan(&timing, &event, &table, &orientation, &body)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("CREATE TRIGGER %s %s %s ON %s FOR EACH %s %s", quoteIdentifier(trigger), timing, event, quoteIdentifier(table), orientation, body), nil
+}
+
+// ALTER TABLE of a large table is run by gh-ost or pt-online-schema-change if it's enabled, not to block writes
+// to the table while it's copied.
+func (d *MysqlDatabase) IsExternalDDL(ddl string) (bool, error) {
+	match := alterTablePattern.FindStringSubmatch(ddl)
+	if !(d.config.Ghost || d.config.PtOsc) || match == nil || renameOnlyPattern.MatchString(match[2]) || foreignKeyPattern.MatchString(match[2]) {
+		return false, nil
+	}
+
+	var size int64
+	err := d.db.QueryRow(
+		"select data_length + index_length from information_schema.tables where table_schema = database() and table_name = ?",
+		strings.Trim(match[1], "`"),
+	).Scan(&size)
+	if err != nil {
+		return false, err
+	}
+	return size >= d.config.LargeTableSize, nil
+}
+
+// Run ALTER TABLE by gh-ost or pt-online-schema-change, which copy the table to a new one with the change and
+// swap them.
+func (d *MysqlDatabase) RunExternalDDL(ddl string) error {
+	match := alterTablePattern.FindStringSubmatch(ddl)
+	if match == nil {
+		return fmt.Errorf("unexpected DDL for an online schema change: %s", ddl)
+	}
+	table := strings.Trim(match[1], "`")
+
+	configFile, err := d.writeClientConfig()
+	if err != nil {
+		return err
+	}
+	if configFile != "" {
+		defer os.Remove(configFile)
+	}
+
+	command, args := "gh-ost", d.ghostArgs(table, match[2], configFile)
+	if d.config.PtOsc {
+		command, args = "pt-online-schema-change", d.ptOscArgs(table, match[2], configFile)
+	}
+	fmt.Printf("-- Running %s for %s --\n", command, table)
+	cmd := exec.Command(command, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// Write the password into a temporary option file of MySQL clients, not to give it by arguments shown by ps(1).
+// Nothing is written without a password.
+func (d *MysqlDatabase) writeClientConfig() (string, error) {
+	if len(d.config.Password) == 0 {
+		return "", nil
+	}
+	file, err := ioutil.TempFile("", "sqldef")
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	escape := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace
+	if _, err := fmt.Fprintf(file, "[client]\npassword=\"%s\"\n", escape(d.config.Password)); err != nil {
+		os.Remove(file.Name())
+		return "", err
+	}
+	return file.Name(), nil
+}
+
+func (d *MysqlDatabase) ghostArgs(table string, alter string, configFile string) []string {
+	args := []string{
+		"--host", d.config.Host,
+		"--port", fmt.Sprintf("%d", d.config.Port),
+		"--user", d.config.User,
+		"--database", d.config.DbName,
+		"--table", table,
+		"--alter", alter,
+	}
+	if configFile != "" {
+		args = append(args, "--conf", configFile)
+	}
+	args = append(args, d.config.GhostOptions...)
+	return append(args, "--execute")
+}
+
+// pt-online-schema-change takes the database, the table and the option file by a DSN, whose commas in values are escaped.
+func (d *MysqlDatabase) ptOscArgs(table string, alter string, configFile string) []string {
+	args := []string{
+		"--host", d.config.Host,
+		"--port", fmt.Sprintf("%d", d.config.Port),
+		"--user", d.config.User,
+		"--alter", alter,
+	}
+	args = append(args, d.config.PtOscOptions...)
+	escape := strings.NewReplacer(",", "\\,").Replace
+	dsn := fmt.Sprintf("D=%s,t=%s", escape(d.config.DbName), escape(table))
+	if configFile != "" {
+		dsn += ",F=" + escape(configFile)
+	}
+	return append(args, "--execute", dsn)
+}
+
+// ER_LOCK_WAIT_TIMEOUT is given by lock_wait_timeout for a metadata lock as well as innodb_lock_wait_timeout.
+func (d *MysqlDatabase) IsLockTimeout(err error) bool {
+	mysqlErr, ok := err.(*driver.MySQLError)
+	return ok && mysqlErr.Number == 1205
 }
 
 func (d *MysqlDatabase) DB() *sql.DB {
@@ -63,6 +335,30 @@ func (d *MysqlDatabase) Close() error {
 	return d.db.Close()
 }
 
+// Append `COLLATE=` to the table options line, which starts with ")", if it's missing.
+func appendTableCollation(ddl string, collation string) string {
+	start := strings.LastIndex(ddl, "\n)")
+	if start < 0 || collation == "" {
+		return ddl
+	}
+	end := strings.Index(ddl[start+1:], "\n")
+	if end < 0 {
+		end = len(ddl)
+	} else {
+		end += start + 1
+	}
+
+	if strings.Contains(ddl[start:end], " COLLATE=") {
+		return ddl
+	}
+	return ddl[:end] + " COLLATE=" + collation + ddl[end:]
+}
+
+// Quote a name given by information_schema for SHOW CREATE, so that a reserved word like `order` can be dumped.
+func quoteIdentifier(name string) string {
+	return "`" + strings.Replace(name, "`", "``", -1) + "`"
+}
+
 func mysqlBuildDSN(config adapter.Config) string {
 	c := driver.NewConfig()
 	c.User = config.User
@@ -9,7 +9,7 @@ import (
 	"strings"
 
 	"github.com/k0kubun/sqldef/adapter"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 )
 
 type PostgresDatabase struct {
@@ -29,8 +29,41 @@ func NewDatabase(config adapter.Config) (adapter.Database, error) {
 	}, nil
 }
 
+// Objects in a schema other than public are qualified by the schema, like `app.users`.
+const qualifiedTableName = "case when table_schema = 'public' then table_name else table_schema || '.' || table_name end"
+
+// System schemas and ones owned by extensions are not managed.
+func (d *PostgresDatabase) SchemaNames() ([]string, error) {
+	rows, err := d.db.Query(
+		"select n.nspname from pg_namespace n where n.nspname not in ('public', 'information_schema') and n.nspname not like 'pg\\_%' " +
+			"and not exists (select 1 from pg_depend d where d.objid = n.oid and d.deptype = 'e') order by n.nspname;",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	schemas := []string{}
+	for rows.Next() {
+		var schema string
+		if err := rows.Scan(&schema); err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, schema)
+	}
+	return schemas, nil
+}
+
+func (d *PostgresDatabase) DumpSchemaDDL(schema string) (string, error) {
+	return fmt.Sprintf("CREATE SCHEMA %s", quoteIdentifier(schema)), nil
+}
+
+// Tables are sorted by their names, so that exported schemas are compared regardless of the catalog order.
 func (d *PostgresDatabase) TableNames() ([]string, error) {
-	rows, err := d.db.Query("select table_name from information_schema.tables where table_schema='public';")
+	rows, err := d.db.Query(
+		"select " + qualifiedTableName + " from information_schema.tables " +
+			"where table_schema not in ('pg_catalog', 'information_schema') and table_type in ('BASE TABLE', 'FOREIGN') order by 1;",
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -63,6 +96,9 @@ func (d *PostgresDatabase) DumpTableDDL(table string) (string, error) {
 	re = regexp.MustCompilePOSIX("^\\\\\\.$")
 	ddl = re.ReplaceAllLiteralString(ddl, "")
 
+	// Apply `SET default_tablespace` to the following statements before ignoring it
+	ddl = applyDefaultTablespace(ddl)
+
 	// Ignore SET statements
 	re = regexp.MustCompilePOSIX("^SET .*;$")
 	ddl = re.ReplaceAllLiteralString(ddl, "")
@@ -72,7 +108,7 @@ func (d *PostgresDatabase) DumpTableDDL(table string) (string, error) {
 	ddl = re.ReplaceAllLiteralString(ddl, "")
 
 	// Ignore COMMENT ON EXTENSION statements
-	re = regexp.MustCompilePOSIX("^COMMENT ON .*;$")
+	re = regexp.MustCompilePOSIX("^COMMENT ON EXTENSION .*;$")
 	ddl = re.ReplaceAllLiteralString(ddl, "")
 
 	// Ignore SELECT statements
@@ -83,8 +119,16 @@ func (d *PostgresDatabase) DumpTableDDL(table string) (string, error) {
 	re = regexp.MustCompilePOSIX("^COPY .*;$")
 	ddl = re.ReplaceAllLiteralString(ddl, "")
 
-	// Ignore ALTER TABLE xxx OWNER TO yyy statements
-	re = regexp.MustCompilePOSIX("^ALTER TABLE [^ ;]+ OWNER TO .+;$")
+	// Ignore ALTER TABLE xxx OWNER TO yyy statements, which are also given for sequences and foreign tables
+	re = regexp.MustCompilePOSIX("^ALTER (TABLE|SEQUENCE|FOREIGN TABLE) [^ ;]+ OWNER TO .+;$")
+	ddl = re.ReplaceAllLiteralString(ddl, "")
+
+	// Ignore ALTER INDEX xxx ATTACH PARTITION yyy statements, since indexes of partitions are managed by the parent
+	re = regexp.MustCompilePOSIX("^ALTER INDEX [^ ;]+ ATTACH PARTITION .+;$")
+	ddl = re.ReplaceAllLiteralString(ddl, "")
+
+	// Ignore SEQUENCE NAME of identity columns, since their sequences are not managed
+	re = regexp.MustCompilePOSIX("^ +SEQUENCE NAME [^ ]+$")
 	ddl = re.ReplaceAllLiteralString(ddl, "")
 
 	// Remove empty lines
@@ -99,6 +143,329 @@ func (d *PostgresDatabase) DumpTableDDL(table string) (string, error) {
 	return ddl, nil
 }
 
+// Views are sorted in the order of creation instead of their names, since a view may refer to another one.
+// Ones owned by extensions are not managed.
+func (d *PostgresDatabase) ViewNames() ([]string, error) {
+	rows, err := d.db.Query(
+		"select case when n.nspname = 'public' then c.relname else n.nspname || '.' || c.relname end " +
+			"from pg_class c join pg_namespace n on n.oid = c.relnamespace " +
+			"where c.relkind in ('v', 'm') and n.nspname not in ('pg_catalog', 'information_schema') " +
+			"and not exists (select 1 from pg_depend d where d.objid = c.oid and d.deptype = 'e') order by c.oid;",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	views := []string{}
+	for rows.Next() {
+		var view string
+		if err := rows.Scan(&view); err != nil {
+			return nil, err
+		}
+		views = append(views, view)
+	}
+	return views, nil
+}
+
+// pg_dump(1) dumps a view in the same way as a table.
+func (d *PostgresDatabase) DumpViewDDL(view string) (string, error) {
+	return d.DumpTableDDL(view)
+}
+
+// plpgsql is installed by default, so it's not managed.
+func (d *PostgresDatabase) ExtensionNames() ([]string, error) {
+	rows, err := d.db.Query("select extname from pg_extension where extname <> 'plpgsql' order by extname;")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	extensions := []string{}
+	for rows.Next() {
+		var extension string
+		if err := rows.Scan(&extension); err != nil {
+			return nil, err
+		}
+		extensions = append(extensions, extension)
+	}
+	return extensions, nil
+}
+
+// The name is quoted, since an extension like uuid-ossp has a name which isn't an identifier.
+func (d *PostgresDatabase) DumpExtensionDDL(extension string) (string, error) {
+	return fmt.Sprintf("CREATE EXTENSION IF NOT EXISTS %s", quoteIdentifier(extension)), nil
+}
+
+// Servers owned by extensions are not managed. User mappings are dumped with their servers.
+func (d *PostgresDatabase) ForeignServerNames() ([]string, error) {
+	rows, err := d.db.Query(
+		"select s.srvname from pg_foreign_server s " +
+			"where not exists (select 1 from pg_depend d where d.objid = s.oid and d.deptype = 'e') order by s.srvname;",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	servers := []string{}
+	for rows.Next() {
+		var server string
+		if err := rows.Scan(&server); err != nil {
+			return nil, err
+		}
+		servers = append(servers, server)
+	}
+	return servers, nil
+}
+
+// pg_dump(1) doesn't dump a server with `--table`, so `CREATE SERVER` and `CREATE USER MAPPING` are built from
+// pg_foreign_server and pg_user_mappings.
+func (d *PostgresDatabase) DumpForeignServerDDL(server string) (string, error) {
+	var serverType, version sql.NullString
+	var wrapper string
+	err := d.db.QueryRow(
+		"select s.srvtype, s.srvversion, w.fdwname from pg_foreign_server s "+
+			"join pg_foreign_data_wrapper w on w.oid = s.srvfdw where s.srvname = $1;", server,
+	).Scan(&serverType, &version, &wrapper)
+	if err != nil {
+		return "", err
+	}
+
+	ddl := fmt.Sprintf("CREATE SERVER %s", quoteIdentifier(server))
+	if serverType.Valid {
+		ddl += fmt.Sprintf(" TYPE %s", quoteLiteral(serverType.String))
+	}
+	if version.Valid {
+		ddl += fmt.Sprintf(" VERSION %s", quoteLiteral(version.String))
+	}
+	options, err := d.dumpForeignOptions(
+		"select o.option_name, o.option_value from pg_foreign_server s, pg_options_to_table(s.srvoptions) o "+
+			"where s.srvname = $1 order by o.option_name;", server,
+	)
+	if err != nil {
+		return "", err
+	}
+	ddls := []string{fmt.Sprintf("%s FOREIGN DATA WRAPPER %s%s", ddl, quoteIdentifier(wrapper), options)}
+
+	rows, err := d.db.Query("select usename from pg_user_mappings where srvname = $1 order by usename;", server)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	users := []string{}
+	for rows.Next() {
+		var user string
+		if err := rows.Scan(&user); err != nil {
+			return "", err
+		}
+		users = append(users, user)
+	}
+	for _, user := range users {
+		options, err := d.dumpForeignOptions(
+			"select o.option_name, o.option_value from pg_user_mappings m, pg_options_to_table(m.umoptions) o "+
+				"where m.srvname = $1 and m.usename = $2 order by o.option_name;", server, user,
+		)
+		if err != nil {
+			return "", err
+		}
+		ddls = append(ddls, fmt.Sprintf("CREATE USER MAPPING FOR %s SERVER %s%s", quoteRoleName(user), quoteIdentifier(server), options))
+	}
+	return strings.Join(ddls, ";\n\n"), nil
+}
+
+// Return ` OPTIONS (name 'value', ...)` selected by the query, or empty if there's no option.
+func (d *PostgresDatabase) dumpForeignOptions(query string, args ...interface{}) (string, error) {
+	rows, err := d.db.Query(query, args...)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	options := []string{}
+	for rows.Next() {
+		var name, value string
+		if err := rows.Scan(&name, &value); err != nil {
+			return "", err
+		}
+		options = append(options, fmt.Sprintf("%s %s", quoteIdentifier(name), quoteLiteral(value)))
+	}
+	if len(options) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf(" OPTIONS (%s)", strings.Join(options, ", ")), nil
+}
+
+// Sequences owned by columns are dumped with their tables by pg_dump(1), and ones owned by extensions are not managed.
+// Only enum types and domains are managed. Ones owned by extensions are not. Enum types are listed first,
+// since domains may be based on them.
+func (d *PostgresDatabase) TypeNames() ([]string, error) {
+	rows, err := d.db.Query(
+		"select t.typname from pg_type t join pg_namespace n on n.oid = t.typnamespace " +
+			"where t.typtype in ('e', 'd') and n.nspname = 'public' " +
+			"and not exists (select 1 from pg_depend d where d.objid = t.oid and d.deptype = 'e') order by t.typtype desc, t.typname;",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	types := []string{}
+	for rows.Next() {
+		var typ string
+		if err := rows.Scan(&typ); err != nil {
+			return nil, err
+		}
+		types = append(types, typ)
+	}
+	return types, nil
+}
+
+// pg_dump(1) doesn't dump a type with `--table`, so `CREATE TYPE` is built from labels in pg_enum,
+// and `CREATE DOMAIN` is built from pg_type and pg_constraint.
+func (d *PostgresDatabase) DumpTypeDDL(typ string) (string, error) {
+	var typeType string
+	var baseType string
+	var notNull bool
+	var defaultVal sql.NullString
+	err := d.db.QueryRow(
+		"select t.typtype, format_type(t.typbasetype, t.typtypmod), t.typnotnull, t.typdefault from pg_type t "+
+			"join pg_namespace n on n.oid = t.typnamespace where n.nspname = 'public' and t.typname = $1;", typ,
+	).Scan(&typeType, &baseType, &notNull, &defaultVal)
+	if err != nil {
+		return "", err
+	}
+	if typeType == "d" {
+		return d.dumpDomainDDL(typ, baseType, notNull, defaultVal)
+	}
+
+	rows, err := d.db.Query(
+		"select e.enumlabel from pg_enum e join pg_type t on t.oid = e.enumtypid join pg_namespace n on n.oid = t.typnamespace "+
+			"where n.nspname = 'public' and t.typname = $1 order by e.enumsortorder;", typ,
+	)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	labels := []string{}
+	for rows.Next() {
+		var label string
+		if err := rows.Scan(&label); err != nil {
+			return "", err
+		}
+		labels = append(labels, quoteLiteral(label))
+	}
+	return fmt.Sprintf("CREATE TYPE %s AS ENUM (%s)", quoteIdentifier(typ), strings.Join(labels, ", ")), nil
+}
+
+func (d *PostgresDatabase) dumpDomainDDL(domain string, baseType string, notNull bool, defaultVal sql.NullString) (string, error) {
+	ddl := fmt.Sprintf("CREATE DOMAIN %s AS %s", quoteIdentifier(domain), baseType)
+	if defaultVal.Valid {
+		ddl += fmt.Sprintf(" DEFAULT %s", defaultVal.String)
+	}
+	if notNull {
+		ddl += " NOT NULL"
+	}
+
+	rows, err := d.db.Query(
+		"select c.conname, pg_get_constraintdef(c.oid) from pg_constraint c join pg_type t on t.oid = c.contypid "+
+			"join pg_namespace n on n.oid = t.typnamespace where n.nspname = 'public' and t.typname = $1 and c.contype = 'c' order by c.conname;", domain,
+	)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name, definition string
+		if err := rows.Scan(&name, &definition); err != nil {
+			return "", err
+		}
+		ddl += fmt.Sprintf(" CONSTRAINT %s %s", quoteIdentifier(name), definition)
+	}
+	return ddl, nil
+}
+
+func (d *PostgresDatabase) SequenceNames() ([]string, error) {
+	rows, err := d.db.Query(
+		"select case when n.nspname = 'public' then c.relname else n.nspname || '.' || c.relname end " +
+			"from pg_class c join pg_namespace n on n.oid = c.relnamespace " +
+			"where c.relkind = 'S' and n.nspname not in ('pg_catalog', 'information_schema') " +
+			"and not exists (select 1 from pg_depend d where d.objid = c.oid and d.deptype in ('a', 'i', 'e')) order by 1;",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sequences := []string{}
+	for rows.Next() {
+		var sequence string
+		if err := rows.Scan(&sequence); err != nil {
+			return nil, err
+		}
+		sequences = append(sequences, sequence)
+	}
+	return sequences, nil
+}
+
+// pg_dump(1) dumps a sequence in the same way as a table.
+func (d *PostgresDatabase) DumpSequenceDDL(sequence string) (string, error) {
+	return d.DumpTableDDL(sequence)
+}
+
+// Functions owned by extensions are not managed.
+func (d *PostgresDatabase) FunctionNames() ([]string, error) {
+	rows, err := d.db.Query(
+		"select distinct p.proname from pg_proc p join pg_namespace n on n.oid = p.pronamespace " +
+			"where n.nspname = 'public' and not p.proisagg and not p.proiswindow " +
+			"and not exists (select 1 from pg_depend d where d.objid = p.oid and d.deptype = 'e') order by p.proname;",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	functions := []string{}
+	for rows.Next() {
+		var function string
+		if err := rows.Scan(&function); err != nil {
+			return nil, err
+		}
+		functions = append(functions, function)
+	}
+	return functions, nil
+}
+
+func (d *PostgresDatabase) DumpFunctionDDL(function string) (string, error) {
+	var ddl string
+	err := d.db.QueryRow(
+		"select pg_get_functiondef(p.oid) from pg_proc p join pg_namespace n on n.oid = p.pronamespace "+
+			"where n.nspname = 'public' and p.proname = $1;", function,
+	).Scan(&ddl)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(ddl), nil
+}
+
+// pg_dump(1) dumps triggers with their tables.
+func (d *PostgresDatabase) TriggerNames() ([]string, error) {
+	return []string{}, nil
+}
+
+func (d *PostgresDatabase) DumpTriggerDDL(trigger string) (string, error) {
+	return "", fmt.Errorf("trigger '%s' is dumped with its table", trigger)
+}
+
+// lock_not_available is given by lock_timeout.
+func (d *PostgresDatabase) IsLockTimeout(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code == "55P03"
+}
+
 func (d *PostgresDatabase) DB() *sql.DB {
 	return d.db
 }
@@ -111,7 +478,7 @@ func runPgDump(config adapter.Config, table string) (string, error) {
 	cmd := exec.Command(
 		"pg_dump", config.DbName,
 		"--schema-only",
-		"-t", table,
+		"-t", quoteQualifiedName(table),
 		"-U", config.User,
 		"-h", config.Host,
 		"-p", fmt.Sprintf("%d", config.Port),
@@ -129,6 +496,57 @@ func runPgDump(config adapter.Config, table string) (string, error) {
 	return string(out), nil
 }
 
+// pg_dump gives the tablespace of tables and indexes by `SET default_tablespace = name;` instead of
+// their TABLESPACE clauses. Put it back to `CREATE TABLE` and `CREATE INDEX` as TABLESPACE.
+func applyDefaultTablespace(ddl string) string {
+	tablespace := ""
+	statements := strings.Split(ddl, ";\n")
+	for i, statement := range statements {
+		trimmed := strings.TrimSpace(statement)
+		if strings.HasPrefix(trimmed, "SET default_tablespace = ") {
+			tablespace = strings.Trim(strings.TrimPrefix(trimmed, "SET default_tablespace = "), "'")
+		} else if tablespace == "" {
+			continue
+		} else if strings.HasPrefix(trimmed, "CREATE TABLE ") {
+			statements[i] = statement + " TABLESPACE " + tablespace
+		} else if strings.HasPrefix(trimmed, "CREATE INDEX ") || strings.HasPrefix(trimmed, "CREATE UNIQUE INDEX ") {
+			// TABLESPACE is given before the predicate of a partial index
+			if where := strings.Index(statement, " WHERE "); where >= 0 {
+				statements[i] = statement[:where] + " TABLESPACE " + tablespace + statement[where:]
+			} else {
+				statements[i] = statement + " TABLESPACE " + tablespace
+			}
+		}
+	}
+	return strings.Join(statements, ";\n")
+}
+
+// Quote each part of a name like `app.users` for `pg_dump -t`, which folds an unquoted name to lowercase and
+// treats `*` and `?` as patterns.
+func quoteQualifiedName(name string) string {
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		parts[i] = quoteIdentifier(part)
+	}
+	return strings.Join(parts, ".")
+}
+
+func quoteIdentifier(name string) string {
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
+
+// pg_user_mappings has `public` as the user of a mapping for PUBLIC, which is a keyword rather than a role.
+func quoteRoleName(name string) string {
+	if name == "public" {
+		return name
+	}
+	return quoteIdentifier(name)
+}
+
+func quoteLiteral(str string) string {
+	return "'" + strings.Replace(str, "'", "''", -1) + "'"
+}
+
 func postgresBuildDSN(config adapter.Config) string {
 	user := config.User
 	password := config.Password
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/jessevdk/go-flags"
 	"github.com/k0kubun/sqldef"
@@ -16,43 +17,127 @@ import (
 // TODO: Support `sqldef schema.sql -opt val...`
 func parseOptions(args []string) (adapter.Config, *sqldef.Options) {
 	var opts struct {
-		User     string `short:"u" long:"user" description:"MySQL user name" value-name:"user_name" default:"root"`
-		Password string `short:"p" long:"password" description:"MySQL user password, overridden by $MYSQL_PWD" value-name:"password"`
-		Host     string `short:"h" long:"host" description:"Host to connect to the MySQL server" value-name:"host_name" default:"127.0.0.1"`
-		Port     uint   `short:"P" long:"port" description:"Port used for the connection" value-name:"port_num" default:"3306"`
-		File     string `long:"file" description:"Read schema SQL from the file, rather than stdin" value-name:"sql_file" default:"-"`
-		DryRun   bool   `long:"dry-run" description:"Don't run DDLs but just show them"`
-		Export   bool   `long:"export" description:"Just dump the current schema to stdout"`
-		Help     bool   `long:"help" description:"Show this help"`
+		User                string        `short:"u" long:"user" description:"MySQL user name" value-name:"user_name" default:"root"`
+		Password            string        `short:"p" long:"password" description:"MySQL user password, overridden by $MYSQL_PWD" value-name:"password"`
+		Host                string        `short:"h" long:"host" description:"Host to connect to the MySQL server" value-name:"host_name" default:"127.0.0.1"`
+		Port                uint          `short:"P" long:"port" description:"Port used for the connection" value-name:"port_num" default:"3306"`
+		File                string        `long:"file" description:"Read schema SQL from the file, or *.sql files in the directory, rather than stdin" value-name:"sql_file" default:"-"`
+		ExpandEnv           bool          `long:"expand-env" description:"Replace ${VAR} in the schema SQL with the environment variable"`
+		Template            bool          `long:"template" description:"Run the schema SQL through Go's text/template"`
+		TemplateVars        string        `long:"template-vars" description:"Give values to the template by the JSON file" value-name:"vars_file"`
+		DryRun              bool          `long:"dry-run" description:"Don't run DDLs but just show them"`
+		Check               bool          `long:"check" description:"Don't run DDLs but exit with 2 showing them if the database doesn't match the schema"`
+		Confirm             bool          `long:"confirm" description:"Ask y/N before running DDLs, which is answered yes when stdin is not a terminal"`
+		Format              string        `long:"format" description:"Format of DDLs shown by --dry-run or --check, which is sql or json" value-name:"format" choice:"sql" choice:"json" default:"sql"`
+		Export              bool          `long:"export" description:"Just dump the current schema to stdout"`
+		OutputDir           string        `long:"output-dir" description:"Export each table, view and other object into a file in the directory by --export" value-name:"dir_name"`
+		PlanOut             string        `long:"plan-out" description:"Don't run DDLs but write them to the file with the fingerprint of the current schema" value-name:"plan_file"`
+		Plan                string        `long:"plan" description:"Run DDLs in the file written by --plan-out unless the database has been changed" value-name:"plan_file"`
+		RollbackOut         string        `long:"rollback-out" description:"Write DDLs to roll back the DDLs which are run to the file" value-name:"sql_file"`
+		MigrationDir        string        `long:"migration-dir" description:"Don't run DDLs but write them to a migration file named by the time in the directory" value-name:"dir_name"`
+		MigrationFormat     string        `long:"migration-format" description:"Format of the migration file by --migration-dir, which is sql, goose or golang-migrate" value-name:"format" default:"sql"`
+		BeforeApply         []string      `long:"before-apply" description:"Run the SQL before DDLs on the same connection, which can be given multiple times" value-name:"sql"`
+		AfterApply          []string      `long:"after-apply" description:"Run the SQL after DDLs on the same connection, which can be given multiple times" value-name:"sql"`
+		LockRetries         int           `long:"lock-retries" description:"Retry a DDL failed by a lock timeout at most the number of times" value-name:"count"`
+		RetryInterval       time.Duration `long:"retry-interval" description:"Wait before the first retry by --lock-retries, which is doubled for each retry" value-name:"duration" default:"1s"`
+		EnableDropTable     bool          `long:"enable-drop-table" description:"Drop tables, views and routines which are not given"`
+		EnableDropColumn    bool          `long:"enable-drop-column" description:"Drop columns which are not given"`
+		CaseInsensitive     bool          `long:"case-insensitive" description:"Compare names of tables, columns and indexes case-insensitively, for lower_case_table_names"`
+		SkipTables          []string      `long:"skip-table" description:"Ignore tables whose names match the regular expression, which can be given multiple times" value-name:"pattern"`
+		TargetTables        []string      `long:"target-table" description:"Manage only tables whose names match the regular expression, which can be given multiple times" value-name:"pattern"`
+		ManageAutoIncrement bool          `long:"manage-auto-increment" description:"Manage AUTO_INCREMENT table option, which is ignored by default"`
+		StrictDisplayWidth  bool          `long:"strict-display-width" description:"Compare display widths of integer types like int(11), which are ignored by default"`
+		ColumnPosition      bool          `long:"column-position" description:"Add columns at the given positions by AFTER or FIRST"`
+		ReorderColumns      bool          `long:"reorder-columns" description:"Move existing columns to the given positions by MODIFY COLUMN as well"`
+		CombineAlterTables  bool          `long:"combine-alter-tables" description:"Combine changes of each table into a single ALTER TABLE"`
+		LockWaitTimeout     uint          `long:"lock-wait-timeout" description:"Set lock_wait_timeout of the session running DDLs, not to block queries by waiting for a metadata lock" value-name:"secs"`
+		Ghost               bool          `long:"gh-ost" description:"Run ALTER TABLE by gh-ost, not to block writes to the table while it's copied"`
+		GhostOptions        []string      `long:"gh-ost-option" description:"Give the option to gh-ost like --allow-on-master, which can be given multiple times" value-name:"option"`
+		PtOsc               bool          `long:"pt-osc" description:"Run ALTER TABLE by pt-online-schema-change, not to block writes to the table while it's copied"`
+		PtOscOptions        []string      `long:"pt-osc-option" description:"Give the option to pt-online-schema-change like --max-load=Threads_running=25, which can be given multiple times" value-name:"option"`
+		LargeTableSize      uint          `long:"large-table-size" description:"Run ALTER TABLE by gh-ost or pt-online-schema-change only for tables whose data and indexes have the megabytes or more" value-name:"mb"`
+		Config              string        `long:"config" description:"Read flags from the YAML file of flag names and values, which are overridden by ones given here" value-name:"config_file"`
+		Help                bool          `long:"help" description:"Show this help"`
 	}
 
 	parser := flags.NewParser(&opts, flags.None)
-	parser.Usage = "[options] db_name"
-	args, err := parser.ParseArgs(args)
+	parser.Usage = "[options] db_name [sql_file]"
+	flagArgs := args
+	args, err := parser.ParseArgs(flagArgs)
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	// Flags in the config file are parsed before the given ones, so that they can be overridden.
+	if opts.Config != "" {
+		configArgs, err := sqldef.ReadConfigFile(opts.Config)
+		if err != nil {
+			log.Fatalf("Failed to read '%s': %s", opts.Config, err)
+		}
+		args, err = parser.ParseArgs(append(configArgs, flagArgs...))
+		if err != nil {
+			log.Fatalf("Failed to parse '%s': %s", opts.Config, err)
+		}
+	}
+
 	if opts.Help {
 		parser.WriteHelp(os.Stdout)
 		os.Exit(0)
 	}
 
+	if opts.Ghost && opts.PtOsc {
+		log.Fatal("--gh-ost and --pt-osc can't be given together")
+	}
+
 	if len(args) == 0 {
 		fmt.Print("No database is specified!\n\n")
 		parser.WriteHelp(os.Stdout)
 		os.Exit(1)
-	} else if len(args) > 1 {
-		fmt.Printf("Multiple databases are given: %v\n\n", args)
+	} else if len(args) > 2 {
+		fmt.Printf("Too many arguments are given: %v\n\n", args)
 		parser.WriteHelp(os.Stdout)
 		os.Exit(1)
 	}
 	database := args[0]
 
+	// The schema file may be given after the database like `mysqldef db_name -`, overriding --file
+	sqlFile := opts.File
+	if len(args) == 2 {
+		sqlFile = args[1]
+	}
+
 	options := sqldef.Options{
-		SqlFile: opts.File,
-		DryRun:  opts.DryRun,
-		Export:  opts.Export,
+		SqlFile:          sqlFile,
+		ExpandEnv:        opts.ExpandEnv,
+		Template:         opts.Template,
+		TemplateVars:     opts.TemplateVars,
+		DryRun:           opts.DryRun,
+		Check:            opts.Check,
+		Confirm:          opts.Confirm,
+		Format:           opts.Format,
+		Export:           opts.Export,
+		OutputDir:        opts.OutputDir,
+		PlanOut:          opts.PlanOut,
+		Plan:             opts.Plan,
+		RollbackOut:      opts.RollbackOut,
+		MigrationDir:     opts.MigrationDir,
+		MigrationFormat:  opts.MigrationFormat,
+		BeforeApply:      opts.BeforeApply,
+		AfterApply:       opts.AfterApply,
+		LockRetries:      opts.LockRetries,
+		RetryInterval:    opts.RetryInterval,
+		EnableDropTable:  opts.EnableDropTable,
+		EnableDropColumn: opts.EnableDropColumn,
+		CaseInsensitive:  opts.CaseInsensitive,
+		SkipTables:       opts.SkipTables,
+		TargetTables:     opts.TargetTables,
+
+		ManageAutoIncrement: opts.ManageAutoIncrement,
+		StrictDisplayWidth:  opts.StrictDisplayWidth,
+		ColumnPosition:      opts.ColumnPosition,
+		ReorderColumns:      opts.ReorderColumns,
+		CombineAlterTables:  opts.CombineAlterTables,
+		LockWaitTimeout:     opts.LockWaitTimeout,
 	}
 
 	password, ok := os.LookupEnv("MYSQL_PWD")
@@ -66,6 +151,13 @@ func parseOptions(args []string) (adapter.Config, *sqldef.Options) {
 		Password: password,
 		Host:     opts.Host,
 		Port:     int(opts.Port),
+
+		DumpAutoIncrement: opts.ManageAutoIncrement,
+		Ghost:             opts.Ghost,
+		GhostOptions:      opts.GhostOptions,
+		PtOsc:             opts.PtOsc,
+		PtOscOptions:      opts.PtOscOptions,
+		LargeTableSize:    int64(opts.LargeTableSize) * 1024 * 1024,
 	}
 	return config, &options
 }
@@ -6,12 +6,16 @@
 package main
 
 import (
+	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"regexp"
 	"strings"
+	"syscall"
 	"testing"
+	"time"
 )
 
 const (
@@ -40,7 +44,12 @@ func TestMysqldefCreateTable(t *testing.T) {
 	assertApplyOutput(t, createTable1+createTable2, applyPrefix+createTable1+createTable2)
 	assertApplyOutput(t, createTable1+createTable2, nothingModified)
 
-	assertApplyOutput(t, createTable1, applyPrefix+"DROP TABLE bigdata;\n")
+	// A table isn't dropped without --enable-drop-table.
+	assertApplyOutput(t, createTable1, nothingModified)
+
+	writeFile("schema.sql", createTable1)
+	actual := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--enable-drop-table")
+	assertEquals(t, actual, applyPrefix+"DROP TABLE bigdata;\n")
 	assertApplyOutput(t, createTable1, nothingModified)
 }
 
@@ -87,6 +96,44 @@ func TestMysqldefCreateTableDropPrimaryKey(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestMysqldefCompositePrimaryKey(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(20)
+		);`,
+	)
+	assertApply(t, createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  tenant_id bigint NOT NULL,
+		  id bigint NOT NULL AUTO_INCREMENT,
+		  name varchar(20),
+		  PRIMARY KEY (tenant_id, id)
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE users ADD COLUMN tenant_id bigint NOT NULL;
+		ALTER TABLE users DROP PRIMARY KEY, ADD PRIMARY KEY(tenant_id, id);
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  tenant_id bigint NOT NULL,
+		  id bigint NOT NULL AUTO_INCREMENT,
+		  name varchar(20),
+		  PRIMARY KEY (id, tenant_id)
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users DROP PRIMARY KEY, ADD PRIMARY KEY(id, tenant_id);\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestMysqldefAddColumn(t *testing.T) {
 	resetTestDatabase()
 
@@ -116,7 +163,12 @@ func TestMysqldefAddColumn(t *testing.T) {
 		  created_at datetime NOT NULL
 		);`,
 	)
-	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users DROP COLUMN name;\n")
+	// A column isn't dropped without --enable-drop-column.
+	assertApplyOutput(t, createTable, "-- Skipped: ALTER TABLE users DROP COLUMN name;\n"+nothingModified)
+
+	writeFile("schema.sql", createTable)
+	actual := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--enable-drop-column")
+	assertEquals(t, actual, applyPrefix+"ALTER TABLE users DROP COLUMN name;\n")
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
@@ -148,6 +200,227 @@ func TestMysqldefChangeColumn(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestMysqldefChangeEnumValues(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  status enum('active', 'inactive') NOT NULL,
+		  tags set('a', 'b')
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  status enum('active', 'inactive', 'banned') NOT NULL,
+		  tags set('a', 'b', 'c')
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE users CHANGE COLUMN status status enum('active', 'inactive', 'banned') NOT NULL;
+		ALTER TABLE users CHANGE COLUMN tags tags set('a', 'b', 'c');
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefZerofill(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  code int(5) ZEROFILL,
+		  score int(3) UNSIGNED NOT NULL
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified) // ZEROFILL implies UNSIGNED
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  code int(5) ZEROFILL,
+		  score int(3) UNSIGNED ZEROFILL NOT NULL
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users CHANGE COLUMN score score int(3) UNSIGNED ZEROFILL NOT NULL;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefDisplayWidth(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint(20) NOT NULL PRIMARY KEY,
+		  age int(11),
+		  active tinyint(1),
+		  name varchar(20)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// Display widths are ignored, while the length of varchar is compared.
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  age int(5),
+		  active tinyint(2),
+		  name varchar(40)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users CHANGE COLUMN name name varchar(40);\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	writeFile("schema.sql", createTable)
+	actual := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--strict-display-width")
+	assertEquals(t, actual, applyPrefix+"ALTER TABLE users CHANGE COLUMN active active tinyint(2);\n")
+}
+
+func TestMysqldefDecimalPrecision(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE items (
+		  id bigint NOT NULL PRIMARY KEY,
+		  price decimal(10,2) NOT NULL,
+		  weight decimal(10)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE items (
+		  id bigint NOT NULL PRIMARY KEY,
+		  price decimal(12,4) NOT NULL,
+		  weight decimal(10,0)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE items CHANGE COLUMN price price decimal(12, 4) NOT NULL;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// An omitted precision is 10.
+	createTable = stripHeredoc(`
+		CREATE TABLE items (
+		  id bigint NOT NULL PRIMARY KEY,
+		  price decimal(12,4) NOT NULL,
+		  weight decimal
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE items (
+		  id bigint NOT NULL PRIMARY KEY,
+		  price decimal(12,4) NOT NULL,
+		  weight decimal(10,2)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE items CHANGE COLUMN weight weight decimal(10, 2);\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefFractionalSeconds(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE events (
+		  id bigint NOT NULL PRIMARY KEY,
+		  started_at datetime(3),
+		  finished_at timestamp(3) NULL,
+		  duration time
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE events (
+		  id bigint NOT NULL PRIMARY KEY,
+		  started_at datetime(6),
+		  finished_at timestamp(3) NULL,
+		  duration time
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE events CHANGE COLUMN started_at started_at datetime(6);\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// An omitted precision is 0.
+	createTable = stripHeredoc(`
+		CREATE TABLE events (
+		  id bigint NOT NULL PRIMARY KEY,
+		  started_at datetime,
+		  finished_at timestamp NULL,
+		  duration time(0)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE events CHANGE COLUMN started_at started_at datetime;
+		ALTER TABLE events CHANGE COLUMN finished_at finished_at timestamp;
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE events (
+		  id bigint NOT NULL PRIMARY KEY,
+		  started_at datetime(6),
+		  finished_at timestamp NULL,
+		  duration time
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE events CHANGE COLUMN started_at started_at datetime(6);\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefBoolean(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  active boolean NOT NULL DEFAULT true,
+		  admin bool DEFAULT FALSE
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified) // dumped as tinyint(1)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  active boolean NOT NULL DEFAULT true,
+		  admin bool DEFAULT FALSE,
+		  verified boolean
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users ADD COLUMN verified tinyint(1);\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestMysqldefAddIndex(t *testing.T) {
 	resetTestDatabase()
 
@@ -225,72 +498,1742 @@ func TestMysqldefCreateTableKey(t *testing.T) {
 	))
 }
 
-func TestMysqldefCreateTableSyntaxError(t *testing.T) {
-	assertApplyFailure(t, "CREATE TABLE users (id bigint,);", `found syntax error when parsing DDL "CREATE TABLE users (id bigint,)": syntax error at position 32`+"\n")
-}
-
-// Both `AUTO_INCREMENT NOT NULL` and `NOT NULL AUTO_INCREMENT` should work
-func TestMysqldefAutoIncrementNotNull(t *testing.T) {
+func TestMysqldefFulltextIndex(t *testing.T) {
 	resetTestDatabase()
-	createTable1 := stripHeredoc(`
-		CREATE TABLE users1 (
-		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY
-		);
-		`,
+
+	createTable := stripHeredoc(`
+		CREATE TABLE posts (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  body text
+		);`,
 	)
-	createTable2 := stripHeredoc(`
-		CREATE TABLE users2 (
-		  id BIGINT UNSIGNED AUTO_INCREMENT NOT NULL PRIMARY KEY
-		);
-		`,
+	assertApply(t, createTable)
+
+	createIndex := "CREATE FULLTEXT INDEX index_body ON posts (body) WITH PARSER ngram;\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+
+	createIndex = "CREATE FULLTEXT INDEX index_body ON posts (body);\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+"ALTER TABLE posts DROP INDEX index_body;\n"+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE posts (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  body text,
+		  FULLTEXT KEY index_body (body) WITH PARSER ngram
+		);`,
 	)
-	assertApplyOutput(t, createTable1+createTable2, applyPrefix+createTable1+createTable2)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE posts DROP INDEX index_body;
+		ALTER TABLE posts ADD fulltext key index_body(body) WITH PARSER ngram;
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
 }
 
-func TestMysqldefColumnLiteral(t *testing.T) {
+func TestMysqldefSpatialIndex(t *testing.T) {
 	resetTestDatabase()
 
-	createTable := "CREATE TABLE users (\n" +
-		"  `id` bigint NOT NULL,\n" +
-		"  `name` text\n" +
-		"  );\n"
-	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	createTable := stripHeredoc(`
+		CREATE TABLE places (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  location point NOT NULL,
+		  KEY index_location(location)
+		);`,
+	)
+	assertApply(t, createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE places (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  location point NOT NULL,
+		  SPATIAL KEY index_location(location)
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE places DROP INDEX index_location;
+		ALTER TABLE places ADD spatial key index_location(location);
+		`,
+	))
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
-func TestMysqldefIntegerBug(t *testing.T) {
+func TestMysqldefSpatialSrid(t *testing.T) {
 	resetTestDatabase()
 
 	createTable := stripHeredoc(`
-		CREATE TABLE users (
-		  charv character(40),
-		  varcharv character varying(40),
-		  intv integer
-		);
-		`,
+		CREATE TABLE places (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  location point NOT NULL SRID 4326,
+		  area geometry
+		);`,
 	)
-	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApply(t, createTable)
 	assertApplyOutput(t, createTable, nothingModified)
-}
 
-//
-// ----------------------- following tests are for CLI -----------------------
-//
+	createTable = stripHeredoc(`
+		CREATE TABLE places (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  location point NOT NULL SRID 4326,
+		  area geometry SRID 0
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE places CHANGE COLUMN area area geometry SRID 0;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
 
-func TestMysqldefDryRun(t *testing.T) {
+func TestMysqldefFunctionalIndex(t *testing.T) {
 	resetTestDatabase()
-	writeFile("schema.sql", stripHeredoc(`
+
+	createTable := stripHeredoc(`
 		CREATE TABLE users (
-		  name varchar(40),
-		  created_at datetime NOT NULL
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  email varchar(255) NOT NULL,
+		  KEY index_email ((lower(email)))
+		);`,
+	)
+	assertApply(t, createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  email varchar(255) NOT NULL,
+		  KEY index_email ((upper(email)))
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE users DROP INDEX index_email;
+		ALTER TABLE users ADD key index_email((upper(email)));
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefRenameTable(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE members (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40)
+		);
+		CREATE TABLE posts (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  user_id BIGINT UNSIGNED NOT NULL,
+		  CONSTRAINT posts_ibfk_1 FOREIGN KEY (user_id) REFERENCES members (id)
+		);`,
+	)
+	assertApply(t, createTable)
+
+	createTable = stripHeredoc(`
+		-- @renamed from=members
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40)
+		);
+		CREATE TABLE posts (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  user_id BIGINT UNSIGNED NOT NULL,
+		  CONSTRAINT posts_ibfk_1 FOREIGN KEY (user_id) REFERENCES users (id)
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE members RENAME TO users;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefRenameColumn(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40),
+		  KEY index_name(name)
+		);`,
+	)
+	assertApply(t, createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  full_name varchar(40), -- @renamed from=name
+		  KEY index_name(full_name)
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users RENAME COLUMN name TO full_name;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	assertApplyFailure(t, stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  full_name varchar(40),
+		  KEY index_name(full_name) -- @renamed from=name
+		);`,
+	), "column 'KEY' to be renamed from 'name' is not found in table 'users': 'KEY index_name(full_name) -- @renamed from=name'\n")
+}
+
+func TestMysqldefRenameIndex(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40),
+		  KEY index_name(name)
+		);`,
+	)
+	assertApply(t, createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40),
+		  KEY index_users_name(name)
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users RENAME INDEX index_name TO index_users_name;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40)
+		);
+		ALTER TABLE users ADD INDEX index_name(name);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users RENAME INDEX index_users_name TO index_name;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefInvisibleColumn(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40) INVISIBLE
+		);`,
+	)
+	assertApply(t, createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40)
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users CHANGE COLUMN name name varchar(40);\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40) INVISIBLE
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users CHANGE COLUMN name name varchar(40) INVISIBLE;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefFunctionDefault(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  created_at datetime NOT NULL DEFAULT NOW()
+		);`,
+	)
+	assertApply(t, createTable)
+
+	// `NOW()` is shown as `CURRENT_TIMESTAMP` by MySQL.
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  created_at datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		  token varchar(36) DEFAULT (UUID())
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users ADD COLUMN token varchar(36) DEFAULT (UUID());\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  created_at datetime NOT NULL,
+		  token varchar(36) DEFAULT (UUID())
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users CHANGE COLUMN created_at created_at datetime NOT NULL;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefColumnPosition(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(20),
+		  age int
+		);`,
+	)
+	assertApply(t, createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  uuid varchar(36),
+		  id bigint NOT NULL,
+		  name varchar(20),
+		  email varchar(255),
+		  age int
+		);`,
+	)
+	writeFile("schema.sql", createTable)
+	actual := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--column-position")
+	assertEquals(t, actual, applyPrefix+
+		"ALTER TABLE users ADD COLUMN uuid varchar(36) FIRST;\n"+
+		"ALTER TABLE users ADD COLUMN email varchar(255) AFTER name;\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// Existing columns are not moved without --reorder-columns.
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  age bigint,
+		  name varchar(20),
+		  email varchar(255),
+		  uuid varchar(36)
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users CHANGE COLUMN age age bigint;\n")
+
+	writeFile("schema.sql", createTable)
+	actual = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--reorder-columns")
+	assertEquals(t, actual, applyPrefix+
+		"ALTER TABLE users MODIFY COLUMN age bigint AFTER id;\n"+
+		"ALTER TABLE users MODIFY COLUMN uuid varchar(36) AFTER email;\n",
+	)
+	actual = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--reorder-columns")
+	assertEquals(t, actual, nothingModified)
+}
+
+func TestMysqldefSchemaDirectory(t *testing.T) {
+	resetTestDatabase()
+
+	if err := os.MkdirAll("schema/tables", 0755); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("schema")
+	writeFile("schema/tables/users.sql", "CREATE TABLE users (\n  id bigint NOT NULL\n)")
+	writeFile("schema/tables/posts.sql", "CREATE TABLE posts (\n  id bigint NOT NULL\n);\n")
+	writeFile("schema/README.md", "Schema of mysqldef_test")
+
+	actual := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema")
+	assertEquals(t, actual, applyPrefix+
+		"CREATE TABLE posts (\n  id bigint NOT NULL\n);\n"+
+		"CREATE TABLE users (\n  id bigint NOT NULL\n);\n",
+	)
+	actual = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema")
+	assertEquals(t, actual, nothingModified)
+}
+
+func TestMysqldefInclude(t *testing.T) {
+	resetTestDatabase()
+
+	if err := os.MkdirAll("shared", 0755); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("shared")
+	writeFile("shared/users.sql", "CREATE TABLE users (\n  id bigint NOT NULL\n)")
+	writeFile("shared/posts.sql", "CREATE TABLE posts (\n  id bigint NOT NULL\n);\n")
+	writeFile("schema.sql", "-- sqldef:include shared/users.sql\n\\i shared/posts.sql\n")
+
+	actual := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql")
+	assertEquals(t, actual, applyPrefix+
+		"CREATE TABLE users (\n  id bigint NOT NULL\n);\n"+
+		"CREATE TABLE posts (\n  id bigint NOT NULL\n);\n",
+	)
+	actual = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql")
+	assertEquals(t, actual, nothingModified)
+
+	writeFile("shared/posts.sql", "-- sqldef:include ../schema.sql\n")
+	_, err := execute("mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql")
+	if err == nil {
+		t.Error("expected an error for the recursive include")
+	}
+}
+
+func TestMysqldefStdin(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := "-- users\nCREATE TABLE users (\n  id bigint NOT NULL\n);\n"
+	cmd := exec.Command("mysqldef", "-uroot", "mysqldef_test", "-")
+	cmd.Stdin = strings.NewReader(createTable)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("failed to execute 'mysqldef -uroot mysqldef_test -' (error: '%s'): `%s`", err, out)
+	}
+	assertEquals(t, string(out), applyPrefix+"CREATE TABLE users (\n  id bigint NOT NULL\n);\n")
+
+	writeFile("schema.sql", createTable)
+	actual := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "schema.sql")
+	assertEquals(t, actual, nothingModified)
+}
+
+func TestMysqldefExpandEnv(t *testing.T) {
+	resetTestDatabase()
+
+	os.Setenv("USERS_COMMENT", "users of staging")
+	defer os.Unsetenv("USERS_COMMENT")
+	writeFile("schema.sql", "CREATE TABLE users (\n  id bigint NOT NULL\n) COMMENT = '${USERS_COMMENT}';\n")
+
+	actual := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--expand-env")
+	assertEquals(t, actual, applyPrefix+"CREATE TABLE users (\n  id bigint NOT NULL\n) COMMENT = 'users of staging';\n")
+	actual = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--expand-env")
+	assertEquals(t, actual, nothingModified)
+
+	writeFile("schema.sql", "CREATE TABLE users (\n  id bigint NOT NULL\n) COMMENT = '${UNDEFINED_COMMENT}';\n")
+	_, err := execute("mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--expand-env")
+	if err == nil {
+		t.Error("expected an error for the unset environment variable")
+	}
+}
+
+func TestMysqldefCombineAlterTables(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(20),
+		  age int,
+		  KEY index_age (age)
+		);
+		CREATE TABLE posts (
+		  id bigint NOT NULL
+		);`,
+	)
+	assertApply(t, createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(40),
+		  email varchar(255),
+		  KEY index_email (email)
+		);
+		CREATE TABLE posts (
+		  id bigint NOT NULL,
+		  title varchar(255)
+		);`,
+	)
+	writeFile("schema.sql", createTable)
+	actual := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--combine-alter-tables", "--enable-drop-column")
+	assertEquals(t, actual, applyPrefix+
+		"ALTER TABLE users CHANGE COLUMN name name varchar(40), ADD COLUMN email varchar(255), ADD key index_email(email), DROP INDEX index_age, DROP COLUMN age;\n"+
+		"ALTER TABLE posts ADD COLUMN title varchar(255);\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefTypeAliases(t *testing.T) {
+	resetTestDatabase()
+
+	// SHOW CREATE TABLE shows them like `int`, `decimal(10,2)`, `double` and `varchar(255)`.
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id int8 NOT NULL,
+		  age integer,
+		  flag int1,
+		  price numeric(10,2),
+		  score double precision,
+		  ratio float8,
+		  name character varying(255)
+		);`,
+	)
+	assertApply(t, createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefLiteralDefault(t *testing.T) {
+	resetTestDatabase()
+
+	// MySQL shows them like `DEFAULT '0'`, `DEFAULT '1.50'` and `DEFAULT NULL`.
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  age int DEFAULT 0,
+		  ratio decimal(5,2) DEFAULT 1.5,
+		  active tinyint(1) DEFAULT TRUE,
+		  name varchar(20),
+		  joined datetime DEFAULT '2020-01-01'
+		);`,
+	)
+	assertApply(t, createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  age int DEFAULT 20,
+		  ratio decimal(5,2) DEFAULT 1.50,
+		  active tinyint(1) DEFAULT 1,
+		  name varchar(20) DEFAULT NULL,
+		  joined datetime
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE users CHANGE COLUMN age age int DEFAULT 20;\n"+
+		"ALTER TABLE users CHANGE COLUMN joined joined datetime;\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefOnUpdateCurrentTimestamp(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  updated_at datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);`,
+	)
+	assertApply(t, createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  updated_at datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users CHANGE COLUMN updated_at updated_at datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// `NOW()` is the same as `CURRENT_TIMESTAMP`.
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  updated_at datetime NOT NULL DEFAULT NOW() ON UPDATE NOW()
+		);`,
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  updated_at datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users CHANGE COLUMN updated_at updated_at datetime NOT NULL DEFAULT CURRENT_TIMESTAMP;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefCurrentTimestampPrecision(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  updated_at datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6)
+		);`,
+	)
+	assertApply(t, createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  updated_at datetime(6) NOT NULL DEFAULT NOW(6) ON UPDATE NOW(6),
+		  created_at datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3)
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users ADD COLUMN created_at datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3);\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefInvisibleIndex(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40),
+		  KEY index_name(name) INVISIBLE
+		);`,
+	)
+	assertApply(t, createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40),
+		  KEY index_name(name)
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users ALTER INDEX index_name VISIBLE;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40)
+		);
+		CREATE INDEX index_name ON users (name) INVISIBLE;`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users ALTER INDEX index_name INVISIBLE;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefIndexPrefixLength(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40),
+		  KEY index_name(name(10))
+		);`,
+	)
+	assertApply(t, createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40),
+		  KEY index_name(name(30))
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE users DROP INDEX index_name;
+		ALTER TABLE users ADD key index_name(name(30));
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40),
+		  KEY index_name(name)
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE users DROP INDEX index_name;
+		ALTER TABLE users ADD key index_name(name);
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefDescendingIndex(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40),
+		  KEY index_name(name DESC, id)
+		);`,
+	)
+	assertApply(t, createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40),
+		  KEY index_name(name, id DESC)
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE users DROP INDEX index_name;
+		ALTER TABLE users ADD key index_name(name, id DESC);
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefCreateTableSyntaxError(t *testing.T) {
+	assertApplyFailure(t, "CREATE TABLE users (id bigint,);", `found syntax error when parsing DDL "CREATE TABLE users (id bigint,)": syntax error at position 32`+"\n")
+}
+
+// Both `AUTO_INCREMENT NOT NULL` and `NOT NULL AUTO_INCREMENT` should work
+func TestMysqldefAutoIncrementNotNull(t *testing.T) {
+	resetTestDatabase()
+	createTable1 := stripHeredoc(`
+		CREATE TABLE users1 (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY
+		);
+		`,
+	)
+	createTable2 := stripHeredoc(`
+		CREATE TABLE users2 (
+		  id BIGINT UNSIGNED AUTO_INCREMENT NOT NULL PRIMARY KEY
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable1+createTable2, applyPrefix+createTable1+createTable2)
+}
+
+func TestMysqldefColumnLiteral(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := "CREATE TABLE users (\n" +
+		"  `id` bigint NOT NULL,\n" +
+		"  `name` text\n" +
+		"  );\n"
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefIntegerBug(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  charv character(40),
+		  varcharv character varying(40),
+		  intv integer
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefSemicolonInString(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(40) DEFAULT 'a;b' -- comment;
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefForeignKey(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id BIGINT PRIMARY KEY);\n"
+	createPosts := stripHeredoc(`
+		CREATE TABLE posts (
+		  id BIGINT,
+		  content text,
+		  user_id BIGINT
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+createUsers+createPosts)
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+
+	createPosts = stripHeredoc(`
+		CREATE TABLE posts (
+		  id BIGINT,
+		  content text,
+		  user_id BIGINT,
+		  CONSTRAINT posts_ibfk_1 FOREIGN KEY (user_id) REFERENCES users (id)
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+"ALTER TABLE posts ADD CONSTRAINT posts_ibfk_1 FOREIGN KEY (user_id) REFERENCES users (id);\n")
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+
+	createPosts = stripHeredoc(`
+		CREATE TABLE posts (
+		  id BIGINT,
+		  content text,
+		  user_id BIGINT,
+		  CONSTRAINT posts_ibfk_1 FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE SET NULL ON UPDATE CASCADE
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+stripHeredoc(`
+		ALTER TABLE posts DROP FOREIGN KEY posts_ibfk_1;
+		ALTER TABLE posts ADD CONSTRAINT posts_ibfk_1 FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE SET NULL ON UPDATE CASCADE;
+		`,
+	))
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+
+	createPosts = stripHeredoc(`
+		CREATE TABLE posts (
+		  id BIGINT,
+		  content text,
+		  user_id BIGINT,
+		  CONSTRAINT posts_ibfk_1 FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE RESTRICT ON UPDATE NO ACTION
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+stripHeredoc(`
+		ALTER TABLE posts DROP FOREIGN KEY posts_ibfk_1;
+		ALTER TABLE posts ADD CONSTRAINT posts_ibfk_1 FOREIGN KEY (user_id) REFERENCES users (id);
+		`,
+	))
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+
+	createPosts = stripHeredoc(`
+		CREATE TABLE posts (
+		  id BIGINT,
+		  content text,
+		  user_id BIGINT,
+		  KEY user_id (user_id)
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+"ALTER TABLE posts DROP FOREIGN KEY posts_ibfk_1;\n")
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+}
+
+func TestMysqldefUnnamedForeignKey(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id BIGINT PRIMARY KEY);\n"
+	createPosts := stripHeredoc(`
+		CREATE TABLE posts (
+		  id BIGINT,
+		  user_id BIGINT,
+		  FOREIGN KEY (user_id) REFERENCES users (id)
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+createUsers+createPosts)
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+}
+
+func TestMysqldefDropTable(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(40) DEFAULT NULL,
+		  KEY index_name (name)
+		);`,
+	)
+	assertApply(t, createTable)
+
+	dropTable := "DROP TABLE IF EXISTS users;\n"
+	assertApplyOutput(t, dropTable+createTable, nothingModified)
+
+	dropIndex := "DROP INDEX index_name ON users;\n"
+	assertApplyOutput(t, createTable+dropIndex, applyPrefix+"ALTER TABLE users DROP INDEX index_name;\n")
+	assertApplyOutput(t, createTable+dropIndex, nothingModified)
+
+	assertApplyOutput(t, createTable+dropTable, applyPrefix+"DROP TABLE users;\n")
+	assertApplyOutput(t, createTable+dropTable, nothingModified)
+}
+
+func TestMysqldefGeneratedColumn(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  first_name varchar(20),
+		  last_name varchar(20),
+		  full_name varchar(41) GENERATED ALWAYS AS (CONCAT(first_name, ' ', last_name)) VIRTUAL
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  first_name varchar(20),
+		  last_name varchar(20),
+		  full_name varchar(41) GENERATED ALWAYS AS (CONCAT(last_name, ' ', first_name)) VIRTUAL
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users CHANGE COLUMN full_name full_name varchar(41) GENERATED ALWAYS AS (concat(last_name, ' ', first_name)) VIRTUAL;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  first_name varchar(20),
+		  last_name varchar(20),
+		  full_name varchar(41) GENERATED ALWAYS AS (CONCAT(last_name, ' ', first_name)) STORED
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE users DROP COLUMN full_name;
+		ALTER TABLE users ADD COLUMN full_name varchar(41) GENERATED ALWAYS AS (concat(last_name, ' ', first_name)) STORED;
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// MySQL shows `->>` as `json_unquote(json_extract(...))` and strings like `_utf8mb4'$.name'`.
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  first_name varchar(20),
+		  last_name varchar(20),
+		  full_name varchar(41) GENERATED ALWAYS AS (CONCAT(last_name, ' ', first_name)) STORED,
+		  profile json,
+		  nickname varchar(20) GENERATED ALWAYS AS (profile->>'$.nickname') VIRTUAL,
+		  tags json GENERATED ALWAYS AS (profile->'$.tags') VIRTUAL
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE users ADD COLUMN profile json;
+		ALTER TABLE users ADD COLUMN nickname varchar(20) GENERATED ALWAYS AS ((profile ->> '$.nickname')) VIRTUAL;
+		ALTER TABLE users ADD COLUMN tags json GENERATED ALWAYS AS ((profile -> '$.tags')) VIRTUAL;
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefSystemVersioning(t *testing.T) {
+	skipUnlessMariaDB(t)
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(40)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+
+	versionedTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(40)
+		) WITH SYSTEM VERSIONING;
+		`,
+	)
+	assertApplyOutput(t, versionedTable, applyPrefix+"ALTER TABLE users ADD SYSTEM VERSIONING;\n")
+	assertApplyOutput(t, versionedTable, nothingModified)
+
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users DROP SYSTEM VERSIONING;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefCheckConstraint(t *testing.T) {
+	resetTestDatabase()
+
+	// MySQL shows strings with their character sets like `_utf8mb4'active'`.
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  age int CHECK (age > 0),
+		  name varchar(40) CHECK (name <> ''),
+		  status varchar(10),
+		  CONSTRAINT users_status_check CHECK (status IN ('active', 'inactive'))
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  age int CHECK (age >= 0) CHECK (age < 200),
+		  name varchar(40) CHECK (name <> ''),
+		  status varchar(10)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE users DROP CHECK users_chk_1;
+		ALTER TABLE users ADD CONSTRAINT users_chk_1 CHECK ((age >= 0));
+		ALTER TABLE users DROP CHECK users_chk_2;
+		ALTER TABLE users ADD CONSTRAINT users_chk_2 CHECK ((age < 200));
+		ALTER TABLE users ADD CONSTRAINT users_chk_3 CHECK ((name != ''));
+		ALTER TABLE users DROP CHECK users_status_check;
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefComment(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL COMMENT 'it''s an id',
+		  name varchar(40)
+		) COMMENT='users';`,
+	)
+	assertApply(t, createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(40) COMMENT 'multi\nline'
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE users CHANGE COLUMN id id bigint NOT NULL;\n"+
+		"ALTER TABLE users CHANGE COLUMN name name varchar(40) COMMENT 'multi\\nline';\n"+
+		"ALTER TABLE users COMMENT = '';\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL COMMENT 'id',
+		  name varchar(40) COMMENT 'multi\nline'
+		) COMMENT 'it''s users';`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE users CHANGE COLUMN id id bigint NOT NULL COMMENT 'id';\n"+
+		"ALTER TABLE users COMMENT = 'it\\'s users';\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// Comments should be exported
+	out := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--export")
+	assertApplyOutput(t, out, nothingModified)
+}
+
+func TestMysqldefTableOptions(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY
+		) ENGINE=InnoDB;
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY
+		) ENGINE=MyISAM ROW_FORMAT=DYNAMIC;
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE users ENGINE = MyISAM;
+		ALTER TABLE users ROW_FORMAT = DYNAMIC;
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// AUTO_INCREMENT is not managed
+	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", "INSERT INTO users VALUES ();")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefCaseInsensitive(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(20),
+		  KEY index_name (name)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  ID bigint NOT NULL,
+		  Name varchar(20),
+		  Age int,
+		  KEY Index_Name (Name)
+		);
+		`,
+	)
+	writeFile("schema.sql", createTable)
+	actual := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--case-insensitive")
+	assertEquals(t, actual, applyPrefix+"ALTER TABLE users ADD COLUMN age int;\n")
+	actual = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--case-insensitive")
+	assertEquals(t, actual, nothingModified)
+}
+
+func TestMysqldefReservedWords(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE ` + "`order`" + ` (
+		  ` + "`group`" + ` int NOT NULL,
+		  ` + "`my col`" + ` varchar(20)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE ` + "`order`" + ` (
+		  ` + "`group`" + ` int NOT NULL,
+		  ` + "`my col`" + ` varchar(40),
+		  ` + "`select`" + ` int,
+		  KEY ` + "`my key`" + ` (` + "`my col`" + `)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE `order` CHANGE COLUMN `my col` `my col` varchar(40);\n"+
+		"ALTER TABLE `order` ADD COLUMN `select` int;\n"+
+		"ALTER TABLE `order` ADD key `my key`(`my col`);\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefCreateTableLike(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40)
+		);
+		CREATE INDEX index_name ON users (name);
+		CREATE TABLE archived_users LIKE users;
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40),
+		  age int
+		);
+		CREATE INDEX index_name ON users (name);
+		CREATE TABLE archived_users LIKE users;
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE users ADD COLUMN age int;
+		ALTER TABLE archived_users ADD COLUMN age int;
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefManageAutoIncrement(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY
+		) AUTO_INCREMENT=100;
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// AUTO_INCREMENT is not exported without --manage-auto-increment.
+	out := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--export")
+	if strings.Contains(out, "AUTO_INCREMENT=") {
+		t.Errorf("expected AUTO_INCREMENT to be stripped, but got: `%s`", out)
+	}
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY
+		) AUTO_INCREMENT=200;
+		`,
+	)
+	writeFile("schema.sql", createTable)
+	actual := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--manage-auto-increment")
+	assertEquals(t, actual, applyPrefix+"ALTER TABLE users AUTO_INCREMENT = 200;\n")
+	actual = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--manage-auto-increment")
+	assertEquals(t, actual, nothingModified)
+
+	out = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--export", "--manage-auto-increment")
+	if !strings.Contains(out, "AUTO_INCREMENT=200") {
+		t.Errorf("expected AUTO_INCREMENT=200 to be exported, but got: `%s`", out)
+	}
+}
+
+func TestMysqldefDefaultCharset(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY
+		) DEFAULT CHARSET=latin1;
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY
+		) DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// The default collation of the charset is not shown by SHOW CREATE TABLE
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY
+		) DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefColumnCharsetAndCollation(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(40) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin,
+		  nickname varchar(40)
+		) DEFAULT CHARSET=latin1;
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(40) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci,
+		  nickname varchar(40) COLLATE latin1_bin
+		) DEFAULT CHARSET=latin1;
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE users CHANGE COLUMN name name varchar(40) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci;
+		ALTER TABLE users CHANGE COLUMN nickname nickname varchar(40) COLLATE latin1_bin;
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// Columns without charset follow the table's default
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(40),
+		  nickname varchar(40)
+		) DEFAULT CHARSET=utf8mb4;
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE users DEFAULT CHARACTER SET utf8mb4;
+		ALTER TABLE users CHANGE COLUMN nickname nickname varchar(40);
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefPartition(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE logs (
+		  id bigint NOT NULL,
+		  created_at date NOT NULL
+		) PARTITION BY RANGE (YEAR(created_at)) (PARTITION p0 VALUES LESS THAN (2010), PARTITION p1 VALUES LESS THAN MAXVALUE);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE logs (
+		  id bigint NOT NULL,
+		  created_at date NOT NULL
+		) PARTITION BY HASH (id) PARTITIONS 4;
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE logs PARTITION BY HASH (id) PARTITIONS 4;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE logs (
+		  id bigint NOT NULL,
+		  created_at date NOT NULL
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE logs REMOVE PARTITIONING;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefAddForeignKey(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id BIGINT PRIMARY KEY);\n"
+	createPosts := stripHeredoc(`
+		CREATE TABLE posts (
+		  id BIGINT,
+		  user_id BIGINT
+		);
+		`,
+	)
+	addForeignKey := "ALTER TABLE posts ADD FOREIGN KEY (user_id) REFERENCES users (id);\n"
+	assertApplyOutput(t, createUsers+createPosts+addForeignKey, applyPrefix+createUsers+createPosts+addForeignKey)
+	assertApplyOutput(t, createUsers+createPosts+addForeignKey, nothingModified)
+
+	// An exported schema should be applied as is
+	out := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--export")
+	assertApplyOutput(t, out, nothingModified)
+
+	writeFile("schema.sql", "")
+	actual := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--enable-drop-table")
+	assertEquals(t, actual, applyPrefix+stripHeredoc(`
+		ALTER TABLE posts DROP FOREIGN KEY posts_ibfk_1;
+		DROP TABLE posts;
+		DROP TABLE users;
+		`,
+	))
+}
+
+func TestMysqldefUniqueConstraint(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  name varchar(40),
+		  email varchar(40),
+		  CONSTRAINT users_name_email_uq UNIQUE (name, email),
+		  UNIQUE (email)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  name varchar(40),
+		  email varchar(40),
+		  CONSTRAINT users_name_email_uq UNIQUE (email, name)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE users DROP INDEX users_name_email_uq;
+		ALTER TABLE users ADD CONSTRAINT users_name_email_uq UNIQUE (email, name);
+		ALTER TABLE users DROP INDEX email;
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefView(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(40)
+		);
+		`,
+	)
+	createView := stripHeredoc(`
+		CREATE VIEW user_names AS SELECT id, name FROM users WHERE id > 1;
+		`,
+	)
+	assertApplyOutput(t, createTable+createView, applyPrefix+createTable+createView)
+	assertApplyOutput(t, createTable+createView, nothingModified)
+
+	createView = stripHeredoc(`
+		CREATE VIEW user_names AS SELECT id FROM users WHERE id > 1;
+		`,
+	)
+	assertApplyOutput(t, createTable+createView, applyPrefix+"CREATE OR REPLACE VIEW user_names AS select id from users where (id > 1);\n")
+	assertApplyOutput(t, createTable+createView, nothingModified)
+
+	// A view isn't dropped without --enable-drop-table.
+	assertApplyOutput(t, createTable, "-- Skipped: DROP VIEW user_names;\n"+nothingModified)
+
+	writeFile("schema.sql", createTable)
+	actual := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--enable-drop-table")
+	assertEquals(t, actual, applyPrefix+"DROP VIEW user_names;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefTrigger(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(40),
+		  updated_at datetime
+		);
+		`,
+	)
+	createTrigger := stripHeredoc(`
+		CREATE TRIGGER users_updated_at BEFORE UPDATE ON users FOR EACH ROW SET NEW.updated_at = NOW();
+		`,
+	)
+	assertApplyOutput(t, createTable+createTrigger, applyPrefix+createTable+createTrigger)
+	assertApplyOutput(t, createTable+createTrigger, nothingModified)
+
+	createTrigger = stripHeredoc(`
+		CREATE TRIGGER users_updated_at BEFORE UPDATE ON users FOR EACH ROW BEGIN
+		  SET NEW.updated_at = NOW();
+		  IF NEW.name = '' THEN
+		    SET NEW.name = NULL;
+		  END IF;
+		END;
+		`,
+	)
+	assertApplyOutput(t, createTable+createTrigger, applyPrefix+"DROP TRIGGER users_updated_at;\n"+createTrigger)
+	assertApplyOutput(t, createTable+createTrigger, nothingModified)
+
+	assertApplyOutput(t, createTable, applyPrefix+"DROP TRIGGER users_updated_at;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefReservedWordTrigger(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE ` + "`order`" + ` (
+		  id bigint NOT NULL PRIMARY KEY,
+		  updated_at datetime
+		);
+		`,
+	)
+	createTrigger := "CREATE TRIGGER `trigger` BEFORE UPDATE ON `order` FOR EACH ROW SET NEW.updated_at = NOW();\n"
+	assertApplyOutput(t, createTable+createTrigger, applyPrefix+createTable+createTrigger)
+	assertApplyOutput(t, createTable+createTrigger, nothingModified)
+
+	assertApplyOutput(t, createTable, applyPrefix+"DROP TRIGGER `trigger`;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefRoutine(t *testing.T) {
+	resetTestDatabase()
+
+	createFunction := stripHeredoc(`
+		CREATE FUNCTION add_numbers(a int, b int) RETURNS int DETERMINISTIC RETURN a + b;
+		`,
+	)
+	createProcedure := stripHeredoc(`
+		CREATE PROCEDURE reset_names(IN prefix varchar(10)) BEGIN
+		  IF prefix IS NULL THEN
+		    SET prefix = '';
+		  END IF;
+		  SELECT prefix;
+		END;
+		`,
+	)
+	assertApplyOutput(t, createFunction+createProcedure, applyPrefix+createFunction+createProcedure)
+	assertApplyOutput(t, createFunction+createProcedure, nothingModified)
+
+	createFunction = stripHeredoc(`
+		CREATE FUNCTION add_numbers(a int, b int) RETURNS int DETERMINISTIC RETURN a + b + 1;
+		`,
+	)
+	assertApplyOutput(t, createFunction+createProcedure, applyPrefix+"DROP FUNCTION add_numbers;\n"+createFunction)
+	assertApplyOutput(t, createFunction+createProcedure, nothingModified)
+
+	// A routine isn't dropped without --enable-drop-table.
+	assertApplyOutput(t, createFunction, "-- Skipped: DROP PROCEDURE reset_names;\n"+nothingModified)
+
+	writeFile("schema.sql", createFunction)
+	actual := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--enable-drop-table")
+	assertEquals(t, actual, applyPrefix+"DROP PROCEDURE reset_names;\n")
+	assertApplyOutput(t, createFunction, nothingModified)
+}
+
+//
+// ----------------------- following tests are for CLI -----------------------
+//
+
+func TestMysqldefDryRun(t *testing.T) {
+	resetTestDatabase()
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  name varchar(40),
+		  created_at datetime NOT NULL
 		);`,
 	))
 
 	dryRun := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--dry-run", "--file", "schema.sql")
+	assertEquals(t, assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--dry-run", "--file", "schema.sql"), dryRun) // not modified by the first one
 	apply := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql")
 	assertEquals(t, dryRun, strings.Replace(apply, "Apply", "dry run", 1))
 }
 
+func TestMysqldefCheck(t *testing.T) {
+	resetTestDatabase()
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL
+		);`,
+	))
+
+	out, err := execute("mysqldef", "-uroot", "mysqldef_test", "--check", "--file", "schema.sql")
+	if exitErr, ok := err.(*exec.ExitError); !ok || exitErr.Sys().(syscall.WaitStatus).ExitStatus() != 2 {
+		t.Errorf("expected exit status 2 for a different schema but got: %v", err)
+	}
+	assertEquals(t, out, "-- Schema is different --\nCREATE TABLE users (\n  id bigint NOT NULL\n);\n")
+
+	assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql")
+	out = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--check", "--file", "schema.sql")
+	assertEquals(t, out, nothingModified)
+}
+
+func TestMysqldefFormatJSON(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", "CREATE TABLE users (id bigint NOT NULL, name varchar(40));")
+	writeFile("schema.sql", "CREATE TABLE users (\n  id bigint NOT NULL\n);\n")
+
+	out := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--dry-run", "--format", "json", "--file", "schema.sql", "--enable-drop-column")
+	assertEquals(t, out, stripHeredoc(`
+		[
+		  {
+		    "statement": "ALTER TABLE users DROP COLUMN name",
+		    "kind": "alter_table",
+		    "table": "users",
+		    "destructive": true
+		  }
+		]
+		`,
+	))
+
+	out = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--dry-run", "--format", "json", "--file", "schema.sql")
+	assertEquals(t, out, stripHeredoc(`
+		[
+		  {
+		    "statement": "ALTER TABLE users DROP COLUMN name",
+		    "kind": "alter_table",
+		    "table": "users",
+		    "destructive": true,
+		    "skipped": true
+		  }
+		]
+		`,
+	))
+}
+
+func TestMysqldefPlan(t *testing.T) {
+	resetTestDatabase()
+	defer os.Remove("plan.json")
+	writeFile("schema.sql", "CREATE TABLE users (\n  id bigint NOT NULL\n);\n")
+
+	out := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--plan-out", "plan.json", "--file", "schema.sql")
+	assertEquals(t, out, "-- Plan is written to 'plan.json' --\nCREATE TABLE users (\n  id bigint NOT NULL\n);\n")
+	out = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--plan", "plan.json")
+	assertEquals(t, out, applyPrefix+"CREATE TABLE users (\n  id bigint NOT NULL\n);\n")
+
+	// The plan is for the database before CREATE TABLE
+	_, err := execute("mysqldef", "-uroot", "mysqldef_test", "--plan", "plan.json")
+	if err == nil {
+		t.Error("expected an error for the plan of the changed database")
+	}
+
+	// A table skipped by --skip-table is also fingerprinted
+	writeFile("schema.sql", "CREATE TABLE users (\n  id bigint NOT NULL,\n  name varchar(40)\n);\n")
+	assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--plan-out", "plan.json", "--skip-table", "logs", "--file", "schema.sql")
+	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", "CREATE TABLE logs (id bigint NOT NULL);")
+	_, err = execute("mysqldef", "-uroot", "mysqldef_test", "--plan", "plan.json", "--skip-table", "logs")
+	if err == nil {
+		t.Error("expected an error for the plan of the database whose skipped table is changed")
+	}
+}
+
+func TestMysqldefRollbackOut(t *testing.T) {
+	resetTestDatabase()
+	defer os.Remove("rollback.sql")
+	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", "CREATE TABLE users (id bigint NOT NULL);")
+	before := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--export")
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(40)
+		);
+		CREATE TABLE posts (
+		  id bigint NOT NULL
+		);`,
+	))
+	assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--rollback-out", "rollback.sql")
+
+	rollback, err := ioutil.ReadFile("rollback.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEquals(t, string(rollback), "ALTER TABLE users DROP COLUMN name;\nDROP TABLE posts;\n")
+	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", string(rollback))
+	assertEquals(t, assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--export"), before)
+}
+
+func TestMysqldefMigrationDir(t *testing.T) {
+	resetTestDatabase()
+	defer os.RemoveAll("migrations")
+	writeFile("schema.sql", "CREATE TABLE users (\n  id bigint NOT NULL\n);\n")
+
+	assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--migration-dir", "migrations", "--migration-format", "goose")
+	paths, err := filepath.Glob("migrations/*_sqldef.sql")
+	if err != nil || len(paths) != 1 {
+		t.Fatalf("expected a migration file but got: %v (%v)", paths, err)
+	}
+	migration, err := ioutil.ReadFile(paths[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEquals(t, string(migration), "-- +goose Up\nCREATE TABLE users (\n  id bigint NOT NULL\n);\n")
+
+	// The database is not modified
+	out := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--export")
+	assertEquals(t, out, "-- No table exists --\n")
+}
+
+func TestMysqldefConfirm(t *testing.T) {
+	resetTestDatabase()
+	writeFile("schema.sql", "CREATE TABLE users (\n  id bigint NOT NULL\n);\n")
+
+	// stdin is piped, so it's answered yes.
+	cmd := exec.Command("mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--confirm")
+	cmd.Stdin = strings.NewReader("")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("failed to execute 'mysqldef --confirm' (error: '%s'): `%s`", err, out)
+	}
+	assertEquals(t, string(out), "-- Confirm --\nCREATE TABLE users (\n  id bigint NOT NULL\n);\n"+applyPrefix+"CREATE TABLE users (\n  id bigint NOT NULL\n);\n")
+}
+
+func TestMysqldefSkipTable(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", "CREATE TABLE schema_migrations (version varchar(255) NOT NULL);")
+	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", "CREATE TABLE awsdms_status (id bigint NOT NULL);")
+	writeFile("schema.sql", "CREATE TABLE users (\n  id bigint NOT NULL\n);\n")
+
+	out := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--enable-drop-table",
+		"--skip-table", "schema_migrations", "--skip-table", "awsdms_.*")
+	assertEquals(t, out, applyPrefix+"CREATE TABLE users (\n  id bigint NOT NULL\n);\n")
+
+	out = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--export", "--skip-table", "schema_migrations", "--skip-table", "awsdms_.*")
+	assertEquals(t, out, "CREATE TABLE `users` (\n  `id` bigint(20) NOT NULL\n) ENGINE=InnoDB DEFAULT CHARSET=latin1 COLLATE=latin1_swedish_ci;\n")
+
+	// A pattern is matched with the whole table name.
+	out = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--enable-drop-table", "--dry-run", "--skip-table", "awsdms")
+	assertEquals(t, out, "-- dry run --\nDROP TABLE awsdms_status;\nDROP TABLE schema_migrations;\n")
+}
+
+func TestMysqldefConfig(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", "CREATE TABLE schema_migrations (version varchar(255) NOT NULL);")
+	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", "CREATE TABLE posts (id bigint NOT NULL);")
+	writeFile("schema.sql", "CREATE TABLE users (\n  id bigint NOT NULL\n);\n")
+	writeFile("sqldef.yml", stripHeredoc(`
+		# Flags shared by environments
+		user: root
+		file: schema.sql
+		enable-drop-table: true
+		skip-table:
+		  - schema_migrations
+		`,
+	))
+
+	// --dry-run is given in addition to ones in the file.
+	out := assertedExecute(t, "mysqldef", "--config", "sqldef.yml", "mysqldef_test", "--dry-run")
+	assertEquals(t, out, "-- dry run --\nCREATE TABLE users (\n  id bigint NOT NULL\n);\nDROP TABLE posts;\n")
+
+	writeFile("sqldef.yml", "user: root\nenable-drop-table: [true]\n")
+	_, err := execute("mysqldef", "--config", "sqldef.yml", "mysqldef_test")
+	if err == nil {
+		t.Error("expected an error for a list given to a boolean flag")
+	}
+}
+
+func TestMysqldefApplyHooks(t *testing.T) {
+	resetTestDatabase()
+	writeFile("schema.sql", "CREATE TABLE users (\n  id bigint NOT NULL\n);\n")
+
+	out := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql",
+		"--before-apply", "SET SESSION lock_wait_timeout = 5;", "--after-apply", "ANALYZE TABLE users")
+	assertEquals(t, out, applyPrefix+"SET SESSION lock_wait_timeout = 5;\nCREATE TABLE users (\n  id bigint NOT NULL\n);\nANALYZE TABLE users;\n")
+
+	// Hooks are not run when nothing is modified.
+	out = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql",
+		"--before-apply", "SET SESSION lock_wait_timeout = 5", "--after-apply", "ANALYZE TABLE users")
+	assertEquals(t, out, nothingModified)
+}
+
+func TestMysqldefLockWaitTimeout(t *testing.T) {
+	resetTestDatabase()
+	writeFile("schema.sql", "CREATE TABLE users (\n  id bigint NOT NULL\n);\n")
+
+	out := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--lock-wait-timeout", "5")
+	assertEquals(t, out, applyPrefix+"SET SESSION lock_wait_timeout = 5;\nCREATE TABLE users (\n  id bigint NOT NULL\n);\n")
+}
+
+func TestMysqldefLockRetries(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", "CREATE TABLE users (id bigint NOT NULL);")
+	writeFile("schema.sql", "CREATE TABLE users (\n  id bigint NOT NULL,\n  name varchar(40)\n);\n")
+
+	// ALTER TABLE waits for the metadata lock of users until the other session releases it.
+	lock := exec.Command("mysql", "-uroot", "mysqldef_test", "-e", "LOCK TABLES users WRITE; SELECT SLEEP(2);")
+	if err := lock.Start(); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(500 * time.Millisecond)
+
+	out := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql",
+		"--lock-wait-timeout", "1", "--lock-retries", "3", "--retry-interval", "100ms")
+	if !strings.HasPrefix(out, applyPrefix+"SET SESSION lock_wait_timeout = 1;\nALTER TABLE users ADD COLUMN name varchar(40);\n-- Retrying in 100ms: ") {
+		t.Errorf("expected ALTER TABLE to be retried, but got: %s", out)
+	}
+	lock.Wait()
+
+	out = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql")
+	assertEquals(t, out, nothingModified)
+}
+
+func TestMysqldefGhost(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", "CREATE TABLE users (id bigint NOT NULL);")
+	writeFile("schema.sql", "CREATE TABLE users (\n  id bigint NOT NULL,\n  name varchar(40)\n);\n")
+	defer fakeCommand(t, "gh-ost")()
+
+	out := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--gh-ost", "--gh-ost-option=--allow-on-master")
+	assertEquals(t, out, applyPrefix+"ALTER TABLE users ADD COLUMN name varchar(40);\n"+
+		"-- Running gh-ost for users --\n"+
+		"gh-ost --host 127.0.0.1 --port 3306 --user root --database mysqldef_test --table users --alter ADD COLUMN name varchar(40) --allow-on-master --execute\n",
+	)
+
+	// users is smaller than 1MB, which is altered without gh-ost.
+	out = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--gh-ost", "--large-table-size", "1")
+	assertEquals(t, out, applyPrefix+"ALTER TABLE users ADD COLUMN name varchar(40);\n")
+	out = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--gh-ost")
+	assertEquals(t, out, nothingModified)
+
+	// Renames are run without gh-ost, which rejects renaming tables.
+	writeFile("schema.sql", "CREATE TABLE users (\n  id bigint NOT NULL,\n  nickname varchar(40) -- @renamed from=name\n);\n")
+	out = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--gh-ost")
+	assertEquals(t, out, applyPrefix+"ALTER TABLE users RENAME COLUMN name TO nickname;\n")
+}
+
+func TestMysqldefPtOsc(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", "CREATE TABLE users (id bigint NOT NULL);")
+	writeFile("schema.sql", "CREATE TABLE users (\n  id bigint NOT NULL,\n  name varchar(40)\n);\n")
+	defer fakeCommand(t, "pt-online-schema-change")()
+
+	out := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--pt-osc", "--pt-osc-option=--max-load=Threads_running=25")
+	assertEquals(t, out, applyPrefix+"ALTER TABLE users ADD COLUMN name varchar(40);\n"+
+		"-- Running pt-online-schema-change for users --\n"+
+		"pt-online-schema-change --host 127.0.0.1 --port 3306 --user root --alter ADD COLUMN name varchar(40) --max-load=Threads_running=25 --execute D=mysqldef_test,t=users\n",
+	)
+
+	// pt-online-schema-change rejects renaming tables as well.
+	writeFile("schema.sql", "-- @renamed from=users\nCREATE TABLE members (\n  id bigint NOT NULL\n);\n")
+	out = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--pt-osc")
+	assertEquals(t, out, applyPrefix+"ALTER TABLE users RENAME TO members;\n")
+
+	if out, err := execute("mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql", "--gh-ost", "--pt-osc"); err == nil {
+		t.Errorf("expected --gh-ost and --pt-osc to fail together, but got: %s", out)
+	}
+}
+
 func TestMysqldefExport(t *testing.T) {
 	resetTestDatabase()
 	out := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--export")
@@ -307,8 +2250,63 @@ func TestMysqldefExport(t *testing.T) {
 		"CREATE TABLE `users` (\n"+
 			"  `name` varchar(40) DEFAULT NULL,\n"+
 			"  `created_at` datetime NOT NULL\n"+
-			") ENGINE=InnoDB DEFAULT CHARSET=latin1;\n",
+			") ENGINE=InnoDB DEFAULT CHARSET=latin1 COLLATE=latin1_swedish_ci;\n",
+	)
+}
+
+func TestMysqldefExportReapply(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(40) COMMENT 'display name',
+		  UNIQUE KEY index_name (name)
+		) ROW_FORMAT=DYNAMIC COMMENT='registered users';
+		CREATE TABLE `+"`order`"+` (
+		  id bigint NOT NULL PRIMARY KEY,
+		  user_id bigint NOT NULL,
+		  KEY index_user_id (user_id),
+		  CONSTRAINT order_ibfk_1 FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+		);`,
+	))
+	writeFile("schema.sql", assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--export"))
+	actual := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql")
+	assertEquals(t, actual, nothingModified)
+}
+
+func TestMysqldefExportOutputDir(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL
+		);
+		CREATE VIEW user_ids AS SELECT id FROM users;`,
+	))
+	defer os.RemoveAll("schema")
+	if err := os.MkdirAll("schema/7_tables", 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeFile("schema/7_tables/dropped.sql", "CREATE TABLE dropped (id bigint);\n")
+
+	out := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--export", "--output-dir", "schema")
+	assertEquals(t, out, "schema/7_tables/users.sql\nschema/8_views/user_ids.sql\n")
+	if _, err := os.Stat("schema/7_tables/dropped.sql"); !os.IsNotExist(err) {
+		t.Errorf("expected a stale file to be removed: %v", err)
+	}
+	users, err := ioutil.ReadFile("schema/7_tables/users.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEquals(t, string(users),
+		"CREATE TABLE `users` (\n"+
+			"  `id` bigint(20) NOT NULL\n"+
+			") ENGINE=InnoDB DEFAULT CHARSET=latin1 COLLATE=latin1_swedish_ci;\n",
 	)
+
+	actual := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema")
+	assertEquals(t, actual, nothingModified)
 }
 
 func TestMysqldefHelp(t *testing.T) {
@@ -379,11 +2377,35 @@ func execute(command string, args ...string) (string, error) {
 	return string(out), err
 }
 
+// Skip tests of MariaDB-only features like system versioning on MySQL.
+func skipUnlessMariaDB(t *testing.T) {
+	out, err := execute("mysql", "-uroot", "-N", "-e", "SELECT VERSION();")
+	if err != nil || !strings.Contains(out, "MariaDB") {
+		t.Skip("MariaDB is required")
+	}
+}
+
 func resetTestDatabase() {
 	mustExecute("mysql", "-uroot", "-e", "DROP DATABASE IF EXISTS mysqldef_test;")
 	mustExecute("mysql", "-uroot", "-e", "CREATE DATABASE mysqldef_test;")
 }
 
+// Put a fake command, which shows how it's run, ahead in PATH. The returned function restores PATH.
+func fakeCommand(t *testing.T, name string) func() {
+	dir, err := ioutil.TempDir("", "mysqldef")
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(filepath.Join(dir, name), "#!/bin/sh\necho "+name+" \"$@\"\n")
+	os.Chmod(filepath.Join(dir, name), 0755)
+	path := os.Getenv("PATH")
+	os.Setenv("PATH", dir+string(os.PathListSeparator)+path)
+	return func() {
+		os.Setenv("PATH", path)
+		os.RemoveAll(dir)
+	}
+}
+
 func writeFile(path string, content string) {
 	file, err := os.Create(path)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/jessevdk/go-flags"
 	"github.com/k0kubun/sqldef"
@@ -16,23 +17,66 @@ import (
 // TODO: Support `sqldef schema.sql -opt val...`
 func parseOptions(args []string) (adapter.Config, *sqldef.Options) {
 	var opts struct {
-		User     string `short:"U" long:"user" description:"PostgreSQL user name" value-name:"username" default:"postgres"`
-		Password string `short:"W" long:"password" description:"PostgreSQL user password, overridden by $PGPASS" value-name:"password"`
-		Host     string `short:"h" long:"host" description:"Host to connect to the PostgreSQL server" value-name:"hostname" default:"127.0.0.1"`
-		Port     uint   `short:"p" long:"port" description:"Port used for the connection" value-name:"port" default:"5432"`
-		File     string `short:"f" long:"file" description:"Read schema SQL from the file, rather than stdin" value-name:"filename" default:"-"`
-		DryRun   bool   `long:"dry-run" description:"Don't run DDLs but just show them"`
-		Export   bool   `long:"export" description:"Just dump the current schema to stdout"`
-		Help     bool   `long:"help" description:"Show this help"`
+		User                      string        `short:"U" long:"user" description:"PostgreSQL user name" value-name:"username" default:"postgres"`
+		Password                  string        `short:"W" long:"password" description:"PostgreSQL user password, overridden by $PGPASS" value-name:"password"`
+		Host                      string        `short:"h" long:"host" description:"Host to connect to the PostgreSQL server" value-name:"hostname" default:"127.0.0.1"`
+		Port                      uint          `short:"p" long:"port" description:"Port used for the connection" value-name:"port" default:"5432"`
+		File                      string        `short:"f" long:"file" description:"Read schema SQL from the file, or *.sql files in the directory, rather than stdin" value-name:"filename" default:"-"`
+		ExpandEnv                 bool          `long:"expand-env" description:"Replace ${VAR} in the schema SQL with the environment variable"`
+		Template                  bool          `long:"template" description:"Run the schema SQL through Go's text/template"`
+		TemplateVars              string        `long:"template-vars" description:"Give values to the template by the JSON file" value-name:"filename"`
+		DryRun                    bool          `long:"dry-run" description:"Don't run DDLs but just show them"`
+		Check                     bool          `long:"check" description:"Don't run DDLs but exit with 2 showing them if the database doesn't match the schema"`
+		Confirm                   bool          `long:"confirm" description:"Ask y/N before running DDLs, which is answered yes when stdin is not a terminal"`
+		Format                    string        `long:"format" description:"Format of DDLs shown by --dry-run or --check, which is sql or json" value-name:"format" choice:"sql" choice:"json" default:"sql"`
+		Export                    bool          `long:"export" description:"Just dump the current schema to stdout"`
+		OutputDir                 string        `long:"output-dir" description:"Export each table, view and other object into a file in the directory by --export" value-name:"dir_name"`
+		PlanOut                   string        `long:"plan-out" description:"Don't run DDLs but write them to the file with the fingerprint of the current schema" value-name:"plan_file"`
+		Plan                      string        `long:"plan" description:"Run DDLs in the file written by --plan-out unless the database has been changed" value-name:"plan_file"`
+		RollbackOut               string        `long:"rollback-out" description:"Write DDLs to roll back the DDLs which are run to the file" value-name:"sql_file"`
+		MigrationDir              string        `long:"migration-dir" description:"Don't run DDLs but write them to a migration file named by the time in the directory" value-name:"dir_name"`
+		MigrationFormat           string        `long:"migration-format" description:"Format of the migration file by --migration-dir, which is sql, goose or golang-migrate" value-name:"format" default:"sql"`
+		BeforeApply               []string      `long:"before-apply" description:"Run the SQL before DDLs on the same connection, which can be given multiple times" value-name:"sql"`
+		AfterApply                []string      `long:"after-apply" description:"Run the SQL after DDLs on the same connection, which can be given multiple times" value-name:"sql"`
+		LockRetries               int           `long:"lock-retries" description:"Retry a DDL failed by a lock timeout at most the number of times" value-name:"count"`
+		RetryInterval             time.Duration `long:"retry-interval" description:"Wait before the first retry by --lock-retries, which is doubled for each retry" value-name:"duration" default:"1s"`
+		EnableDropTable           bool          `long:"enable-drop-table" description:"Drop tables, views, functions, sequences, types, domains and schemas which are not given"`
+		EnableDropColumn          bool          `long:"enable-drop-column" description:"Drop columns which are not given"`
+		CaseInsensitive           bool          `long:"case-insensitive" description:"Fold unquoted identifiers to lowercase as PostgreSQL does, while quoted ones are case-sensitive"`
+		SkipTables                []string      `long:"skip-table" description:"Ignore tables whose names match the regular expression, which can be given multiple times" value-name:"pattern"`
+		TargetTables              []string      `long:"target-table" description:"Manage only tables whose names match the regular expression, which can be given multiple times" value-name:"pattern"`
+		RecreateMaterializedViews bool          `long:"recreate-materialized-views" description:"Drop and create materialized views to change them"`
+		RefreshMaterializedViews  bool          `long:"refresh-materialized-views" description:"Refresh materialized views created by DDLs"`
+		DropExtensions            bool          `long:"drop-extensions" description:"Drop extensions which are not given"`
+		ManagePrivileges          bool          `long:"manage-privileges" description:"Grant and revoke privileges of tables and sequences as given"`
+		ManageForeignData         bool          `long:"manage-foreign-data" description:"Create, alter and drop foreign servers, user mappings and foreign tables as given"`
+		NoTransaction             bool          `long:"no-transaction" description:"Run DDLs without a transaction, which keeps DDLs run before a failure"`
+		LockTimeout               string        `long:"lock-timeout" description:"Set lock_timeout of the session running DDLs like 5s, not to block queries by waiting for a lock" value-name:"duration"`
+		StatementTimeout          string        `long:"statement-timeout" description:"Set statement_timeout of the session running DDLs like 1min" value-name:"duration"`
+		Config                    string        `long:"config" description:"Read flags from the YAML file of flag names and values, which are overridden by ones given here" value-name:"config_file"`
+		Help                      bool          `long:"help" description:"Show this help"`
 	}
 
 	parser := flags.NewParser(&opts, flags.None)
-	parser.Usage = "[option...] db_name"
-	args, err := parser.ParseArgs(args)
+	parser.Usage = "[option...] db_name [sql_file]"
+	flagArgs := args
+	args, err := parser.ParseArgs(flagArgs)
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	// Flags in the config file are parsed before the given ones, so that they can be overridden.
+	if opts.Config != "" {
+		configArgs, err := sqldef.ReadConfigFile(opts.Config)
+		if err != nil {
+			log.Fatalf("Failed to read '%s': %s", opts.Config, err)
+		}
+		args, err = parser.ParseArgs(append(configArgs, flagArgs...))
+		if err != nil {
+			log.Fatalf("Failed to parse '%s': %s", opts.Config, err)
+		}
+	}
+
 	if opts.Help {
 		parser.WriteHelp(os.Stdout)
 		os.Exit(0)
@@ -42,17 +86,53 @@ func parseOptions(args []string) (adapter.Config, *sqldef.Options) {
 		fmt.Print("No database is specified!\n\n")
 		parser.WriteHelp(os.Stdout)
 		os.Exit(1)
-	} else if len(args) > 1 {
-		fmt.Printf("Multiple databases are given: %v\n\n", args)
+	} else if len(args) > 2 {
+		fmt.Printf("Too many arguments are given: %v\n\n", args)
 		parser.WriteHelp(os.Stdout)
 		os.Exit(1)
 	}
 	database := args[0]
 
+	// The schema file may be given after the database like `psqldef db_name -`, overriding --file
+	sqlFile := opts.File
+	if len(args) == 2 {
+		sqlFile = args[1]
+	}
+
 	options := sqldef.Options{
-		SqlFile: opts.File,
-		DryRun:  opts.DryRun,
-		Export:  opts.Export,
+		SqlFile:          sqlFile,
+		ExpandEnv:        opts.ExpandEnv,
+		Template:         opts.Template,
+		TemplateVars:     opts.TemplateVars,
+		DryRun:           opts.DryRun,
+		Check:            opts.Check,
+		Confirm:          opts.Confirm,
+		Format:           opts.Format,
+		Export:           opts.Export,
+		OutputDir:        opts.OutputDir,
+		PlanOut:          opts.PlanOut,
+		Plan:             opts.Plan,
+		RollbackOut:      opts.RollbackOut,
+		MigrationDir:     opts.MigrationDir,
+		MigrationFormat:  opts.MigrationFormat,
+		BeforeApply:      opts.BeforeApply,
+		AfterApply:       opts.AfterApply,
+		LockRetries:      opts.LockRetries,
+		RetryInterval:    opts.RetryInterval,
+		EnableDropTable:  opts.EnableDropTable,
+		EnableDropColumn: opts.EnableDropColumn,
+		CaseInsensitive:  opts.CaseInsensitive,
+		SkipTables:       opts.SkipTables,
+		TargetTables:     opts.TargetTables,
+
+		RecreateMaterializedViews: opts.RecreateMaterializedViews,
+		RefreshMaterializedViews:  opts.RefreshMaterializedViews,
+		DropExtensions:            opts.DropExtensions,
+		ManagePrivileges:          opts.ManagePrivileges,
+		ManageForeignData:         opts.ManageForeignData,
+		NoTransaction:             opts.NoTransaction,
+		LockTimeout:               opts.LockTimeout,
+		StatementTimeout:          opts.StatementTimeout,
 	}
 
 	password, ok := os.LookupEnv("PGPASS")
@@ -40,7 +40,12 @@ func TestPsqldefCreateTable(t *testing.T) {
 	assertApplyOutput(t, createTable1+createTable2, applyPrefix+createTable1+createTable2)
 	assertApplyOutput(t, createTable1+createTable2, nothingModified)
 
-	assertApplyOutput(t, createTable1, applyPrefix+"DROP TABLE bigdata;\n")
+	// A table isn't dropped without --enable-drop-table.
+	assertApplyOutput(t, createTable1, nothingModified)
+
+	writeFile("schema.sql", createTable1)
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--enable-drop-table")
+	assertEquals(t, actual, applyPrefix+"DROP TABLE bigdata;\n")
 	assertApplyOutput(t, createTable1, nothingModified)
 }
 
@@ -62,7 +67,9 @@ func TestPsqldefCreateTablePrimaryKey(t *testing.T) {
 		  name text
 		);`,
 	)
-	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users DROP COLUMN id;\n")
+	writeFile("schema.sql", createTable)
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--enable-drop-column")
+	assertEquals(t, actual, applyPrefix+"ALTER TABLE users DROP COLUMN id;\n")
 	assertApplyOutput(t, createTable, nothingModified)
 
 	createTable = stripHeredoc(`
@@ -76,7 +83,7 @@ func TestPsqldefCreateTablePrimaryKey(t *testing.T) {
 }
 
 func TestPsqldefDropPrimaryKey(t *testing.T) {
-	t.Skip()
+	resetTestDatabase()
 
 	createTable := stripHeredoc(`
 		CREATE TABLE users (
@@ -92,7 +99,33 @@ func TestPsqldefDropPrimaryKey(t *testing.T) {
 		  name text
 		);`,
 	)
-	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users DROP PRIMARY KEY;\n")
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users DROP CONSTRAINT users_pkey;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefCompositePrimaryKey(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  tenant_id bigint NOT NULL
+		);`,
+	)
+	assertApply(t, createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  tenant_id bigint NOT NULL,
+		  PRIMARY KEY (tenant_id, id)
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE users DROP CONSTRAINT users_pkey;
+		ALTER TABLE users ADD PRIMARY KEY(tenant_id, id);
+		`,
+	))
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
@@ -125,7 +158,12 @@ func TestPsqldefAddColumn(t *testing.T) {
 		  age integer
 		);`,
 	)
-	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users DROP COLUMN name;\n")
+	// A column isn't dropped without --enable-drop-column.
+	assertApplyOutput(t, createTable, "-- Skipped: ALTER TABLE users DROP COLUMN name;\n"+nothingModified)
+
+	writeFile("schema.sql", createTable)
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--enable-drop-column")
+	assertEquals(t, actual, applyPrefix+"ALTER TABLE users DROP COLUMN name;\n")
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
@@ -153,6 +191,401 @@ func TestPsqldefCreateIndex(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestPsqldefIndexMethod(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  profile jsonb
+		);
+		`,
+	)
+	createIndex := "CREATE INDEX index_profile ON users USING gin (profile);\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+createTable+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+
+	createIndex = "CREATE INDEX index_profile ON users (profile);\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+"DROP INDEX index_profile;\n"+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+
+	createIndex = "CREATE INDEX index_profile ON users USING btree (profile);\n"
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+}
+
+func TestPsqldefPartialIndex(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name text,
+		  deleted_at timestamp
+		);
+		`,
+	)
+	createIndex := "CREATE UNIQUE INDEX index_name ON users (name) WHERE deleted_at IS NULL;\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+createTable+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+
+	createIndex = "CREATE UNIQUE INDEX index_name ON users (name) WHERE deleted_at IS NULL AND id > 0;\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+"DROP INDEX index_name;\n"+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+
+	createIndex = "CREATE UNIQUE INDEX index_name ON users (name);\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+"DROP INDEX index_name;\n"+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+}
+
+func TestPsqldefRenameTable(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE members (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name text
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		-- @renamed from=members
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  full_name text -- @renamed from=name
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE members RENAME TO users;
+		ALTER TABLE users RENAME COLUMN name TO full_name;
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefRenameColumn(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name text
+		);
+		`,
+	)
+	createIndex := "CREATE INDEX index_name ON users (name);\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+createTable+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  user_id bigint NOT NULL PRIMARY KEY, -- @renamed from=id
+		  full_name text -- @renamed from=name
+		);
+		`,
+	)
+	createIndex = "CREATE INDEX index_name ON users (full_name);\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+stripHeredoc(`
+		ALTER TABLE users RENAME COLUMN id TO user_id;
+		ALTER TABLE users RENAME COLUMN name TO full_name;
+		`,
+	))
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+}
+
+func TestPsqldefRenameIndex(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name text,
+		  email text,
+		  CONSTRAINT users_email_key UNIQUE (email)
+		);
+		`,
+	)
+	createIndex := "CREATE INDEX index_name ON users (name);\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+createTable+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+
+	createIndex = "CREATE INDEX index_users_name ON users (name);\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+"ALTER INDEX index_name RENAME TO index_users_name;\n")
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name text,
+		  email text,
+		  CONSTRAINT unique_email UNIQUE (email)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+"ALTER TABLE users RENAME CONSTRAINT users_email_key TO unique_email;\n")
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+}
+
+func TestPsqldefExpressionIndex(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  email text
+		);
+		`,
+	)
+	createIndex := "CREATE UNIQUE INDEX index_email ON users (lower(email));\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+createTable+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+
+	createIndex = "CREATE INDEX index_email ON users ((id + 1), lower(email));\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+"DROP INDEX index_email;\n"+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+
+	// An unnamed index is named by PostgreSQL after the table and the expression
+	createIndex = "CREATE INDEX ON users (lower(email));\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+createIndex+"DROP INDEX index_email;\n")
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+}
+
+func TestPsqldefFunctionDefault(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  created_on date DEFAULT CURRENT_DATE
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  created_on date,
+		  token uuid DEFAULT gen_random_uuid()
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE users ADD COLUMN token uuid DEFAULT gen_random_uuid();\n"+
+		"ALTER TABLE users ALTER COLUMN created_on DROP DEFAULT;\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  created_on date DEFAULT CURRENT_DATE,
+		  token uuid DEFAULT gen_random_uuid()
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users ALTER COLUMN created_on SET DEFAULT CURRENT_DATE;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefTypeAliases(t *testing.T) {
+	resetTestDatabase()
+
+	// pg_dump(1) shows them like `integer`, `double precision`, `character(3)` and `character varying(255)`.
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id int8 NOT NULL PRIMARY KEY,
+		  age int4,
+		  flag int2,
+		  score float8,
+		  ratio float4,
+		  price decimal(10,2),
+		  code char(3),
+		  name varchar(255),
+		  active bool
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefLiteralDefault(t *testing.T) {
+	resetTestDatabase()
+
+	// pg_dump(1) shows them like `'foo'::character varying`, `'ab'::bpchar` and `'-1'::integer`.
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(20) DEFAULT 'foo',
+		  code char(3) DEFAULT 'ab',
+		  score integer DEFAULT -1,
+		  ratio numeric(5,2) DEFAULT 1.5,
+		  active boolean DEFAULT 't',
+		  note text DEFAULT NULL
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(20) DEFAULT 'it''s',
+		  code char(3),
+		  score integer DEFAULT '-1'::integer,
+		  ratio numeric(5,2) DEFAULT 1.50,
+		  active boolean DEFAULT true,
+		  note text
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE users ALTER COLUMN name SET DEFAULT 'it''s';\n"+
+		"ALTER TABLE users ALTER COLUMN code DROP DEFAULT;\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefGeneratedColumn(t *testing.T) {
+	resetTestDatabase()
+
+	// pg_dump(1) shows `first_name || ' '` of a varchar column as `((first_name)::text || ' '::text)`.
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  first_name varchar(20),
+		  last_name varchar(20),
+		  full_name text GENERATED ALWAYS AS (first_name || ' ' || last_name) STORED
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  first_name varchar(20),
+		  last_name varchar(20),
+		  full_name text GENERATED ALWAYS AS (last_name || ' ' || first_name) STORED
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE users DROP COLUMN full_name;\n"+
+		"ALTER TABLE users ADD COLUMN full_name text GENERATED ALWAYS AS (((last_name || ' ') || first_name)) STORED;\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefFullTextSearch(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE posts (
+		  id bigint NOT NULL PRIMARY KEY,
+		  title text,
+		  body text,
+		  search tsvector GENERATED ALWAYS AS (to_tsvector('english', title)) STORED
+		);
+		`,
+	)
+	createIndex := "CREATE INDEX index_search ON posts USING gin (search);\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+createTable+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+
+	// The index is dropped together with the column, and it's created again.
+	createTable = stripHeredoc(`
+		CREATE TABLE posts (
+		  id bigint NOT NULL PRIMARY KEY,
+		  title text,
+		  body text,
+		  search tsvector GENERATED ALWAYS AS (to_tsvector('english', coalesce(title, '') || ' ' || coalesce(body, ''))) STORED
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+
+		"ALTER TABLE posts DROP COLUMN search;\n"+
+		"ALTER TABLE posts ADD COLUMN search tsvector GENERATED ALWAYS AS (to_tsvector('english', ((coalesce(title, '') || ' ') || coalesce(body, '')))) STORED;\n"+
+		createIndex,
+	)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+}
+
+func TestPsqldefFullTextSearchOfVarchar(t *testing.T) {
+	resetTestDatabase()
+
+	// pg_dump(1) shows a varchar column as `(title)::text` in `to_tsvector('english'::regconfig, (title)::text)`.
+	createTable := stripHeredoc(`
+		CREATE TABLE articles (
+		  id bigint NOT NULL PRIMARY KEY,
+		  title varchar(100),
+		  search tsvector GENERATED ALWAYS AS (to_tsvector('english', title)) STORED
+		);
+		`,
+	)
+	createIndex := stripHeredoc(`
+		CREATE INDEX index_articles_search ON articles USING gin (search);
+		CREATE INDEX index_articles_title ON articles USING gin (to_tsvector('english', title));
+		`,
+	)
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+createTable+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+}
+
+func TestPsqldefCoveringIndex(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name text,
+		  email text
+		);
+		`,
+	)
+	createIndex := "CREATE UNIQUE INDEX index_id ON users (id) INCLUDE (name, email);\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+createTable+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+
+	createIndex = "CREATE UNIQUE INDEX index_id ON users (id) INCLUDE (name);\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+"DROP INDEX index_id;\n"+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+
+	createIndex = "CREATE UNIQUE INDEX index_id ON users (id);\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+"DROP INDEX index_id;\n"+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+}
+
+func TestPsqldefIndexColumnOrder(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name text,
+		  created_at timestamp
+		);
+		`,
+	)
+	createIndex := "CREATE INDEX index_created_at ON users (created_at DESC, name);\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+createTable+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+
+	// The default of NULLS FIRST or NULLS LAST depends on the direction
+	assertApplyOutput(t, createTable+"CREATE INDEX index_created_at ON users (created_at DESC NULLS FIRST, name ASC NULLS LAST);\n", nothingModified)
+
+	createIndex = "CREATE INDEX index_created_at ON users (created_at DESC NULLS LAST, name);\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+"DROP INDEX index_created_at;\n"+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+}
+
 func TestPsqldefColumnLiteral(t *testing.T) {
 	resetTestDatabase()
 
@@ -184,8 +617,6 @@ func TestPsqldefDataTypes(t *testing.T) {
 	//   - inet
 	//   - int4
 	//   - interval [ fields ] [ (p) ]
-	//   - json
-	//   - jsonb
 	//   - line
 	//   - lseg
 	//   - macaddr
@@ -205,13 +636,8 @@ func TestPsqldefDataTypes(t *testing.T) {
 	//   - serial
 	//   - serial4
 	//   - time [ (p) ] [ without time zone ]
-	//   - time [ (p) ] with time zone
-	//   - timetz
 	//   - timestamp [ (p) ] [ without time zone ]
-	//   - timestamp [ (p) ] with time zone
-	//   - timestamptz
 	//   - tsquer
-	//   - tsvector
 	//   - txid_snapshot
 	//   - uuid
 	//   - xml
@@ -241,6 +667,1334 @@ func TestPsqldefDataTypes(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified) // Label for column type may change. Type will be examined.
 }
 
+func TestPsqldefNumericPrecision(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE items (
+		  id bigint NOT NULL PRIMARY KEY,
+		  price numeric(10,2) NOT NULL,
+		  weight numeric(10),
+		  name varchar(20)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE items (
+		  id bigint NOT NULL PRIMARY KEY,
+		  price numeric(12,4) NOT NULL,
+		  weight numeric(10,0),
+		  name varchar(40)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE items ALTER COLUMN price TYPE numeric(12, 4);
+		ALTER TABLE items ALTER COLUMN name TYPE varchar(40);
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// numeric without a precision has no limit.
+	createTable = stripHeredoc(`
+		CREATE TABLE items (
+		  id bigint NOT NULL PRIMARY KEY,
+		  price numeric(12,4) NOT NULL,
+		  weight numeric,
+		  name varchar(40)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE items ALTER COLUMN weight TYPE numeric;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE items (
+		  id bigint NOT NULL PRIMARY KEY,
+		  price numeric(12,4) NOT NULL,
+		  weight numeric(10,2),
+		  name varchar(40)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE items ALTER COLUMN weight TYPE numeric(10, 2);\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefTimestampPrecision(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE events (
+		  id bigint NOT NULL PRIMARY KEY,
+		  started_at timestamp(3) with time zone,
+		  finished_at timestamptz,
+		  created_at timestamp(3)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE events (
+		  id bigint NOT NULL PRIMARY KEY,
+		  started_at timestamp(6) with time zone,
+		  finished_at timestamptz,
+		  created_at timestamp(3) with time zone
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE events ALTER COLUMN started_at TYPE timestamp(6) with time zone;
+		ALTER TABLE events ALTER COLUMN created_at TYPE timestamp(3) with time zone;
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// An omitted precision is 6.
+	createTable = stripHeredoc(`
+		CREATE TABLE events (
+		  id bigint NOT NULL PRIMARY KEY,
+		  started_at timestamp with time zone,
+		  finished_at timestamptz,
+		  created_at timestamp with time zone
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE events ALTER COLUMN created_at TYPE timestamp with time zone;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE events (
+		  id bigint NOT NULL PRIMARY KEY,
+		  started_at timestamp with time zone,
+		  finished_at timestamptz(0),
+		  created_at timestamp with time zone
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE events ALTER COLUMN finished_at TYPE timestamp(0) with time zone;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefArrayType(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  tags text[],
+		  matrix integer[][] NOT NULL,
+		  codes varchar(10) ARRAY
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  tags text[],
+		  matrix integer[][] NOT NULL,
+		  codes varchar(10) ARRAY,
+		  scores int[3]
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users ADD COLUMN scores int[];\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefJsonType(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  profile json,
+		  settings jsonb NOT NULL DEFAULT '{}'
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  profile json,
+		  settings jsonb NOT NULL DEFAULT '{}'::jsonb,
+		  tags jsonb DEFAULT '[]'
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users ADD COLUMN tags jsonb DEFAULT '[]';\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefForeignKey(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id BIGINT PRIMARY KEY);\n"
+	createPosts := stripHeredoc(`
+		CREATE TABLE posts (
+		  id BIGINT,
+		  user_id BIGINT REFERENCES users (id),
+		  editor_id BIGINT
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+createUsers+createPosts)
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+
+	createPosts = stripHeredoc(`
+		CREATE TABLE posts (
+		  id BIGINT,
+		  user_id BIGINT REFERENCES users (id) ON DELETE CASCADE,
+		  editor_id BIGINT,
+		  CONSTRAINT posts_editor_fk FOREIGN KEY (editor_id) REFERENCES users (id)
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+stripHeredoc(`
+		ALTER TABLE posts DROP CONSTRAINT posts_user_id_fkey;
+		ALTER TABLE posts ADD CONSTRAINT posts_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+		ALTER TABLE posts ADD CONSTRAINT posts_editor_fk FOREIGN KEY (editor_id) REFERENCES users (id);
+		`,
+	))
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+
+	createPosts = stripHeredoc(`
+		CREATE TABLE posts (
+		  id BIGINT,
+		  user_id BIGINT REFERENCES users (id) ON DELETE CASCADE ON UPDATE NO ACTION,
+		  editor_id BIGINT,
+		  CONSTRAINT posts_editor_fk FOREIGN KEY (editor_id) REFERENCES users (id) ON DELETE RESTRICT
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+stripHeredoc(`
+		ALTER TABLE posts DROP CONSTRAINT posts_editor_fk;
+		ALTER TABLE posts ADD CONSTRAINT posts_editor_fk FOREIGN KEY (editor_id) REFERENCES users (id) ON DELETE RESTRICT;
+		`,
+	))
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+
+	createPosts = stripHeredoc(`
+		CREATE TABLE posts (
+		  id BIGINT,
+		  user_id BIGINT,
+		  editor_id BIGINT
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+stripHeredoc(`
+		ALTER TABLE posts DROP CONSTRAINT posts_editor_fk;
+		ALTER TABLE posts DROP CONSTRAINT posts_user_id_fkey;
+		`,
+	))
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+}
+
+func TestPsqldefDropTable(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name text
+		);
+		`,
+	)
+	createIndex := "CREATE INDEX index_name on users (name);\n"
+	assertApply(t, createTable+createIndex)
+
+	dropTable := "DROP TABLE IF EXISTS users;\n"
+	assertApplyOutput(t, dropTable+createTable+createIndex, nothingModified)
+
+	dropIndex := "DROP INDEX index_name;\n"
+	assertApplyOutput(t, createTable+createIndex+dropIndex, applyPrefix+"DROP INDEX index_name;\n")
+	assertApplyOutput(t, createTable+createIndex+dropIndex, nothingModified)
+
+	assertApplyOutput(t, createTable+dropTable, applyPrefix+"DROP TABLE users;\n")
+	assertApplyOutput(t, createTable+dropTable, nothingModified)
+}
+
+func TestPsqldefComment(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name text
+		);
+		`,
+	)
+	commentOnTable := "COMMENT ON TABLE users IS 'users';\n"
+	commentOnColumn := "COMMENT ON COLUMN users.id IS 'it''s an id';\n"
+	assertApplyOutput(t, createTable+commentOnTable+commentOnColumn, applyPrefix+createTable+commentOnTable+commentOnColumn)
+	assertApplyOutput(t, createTable+commentOnTable+commentOnColumn, nothingModified)
+
+	commentOnColumn = "COMMENT ON COLUMN users.name IS 'name';\n"
+	assertApplyOutput(t, createTable+commentOnColumn, applyPrefix+commentOnColumn+
+		"COMMENT ON TABLE users IS NULL;\n"+
+		"COMMENT ON COLUMN users.id IS NULL;\n",
+	)
+	assertApplyOutput(t, createTable+commentOnColumn, nothingModified)
+
+	// Comments should be exported
+	out := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--export")
+	assertApplyOutput(t, out, nothingModified)
+}
+
+func TestPsqldefAddForeignKey(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id BIGINT PRIMARY KEY);\n"
+	createPosts := stripHeredoc(`
+		CREATE TABLE posts (
+		  id BIGINT,
+		  user_id BIGINT
+		);
+		`,
+	)
+	addForeignKey := "ALTER TABLE posts ADD FOREIGN KEY (user_id) REFERENCES users (id);\n"
+	assertApplyOutput(t, createUsers+createPosts+addForeignKey, applyPrefix+createUsers+createPosts+addForeignKey)
+	assertApplyOutput(t, createUsers+createPosts+addForeignKey, nothingModified)
+
+	writeFile("schema.sql", createPosts)
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--enable-drop-table")
+	assertEquals(t, actual, applyPrefix+stripHeredoc(`
+		ALTER TABLE posts DROP CONSTRAINT posts_user_id_fkey;
+		DROP TABLE users;
+		`,
+	))
+	assertApplyOutput(t, createPosts, nothingModified)
+}
+
+func TestPsqldefCheckConstraint(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  age integer CHECK (age > 0),
+		  height integer,
+		  CONSTRAINT users_height_check CHECK (height > age AND height < 300)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  age integer CHECK (age >= 0),
+		  height integer
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE users DROP CONSTRAINT users_age_check;
+		ALTER TABLE users ADD CONSTRAINT users_age_check CHECK ((age >= 0));
+		ALTER TABLE users DROP CONSTRAINT users_height_check;
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// pg_dump(1) shows them as `((name)::text <> ''::text)` and `((status)::text = ANY ((ARRAY[...])::text[]))`.
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  age integer CHECK (age >= 0) CHECK (age < 200),
+		  height integer,
+		  name varchar(40) CHECK (name <> ''),
+		  status varchar(10) CHECK (status IN ('active', 'inactive')),
+		  kind text CHECK (kind NOT IN ('admin'))
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE users ADD COLUMN name varchar(40);
+		ALTER TABLE users ADD COLUMN status varchar(10);
+		ALTER TABLE users ADD COLUMN kind text;
+		ALTER TABLE users ADD CONSTRAINT users_age_check1 CHECK ((age < 200));
+		ALTER TABLE users ADD CONSTRAINT users_name_check CHECK ((name != ''));
+		ALTER TABLE users ADD CONSTRAINT users_status_check CHECK (("status" in ('active', 'inactive')));
+		ALTER TABLE users ADD CONSTRAINT users_kind_check CHECK ((kind not in ('admin')));
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefUniqueConstraint(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  name text,
+		  email text,
+		  CONSTRAINT users_name_email_uq UNIQUE (name, email),
+		  UNIQUE (email)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  name text,
+		  email text,
+		  CONSTRAINT users_name_email_uq UNIQUE (email, name)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE users DROP CONSTRAINT users_name_email_uq;
+		ALTER TABLE users ADD CONSTRAINT users_name_email_uq UNIQUE (email, name);
+		ALTER TABLE users DROP CONSTRAINT users_email_key;
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefView(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name text
+		);
+		`,
+	)
+	createView := stripHeredoc(`
+		CREATE VIEW user_names AS SELECT id, name FROM users WHERE id > 1;
+		`,
+	)
+	assertApplyOutput(t, createTable+createView, applyPrefix+createTable+createView)
+	assertApplyOutput(t, createTable+createView, nothingModified)
+
+	createView = stripHeredoc(`
+		CREATE VIEW user_names AS SELECT id, name, name || '!' AS greeting FROM users WHERE id > 1;
+		`,
+	)
+	assertApplyOutput(t, createTable+createView, applyPrefix+"CREATE OR REPLACE VIEW user_names AS select id, name, (name || '!') as greeting from users where (id > 1);\n")
+	assertApplyOutput(t, createTable+createView, nothingModified)
+
+	// A view isn't dropped without --enable-drop-table.
+	assertApplyOutput(t, createTable, "-- Skipped: DROP VIEW user_names;\n"+nothingModified)
+
+	writeFile("schema.sql", createTable)
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--enable-drop-table")
+	assertEquals(t, actual, applyPrefix+"DROP VIEW user_names;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefFunction(t *testing.T) {
+	resetTestDatabase()
+
+	createFunction := stripHeredoc(`
+		CREATE FUNCTION add_numbers(a integer, b integer DEFAULT 1) RETURNS integer AS $$
+		  SELECT a + b;
+		$$ LANGUAGE sql IMMUTABLE;
+		`,
+	)
+	assertApplyOutput(t, createFunction, applyPrefix+createFunction)
+	assertApplyOutput(t, createFunction, nothingModified)
+
+	createFunction = stripHeredoc(`
+		CREATE FUNCTION add_numbers(a integer, b integer DEFAULT 1) RETURNS integer AS $$
+		  SELECT a + b + 0;
+		$$ LANGUAGE sql IMMUTABLE;
+		`,
+	)
+	assertApplyOutput(t, createFunction, applyPrefix+strings.Replace(createFunction, "CREATE FUNCTION", "CREATE OR REPLACE FUNCTION", 1))
+	assertApplyOutput(t, createFunction, nothingModified)
+
+	createFunction = stripHeredoc(`
+		CREATE FUNCTION add_numbers(a bigint, b bigint DEFAULT 1) RETURNS bigint AS $$
+		  SELECT a + b;
+		$$ LANGUAGE sql;
+		`,
+	)
+	assertApplyOutput(t, createFunction, applyPrefix+"DROP FUNCTION add_numbers(a int, b int);\n"+createFunction)
+	assertApplyOutput(t, createFunction, nothingModified)
+
+	// A function isn't dropped without --enable-drop-table.
+	assertApplyOutput(t, "", "-- Skipped: DROP FUNCTION add_numbers(a bigint, b bigint);\n"+nothingModified)
+
+	writeFile("schema.sql", "")
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--enable-drop-table")
+	assertEquals(t, actual, applyPrefix+"DROP FUNCTION add_numbers(a bigint, b bigint);\n")
+	assertApplyOutput(t, "", nothingModified)
+}
+
+func TestPsqldefTrigger(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE FUNCTION set_updated_at() RETURNS trigger AS $$
+		BEGIN
+		  NEW.updated_at := now();
+		  RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql;
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name text,
+		  updated_at timestamp
+		);
+		`,
+	)
+	createTrigger := stripHeredoc(`
+		CREATE TRIGGER users_updated_at BEFORE UPDATE ON users FOR EACH ROW EXECUTE PROCEDURE set_updated_at();
+		`,
+	)
+	assertApplyOutput(t, createTable+createTrigger, applyPrefix+createTable+createTrigger)
+	assertApplyOutput(t, createTable+createTrigger, nothingModified)
+
+	createTrigger = stripHeredoc(`
+		CREATE TRIGGER users_updated_at BEFORE INSERT OR UPDATE ON users FOR EACH ROW WHEN (NEW.name IS NOT NULL) EXECUTE PROCEDURE set_updated_at();
+		`,
+	)
+	assertApplyOutput(t, createTable+createTrigger, applyPrefix+"DROP TRIGGER users_updated_at ON users;\n"+createTrigger)
+	assertApplyOutput(t, createTable+createTrigger, nothingModified)
+
+	assertApplyOutput(t, createTable, applyPrefix+"DROP TRIGGER users_updated_at ON users;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefSequence(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY
+		);
+		`,
+	)
+	createSequence := stripHeredoc(`
+		CREATE SEQUENCE user_ids AS integer OWNED BY users.id;
+		CREATE SEQUENCE counters;
+		`,
+	)
+	assertApplyOutput(t, createTable+createSequence, applyPrefix+createTable+createSequence)
+	assertApplyOutput(t, createTable+createSequence, nothingModified)
+
+	createSequence = stripHeredoc(`
+		CREATE SEQUENCE user_ids AS integer INCREMENT BY 2 MAXVALUE 1000 CYCLE OWNED BY users.id;
+		CREATE SEQUENCE counters START WITH 10 CACHE 5;
+		`,
+	)
+	assertApplyOutput(t, createTable+createSequence, applyPrefix+
+		"ALTER SEQUENCE user_ids INCREMENT BY 2 MAXVALUE 1000 CYCLE;\n"+
+		"ALTER SEQUENCE counters START WITH 10 CACHE 5;\n",
+	)
+	assertApplyOutput(t, createTable+createSequence, nothingModified)
+
+	// A sequence isn't dropped without --enable-drop-table.
+	assertApplyOutput(t, createTable, "-- Skipped: DROP SEQUENCE counters;\n-- Skipped: DROP SEQUENCE user_ids;\n"+nothingModified)
+
+	writeFile("schema.sql", createTable)
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--enable-drop-table")
+	assertEquals(t, actual, applyPrefix+"DROP SEQUENCE counters;\nDROP SEQUENCE user_ids;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefEnum(t *testing.T) {
+	resetTestDatabase()
+
+	createType := "CREATE TYPE mood AS ENUM ('happy', 'sad');\n"
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  current_mood mood
+		);
+		`,
+	)
+	assertApplyOutput(t, createType+createTable, applyPrefix+createType+createTable)
+	assertApplyOutput(t, createType+createTable, nothingModified)
+
+	createType = "CREATE TYPE mood AS ENUM ('ok', 'happy', 'sad', 'angry');\n"
+	assertApplyOutput(t, createType+createTable, applyPrefix+
+		"ALTER TYPE mood ADD VALUE 'ok' BEFORE 'happy';\n"+
+		"ALTER TYPE mood ADD VALUE 'angry';\n",
+	)
+	assertApplyOutput(t, createType+createTable, nothingModified)
+
+	writeFile("schema.sql", "CREATE TYPE mood AS ENUM ('ok', 'sad', 'happy', 'angry');\n"+createTable)
+	_, err := execute("psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql")
+	if err == nil {
+		t.Error("expected psqldef to fail for reordered labels")
+	}
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL
+		);
+		`,
+	)
+	writeFile("schema.sql", createTable)
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--enable-drop-column")
+	assertEquals(t, actual, "-- Skipped: DROP TYPE mood;\n"+applyPrefix+"ALTER TABLE users DROP COLUMN current_mood;\n")
+
+	// A type is dropped only with --enable-drop-table.
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--enable-drop-table")
+	assertEquals(t, actual, applyPrefix+"DROP TYPE mood;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefDomain(t *testing.T) {
+	resetTestDatabase()
+
+	createDomain := "CREATE DOMAIN positive AS integer DEFAULT 1 NOT NULL CHECK (VALUE > 0);\n"
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  score positive
+		);
+		`,
+	)
+	assertApplyOutput(t, createDomain+createTable, applyPrefix+createDomain+createTable)
+	assertApplyOutput(t, createDomain+createTable, nothingModified)
+
+	createDomain = "CREATE DOMAIN positive AS integer CHECK (VALUE > 0) CHECK (VALUE < 100);\n"
+	assertApplyOutput(t, createDomain+createTable, applyPrefix+
+		"ALTER DOMAIN positive DROP DEFAULT;\n"+
+		"ALTER DOMAIN positive DROP NOT NULL;\n"+
+		"ALTER DOMAIN positive ADD CONSTRAINT positive_check1 CHECK ((VALUE < 100));\n",
+	)
+	assertApplyOutput(t, createDomain+createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL
+		);
+		`,
+	)
+	writeFile("schema.sql", createTable)
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--enable-drop-column")
+	assertEquals(t, actual, "-- Skipped: DROP DOMAIN positive;\n"+applyPrefix+"ALTER TABLE users DROP COLUMN score;\n")
+
+	// A domain is dropped only with --enable-drop-table.
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--enable-drop-table")
+	assertEquals(t, actual, applyPrefix+"DROP DOMAIN positive;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefSchema(t *testing.T) {
+	resetTestDatabase()
+
+	createSchema := "CREATE SCHEMA app;\n"
+	createTable := stripHeredoc(`
+		CREATE TABLE app.users (
+		  id bigserial PRIMARY KEY,
+		  name text
+		);
+		CREATE INDEX index_users_on_name ON app.users (name);
+		CREATE TABLE posts (
+		  id bigint NOT NULL,
+		  user_id bigint REFERENCES app.users (id)
+		);
+		`,
+	)
+	assertApplyOutput(t, createSchema+createTable, applyPrefix+createSchema+createTable)
+	assertApplyOutput(t, createSchema+createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE app.users (
+		  id bigserial PRIMARY KEY,
+		  name text
+		);
+		CREATE TABLE posts (
+		  id bigint NOT NULL,
+		  user_id bigint REFERENCES app.users (id)
+		);
+		`,
+	)
+	assertApplyOutput(t, createSchema+createTable, applyPrefix+"DROP INDEX app.index_users_on_name;\n")
+	assertApplyOutput(t, createSchema+createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE posts (
+		  id bigint NOT NULL
+		);
+		`,
+	)
+	writeFile("schema.sql", createTable)
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--enable-drop-table", "--enable-drop-column")
+	assertEquals(t, actual, applyPrefix+
+		"ALTER TABLE posts DROP CONSTRAINT posts_user_id_fkey;\n"+
+		"DROP TABLE app.users;\n"+
+		"ALTER TABLE posts DROP COLUMN user_id;\n"+
+		"DROP SCHEMA app;\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefInherits(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE cities (
+		  name text NOT NULL,
+		  population integer
+		);
+		CREATE TABLE capitals (
+		  state text
+		) INHERITS (cities);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE cities (
+		  name text NOT NULL,
+		  population integer
+		);
+		CREATE TABLE capitals (
+		  state text,
+		  country text
+		) INHERITS (cities);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE capitals ADD COLUMN country text;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE cities (
+		  name text NOT NULL,
+		  population integer
+		);
+		CREATE TABLE capitals (
+		  state text,
+		  country text
+		);
+		`,
+	))
+	_, err := execute("psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql")
+	if err == nil {
+		t.Error("expected psqldef to fail for removed INHERITS")
+	}
+}
+
+func TestPsqldefCaseInsensitive(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id integer NOT NULL,
+		  name text
+		);
+		CREATE INDEX index_name ON users (name);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE Users (
+		  Id integer NOT NULL,
+		  Name text,
+		  Age integer
+		);
+		CREATE INDEX Index_Name ON Users (Name);
+		`,
+	)
+	writeFile("schema.sql", createTable)
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--case-insensitive")
+	assertEquals(t, actual, applyPrefix+"ALTER TABLE users ADD COLUMN age integer;\n")
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--case-insensitive")
+	assertEquals(t, actual, nothingModified)
+
+	// Quoted identifiers are case-sensitive.
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", `CREATE TABLE "Posts" ("Id" integer NOT NULL);`)
+	createTable += stripHeredoc(`
+		CREATE TABLE "Posts" (
+		  "Id" integer NOT NULL,
+		  Title text
+		);
+		`,
+	)
+	writeFile("schema.sql", createTable)
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--case-insensitive")
+	assertEquals(t, actual, applyPrefix+"ALTER TABLE \"Posts\" ADD COLUMN title text;\n")
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--case-insensitive")
+	assertEquals(t, actual, nothingModified)
+}
+
+func TestPsqldefTemplate(t *testing.T) {
+	resetTestDatabase()
+
+	writeFile("vars.json", `{"name_length": 40, "tables": ["users", "admins"]}`)
+	writeFile("schema.sql", stripHeredoc(`
+		{{ range .tables }}
+		CREATE TABLE {{ . }} (
+		  id integer NOT NULL,
+		  name character varying({{ $.name_length }})
+		);
+		{{ end }}
+		`,
+	))
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--template", "--template-vars", "vars.json")
+	assertEquals(t, actual, applyPrefix+
+		"CREATE TABLE users (\n  id integer NOT NULL,\n  name character varying(40)\n);\n"+
+		"CREATE TABLE admins (\n  id integer NOT NULL,\n  name character varying(40)\n);\n",
+	)
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--template", "--template-vars", "vars.json")
+	assertEquals(t, actual, nothingModified)
+}
+
+func TestPsqldefTargetTable(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", stripHeredoc(`
+		CREATE TABLE users (id bigint NOT NULL);
+		CREATE TABLE posts (id bigint NOT NULL);
+		CREATE VIEW user_ids AS SELECT id FROM users;
+		`,
+	))
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name text
+		);
+		CREATE TABLE posts (
+		  id bigint NOT NULL,
+		  title text
+		);
+		`,
+	))
+
+	// posts and the view are neither changed nor dropped.
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--enable-drop-table", "--target-table", "users")
+	assertEquals(t, actual, applyPrefix+"ALTER TABLE users ADD COLUMN name text;\n")
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--enable-drop-table", "--target-table", "users")
+	assertEquals(t, actual, nothingModified)
+
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--target-table", "posts")
+	assertEquals(t, actual, applyPrefix+"ALTER TABLE posts ADD COLUMN title text;\n")
+}
+
+func TestPsqldefTransaction(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", "CREATE TABLE users (id bigint NOT NULL); INSERT INTO users VALUES (1);")
+
+	// Adding a NOT NULL column without a default fails for existing rows, and posts is not created either.
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE posts (
+		  id bigint NOT NULL
+		);
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name text NOT NULL
+		);
+		`,
+	))
+	if _, err := execute("psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql"); err == nil {
+		t.Error("expected an error for a NOT NULL column of existing rows")
+	}
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--dry-run")
+	assertEquals(t, actual, "-- dry run --\nCREATE TABLE posts (\n  id bigint NOT NULL\n);\nALTER TABLE users ADD COLUMN name text NOT NULL;\n")
+
+	// CREATE INDEX CONCURRENTLY is run out of the transaction.
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE posts (
+		  id bigint NOT NULL
+		);
+		CREATE INDEX CONCURRENTLY index_posts_on_id ON posts (id);
+		CREATE TABLE users (
+		  id bigint NOT NULL
+		);
+		`,
+	))
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql")
+	assertEquals(t, actual, applyPrefix+"CREATE TABLE posts (\n  id bigint NOT NULL\n);\nCREATE INDEX CONCURRENTLY index_posts_on_id ON posts (id);\n")
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql")
+	assertEquals(t, actual, nothingModified)
+}
+
+func TestPsqldefTimeouts(t *testing.T) {
+	resetTestDatabase()
+	writeFile("schema.sql", "CREATE TABLE users (\n  id bigint NOT NULL\n);\n")
+
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--lock-timeout", "5s", "--statement-timeout", "1min")
+	assertEquals(t, actual, applyPrefix+"SET lock_timeout = '5s';\nSET statement_timeout = '1min';\nCREATE TABLE users (\n  id bigint NOT NULL\n);\n")
+
+	if _, err := execute("psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--lock-timeout", "forever"); err != nil {
+		t.Errorf("a timeout must not be set when nothing is modified: %s", err)
+	}
+}
+
+func TestPsqldefReservedWords(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE "order" (
+		  "group" integer NOT NULL,
+		  "my col" text
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE "order" (
+		  "group" integer NOT NULL,
+		  "my col" text,
+		  "select" integer,
+		  CONSTRAINT "order_select_key" UNIQUE ("select")
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE "order" ADD COLUMN "select" integer;
+		ALTER TABLE "order" ADD CONSTRAINT order_select_key UNIQUE ("select");
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefTablespace(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name text
+		);
+		CREATE INDEX index_name ON users (name);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// pg_default is the same as no TABLESPACE
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name text
+		) TABLESPACE pg_default;
+		CREATE INDEX index_name ON users (name) TABLESPACE pg_default;
+		`,
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefExclusion(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE reservations (
+		  room integer NOT NULL,
+		  during tsrange NOT NULL,
+		  EXCLUDE USING gist (during WITH &&)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE reservations (
+		  room integer NOT NULL,
+		  during tsrange NOT NULL,
+		  CONSTRAINT reservations_during_excl EXCLUDE USING gist (during WITH &&) WHERE (room > 0)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE reservations DROP CONSTRAINT reservations_during_excl;\n"+
+		"ALTER TABLE reservations ADD CONSTRAINT reservations_during_excl EXCLUDE USING gist (during WITH &&) WHERE ((room > 0));\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE reservations (
+		  room integer NOT NULL,
+		  during tsrange NOT NULL
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE reservations DROP CONSTRAINT reservations_during_excl;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefDeferrable(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY
+		);
+		CREATE TABLE posts (
+		  id bigint NOT NULL,
+		  user_id bigint,
+		  CONSTRAINT posts_id_key UNIQUE (id) DEFERRABLE,
+		  CONSTRAINT posts_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) DEFERRABLE INITIALLY DEFERRED
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY
+		);
+		CREATE TABLE posts (
+		  id bigint NOT NULL,
+		  user_id bigint,
+		  CONSTRAINT posts_id_key UNIQUE (id) DEFERRABLE INITIALLY DEFERRED,
+		  CONSTRAINT posts_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE posts DROP CONSTRAINT posts_id_key;\n"+
+		"ALTER TABLE posts ADD CONSTRAINT posts_id_key UNIQUE (id) DEFERRABLE INITIALLY DEFERRED;\n"+
+		"ALTER TABLE posts ALTER CONSTRAINT posts_user_id_fkey NOT DEFERRABLE;\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefPolicy(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  owner text
+		);
+		ALTER TABLE users ENABLE ROW LEVEL SECURITY;
+		`,
+	)
+	createPolicy := "CREATE POLICY users_owner ON users FOR SELECT USING (owner = current_user);\n"
+	assertApplyOutput(t, createTable+createPolicy, applyPrefix+
+		"CREATE TABLE users (\n"+
+		"  id bigint NOT NULL,\n"+
+		"  owner text\n"+
+		");\n"+
+		createPolicy+
+		"ALTER TABLE users ENABLE ROW LEVEL SECURITY;\n",
+	)
+	assertApplyOutput(t, createTable+createPolicy, nothingModified)
+
+	createPolicy = "CREATE POLICY users_owner ON users USING (owner = current_user);\n"
+	assertApplyOutput(t, createTable+createPolicy, applyPrefix+"DROP POLICY users_owner ON users;\n"+createPolicy)
+	assertApplyOutput(t, createTable+createPolicy, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  owner text
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"DROP POLICY users_owner ON users;\n"+
+		"ALTER TABLE users DISABLE ROW LEVEL SECURITY;\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefReservedWordTriggerAndPrivilege(t *testing.T) {
+	resetTestDatabase()
+	execute("psql", "-Upostgres", "-c", "CREATE ROLE psqldef_reader;") // Roles are shared by databases
+
+	createTable := stripHeredoc(`
+		CREATE FUNCTION set_updated_at() RETURNS trigger AS $$
+		BEGIN
+		  NEW.updated_at := now();
+		  RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql;
+		CREATE TABLE "order" (
+		  id bigint NOT NULL PRIMARY KEY,
+		  updated_at timestamp
+		);
+		`,
+	)
+	createTrigger := "CREATE TRIGGER order_updated_at BEFORE UPDATE ON \"order\" FOR EACH ROW EXECUTE PROCEDURE set_updated_at();\n"
+	grant := "GRANT SELECT ON TABLE \"order\" TO psqldef_reader;\n"
+	writeFile("schema.sql", createTable+createTrigger+grant)
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--manage-privileges")
+	assertEquals(t, actual, applyPrefix+createTable+createTrigger+grant)
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--manage-privileges")
+	assertEquals(t, actual, nothingModified)
+
+	writeFile("schema.sql", createTable)
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--manage-privileges")
+	assertEquals(t, actual, applyPrefix+stripHeredoc(`
+		DROP TRIGGER order_updated_at ON "order";
+		REVOKE SELECT ON TABLE "order" FROM psqldef_reader;
+		`,
+	))
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--manage-privileges")
+	assertEquals(t, actual, nothingModified)
+}
+
+func TestPsqldefPrivilege(t *testing.T) {
+	resetTestDatabase()
+	execute("psql", "-Upostgres", "-c", "CREATE ROLE psqldef_reader;") // Roles are shared by databases
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name text
+		);
+		`,
+	)
+	grant := "GRANT SELECT, INSERT ON TABLE users TO psqldef_reader;\n"
+	writeFile("schema.sql", createTable+grant)
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--manage-privileges")
+	assertEquals(t, actual, applyPrefix+createTable+grant)
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--manage-privileges")
+	assertEquals(t, actual, nothingModified)
+
+	// Privileges are not revoked without --manage-privileges.
+	assertApplyOutput(t, createTable, nothingModified)
+
+	grant = "GRANT SELECT ON TABLE users TO psqldef_reader;\n"
+	writeFile("schema.sql", createTable+grant)
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--manage-privileges")
+	assertEquals(t, actual, applyPrefix+"REVOKE INSERT ON TABLE users FROM psqldef_reader;\n")
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--manage-privileges")
+	assertEquals(t, actual, nothingModified)
+}
+
+func TestPsqldefExtension(t *testing.T) {
+	resetTestDatabase()
+
+	createExtension := "CREATE EXTENSION IF NOT EXISTS pgcrypto;\n"
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  digest bytea
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable+createExtension, applyPrefix+createExtension+createTable)
+	assertApplyOutput(t, createTable+createExtension, nothingModified)
+
+	// An extension isn't dropped without --drop-extensions.
+	assertApplyOutput(t, createTable, nothingModified)
+
+	writeFile("schema.sql", createTable)
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--drop-extensions")
+	assertEquals(t, actual, applyPrefix+"DROP EXTENSION \"pgcrypto\";\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefExtensionType(t *testing.T) {
+	resetTestDatabase()
+
+	createExtension := "CREATE EXTENSION IF NOT EXISTS citext;\nCREATE EXTENSION IF NOT EXISTS hstore;\nCREATE EXTENSION IF NOT EXISTS \"uuid-ossp\";\n"
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id uuid NOT NULL PRIMARY KEY DEFAULT uuid_generate_v4(),
+		  email citext NOT NULL,
+		  attributes hstore DEFAULT ''
+		);
+		`,
+	)
+	assertApplyOutput(t, createExtension+createTable, applyPrefix+createExtension+createTable)
+	assertApplyOutput(t, createExtension+createTable, nothingModified) // pg_dump(1) qualifies the types and the function
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id uuid NOT NULL PRIMARY KEY DEFAULT uuid_generate_v4(),
+		  email citext NOT NULL,
+		  attributes hstore DEFAULT '',
+		  aliases citext[]
+		);
+		`,
+	)
+	assertApplyOutput(t, createExtension+createTable, applyPrefix+"ALTER TABLE users ADD COLUMN aliases citext[];\n")
+	assertApplyOutput(t, createExtension+createTable, nothingModified)
+}
+
+func TestPsqldefForeignData(t *testing.T) {
+	resetTestDatabase()
+
+	createExtension := "CREATE EXTENSION IF NOT EXISTS postgres_fdw;\n"
+	createServer := stripHeredoc(`
+		CREATE SERVER remote FOREIGN DATA WRAPPER postgres_fdw OPTIONS (host 'localhost', dbname 'psqldef_test');
+		CREATE USER MAPPING FOR postgres SERVER remote OPTIONS (user 'postgres');
+		`,
+	)
+	createForeignTable := stripHeredoc(`
+		CREATE FOREIGN TABLE remote_users (
+		  id bigint NOT NULL,
+		  name text
+		) SERVER remote OPTIONS (table_name 'users');
+		`,
+	)
+	writeFile("schema.sql", createExtension+createServer+createForeignTable)
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--manage-foreign-data")
+	assertEquals(t, actual, applyPrefix+createExtension+createServer+createForeignTable)
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--manage-foreign-data")
+	assertEquals(t, actual, nothingModified)
+
+	createServer = stripHeredoc(`
+		CREATE SERVER remote FOREIGN DATA WRAPPER postgres_fdw OPTIONS (host '127.0.0.1', port '5432', dbname 'psqldef_test');
+		CREATE USER MAPPING FOR postgres SERVER remote OPTIONS (user 'postgres');
+		`,
+	)
+	createForeignTable = stripHeredoc(`
+		CREATE FOREIGN TABLE remote_users (
+		  id bigint NOT NULL,
+		  name text,
+		  email text
+		) SERVER remote OPTIONS (schema_name 'public', table_name 'users');
+		`,
+	)
+	writeFile("schema.sql", createExtension+createServer+createForeignTable)
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--manage-foreign-data")
+	assertEquals(t, actual, applyPrefix+
+		"ALTER SERVER remote OPTIONS (SET host '127.0.0.1', ADD port '5432');\n"+
+		"ALTER FOREIGN TABLE remote_users ADD COLUMN email text;\n"+
+		"ALTER FOREIGN TABLE remote_users OPTIONS (ADD schema_name 'public');\n",
+	)
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--manage-foreign-data")
+	assertEquals(t, actual, nothingModified)
+
+	// Foreign data is not dropped without --manage-foreign-data.
+	assertApplyOutput(t, createExtension, nothingModified)
+
+	writeFile("schema.sql", createExtension)
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--manage-foreign-data")
+	assertEquals(t, actual, applyPrefix+
+		"DROP FOREIGN TABLE remote_users;\n"+
+		"DROP USER MAPPING FOR postgres SERVER remote;\n"+
+		"DROP SERVER remote;\n",
+	)
+}
+
+func TestPsqldefSerial(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigserial PRIMARY KEY,
+		  name text
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigserial PRIMARY KEY,
+		  name text,
+		  code serial
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users ADD COLUMN code serial;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name text,
+		  code serial
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE users ALTER COLUMN id DROP DEFAULT;\n"+
+		"DROP SEQUENCE users_id_seq;\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefIdentity(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id integer GENERATED ALWAYS AS IDENTITY,
+		  name text
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id integer GENERATED BY DEFAULT AS IDENTITY (START WITH 10 INCREMENT BY 2),
+		  name text,
+		  code bigint GENERATED ALWAYS AS IDENTITY
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE users ADD COLUMN code bigint GENERATED ALWAYS AS IDENTITY;\n"+
+		"ALTER TABLE users ALTER COLUMN id SET GENERATED BY DEFAULT SET INCREMENT BY 2 SET START WITH 10;\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id integer NOT NULL,
+		  name text,
+		  code bigint NOT NULL
+		);
+		ALTER TABLE users ALTER COLUMN code ADD GENERATED ALWAYS AS IDENTITY;
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE users ALTER COLUMN id DROP IDENTITY;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefMaterializedView(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name text
+		);
+		`,
+	)
+	createView := stripHeredoc(`
+		CREATE MATERIALIZED VIEW user_names AS SELECT id, name FROM users WITH NO DATA;
+		CREATE INDEX index_user_names_on_name ON user_names (name);
+		`,
+	)
+	assertApplyOutput(t, createTable+createView, applyPrefix+createTable+createView)
+	assertApplyOutput(t, createTable+createView, nothingModified)
+
+	createView = stripHeredoc(`
+		CREATE MATERIALIZED VIEW user_names AS SELECT id, name FROM users WHERE id > 1;
+		CREATE INDEX index_user_names_on_name ON user_names (name);
+		`,
+	)
+	writeFile("schema.sql", createTable+createView)
+	_, err := execute("psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql")
+	if err == nil {
+		t.Error("expected psqldef to fail without --recreate-materialized-views")
+	}
+
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql",
+		"--recreate-materialized-views", "--refresh-materialized-views")
+	assertEquals(t, actual, applyPrefix+"DROP MATERIALIZED VIEW user_names;\n"+createView+"REFRESH MATERIALIZED VIEW user_names;\n")
+	assertApplyOutput(t, createTable+createView, nothingModified)
+
+	writeFile("schema.sql", createTable)
+	actual = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql", "--enable-drop-table")
+	assertEquals(t, actual, applyPrefix+"DROP MATERIALIZED VIEW user_names;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 //
 // ----------------------- following tests are for CLI -----------------------
 //
@@ -255,6 +2009,7 @@ func TestPsqldefDryRun(t *testing.T) {
 	))
 
 	dryRun := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--dry-run", "--file", "schema.sql")
+	assertEquals(t, assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--dry-run", "--file", "schema.sql"), dryRun) // not modified by the first one
 	apply := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql")
 	assertEquals(t, dryRun, strings.Replace(apply, "Apply", "dry run", 1))
 }
@@ -283,6 +2038,85 @@ func TestPsqldefExport(t *testing.T) {
 	))
 }
 
+func TestPsqldefExportReapply(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", stripHeredoc(`
+		CREATE TABLE users (
+		    id bigint NOT NULL PRIMARY KEY,
+		    name text CHECK (length(name) > 0)
+		);
+		CREATE INDEX index_name ON users (name);
+		COMMENT ON TABLE users IS 'registered users';
+		COMMENT ON COLUMN users.name IS 'display name';
+		CREATE TABLE "order" (
+		    id bigint NOT NULL PRIMARY KEY,
+		    user_id bigint NOT NULL REFERENCES users (id) ON DELETE CASCADE
+		);`,
+	))
+	writeFile("schema.sql", assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--export"))
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql")
+	assertEquals(t, actual, nothingModified)
+}
+
+func TestPsqldefPartition(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE measurement (
+		  city_id integer NOT NULL,
+		  logdate date NOT NULL
+		) PARTITION BY RANGE (logdate);
+		`,
+	)
+	createPartition := "CREATE TABLE measurement_y2024 PARTITION OF measurement FOR VALUES FROM ('2024-01-01') TO ('2025-01-01');\n"
+	assertApplyOutput(t, createTable+createPartition, applyPrefix+createTable+createPartition)
+	assertApplyOutput(t, createTable+createPartition, nothingModified)
+
+	writeFile("schema.sql", assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--export"))
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql")
+	assertEquals(t, actual, nothingModified)
+
+	detachedTable := stripHeredoc(`
+		CREATE TABLE measurement_y2024 (
+		  city_id integer NOT NULL,
+		  logdate date NOT NULL
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable+detachedTable, applyPrefix+"ALTER TABLE measurement DETACH PARTITION measurement_y2024;\n")
+	assertApplyOutput(t, createTable+detachedTable, nothingModified)
+
+	attachPartition := "ALTER TABLE measurement ATTACH PARTITION measurement_y2024 FOR VALUES FROM ('2024-01-01') TO ('2025-01-01');\n"
+	assertApplyOutput(t, createTable+detachedTable+attachPartition, applyPrefix+attachPartition)
+	assertApplyOutput(t, createTable+detachedTable+attachPartition, nothingModified)
+	assertApplyOutput(t, createTable+createPartition, nothingModified)
+}
+
+func TestPsqldefExportOrder(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", stripHeredoc(`
+		CREATE TABLE zebras (
+		    id bigint
+		);
+		CREATE TABLE apples (
+		    id bigint
+		);`,
+	))
+	out := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--export")
+	// workaround: local has `public.` but travis doesn't.
+	assertEquals(t, strings.Replace(out, "public.", "", -1), stripHeredoc(`
+		CREATE TABLE apples (
+		    id bigint
+		);
+		CREATE TABLE zebras (
+		    id bigint
+		);
+		`,
+	))
+}
+
 func TestPsqldefHelp(t *testing.T) {
 	_, err := execute("psqldef", "--help")
 	if err != nil {
@@ -0,0 +1,219 @@
+package sqldef
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+)
+
+var (
+	configKeyPattern   = regexp.MustCompile(`^([a-z][a-z0-9-]*):(?:[ \t]+(.*))?$`)
+	configItemPattern  = regexp.MustCompile(`^[ \t]+-[ \t]+(.*)$`)
+	configBlockPattern = regexp.MustCompile(`^([|>])([-+]?)$`)
+)
+
+// Read the YAML config file given by `--config`, and return its keys and values as command-line flags like
+// `--enable-drop-table` and `--skip-table=schema_migrations`, to be parsed before ones given to the command.
+// Only a mapping of flag names to scalars or lists of scalars is supported, which is enough for flags. A scalar may be
+// a block scalar like `|` for multi-line SQL.
+func ReadConfigFile(path string) ([]string, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{}
+	listKey := "" // a key whose value is given as `- item` lines
+	lines := strings.Split(string(buf), "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimRight(stripConfigComment(lines[i]), " \t\r")
+		if strings.TrimSpace(line) == "" || line == "---" {
+			continue
+		}
+
+		if match := configItemPattern.FindStringSubmatch(line); match != nil && listKey != "" {
+			if block := configBlockPattern.FindStringSubmatch(match[1]); block != nil {
+				value, n := readConfigBlock(block[1], block[2], lines[i+1:])
+				args = append(args, "--"+listKey+"="+value)
+				i += n
+				continue
+			}
+			value, err := parseConfigScalar(match[1])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", i+1, err)
+			}
+			args = append(args, "--"+listKey+"="+value)
+			continue
+		}
+
+		match := configKeyPattern.FindStringSubmatch(line)
+		if match == nil {
+			return nil, fmt.Errorf("line %d: unsupported YAML '%s', which must be `flag-name: value`", i+1, line)
+		}
+		key, value := match[1], match[2]
+		listKey = ""
+
+		switch {
+		case value == "":
+			listKey = key
+		case configBlockPattern.MatchString(value):
+			block := configBlockPattern.FindStringSubmatch(value)
+			value, n := readConfigBlock(block[1], block[2], lines[i+1:])
+			args = append(args, "--"+key+"="+value)
+			i += n
+		case strings.HasPrefix(value, "[") && strings.HasSuffix(value, "]"):
+			items, err := splitConfigList(value[1 : len(value)-1])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", i+1, err)
+			}
+			for _, item := range items {
+				item, err := parseConfigScalar(item)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %s", i+1, err)
+				}
+				args = append(args, "--"+key+"="+item)
+			}
+		case value == "true":
+			args = append(args, "--"+key)
+		case value == "false":
+			// A boolean flag is false unless it's given.
+		default:
+			value, err := parseConfigScalar(value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", i+1, err)
+			}
+			args = append(args, "--"+key+"="+value)
+		}
+	}
+	return args, nil
+}
+
+// Read the indented lines of a block scalar given by `|` or `>` and its chomping indicator, and return its value
+// and the number of the lines. `>` folds lines into a line unless they're separated by an empty line or indented more.
+func readConfigBlock(style string, chomping string, lines []string) (string, int) {
+	block := []string{}
+	indent := ""
+	n := 0
+	for ; n < len(lines); n++ {
+		line := strings.TrimRight(lines[n], "\r")
+		if strings.TrimSpace(line) == "" {
+			block = append(block, "")
+			continue
+		}
+		if indent == "" {
+			indent = line[:len(line)-len(strings.TrimLeft(line, " "))]
+		}
+		if indent == "" || !strings.HasPrefix(line, indent) {
+			break
+		}
+		block = append(block, line[len(indent):])
+	}
+
+	// Empty lines at the end are kept only by `+`.
+	trailing := 0
+	for len(block) > 0 && block[len(block)-1] == "" {
+		block = block[:len(block)-1]
+		trailing++
+	}
+	if len(block) == 0 {
+		return "", n
+	}
+
+	var value string
+	if style == "|" {
+		value = strings.Join(block, "\n")
+	} else {
+		value = block[0]
+		for j := 1; j < len(block); j++ {
+			prev, line := block[j-1], block[j]
+			if line == "" {
+				value += "\n"
+				continue
+			}
+			if prev != "" && !strings.HasPrefix(prev, " ") && !strings.HasPrefix(line, " ") {
+				value += " "
+			} else if prev != "" {
+				value += "\n"
+			}
+			value += line
+		}
+	}
+
+	switch chomping {
+	case "-":
+		return value, n
+	case "+":
+		return value + strings.Repeat("\n", trailing+1), n
+	default:
+		return value + "\n", n
+	}
+}
+
+// Split items of a flow sequence like `[a, 'b, c']` by commas out of quoted strings. A nested collection is not
+// supported, so an item having `[` or `{` needs to be quoted.
+func splitConfigList(list string) ([]string, error) {
+	items := []string{}
+	var quote rune
+	escaped := false // by `\` in a double-quoted string
+	start := 0
+	for i, char := range list {
+		switch {
+		case escaped:
+			escaped = false
+		case quote == '"' && char == '\\':
+			escaped = true
+		case quote != 0:
+			if char == quote {
+				quote = 0
+			}
+		case char == '\'' || char == '"':
+			quote = char
+		case char == '[' || char == ']' || char == '{' || char == '}':
+			return nil, fmt.Errorf("unsupported YAML list '[%s]', whose item having '%c' must be quoted", list, char)
+		case char == ',':
+			items = append(items, list[start:i])
+			start = i + 1
+		}
+	}
+	items = append(items, list[start:])
+
+	result := []string{}
+	for _, item := range items {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
+
+// Remove `# comment` unless `#` is in a quoted string or a word.
+func stripConfigComment(line string) string {
+	var quote rune
+	for i, char := range line {
+		switch {
+		case quote != 0:
+			if char == quote {
+				quote = 0
+			}
+		case char == '\'' || char == '"':
+			quote = char
+		case char == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+	return line
+}
+
+func parseConfigScalar(value string) (string, error) {
+	if len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'' {
+		return strings.Replace(value[1:len(value)-1], "''", "'", -1), nil
+	}
+	if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+		return strings.NewReplacer(`\"`, `"`, `\\`, `\`).Replace(value[1 : len(value)-1]), nil
+	}
+	if strings.ContainsAny(value[:1], `'"[{&*!|>%@`+"`") {
+		return "", fmt.Errorf("unsupported YAML value '%s'", value)
+	}
+	return value, nil
+}
@@ -0,0 +1,91 @@
+package sqldef
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadConfigFile(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config string
+		args   []string
+		err    string
+	}{{
+		name:   "scalars",
+		config: "---\nuser: app # comment\nhost: db.example.com\nport: 3307\npassword: 'it''s#secret'\n",
+		args:   []string{"--user=app", "--host=db.example.com", "--port=3307", "--password=it's#secret"},
+	}, {
+		name:   "booleans",
+		config: "enable-drop-table: true\nenable-drop-column: false\n",
+		args:   []string{"--enable-drop-table"},
+	}, {
+		name:   "a block list",
+		config: "skip-table:\n  - schema_migrations\n  - 'awsdms_.*'\n  - \"tmp_\\\"\"\nuser: app\n",
+		args:   []string{"--skip-table=schema_migrations", "--skip-table=awsdms_.*", `--skip-table=tmp_"`, "--user=app"},
+	}, {
+		name:   "a flow list",
+		config: "skip-table: [schema_migrations, 'a, b', \"^tmp_.{1,3}$\", '[x]']\n",
+		args:   []string{"--skip-table=schema_migrations", "--skip-table=a, b", "--skip-table=^tmp_.{1,3}$", "--skip-table=[x]"},
+	}, {
+		name:   "an empty flow list",
+		config: "skip-table: []\n",
+		args:   []string{},
+	}, {
+		name:   "a literal block scalar",
+		config: "before-apply: |\n  SET a = 1;\n\n  SET b = 2; # not a comment\nuser: app\n",
+		args:   []string{"--before-apply=SET a = 1;\n\nSET b = 2; # not a comment\n", "--user=app"},
+	}, {
+		name:   "a folded block scalar with strip chomping",
+		config: "before-apply: >-\n  SET a =\n  1;\n\n  SET b = 2;\n",
+		args:   []string{"--before-apply=SET a = 1;\nSET b = 2;"},
+	}, {
+		name:   "a block scalar with keep chomping in a list",
+		config: "after-apply:\n  - |+\n    ANALYZE TABLE users\n\n  - ANALYZE TABLE posts\n",
+		args:   []string{"--after-apply=ANALYZE TABLE users\n\n", "--after-apply=ANALYZE TABLE posts"},
+	}, {
+		name:   "an unquoted item having a brace in a flow list",
+		config: "user: app\nskip-table: [^tmp_.{1,3}$]\n",
+		err:    "line 2: unsupported YAML list '[^tmp_.{1,3}$]', whose item having '{' must be quoted",
+	}, {
+		name:   "a nested mapping",
+		config: "user: app\nmysql:\n  user: app\n",
+		err:    "line 3: unsupported YAML '  user: app', which must be `flag-name: value`",
+	}, {
+		name:   "an alias",
+		config: "user: *app\n",
+		err:    "line 1: unsupported YAML value '*app'",
+	}, {
+		name:   "a block scalar with an indentation indicator",
+		config: "before-apply: |2\n  SET a = 1;\n",
+		err:    "line 1: unsupported YAML value '|2'",
+	}}
+
+	dir, err := ioutil.TempDir("", "sqldef")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "sqldef.yml")
+
+	for _, tc := range testCases {
+		if err := ioutil.WriteFile(path, []byte(tc.config), 0644); err != nil {
+			t.Fatal(err)
+		}
+		args, err := ReadConfigFile(path)
+		if tc.err != "" {
+			if err == nil || err.Error() != tc.err {
+				t.Errorf("%s: error %v, want %q", tc.name, err, tc.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+		} else if !reflect.DeepEqual(args, tc.args) {
+			t.Errorf("%s: args %q, want %q", tc.name, args, tc.args)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package sqldef
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Write DDLs into a migration file named by the time, for a migration runner like goose or golang-migrate.
+// Only the up migration is written, since the schema after running DDLs is not known without running them.
+func writeMigrationFile(dirpath string, format string, ddls []string, now time.Time) (string, error) {
+	if err := os.MkdirAll(dirpath, 0755); err != nil {
+		return "", err
+	}
+
+	var buffer bytes.Buffer
+	name := now.UTC().Format("20060102150405") + "_sqldef"
+	switch format {
+	case "sql":
+		name += ".sql"
+	case "goose":
+		name += ".sql"
+		buffer.WriteString("-- +goose Up\n")
+	case "golang-migrate":
+		name += ".up.sql"
+	default:
+		return "", fmt.Errorf("unknown migration format '%s'", format)
+	}
+	for _, ddl := range ddls {
+		// goose splits statements by `;`, which may be in a function body.
+		if format == "goose" && strings.Contains(ddl, ";") {
+			buffer.WriteString("-- +goose StatementBegin\n" + ddl + ";\n-- +goose StatementEnd\n")
+		} else {
+			buffer.WriteString(ddl + ";\n")
+		}
+	}
+
+	path := filepath.Join(dirpath, name)
+	if _, err := os.Stat(path); err == nil {
+		return "", fmt.Errorf("'%s' already exists", path)
+	}
+	return path, ioutil.WriteFile(path, buffer.Bytes(), 0644)
+}
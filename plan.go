@@ -0,0 +1,126 @@
+package sqldef
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+)
+
+const namePattern = "((?:[\\w$]+|`[^`]+`|\"[^\"]+\")(?:\\.(?:[\\w$]+|`[^`]+`|\"[^\"]+\"))*)"
+
+var (
+	objectKindPattern  = regexp.MustCompile(`^(CREATE|ALTER|DROP|REFRESH)(?: OR REPLACE)?(?: UNIQUE)? (MATERIALIZED VIEW|FOREIGN TABLE|USER MAPPING|TABLE|INDEX|VIEW|FUNCTION|PROCEDURE|TRIGGER|SEQUENCE|TYPE|DOMAIN|EXTENSION|SCHEMA|POLICY|SERVER)\b`)
+	tableNamePattern   = regexp.MustCompile(`^(?:CREATE|ALTER|DROP|COMMENT ON|GRANT .+ ON|REVOKE .+ ON)(?: FOREIGN)? TABLE (?:IF EXISTS |ONLY )?` + namePattern)
+	columnNamePattern  = regexp.MustCompile(`^COMMENT ON COLUMN ` + namePattern + `\.(?:[\w$]+|` + "`[^`]+`" + `|"[^"]+")`)
+	onTablePattern     = regexp.MustCompile(`^(?:CREATE|ALTER|DROP)[^;]*? (?:INDEX|TRIGGER|POLICY) .*? ON (?:ONLY )?` + namePattern)
+	destructivePattern = regexp.MustCompile(`^(?:DROP|REVOKE)\b|^ALTER TABLE .*\bDROP (?:COLUMN|PARTITION)\b`)
+	rebuildablePattern = regexp.MustCompile(`^DROP INDEX\b`)
+)
+
+// A DDL with metadata given by `--format json`, so that other tools don't parse it.
+type PlannedDDL struct {
+	Statement   string `json:"statement"`
+	Kind        string `json:"kind"`            // like "create_table", "alter_table" and "grant"
+	Table       string `json:"table,omitempty"` // the table which is changed, if any
+	Destructive bool   `json:"destructive"`     // whether it loses data or objects, like DROP TABLE, DROP COLUMN and REVOKE
+	Skipped     bool   `json:"skipped,omitempty"`
+}
+
+// A plan written by --plan-out and run by --plan. It has the fingerprint of the current schema, not to run DDLs
+// for a database which has been changed since planning.
+type Plan struct {
+	Fingerprint string       `json:"fingerprint"`
+	DDLs        []PlannedDDL `json:"ddls"`
+}
+
+func planDDLs(ddls []string, skippedDDLs []string) []PlannedDDL {
+	plan := []PlannedDDL{}
+	for _, ddl := range skippedDDLs {
+		planned := planDDL(ddl)
+		planned.Skipped = true
+		plan = append(plan, planned)
+	}
+	for _, ddl := range ddls {
+		plan = append(plan, planDDL(ddl))
+	}
+	return plan
+}
+
+func planDDL(ddl string) PlannedDDL {
+	// Keywords are matched on a single line, since pg_dump's DDLs like `ALTER TABLE ONLY` may be broken.
+	statement := strings.Join(strings.Fields(ddl), " ")
+	// Any DROP and REVOKE is destructive, except for DROP INDEX, whose index can be built again from the table.
+	// ALTER TABLE loses data only by dropping columns and partitions, unlike dropping constraints, keys and defaults.
+	planned := PlannedDDL{
+		Statement:   ddl,
+		Destructive: destructivePattern.MatchString(statement) && !rebuildablePattern.MatchString(statement),
+	}
+
+	if match := objectKindPattern.FindStringSubmatch(statement); match != nil {
+		planned.Kind = strings.ToLower(match[1] + "_" + strings.Replace(match[2], " ", "_", -1))
+	} else {
+		planned.Kind = strings.ToLower(strings.SplitN(statement, " ", 2)[0])
+	}
+
+	if match := tableNamePattern.FindStringSubmatch(statement); match != nil {
+		planned.Table = unquoteName(match[1])
+	} else if match := columnNamePattern.FindStringSubmatch(statement); match != nil {
+		planned.Table = unquoteName(match[1])
+	} else if match := onTablePattern.FindStringSubmatch(statement); match != nil {
+		planned.Table = unquoteName(match[1])
+	}
+	return planned
+}
+
+func unquoteName(name string) string {
+	return strings.NewReplacer("`", "", `"`, "").Replace(name)
+}
+
+func writePlanFile(path string, ddls []string, skippedDDLs []string, currentDDLs string) error {
+	out, err := json.MarshalIndent(Plan{Fingerprint: fingerprint(currentDDLs), DDLs: planDDLs(ddls, skippedDDLs)}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(out, '\n'), 0644)
+}
+
+// Return DDLs in the plan file except skipped ones, or an error if the current schema is not the planned one.
+func readPlanFile(path string, currentDDLs string) ([]string, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var plan Plan
+	if err := json.Unmarshal(buf, &plan); err != nil {
+		return nil, err
+	}
+	if plan.Fingerprint != fingerprint(currentDDLs) {
+		return nil, fmt.Errorf("the database has been changed since it was planned")
+	}
+
+	ddls := []string{}
+	for _, ddl := range plan.DDLs {
+		if !ddl.Skipped {
+			ddls = append(ddls, ddl.Statement)
+		}
+	}
+	return ddls, nil
+}
+
+func fingerprint(currentDDLs string) string {
+	sum := sha256.Sum256([]byte(currentDDLs))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func showPlan(ddls []string, skippedDDLs []string) error {
+	out, err := json.MarshalIndent(planDDLs(ddls, skippedDDLs), "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
@@ -0,0 +1,62 @@
+package sqldef
+
+import (
+	"testing"
+)
+
+func TestPlanDDL(t *testing.T) {
+	testCases := []struct {
+		ddl         string
+		kind        string
+		table       string
+		destructive bool
+	}{
+		{ddl: "CREATE TABLE users (id bigint)", kind: "create_table", table: "users"},
+		{ddl: "ALTER TABLE users ADD COLUMN name text", kind: "alter_table", table: "users"},
+		{ddl: "ALTER TABLE users DROP COLUMN name", kind: "alter_table", table: "users", destructive: true},
+		{ddl: "ALTER TABLE `order` DROP COLUMN `group`", kind: "alter_table", table: "order", destructive: true},
+		{ddl: "ALTER TABLE logs DROP PARTITION logs_2020", kind: "alter_table", table: "logs", destructive: true},
+		{ddl: "ALTER TABLE logs DETACH PARTITION logs_2020", kind: "alter_table", table: "logs"},
+		{ddl: "ALTER TABLE users DROP CONSTRAINT users_name_check", kind: "alter_table", table: "users"},
+		{ddl: "ALTER TABLE users DROP FOREIGN KEY users_ibfk_1", kind: "alter_table", table: "users"},
+		{ddl: "ALTER TABLE users DROP PRIMARY KEY", kind: "alter_table", table: "users"},
+		{ddl: "ALTER TABLE users ALTER COLUMN name DROP DEFAULT", kind: "alter_table", table: "users"},
+		{ddl: "ALTER TABLE ONLY public.users\n    DROP COLUMN name", kind: "alter_table", table: "public.users", destructive: true},
+		{ddl: "DROP TABLE users", kind: "drop_table", table: "users", destructive: true},
+		{ddl: "DROP FOREIGN TABLE remote_users", kind: "drop_foreign_table", table: "remote_users", destructive: true},
+		{ddl: "CREATE INDEX index_name ON users (name)", kind: "create_index", table: "users"},
+		{ddl: "CREATE UNIQUE INDEX index_name ON users (name)", kind: "create_index", table: "users"},
+		{ddl: "DROP INDEX index_name", kind: "drop_index"},
+		{ddl: "CREATE VIEW active_users AS select * from users", kind: "create_view"},
+		{ddl: "CREATE OR REPLACE VIEW active_users AS select * from users", kind: "create_view"},
+		{ddl: "DROP VIEW active_users", kind: "drop_view", destructive: true},
+		{ddl: "DROP MATERIALIZED VIEW user_counts", kind: "drop_materialized_view", destructive: true},
+		{ddl: "REFRESH MATERIALIZED VIEW user_counts", kind: "refresh_materialized_view"},
+		{ddl: "DROP FUNCTION add_numbers(integer, integer)", kind: "drop_function", destructive: true},
+		{ddl: "DROP PROCEDURE reset_names", kind: "drop_procedure", destructive: true},
+		{ddl: "DROP TRIGGER users_updated_at ON users", kind: "drop_trigger", table: "users", destructive: true},
+		{ddl: "DROP SEQUENCE user_ids", kind: "drop_sequence", destructive: true},
+		{ddl: "ALTER SEQUENCE user_ids INCREMENT BY 2", kind: "alter_sequence"},
+		{ddl: "DROP TYPE mood", kind: "drop_type", destructive: true},
+		{ddl: "ALTER TYPE mood ADD VALUE 'happy'", kind: "alter_type"},
+		{ddl: "DROP DOMAIN positive_int", kind: "drop_domain", destructive: true},
+		{ddl: "ALTER DOMAIN positive_int DROP NOT NULL", kind: "alter_domain"},
+		{ddl: "DROP EXTENSION \"citext\"", kind: "drop_extension", destructive: true},
+		{ddl: "DROP SCHEMA app", kind: "drop_schema", destructive: true},
+		{ddl: "DROP POLICY p_users ON users", kind: "drop_policy", table: "users", destructive: true},
+		{ddl: "DROP SERVER remote", kind: "drop_server", destructive: true},
+		{ddl: "DROP USER MAPPING FOR public SERVER remote", kind: "drop_user_mapping", destructive: true},
+		{ddl: "ALTER SERVER remote OPTIONS (DROP port)", kind: "alter_server"},
+		{ddl: "GRANT SELECT ON TABLE users TO reader", kind: "grant", table: "users"},
+		{ddl: "REVOKE INSERT ON TABLE users FROM reader", kind: "revoke", table: "users", destructive: true},
+		{ddl: "REVOKE GRANT OPTION FOR SELECT ON TABLE users FROM reader", kind: "revoke", table: "users", destructive: true},
+		{ddl: "COMMENT ON COLUMN users.name IS 'name'", kind: "comment", table: "users"},
+	}
+	for _, tc := range testCases {
+		planned := planDDL(tc.ddl)
+		if planned.Kind != tc.kind || planned.Table != tc.table || planned.Destructive != tc.destructive {
+			t.Errorf("planDDL(%q) = (kind: %q, table: %q, destructive: %t), want (kind: %q, table: %q, destructive: %t)",
+				tc.ddl, planned.Kind, planned.Table, planned.Destructive, tc.kind, tc.table, tc.destructive)
+		}
+	}
+}
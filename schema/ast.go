@@ -1,5 +1,7 @@
 package schema
 
+import "github.com/k0kubun/sqldef/sqlparser"
+
 type DDL interface {
 	Statement() string
 }
@@ -27,38 +29,373 @@ type AddPrimaryKey struct {
 	index     Index
 }
 
+type AddForeignKey struct {
+	statement  string
+	tableName  string
+	foreignKey ForeignKey
+}
+
+// PostgreSQL's `ALTER TABLE ... ADD CONSTRAINT ... EXCLUDE`
+type AddExclusion struct {
+	statement string
+	tableName string
+	exclusion Exclusion
+}
+
+// PostgreSQL's `ALTER TABLE parent ATTACH PARTITION child FOR VALUES ...`
+type AttachPartition struct {
+	statement     string
+	tableName     string // parent
+	partitionName string // child
+	bound         string
+}
+
+type CreateView struct {
+	statement string
+	view      View
+}
+
+type CreateTrigger struct {
+	statement string
+	trigger   Trigger
+}
+
+// `CREATE FUNCTION`, or MySQL's `CREATE PROCEDURE`
+type CreateFunction struct {
+	statement string
+	function  Function
+}
+
+// PostgreSQL's `ALTER TABLE ... ALTER COLUMN ... ADD GENERATED ... AS IDENTITY`, which pg_dump(1) gives after `CREATE TABLE`
+type AddIdentity struct {
+	statement  string
+	tableName  string
+	columnName string
+	identity   Identity
+}
+
+// PostgreSQL's `ALTER TABLE ... ALTER COLUMN ... SET DEFAULT nextval(...)`, which pg_dump(1) gives for a serial column
+type SetDefaultSequence struct {
+	statement    string
+	tableName    string
+	columnName   string
+	sequenceName string
+}
+
+// PostgreSQL's `CREATE SEQUENCE`
+type CreateSequence struct {
+	statement string
+	sequence  Sequence
+}
+
+// PostgreSQL's `ALTER SEQUENCE`, which changes options given by `CREATE SEQUENCE`
+type AlterSequence struct {
+	statement string
+	name      string
+	spec      *sqlparser.SequenceSpec
+}
+
+// PostgreSQL's `CREATE TYPE ... AS ENUM`
+type CreateType struct {
+	statement string
+	enum      Enum
+}
+
+// PostgreSQL's `CREATE DOMAIN`
+type CreateDomain struct {
+	statement string
+	domain    Domain
+}
+
+// PostgreSQL's `CREATE SCHEMA`
+type CreateSchema struct {
+	statement string
+	schema    Schema
+}
+
+// PostgreSQL's `CREATE EXTENSION`
+type CreateExtension struct {
+	statement string
+	extension Extension
+}
+
+// PostgreSQL's `CREATE SERVER`
+type CreateServer struct {
+	statement string
+	server    Server
+}
+
+// PostgreSQL's `CREATE USER MAPPING`
+type CreateUserMapping struct {
+	statement   string
+	userMapping UserMapping
+}
+
+// PostgreSQL's `CREATE FOREIGN TABLE`
+type CreateForeignTable struct {
+	statement    string
+	foreignTable ForeignTable
+}
+
+type DropTable struct {
+	statement string
+	tableName string
+	ifExists  bool
+}
+
+type DropIndex struct {
+	statement string
+	tableName string // Empty for PostgreSQL's `DROP INDEX`
+	indexName string
+	ifExists  bool
+}
+
+// PostgreSQL's `COMMENT ON TABLE` or `COMMENT ON COLUMN`
+type CommentOn struct {
+	statement  string
+	tableName  string
+	columnName string  // Empty for `COMMENT ON TABLE`
+	comment    *string // nil for `IS NULL`
+}
+
+// PostgreSQL's `ALTER TABLE ... { ENABLE | DISABLE | FORCE | NO FORCE } ROW LEVEL SECURITY`
+type SetRowLevelSecurity struct {
+	statement string
+	tableName string
+	action    string
+}
+
+// PostgreSQL's `CREATE POLICY`
+type CreatePolicy struct {
+	statement string
+	policy    Policy
+}
+
+// PostgreSQL's `GRANT` and `REVOKE`, whose privileges are given for each object, grantee and privilege
+type GrantPrivilege struct {
+	statement  string
+	privileges []Privilege
+}
+
+type RevokePrivilege struct {
+	statement  string
+	privileges []Privilege
+}
+
 type Table struct {
-	name    string
-	columns []Column
-	indexes []Index
-	// XXX: have options and alter on its change?
+	name             string
+	columns          []Column
+	indexes          []Index
+	foreignKeys      []ForeignKey
+	checks           []Check
+	exclusions       []Exclusion       // Only for PostgreSQL
+	comment          *string           // Only for MySQL. PostgreSQL's one is set by `CommentOn`.
+	options          map[string]string // Table options like MySQL's ENGINE or PostgreSQL's TABLESPACE, keyed by an uppercased name. COMMENT is not included.
+	partition        string            // Normalized `PARTITION BY` clause, or empty if not partitioned.
+	partitionOf      string            // PostgreSQL's parent table of a partition, or empty if it's not.
+	bound            string            // PostgreSQL's normalized partition bound like `FOR VALUES IN (1)`.
+	inherits         []string          // PostgreSQL's parent tables given by INHERITS
+	rowSecurity      bool              // PostgreSQL's ENABLE ROW LEVEL SECURITY
+	forceRowSecurity bool              // PostgreSQL's FORCE ROW LEVEL SECURITY, which applies policies to the owner as well
+	systemVersioning bool              // MariaDB's WITH SYSTEM VERSIONING
+	like             string            // MySQL's table given by `CREATE TABLE ... LIKE`, which is expanded by `parseDDLs`
+	renamedFrom      string            // The old name given by `-- @renamed from=old_name` above CREATE TABLE, or empty
 }
 
 type Column struct {
 	name          string
 	typeName      string
 	unsigned      bool
+	zerofill      bool
 	notNull       bool
 	autoIncrement bool
 	defaultVal    *Value
+	onUpdate      *Value // MySQL's ON UPDATE CURRENT_TIMESTAMP
+	defaultSeq    string // PostgreSQL's DEFAULT nextval('sequence'), unless it's normalized to a serial type
 	length        *Value
 	scale         *Value
+	array         bool     // PostgreSQL's array type like `text[]`. Its dimensions are not kept.
+	timezone      bool     // PostgreSQL's time or timestamp WITH TIME ZONE, including timestamptz and timetz
+	geometryType  string   // PostGIS's geometry type like `point` of `geometry(Point,4326)`, lowercased
+	srid          *Value   // MySQL's SRID attribute, or the SRID of PostGIS's geometry type
+	enumValues    []string // Unquoted values of MySQL's ENUM or SET
 	keyOption     ColumnKeyOption
+	comment       *string // nil if it has no COMMENT, which is distinguished from `COMMENT ''`
+	generated     *Generated
+	identity      *Identity
+	charset       string // Empty if it's not specified. MySQL omits it when it's the same as the table's one.
+	collate       string // Empty if it's not specified. MySQL omits it when it's the default of the charset.
+	invisible     bool   // MySQL's INVISIBLE column
+	rowPeriod     string // MariaDB's "row start" or "row end" of a system-versioned table, or empty
+	renamedFrom   string // The old name given by `-- @renamed from=old_name`, or empty
 	// TODO: keyopt
-	// XXX: charset, collate, zerofill?
 }
 
 type Index struct {
-	name      string
-	indexType string // Parsed only in "create table" but not parsed in "add index". Only used inside `generateDDLsForCreateTable`.
-	columns   []IndexColumn
-	primary   bool
-	unique    bool
+	name       string
+	indexType  string // Parsed only in "create table" but not parsed in "add index". Only used inside `generateDDLsForCreateTable`.
+	columns    []IndexColumn
+	primary    bool
+	unique     bool
+	constraint bool   // `CONSTRAINT name UNIQUE`. PostgreSQL drops it by `DROP CONSTRAINT` instead of `DROP INDEX`.
+	deferrable string // PostgreSQL's `deferrable` or `deferrable initially deferred` of a unique constraint, or empty
+	fulltext   bool
+	parser     string // MySQL's WITH PARSER of a fulltext index, lowercased
+	spatial    bool
+	invisible  bool     // MySQL's INVISIBLE index
+	method     string   // PostgreSQL's access method like `btree` or `gin`. Empty for MySQL.
+	include    []string // PostgreSQL's INCLUDE columns of a covering index
+	where      string   // PostgreSQL's predicate of a partial index, normalized by `normalizeExpr`
+	tablespace string   // PostgreSQL's TABLESPACE, or empty for the default one
 }
 
 type IndexColumn struct {
-	column string
-	length *Value // Parsed in "create table" but not parsed in "add index". So actually not used yet.
+	column    string // An expression is given in parentheses like `(lower(email))`
+	length    *Value // MySQL's prefix length like `name(10)`, or nil
+	direction string // "asc" or "desc"
+	nulls     string // PostgreSQL's "nulls first" or "nulls last". Empty if it's the default of the direction.
+}
+
+type ForeignKey struct {
+	constraintName   string
+	indexName        string // Only for MySQL. Not compared since it's not shown by `SHOW CREATE TABLE`.
+	indexColumns     []string
+	referenceName    string
+	referenceColumns []string
+	onDelete         string
+	onUpdate         string
+	deferrable       string // PostgreSQL's `deferrable` or `deferrable initially deferred`, or empty
+}
+
+type View struct {
+	name         string
+	definition   string // Normalized by `normalizeView` for comparison
+	materialized bool   // PostgreSQL's materialized view
+	indexes      []Index
+}
+
+// Parts of a function are normalized by `parseFunction` for comparison.
+type Function struct {
+	name      string
+	arguments []string
+	returns   string
+	language  string
+	body      string
+	options   []string // Sorted, without default ones
+	procedure bool     // MySQL's procedure, which has a namespace separated from functions
+}
+
+// Options of a sequence are kept as they're given, and `normalizeSequence` fills the defaults for comparison.
+type Sequence struct {
+	name        string
+	dataType    string // smallint, integer or bigint. Empty for the default.
+	incrementBy string
+	minValue    string // Empty for NO MINVALUE
+	maxValue    string // Empty for NO MAXVALUE
+	startWith   string
+	cache       string
+	cycle       bool
+	ownedBy     string // `table.column`, or empty for OWNED BY NONE
+}
+
+// PostgreSQL's enum type
+type Enum struct {
+	name   string
+	labels []string // Unquoted labels in the sort order
+}
+
+// PostgreSQL's domain, which is a base type with constraints
+type Domain struct {
+	name       string
+	dataType   string // Normalized base type with its length like `character varying(10)`
+	defaultVal string // Normalized by `normalizeExpr`, or empty if it's not given
+	notNull    bool
+	checks     []Check
+}
+
+// PostgreSQL's schema other than public
+type Schema struct {
+	name string
+}
+
+// PostgreSQL's extension like pgcrypto
+type Extension struct {
+	name string
+}
+
+// PostgreSQL's foreign server, which connects to an external data source by its foreign data wrapper
+type Server struct {
+	name       string
+	serverType string // Empty if TYPE is not given
+	version    string // Empty if VERSION is not given
+	wrapper    string
+	options    map[string]string // OPTIONS keyed by a lowercased name
+}
+
+// PostgreSQL's user mapping of a role for a foreign server
+type UserMapping struct {
+	user    string // A role or `public`
+	server  string
+	options map[string]string // OPTIONS keyed by a lowercased name
+}
+
+// PostgreSQL's foreign table, whose data is stored by a foreign server
+type ForeignTable struct {
+	name    string
+	columns []Column
+	server  string
+	options map[string]string // OPTIONS keyed by a lowercased name
+}
+
+type Trigger struct {
+	name      string
+	tableName string
+	time      string   // before, after or instead of
+	events    []string // Sorted to compare them regardless of the order
+	forEach   string   // row or statement
+	body      string   // Normalized by `normalizeTriggerBody` for comparison
+}
+
+// PostgreSQL's row-level security policy of a table
+type Policy struct {
+	name       string
+	tableName  string
+	permissive string   // permissive or restrictive
+	command    string   // all, select, insert, update or delete
+	roles      []string // Sorted, and `public` if no role is given
+	using      string   // Normalized by `normalizeExpr`, or empty if it's not given
+	withCheck  string   // Normalized by `normalizeExpr`, or empty if it's not given
+}
+
+// A privilege of a table or a sequence granted to a role
+type Privilege struct {
+	objectType  string // table or sequence
+	objectName  string
+	grantee     string
+	privilege   string // Lowercased like `select`. ALL PRIVILEGES is expanded.
+	grantOption bool
+}
+
+type Check struct {
+	constraintName string
+	definition     string // Normalized by `normalizeExpr` for comparison
+}
+
+// PostgreSQL's exclusion constraint
+type Exclusion struct {
+	constraintName string
+	definition     string // Normalized like `USING gist (room WITH =, during WITH &&) WHERE (...)` for comparison
+}
+
+// PostgreSQL's identity column, whose sequence has the same type as the column.
+type Identity struct {
+	behavior string   // always or by default
+	sequence Sequence // Options of the sequence, whose name is not managed
+}
+
+type Generated struct {
+	expr   string // Normalized by `normalizeExpr` for comparison
+	stored bool   // VIRTUAL if false
 }
 
 type Value struct {
@@ -70,6 +407,7 @@ type Value struct {
 	intVal   int     // ValueTypeInt
 	floatVal float64 // ValueTypeFloat
 	bitVal   bool    // ValueTypeBit
+	exprVal  string  // ValueTypeExpr, normalized to compare equivalent functions
 }
 
 type ValueType int
@@ -82,6 +420,7 @@ const (
 	ValueTypeHex
 	ValueTypeValArg
 	ValueTypeBit
+	ValueTypeExpr // A function call like now(), whose raw is the given expression
 )
 
 type ColumnKeyOption int
@@ -110,3 +449,99 @@ func (a *AddIndex) Statement() string {
 func (a *AddPrimaryKey) Statement() string {
 	return a.statement
 }
+
+func (a *AddForeignKey) Statement() string {
+	return a.statement
+}
+
+func (a *AddExclusion) Statement() string {
+	return a.statement
+}
+
+func (c *CreateView) Statement() string {
+	return c.statement
+}
+
+func (c *CreateTrigger) Statement() string {
+	return c.statement
+}
+
+func (c *CreateFunction) Statement() string {
+	return c.statement
+}
+
+func (a *AddIdentity) Statement() string {
+	return a.statement
+}
+
+func (s *SetDefaultSequence) Statement() string {
+	return s.statement
+}
+
+func (c *CreateType) Statement() string {
+	return c.statement
+}
+
+func (c *CreateDomain) Statement() string {
+	return c.statement
+}
+
+func (c *CreateSchema) Statement() string {
+	return c.statement
+}
+
+func (c *CreateExtension) Statement() string {
+	return c.statement
+}
+
+func (c *CreateSequence) Statement() string {
+	return c.statement
+}
+
+func (a *AlterSequence) Statement() string {
+	return a.statement
+}
+
+func (a *AttachPartition) Statement() string {
+	return a.statement
+}
+
+func (c *CommentOn) Statement() string {
+	return c.statement
+}
+
+func (s *SetRowLevelSecurity) Statement() string {
+	return s.statement
+}
+
+func (c *CreatePolicy) Statement() string {
+	return c.statement
+}
+
+func (g *GrantPrivilege) Statement() string {
+	return g.statement
+}
+
+func (r *RevokePrivilege) Statement() string {
+	return r.statement
+}
+
+func (c *CreateServer) Statement() string {
+	return c.statement
+}
+
+func (c *CreateUserMapping) Statement() string {
+	return c.statement
+}
+
+func (c *CreateForeignTable) Statement() string {
+	return c.statement
+}
+
+func (d *DropTable) Statement() string {
+	return d.statement
+}
+
+func (d *DropIndex) Statement() string {
+	return d.statement
+}
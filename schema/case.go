@@ -0,0 +1,115 @@
+package schema
+
+import (
+	"strings"
+)
+
+// Lowercase names of tables, columns, indexes, constraints, triggers and policies to compare them case-insensitively,
+// for MySQL with lower_case_table_names. PostgreSQL's unquoted identifiers are folded by the parser instead, since
+// quoted ones are case-sensitive. Expressions are kept as they are, since they may have string literals.
+func foldIdentifiers(ddls []DDL) {
+	for _, ddl := range ddls {
+		switch ddl := ddl.(type) {
+		case *CreateTable:
+			foldTable(&ddl.table)
+		case *CreateIndex:
+			ddl.tableName = strings.ToLower(ddl.tableName)
+			foldIndex(&ddl.index)
+		case *AddIndex:
+			ddl.tableName = strings.ToLower(ddl.tableName)
+			foldIndex(&ddl.index)
+		case *AddPrimaryKey:
+			ddl.tableName = strings.ToLower(ddl.tableName)
+			foldIndex(&ddl.index)
+		case *AddForeignKey:
+			ddl.tableName = strings.ToLower(ddl.tableName)
+			foldForeignKey(&ddl.foreignKey)
+		case *AddExclusion:
+			ddl.tableName = strings.ToLower(ddl.tableName)
+			ddl.exclusion.constraintName = strings.ToLower(ddl.exclusion.constraintName)
+		case *AttachPartition:
+			ddl.tableName = strings.ToLower(ddl.tableName)
+			ddl.partitionName = strings.ToLower(ddl.partitionName)
+		case *CreateView:
+			ddl.view.name = strings.ToLower(ddl.view.name)
+			for i := range ddl.view.indexes {
+				foldIndex(&ddl.view.indexes[i])
+			}
+		case *CreateTrigger:
+			ddl.trigger.name = strings.ToLower(ddl.trigger.name)
+			ddl.trigger.tableName = strings.ToLower(ddl.trigger.tableName)
+		case *AddIdentity:
+			ddl.tableName = strings.ToLower(ddl.tableName)
+			ddl.columnName = strings.ToLower(ddl.columnName)
+		case *SetDefaultSequence:
+			ddl.tableName = strings.ToLower(ddl.tableName)
+			ddl.columnName = strings.ToLower(ddl.columnName)
+		case *DropTable:
+			ddl.tableName = strings.ToLower(ddl.tableName)
+		case *DropIndex:
+			ddl.tableName = strings.ToLower(ddl.tableName)
+			ddl.indexName = strings.ToLower(ddl.indexName)
+		case *CommentOn:
+			ddl.tableName = strings.ToLower(ddl.tableName)
+			ddl.columnName = strings.ToLower(ddl.columnName)
+		case *SetRowLevelSecurity:
+			ddl.tableName = strings.ToLower(ddl.tableName)
+		case *CreatePolicy:
+			ddl.policy.name = strings.ToLower(ddl.policy.name)
+			ddl.policy.tableName = strings.ToLower(ddl.policy.tableName)
+		}
+	}
+}
+
+func foldTable(table *Table) {
+	table.name = strings.ToLower(table.name)
+	table.partitionOf = strings.ToLower(table.partitionOf)
+	table.like = strings.ToLower(table.like)
+	table.renamedFrom = strings.ToLower(table.renamedFrom)
+	table.inherits = foldNames(table.inherits)
+	for i := range table.columns {
+		table.columns[i].name = strings.ToLower(table.columns[i].name)
+		table.columns[i].renamedFrom = strings.ToLower(table.columns[i].renamedFrom)
+	}
+	for i := range table.indexes {
+		foldIndex(&table.indexes[i])
+	}
+	for i := range table.foreignKeys {
+		foldForeignKey(&table.foreignKeys[i])
+	}
+	for i := range table.checks {
+		table.checks[i].constraintName = strings.ToLower(table.checks[i].constraintName)
+	}
+	for i := range table.exclusions {
+		table.exclusions[i].constraintName = strings.ToLower(table.exclusions[i].constraintName)
+	}
+}
+
+func foldIndex(index *Index) {
+	index.name = strings.ToLower(index.name)
+	index.include = foldNames(index.include)
+	for i, column := range index.columns {
+		if !strings.HasPrefix(column.column, "(") {
+			index.columns[i].column = strings.ToLower(column.column)
+		}
+	}
+}
+
+func foldForeignKey(foreignKey *ForeignKey) {
+	foreignKey.constraintName = strings.ToLower(foreignKey.constraintName)
+	foreignKey.indexName = strings.ToLower(foreignKey.indexName)
+	foreignKey.indexColumns = foldNames(foreignKey.indexColumns)
+	foreignKey.referenceName = strings.ToLower(foreignKey.referenceName)
+	foreignKey.referenceColumns = foldNames(foreignKey.referenceColumns)
+}
+
+func foldNames(names []string) []string {
+	if names == nil {
+		return nil
+	}
+	folded := []string{}
+	for _, name := range names {
+		folded = append(folded, strings.ToLower(name))
+	}
+	return folded
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	alterTablePattern = regexp.MustCompile("(?s)^ALTER TABLE ((?:`(?:[^`]|``)+`|[^ `])+) (.+)$")
+	// Specifications which MySQL applies together in a single ALTER TABLE. Foreign keys, renames of tables or columns,
+	// partitioning and system versioning are kept in their own statements, since they may depend on the preceding ones.
+	combinableAlterPattern   = regexp.MustCompile(`^(ADD|DROP|CHANGE|MODIFY|ALTER|RENAME INDEX) `)
+	uncombinableAlterPattern = regexp.MustCompile(`FOREIGN KEY|SYSTEM VERSIONING|PARTITION`)
+)
+
+// Combine MySQL's ALTER TABLE of the same table into a single statement like `ALTER TABLE t ADD COLUMN ..., DROP INDEX ...`,
+// so that the table is rebuilt only once. A statement is combined into the preceding one unless any statement between
+// them refers to the table, like a foreign key or a view, which may depend on the order.
+func combineAlterTables(ddls []string) []string {
+	combined := []string{}
+	openAlters := map[string]int{} // The index of the combinable ALTER TABLE of each table
+	for _, ddl := range ddls {
+		table, spec, ok := splitCombinableAlter(ddl)
+		if i, open := openAlters[table]; ok && open {
+			combined[i] += ", " + spec
+			continue
+		}
+		for openTable := range openAlters {
+			if refersToName(ddl, strings.Trim(openTable, "`")) {
+				delete(openAlters, openTable)
+			}
+		}
+		combined = append(combined, ddl)
+		if ok {
+			openAlters[table] = len(combined) - 1
+		}
+	}
+	return combined
+}
+
+func refersToName(ddl string, name string) bool {
+	return regexp.MustCompile(`(^|[^\w$])` + regexp.QuoteMeta(name) + `($|[^\w$])`).MatchString(ddl)
+}
+
+// Return the table name and the specification of ALTER TABLE, or false if it can't be combined with others.
+func splitCombinableAlter(ddl string) (string, string, bool) {
+	match := alterTablePattern.FindStringSubmatch(ddl)
+	if match == nil {
+		return "", "", false
+	}
+	spec := match[2]
+	if !combinableAlterPattern.MatchString(spec) || uncombinableAlterPattern.MatchString(strings.ToUpper(spec)) {
+		return "", "", false
+	}
+	return match[1], spec, true
+}
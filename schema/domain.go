@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"fmt"
+)
+
+// Generate `ALTER DOMAIN` to change the default and constraints of the domain. Its base type can't be changed,
+// since columns using the domain would have to be converted.
+func (g *Generator) generateDDLsForCreateDomain(currentDomain Domain, desired CreateDomain) ([]string, error) {
+	ddls := []string{}
+	desiredDomain := desired.domain
+
+	if currentDomain.dataType != desiredDomain.dataType {
+		return ddls, fmt.Errorf(
+			"changing the base type of domain '%s' from '%s' to '%s' is not supported: '%s'",
+			currentDomain.name, currentDomain.dataType, desiredDomain.dataType, desired.statement,
+		)
+	}
+
+	if currentDomain.defaultVal != desiredDomain.defaultVal {
+		if desiredDomain.defaultVal == "" {
+			ddls = append(ddls, fmt.Sprintf("ALTER DOMAIN %s DROP DEFAULT", g.escapeTableName(currentDomain.name)))
+		} else {
+			ddls = append(ddls, fmt.Sprintf("ALTER DOMAIN %s SET DEFAULT %s", g.escapeTableName(currentDomain.name), desiredDomain.defaultVal))
+		}
+	}
+
+	if currentDomain.notNull != desiredDomain.notNull {
+		if desiredDomain.notNull {
+			ddls = append(ddls, fmt.Sprintf("ALTER DOMAIN %s SET NOT NULL", g.escapeTableName(currentDomain.name)))
+		} else {
+			ddls = append(ddls, fmt.Sprintf("ALTER DOMAIN %s DROP NOT NULL", g.escapeTableName(currentDomain.name)))
+		}
+	}
+
+	// A changed check constraint is dropped and added again.
+	for _, check := range currentDomain.checks {
+		if desiredCheck := findCheckByName(desiredDomain.checks, check.constraintName); desiredCheck == nil || desiredCheck.definition != check.definition {
+			ddls = append(ddls, fmt.Sprintf("ALTER DOMAIN %s DROP CONSTRAINT %s", g.escapeTableName(currentDomain.name), g.escapeSQLName(check.constraintName)))
+		}
+	}
+	for _, check := range desiredDomain.checks {
+		if currentCheck := findCheckByName(currentDomain.checks, check.constraintName); currentCheck == nil || currentCheck.definition != check.definition {
+			ddls = append(ddls, fmt.Sprintf("ALTER DOMAIN %s ADD CONSTRAINT %s CHECK (%s)", g.escapeTableName(currentDomain.name), g.escapeSQLName(check.constraintName), check.definition))
+		}
+	}
+
+	return ddls, nil
+}
+
+func convertDDLsToDomains(ddls []DDL) []*Domain {
+	domains := []*Domain{}
+	for _, ddl := range ddls {
+		if createDomain, ok := ddl.(*CreateDomain); ok {
+			domain := createDomain.domain // copy domain
+			domains = append(domains, &domain)
+		}
+	}
+	return domains
+}
+
+func findDomainByName(domains []*Domain, name string) *Domain {
+	for _, domain := range domains {
+		if domain.name == name {
+			return domain
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Generate `ALTER TYPE ... ADD VALUE` for labels added to the enum type. Since PostgreSQL can't remove or reorder
+// labels of an enum type, the current labels must appear in the desired ones in the same order.
+func (g *Generator) generateDDLsForCreateType(currentEnum Enum, desired CreateType) ([]string, error) {
+	ddls := []string{}
+	desiredLabels := desired.enum.labels
+
+	i := 0 // The index of the next current label to be found
+	for _, label := range desiredLabels {
+		if i < len(currentEnum.labels) && currentEnum.labels[i] == label {
+			i++
+			continue
+		}
+		if containsString(currentEnum.labels, label) {
+			break // Reordered. It's reported below.
+		}
+
+		// Label not found, add value before the next current label, or at the end.
+		ddl := fmt.Sprintf("ALTER TYPE %s ADD VALUE %s", g.escapeTableName(currentEnum.name), g.quoteString(label))
+		if i < len(currentEnum.labels) {
+			ddl += fmt.Sprintf(" BEFORE %s", g.quoteString(currentEnum.labels[i]))
+		}
+		ddls = append(ddls, ddl)
+	}
+
+	if i < len(currentEnum.labels) {
+		return ddls, fmt.Errorf(
+			"removing or reordering labels of enum type '%s' is not supported (current: %s, desired: %s): '%s'",
+			currentEnum.name, strings.Join(currentEnum.labels, ", "), strings.Join(desiredLabels, ", "), desired.statement,
+		)
+	}
+	return ddls, nil
+}
+
+func convertDDLsToEnums(ddls []DDL) []*Enum {
+	enums := []*Enum{}
+	for _, ddl := range ddls {
+		if createType, ok := ddl.(*CreateType); ok {
+			enum := createType.enum // copy enum
+			enums = append(enums, &enum)
+		}
+	}
+	return enums
+}
+
+func findEnumByName(enums []*Enum, name string) *Enum {
+	for _, enum := range enums {
+		if enum.name == name {
+			return enum
+		}
+	}
+	return nil
+}
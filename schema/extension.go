@@ -0,0 +1,21 @@
+package schema
+
+func convertDDLsToExtensions(ddls []DDL) []*Extension {
+	extensions := []*Extension{}
+	for _, ddl := range ddls {
+		if createExtension, ok := ddl.(*CreateExtension); ok {
+			extension := createExtension.extension // copy extension
+			extensions = append(extensions, &extension)
+		}
+	}
+	return extensions
+}
+
+func findExtensionByName(extensions []*Extension, name string) *Extension {
+	for _, extension := range extensions {
+		if extension.name == name {
+			return extension
+		}
+	}
+	return nil
+}
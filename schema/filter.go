@@ -0,0 +1,101 @@
+package schema
+
+// Return whether the table is managed, i.e. its name doesn't match any of SkipTables but matches one of
+// TargetTables if given. PostgreSQL's table name is given without `public.` as the parser normalizes it.
+func (config GeneratorConfig) ManagesTable(name string) bool {
+	for _, pattern := range config.SkipTables {
+		if pattern.MatchString(name) {
+			return false
+		}
+	}
+	if len(config.TargetTables) == 0 {
+		return true
+	}
+	for _, pattern := range config.TargetTables {
+		if pattern.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Remove DDLs for tables which are not managed, so that they're neither created, changed nor dropped.
+// DDLs for other objects like views and functions are removed as well when TargetTables are given.
+func filterDDLs(ddls []DDL, config GeneratorConfig) []DDL {
+	filtered := []DDL{}
+	for _, ddl := range ddls {
+		switch ddl := ddl.(type) {
+		case *GrantPrivilege:
+			ddl.privileges = filterPrivileges(ddl.privileges, config)
+			if len(ddl.privileges) == 0 {
+				continue
+			}
+		case *RevokePrivilege:
+			ddl.privileges = filterPrivileges(ddl.privileges, config)
+			if len(ddl.privileges) == 0 {
+				continue
+			}
+		default:
+			if tableName := ddlTableName(ddl); tableName == "" && len(config.TargetTables) > 0 {
+				continue
+			} else if tableName != "" && !config.ManagesTable(tableName) {
+				continue
+			}
+		}
+		filtered = append(filtered, ddl)
+	}
+	return filtered
+}
+
+// Return the table which the DDL is for, or empty if it's not for a table.
+func ddlTableName(ddl DDL) string {
+	switch ddl := ddl.(type) {
+	case *CreateTable:
+		return ddl.table.name
+	case *CreateForeignTable:
+		return ddl.foreignTable.name
+	case *CreateIndex:
+		return ddl.tableName
+	case *AddIndex:
+		return ddl.tableName
+	case *AddPrimaryKey:
+		return ddl.tableName
+	case *AddForeignKey:
+		return ddl.tableName
+	case *AddExclusion:
+		return ddl.tableName
+	case *AttachPartition:
+		return ddl.partitionName
+	case *CreateTrigger:
+		return ddl.trigger.tableName
+	case *AddIdentity:
+		return ddl.tableName
+	case *SetDefaultSequence:
+		return ddl.tableName
+	case *DropTable:
+		return ddl.tableName
+	case *DropIndex:
+		return ddl.tableName
+	case *CommentOn:
+		return ddl.tableName
+	case *SetRowLevelSecurity:
+		return ddl.tableName
+	case *CreatePolicy:
+		return ddl.policy.tableName
+	}
+	return ""
+}
+
+func filterPrivileges(privileges []Privilege, config GeneratorConfig) []Privilege {
+	filtered := []Privilege{}
+	for _, privilege := range privileges {
+		if privilege.objectType == "table" && !config.ManagesTable(privilege.objectName) {
+			continue
+		}
+		if privilege.objectType != "table" && len(config.TargetTables) > 0 {
+			continue
+		}
+		filtered = append(filtered, privilege)
+	}
+	return filtered
+}
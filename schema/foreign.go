@@ -0,0 +1,217 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/k0kubun/sqldef/sqlparser"
+)
+
+func parseServer(stmt *sqlparser.DDL) Server {
+	spec := stmt.ForeignSpec
+	server := Server{
+		name:    stmt.Table.Name.String(),
+		wrapper: spec.Wrapper.String(),
+		options: parseForeignOptions(spec.Options),
+	}
+	if spec.Type != nil {
+		server.serverType = string(spec.Type.Val)
+	}
+	if spec.Version != nil {
+		server.version = string(spec.Version.Val)
+	}
+	return server
+}
+
+func parseUserMapping(stmt *sqlparser.DDL) UserMapping {
+	return UserMapping{
+		user:    stmt.ForeignSpec.User.String(),
+		server:  stmt.ForeignSpec.Server.String(),
+		options: parseForeignOptions(stmt.ForeignSpec.Options),
+	}
+}
+
+func parseForeignTable(mode GeneratorMode, stmt *sqlparser.DDL) ForeignTable {
+	return ForeignTable{
+		name:    normalizeTableName(mode, stmt.NewName),
+		columns: parseTable(mode, stmt).columns,
+		server:  stmt.ForeignSpec.Server.String(),
+		options: parseForeignOptions(stmt.ForeignSpec.Options),
+	}
+}
+
+func parseForeignOptions(options sqlparser.ForeignOptions) map[string]string {
+	result := map[string]string{}
+	for _, option := range options {
+		result[option.Name.Lowered()] = string(option.Value.Val)
+	}
+	return result
+}
+
+// Generate `ALTER SERVER` to change the version and options of the server. Its type and foreign data wrapper
+// can't be changed. VERSION can't be removed, so it's kept unless another one is given.
+func (g *Generator) generateDDLsForCreateServer(currentServer Server, desired CreateServer) ([]string, error) {
+	desiredServer := desired.server
+	if currentServer.serverType != desiredServer.serverType || currentServer.wrapper != desiredServer.wrapper {
+		return []string{}, fmt.Errorf(
+			"changing the type or foreign data wrapper of server '%s' is not supported: '%s'", currentServer.name, desired.statement,
+		)
+	}
+
+	options := []string{}
+	if desiredServer.version != "" && currentServer.version != desiredServer.version {
+		options = append(options, fmt.Sprintf("VERSION %s", g.quoteString(desiredServer.version)))
+	}
+	if changes := g.generateForeignOptionChanges(currentServer.options, desiredServer.options); changes != "" {
+		options = append(options, changes)
+	}
+	if len(options) == 0 {
+		return []string{}, nil
+	}
+	return []string{fmt.Sprintf("ALTER SERVER %s %s", g.escapeSQLName(currentServer.name), strings.Join(options, " "))}, nil
+}
+
+// Generate `ALTER USER MAPPING` to change the options of the user mapping.
+func (g *Generator) generateDDLsForCreateUserMapping(currentUserMapping UserMapping, desiredUserMapping UserMapping) []string {
+	changes := g.generateForeignOptionChanges(currentUserMapping.options, desiredUserMapping.options)
+	if changes == "" {
+		return []string{}
+	}
+	return []string{fmt.Sprintf("ALTER USER MAPPING FOR %s SERVER %s %s", g.escapeRoleName(currentUserMapping.user), g.escapeSQLName(currentUserMapping.server), changes)}
+}
+
+// Generate `ALTER FOREIGN TABLE` to change columns and options of the foreign table. Its data is stored by the server,
+// so columns are dropped without EnableDropColumn. The server can't be changed.
+func (g *Generator) generateDDLsForCreateForeignTable(currentTable ForeignTable, desired CreateForeignTable) ([]string, error) {
+	ddls := []string{}
+	desiredTable := desired.foreignTable
+	if currentTable.server != desiredTable.server {
+		return ddls, fmt.Errorf("changing the server of foreign table '%s' is not supported: '%s'", currentTable.name, desired.statement)
+	}
+
+	for _, column := range currentTable.columns {
+		if findColumnByName(desiredTable.columns, column.name) == nil {
+			ddls = append(ddls, fmt.Sprintf("ALTER FOREIGN TABLE %s DROP COLUMN %s", g.escapeTableName(currentTable.name), g.escapeSQLName(column.name)))
+		}
+	}
+	for _, desiredColumn := range desiredTable.columns {
+		currentColumn := findColumnByName(currentTable.columns, desiredColumn.name)
+		if currentColumn == nil {
+			definition, err := g.generateColumnDefinition(desiredColumn)
+			if err != nil {
+				return ddls, err
+			}
+			ddls = append(ddls, fmt.Sprintf("ALTER FOREIGN TABLE %s ADD COLUMN %s", g.escapeTableName(currentTable.name), definition))
+			continue
+		}
+		if normalizeDataType(g.mode, currentColumn.typeName) != normalizeDataType(g.mode, desiredColumn.typeName) || !g.haveSameLengthAndScale(*currentColumn, desiredColumn) ||
+			currentColumn.array != desiredColumn.array || currentColumn.timezone != desiredColumn.timezone {
+			ddls = append(ddls, fmt.Sprintf("ALTER FOREIGN TABLE %s ALTER COLUMN %s TYPE %s", g.escapeTableName(currentTable.name), g.escapeSQLName(desiredColumn.name), g.generateDataType(desiredColumn)))
+		}
+		if currentColumn.notNull != desiredColumn.notNull {
+			action := "DROP NOT NULL"
+			if desiredColumn.notNull {
+				action = "SET NOT NULL"
+			}
+			ddls = append(ddls, fmt.Sprintf("ALTER FOREIGN TABLE %s ALTER COLUMN %s %s", g.escapeTableName(currentTable.name), g.escapeSQLName(desiredColumn.name), action))
+		}
+	}
+
+	if changes := g.generateForeignOptionChanges(currentTable.options, desiredTable.options); changes != "" {
+		ddls = append(ddls, fmt.Sprintf("ALTER FOREIGN TABLE %s %s", g.escapeTableName(currentTable.name), changes))
+	}
+	return ddls, nil
+}
+
+// Return `OPTIONS (ADD ..., SET ..., DROP ...)` to change the options, or empty if they are the same.
+func (g *Generator) generateForeignOptionChanges(currentOptions map[string]string, desiredOptions map[string]string) string {
+	names := []string{}
+	for name := range currentOptions {
+		names = append(names, name)
+	}
+	for name := range desiredOptions {
+		if _, ok := currentOptions[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	changes := []string{}
+	for _, name := range names {
+		currentValue, inCurrent := currentOptions[name]
+		desiredValue, inDesired := desiredOptions[name]
+		if !inDesired {
+			changes = append(changes, fmt.Sprintf("DROP %s", name))
+		} else if !inCurrent {
+			changes = append(changes, fmt.Sprintf("ADD %s %s", name, g.quoteString(desiredValue)))
+		} else if currentValue != desiredValue {
+			changes = append(changes, fmt.Sprintf("SET %s %s", name, g.quoteString(desiredValue)))
+		}
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("OPTIONS (%s)", strings.Join(changes, ", "))
+}
+
+func convertDDLsToServers(ddls []DDL) []*Server {
+	servers := []*Server{}
+	for _, ddl := range ddls {
+		if createServer, ok := ddl.(*CreateServer); ok {
+			server := createServer.server // copy server
+			servers = append(servers, &server)
+		}
+	}
+	return servers
+}
+
+func convertDDLsToUserMappings(ddls []DDL) []*UserMapping {
+	userMappings := []*UserMapping{}
+	for _, ddl := range ddls {
+		if createUserMapping, ok := ddl.(*CreateUserMapping); ok {
+			userMapping := createUserMapping.userMapping // copy user mapping
+			userMappings = append(userMappings, &userMapping)
+		}
+	}
+	return userMappings
+}
+
+func convertDDLsToForeignTables(ddls []DDL) []*ForeignTable {
+	foreignTables := []*ForeignTable{}
+	for _, ddl := range ddls {
+		if createForeignTable, ok := ddl.(*CreateForeignTable); ok {
+			foreignTable := createForeignTable.foreignTable // copy foreign table
+			foreignTables = append(foreignTables, &foreignTable)
+		}
+	}
+	return foreignTables
+}
+
+func findServerByName(servers []*Server, name string) *Server {
+	for _, server := range servers {
+		if server.name == name {
+			return server
+		}
+	}
+	return nil
+}
+
+// A user mapping is unique for its user and server.
+func findUserMapping(userMappings []*UserMapping, user string, server string) *UserMapping {
+	for _, userMapping := range userMappings {
+		if userMapping.user == user && userMapping.server == server {
+			return userMapping
+		}
+	}
+	return nil
+}
+
+func findForeignTableByName(foreignTables []*ForeignTable, name string) *ForeignTable {
+	for _, foreignTable := range foreignTables {
+		if foreignTable.name == name {
+			return foreignTable
+		}
+	}
+	return nil
+}
@@ -3,7 +3,11 @@ package schema
 import (
 	"fmt"
 	"log"
+	"math/big"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type GeneratorMode int
@@ -14,55 +18,251 @@ const (
 )
 
 var (
-	dataTypeAliases = map[string]string{
-		"bool":    "boolean",
-		"int":     "integer",
-		"char":    "character",
-		"varchar": "character varying",
+	// Types which are the same as the ones shown by each database, which are compared after the normalization
+	dataTypeAliases = map[GeneratorMode]map[string]string{
+		GeneratorModeMysql: {
+			"bool":              "boolean",
+			"integer":           "int",
+			"int1":              "tinyint",
+			"int2":              "smallint",
+			"int3":              "mediumint",
+			"middleint":         "mediumint",
+			"int4":              "int",
+			"int8":              "bigint",
+			"dec":               "decimal",
+			"numeric":           "decimal",
+			"float4":            "float",
+			"float8":            "double",
+			"double precision":  "double",
+			"real":              "double",
+			"character":         "char",
+			"character varying": "varchar",
+		},
+		GeneratorModePostgres: {
+			"bool":    "boolean",
+			"int":     "integer",
+			"int2":    "smallint",
+			"int4":    "integer",
+			"int8":    "bigint",
+			"decimal": "numeric",
+			"float4":  "real",
+			"float8":  "double precision",
+			"char":    "character",
+			"bpchar":  "character",
+			"varchar": "character varying",
+			"serial2": "smallserial",
+			"serial4": "serial",
+			"serial8": "bigserial",
+		},
 	}
+	numericDataTypes = []string{
+		"tinyint", "smallint", "mediumint", "int", "integer", "bigint", "decimal", "numeric", "float", "double", "double precision", "real", "bit",
+	}
+	datePattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	// AUTO_INCREMENT is managed only when it's requested, since it's updated by inserts.
+	managedTableOptions   = []string{"ENGINE", "ROW_FORMAT", "KEY_BLOCK_SIZE"}
+	createFunctionPattern = regexp.MustCompile(`(?i)^CREATE\s+(OR\s+REPLACE\s+)?FUNCTION`)
+	mysqlStringEscaper    = strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\n", `\n`, "\r", `\r`, "\x00", `\0`)
 )
 
+// Options to change generated DDLs
+type GeneratorConfig struct {
+	RecreateMaterializedViews bool // Drop and create a materialized view to change its definition
+	RefreshMaterializedViews  bool // Refresh materialized views created by generated DDLs
+	DropExtensions            bool // Drop extensions which are not given
+	EnableDropTable           bool // Drop tables and other objects like views and sequences which are not given
+	EnableDropColumn          bool // Drop columns which are not given
+	ManageAutoIncrement       bool // Increase MySQL's AUTO_INCREMENT table option to the given one
+	StrictDisplayWidth        bool // Compare display widths of MySQL's integer types, which are deprecated since MySQL 8.0.17
+	ManagePrivileges          bool // Grant and revoke privileges of tables and sequences to be the given ones
+	ManageForeignData         bool // Create, alter and drop foreign servers, user mappings and foreign tables to be the given ones
+	CaseInsensitive           bool // Compare MySQL's names case-insensitively, or fold PostgreSQL's unquoted identifiers
+	ColumnPosition            bool // Add MySQL's columns at the given positions by AFTER or FIRST
+	ReorderColumns            bool // Move MySQL's existing columns to the given positions by MODIFY COLUMN, which implies ColumnPosition
+	CombineAlterTables        bool // Combine MySQL's changes of a table into a single ALTER TABLE
+
+	// The time to create and expire partitions by partition policies, which is the current time if it's zero
+	Now time.Time
+
+	// Ignore tables whose names match any of them, like ones owned by other tools, and DDLs for them
+	SkipTables []*regexp.Regexp
+
+	// Manage only tables whose names match any of them, to apply a change of a table independently of the others
+	TargetTables []*regexp.Regexp
+}
+
 // This struct holds simulated schema states during GenerateIdempotentDDLs().
 type Generator struct {
-	mode          GeneratorMode
-	desiredTables []*Table
-	currentTables []*Table
+	mode                 GeneratorMode
+	config               GeneratorConfig
+	desiredTables        []*Table
+	currentTables        []*Table
+	desiredViews         []*View
+	currentViews         []*View
+	desiredTriggers      []*Trigger
+	currentTriggers      []*Trigger
+	desiredFunctions     []*Function
+	currentFunctions     []*Function
+	desiredSequences     []*Sequence // All options are applied in advance, since ALTER SEQUENCE may follow CREATE SEQUENCE.
+	currentSequences     []*Sequence
+	desiredEnums         []*Enum
+	currentEnums         []*Enum
+	desiredDomains       []*Domain
+	currentDomains       []*Domain
+	desiredSchemas       []*Schema
+	currentSchemas       []*Schema
+	desiredExtensions    []*Extension
+	currentExtensions    []*Extension
+	desiredPolicies      []*Policy
+	currentPolicies      []*Policy
+	desiredPrivileges    []Privilege // GRANT and REVOKE are applied in advance, since REVOKE may follow GRANT.
+	currentPrivileges    []Privilege
+	desiredServers       []*Server
+	currentServers       []*Server
+	desiredUserMappings  []*UserMapping
+	currentUserMappings  []*UserMapping
+	desiredForeignTables []*ForeignTable
+	currentForeignTables []*ForeignTable
+	partitionPolicies    []PartitionPolicy
+	desiredIndexNames    map[string][]string // Names of all desired indexes by table, since an index to be renamed must not be desired.
+	desiredPrimaryKeys   map[string]Index    // Primary keys given by `ALTER TABLE ... ADD PRIMARY KEY` by table, which are examined with CREATE TABLE.
+	createdTables        []string            // Tables created by CREATE TABLE, whose `ALTER TABLE ... ADD PRIMARY KEY` is run as it is
+	now                  time.Time
+	refreshedViews       []string // Materialized views to be refreshed after all DDLs
+	droppedTables        []string // Tables given by `DROP TABLE`, which are dropped without EnableDropTable
+	skippedDDLs          []string // Destructive DDLs which are not enabled by the config
 }
 
-// Parse argument DDLs and call `generateDDLs()`
-func GenerateIdempotentDDLs(mode GeneratorMode, desiredSQL string, currentSQL string) ([]string, error) {
+// Parse argument DDLs and call `generateDDLs()`. DDLs skipped by the config are returned separately.
+func GenerateIdempotentDDLs(mode GeneratorMode, desiredSQL string, currentSQL string, config GeneratorConfig) ([]string, []string, error) {
 	// TODO: invalidate duplicated tables, columns
-	desiredDDLs, err := parseDDLs(mode, desiredSQL)
+	// PostgreSQL folds only unquoted identifiers, which is done by the parser. MySQL's lower_case_table_names folds any names.
+	foldByParser := config.CaseInsensitive && mode == GeneratorModePostgres
+	desiredDDLs, err := parseDDLs(mode, desiredSQL, foldByParser)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	currentDDLs, err := parseDDLs(mode, currentSQL)
+	currentDDLs, err := parseDDLs(mode, currentSQL, foldByParser)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	if config.CaseInsensitive && mode == GeneratorModeMysql {
+		foldIdentifiers(desiredDDLs)
+		foldIdentifiers(currentDDLs)
 	}
+	desiredDDLs = filterDDLs(desiredDDLs, config)
+	currentDDLs = filterDDLs(currentDDLs, config)
 
 	tables, err := convertDDLsToTables(currentDDLs)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	currentSequences, err := convertDDLsToSequences(currentDDLs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policies, err := parsePartitionPolicies(mode, desiredSQL)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(policies) > 0 && mode != GeneratorModePostgres {
+		return nil, nil, fmt.Errorf("partition policy is supported only for PostgreSQL")
+	}
+	now := config.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	now = now.UTC()
+	for _, policy := range policies {
+		partitionDDLs, err := policy.generatePartitionDDLs(mode, desiredDDLs, now)
+		if err != nil {
+			return nil, nil, err
+		}
+		desiredDDLs = append(desiredDDLs, partitionDDLs...)
+	}
+
+	desiredSequences, err := convertDDLsToSequences(desiredDDLs)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	generator := Generator{
-		mode:          mode,
-		desiredTables: []*Table{},
-		currentTables: tables,
+		mode:                 mode,
+		config:               config,
+		desiredTables:        []*Table{},
+		currentTables:        tables,
+		desiredViews:         []*View{},
+		currentViews:         convertDDLsToViews(currentDDLs),
+		desiredTriggers:      []*Trigger{},
+		currentTriggers:      convertDDLsToTriggers(currentDDLs),
+		desiredFunctions:     []*Function{},
+		currentFunctions:     convertDDLsToFunctions(currentDDLs),
+		desiredSequences:     desiredSequences,
+		currentSequences:     currentSequences,
+		desiredEnums:         []*Enum{},
+		currentEnums:         convertDDLsToEnums(currentDDLs),
+		desiredDomains:       []*Domain{},
+		currentDomains:       convertDDLsToDomains(currentDDLs),
+		desiredSchemas:       convertDDLsToSchemas(desiredDDLs),
+		currentSchemas:       convertDDLsToSchemas(currentDDLs),
+		desiredExtensions:    convertDDLsToExtensions(desiredDDLs),
+		currentExtensions:    convertDDLsToExtensions(currentDDLs),
+		desiredPolicies:      []*Policy{},
+		currentPolicies:      convertDDLsToPolicies(currentDDLs),
+		desiredPrivileges:    convertDDLsToPrivileges(desiredDDLs),
+		currentPrivileges:    convertDDLsToPrivileges(currentDDLs),
+		desiredServers:       []*Server{},
+		currentServers:       convertDDLsToServers(currentDDLs),
+		desiredUserMappings:  []*UserMapping{},
+		currentUserMappings:  convertDDLsToUserMappings(currentDDLs),
+		desiredForeignTables: []*ForeignTable{},
+		currentForeignTables: convertDDLsToForeignTables(currentDDLs),
+		partitionPolicies:    policies,
+		desiredIndexNames:    convertDDLsToIndexNames(desiredDDLs),
+		desiredPrimaryKeys:   convertDDLsToPrimaryKeys(desiredDDLs),
+		now:                  now,
 	}
-	return generator.generateDDLs(desiredDDLs)
+	ddls, err := generator.generateDDLs(desiredDDLs)
+	if err == nil && mode == GeneratorModeMysql && config.CombineAlterTables {
+		ddls = combineAlterTables(ddls)
+	}
+	return ddls, generator.skippedDDLs, err
 }
 
 // Main part of DDL genearation
 func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 	ddls := []string{}
 
+	// Create schemas and extensions first, since objects in them may be used by any other DDLs.
+	for _, ddl := range desiredDDLs {
+		if desired, ok := ddl.(*CreateSchema); ok && findSchemaByName(g.currentSchemas, desired.schema.name) == nil {
+			ddls = append(ddls, desired.statement)
+			schema := desired.schema // copy schema
+			g.currentSchemas = append(g.currentSchemas, &schema)
+		}
+	}
+	for _, ddl := range desiredDDLs {
+		if desired, ok := ddl.(*CreateExtension); ok && findExtensionByName(g.currentExtensions, desired.extension.name) == nil {
+			ddls = append(ddls, desired.statement)
+			extension := desired.extension // copy extension
+			g.currentExtensions = append(g.currentExtensions, &extension)
+		}
+	}
+
 	// Incrementally examine desiredDDLs
 	for _, ddl := range desiredDDLs {
 		switch desired := ddl.(type) {
 		case *CreateTable:
+			// Rename the table if its old name is not desired anymore.
+			if oldTable := findTableByName(g.currentTables, desired.table.renamedFrom); oldTable != nil &&
+				findTableByName(g.currentTables, desired.table.name) == nil && findCreateTableByName(desiredDDLs, oldTable.name) == nil {
+				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s RENAME TO %s", g.escapeTableName(oldTable.name), g.escapeSQLName(unqualifiedName(desired.table.name))))
+				g.renameTable(oldTable.name, desired.table.name)
+			}
 			if currentTable := findTableByName(g.currentTables, desired.table.name); currentTable != nil {
 				// Table already exists, guess required DDLs.
 				tableDDLs, err := g.generateDDLsForCreateTable(*currentTable, *desired)
@@ -76,10 +276,19 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 				ddls = append(ddls, desired.statement)
 				table := desired.table // copy table
 				g.currentTables = append(g.currentTables, &table)
+				g.createdTables = append(g.createdTables, desired.table.name)
 			}
 			table := desired.table // copy table
 			g.desiredTables = append(g.desiredTables, &table)
 		case *CreateIndex:
+			if findViewByName(g.desiredViews, desired.tableName) != nil {
+				indexDDLs, err := g.generateDDLsForCreateViewIndex(desired.tableName, desired.index, ddl.Statement())
+				if err != nil {
+					return ddls, err
+				}
+				ddls = append(ddls, indexDDLs...)
+				continue
+			}
 			indexDDLs, err := g.generateDDLsForCreateIndex(desired.tableName, desired.index, "CREATE INDEX", ddl.Statement())
 			if err != nil {
 				return ddls, err
@@ -91,26 +300,350 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 				return ddls, err
 			}
 			ddls = append(ddls, indexDDLs...)
+		case *AddPrimaryKey:
+			// pg_dump(1) gives a primary key by ALTER TABLE. It's examined with CREATE TABLE unless the table is created.
+			desiredTable := findTableByName(g.desiredTables, desired.tableName)
+			if desiredTable == nil {
+				return ddls, fmt.Errorf("ADD PRIMARY KEY is performed before CREATE TABLE: %s", ddl.Statement())
+			}
+			if containsString(g.createdTables, desired.tableName) {
+				ddls = append(ddls, desired.statement)
+				currentTable := findTableByName(g.currentTables, desired.tableName)
+				currentTable.indexes = append(currentTable.indexes, desired.index)
+			}
+			desiredTable.indexes = append(desiredTable.indexes, desired.index)
+		case *AddForeignKey:
+			foreignKeyDDLs, err := g.generateDDLsForAddForeignKey(desired.tableName, desired.foreignKey, "ALTER TABLE", ddl.Statement())
+			if err != nil {
+				return ddls, err
+			}
+			ddls = append(ddls, foreignKeyDDLs...)
+		case *AddExclusion:
+			exclusionDDLs, err := g.generateDDLsForAddExclusion(*desired)
+			if err != nil {
+				return ddls, err
+			}
+			ddls = append(ddls, exclusionDDLs...)
+		case *CreateView:
+			viewDDLs, err := g.generateDDLsForCreateView(*desired)
+			if err != nil {
+				return ddls, err
+			}
+			ddls = append(ddls, viewDDLs...)
+		case *CreateFunction:
+			if currentFunction := findFunction(g.currentFunctions, desired.function); currentFunction == nil {
+				// Function not found, create function.
+				ddls = append(ddls, desired.statement)
+			} else if !areSameFunctions(*currentFunction, desired.function) {
+				// Function found but it's different. CREATE OR REPLACE FUNCTION can't change its arguments or return type,
+				// and MySQL doesn't have it.
+				if g.mode == GeneratorModeMysql ||
+					strings.Join(currentFunction.arguments, ",") != strings.Join(desired.function.arguments, ",") ||
+					currentFunction.returns != desired.function.returns {
+					ddls = append(ddls, g.generateDropFunction(*currentFunction))
+					ddls = append(ddls, desired.statement)
+				} else {
+					ddls = append(ddls, createFunctionPattern.ReplaceAllLiteralString(desired.statement, "CREATE OR REPLACE FUNCTION"))
+				}
+			}
+			function := desired.function // copy function
+			g.desiredFunctions = append(g.desiredFunctions, &function)
+		case *CreateTrigger:
+			if currentTrigger := findTriggerByName(g.currentTriggers, desired.trigger.name); currentTrigger == nil {
+				// Trigger not found, create trigger.
+				ddls = append(ddls, desired.statement)
+			} else if !areSameTriggers(*currentTrigger, desired.trigger) {
+				// Trigger found but it's different. A trigger can't be altered, so drop and create trigger.
+				ddls = append(ddls, g.generateDropTrigger(*currentTrigger))
+				ddls = append(ddls, desired.statement)
+			}
+			trigger := desired.trigger // copy trigger
+			g.desiredTriggers = append(g.desiredTriggers, &trigger)
+		case *CreateSequence:
+			desiredSequence := findSequenceByName(g.desiredSequences, desired.sequence.name)
+			if currentSequence := findSequenceByName(g.currentSequences, desired.sequence.name); currentSequence == nil {
+				// Sequence not found, create sequence. Options given by ALTER SEQUENCE later are changed by it.
+				ddls = append(ddls, desired.statement)
+				sequence := desired.sequence // copy sequence
+				g.currentSequences = append(g.currentSequences, &sequence)
+			} else {
+				// Sequence found. Change options to the ones given by CREATE SEQUENCE and ALTER SEQUENCE.
+				ddls = append(ddls, g.generateDDLsForAlterSequence(*currentSequence, *desiredSequence)...)
+				*currentSequence = *desiredSequence
+			}
+		case *CreateType:
+			if currentEnum := findEnumByName(g.currentEnums, desired.enum.name); currentEnum == nil {
+				// Enum type not found, create type.
+				ddls = append(ddls, desired.statement)
+			} else {
+				// Enum type found. Add labels which don't exist.
+				typeDDLs, err := g.generateDDLsForCreateType(*currentEnum, *desired)
+				if err != nil {
+					return ddls, err
+				}
+				ddls = append(ddls, typeDDLs...)
+			}
+			enum := desired.enum // copy enum
+			g.desiredEnums = append(g.desiredEnums, &enum)
+		case *CreateDomain:
+			if currentDomain := findDomainByName(g.currentDomains, desired.domain.name); currentDomain == nil {
+				// Domain not found, create domain.
+				ddls = append(ddls, desired.statement)
+			} else {
+				// Domain found. Change the default and constraints as needed.
+				domainDDLs, err := g.generateDDLsForCreateDomain(*currentDomain, *desired)
+				if err != nil {
+					return ddls, err
+				}
+				ddls = append(ddls, domainDDLs...)
+			}
+			domain := desired.domain // copy domain
+			g.desiredDomains = append(g.desiredDomains, &domain)
+		case *AlterSequence:
+			currentSequence := findSequenceByName(g.currentSequences, desired.name)
+			if currentSequence == nil {
+				return ddls, fmt.Errorf("ALTER SEQUENCE is performed before CREATE SEQUENCE '%s': '%s'", desired.name, ddl.Statement())
+			}
+			desiredSequence := findSequenceByName(g.desiredSequences, desired.name)
+			ddls = append(ddls, g.generateDDLsForAlterSequence(*currentSequence, *desiredSequence)...)
+			*currentSequence = *desiredSequence
+		case *AttachPartition:
+			// The partition is attached or detached after examining all tables.
+			desiredTable := findTableByName(g.desiredTables, desired.partitionName)
+			if desiredTable == nil {
+				return ddls, fmt.Errorf("ATTACH PARTITION is performed before CREATE TABLE '%s': '%s'", desired.partitionName, ddl.Statement())
+			}
+			desiredTable.partitionOf = desired.tableName
+			desiredTable.bound = desired.bound
+		case *AddIdentity:
+			// The identity is added or changed after examining all tables.
+			desiredTable := findTableByName(g.desiredTables, desired.tableName)
+			if desiredTable == nil {
+				return ddls, fmt.Errorf("ADD GENERATED is performed before CREATE TABLE '%s': '%s'", desired.tableName, ddl.Statement())
+			}
+			desiredTable.columns = append([]Column{}, desiredTable.columns...) // copy columns shared with the current table
+			if err := modifyColumn(desiredTable, desired.columnName, func(column *Column) {
+				identity := desired.identity // copy identity
+				column.identity = &identity
+			}); err != nil {
+				return ddls, err
+			}
+		case *SetDefaultSequence:
+			desiredTable := findTableByName(g.desiredTables, desired.tableName)
+			if desiredTable == nil {
+				return ddls, fmt.Errorf("SET DEFAULT is performed before CREATE TABLE '%s': '%s'", desired.tableName, ddl.Statement())
+			}
+			desiredTable.columns = append([]Column{}, desiredTable.columns...) // copy columns shared with the current table
+			if err := modifyColumn(desiredTable, desired.columnName, func(column *Column) {
+				column.defaultSeq = desired.sequenceName
+				normalizeSerialColumn(desiredTable.name, column)
+			}); err != nil {
+				return ddls, err
+			}
+		case *CreateSchema, *CreateExtension:
+			// Schemas and extensions are created in advance.
+		case *SetRowLevelSecurity:
+			// Row-level security is changed after examining all tables.
+			desiredTable := findTableByName(g.desiredTables, desired.tableName)
+			if desiredTable == nil {
+				return ddls, fmt.Errorf("ROW LEVEL SECURITY is performed before CREATE TABLE '%s': '%s'", desired.tableName, ddl.Statement())
+			}
+			applyRowLevelSecurity(desiredTable, desired.action)
+		case *CreatePolicy:
+			if findTableByName(g.desiredTables, desired.policy.tableName) == nil {
+				return ddls, fmt.Errorf("CREATE POLICY is performed before CREATE TABLE '%s': '%s'", desired.policy.tableName, ddl.Statement())
+			}
+			if currentPolicy := findPolicy(g.currentPolicies, desired.policy.tableName, desired.policy.name); currentPolicy == nil {
+				// Policy not found, create policy.
+				ddls = append(ddls, desired.statement)
+			} else if !areSamePolicies(*currentPolicy, desired.policy) {
+				// Policy found but it's different. ALTER POLICY can't change its command, so drop and create policy.
+				ddls = append(ddls, g.generateDropPolicy(*currentPolicy))
+				ddls = append(ddls, desired.statement)
+			}
+			policy := desired.policy // copy policy
+			g.desiredPolicies = append(g.desiredPolicies, &policy)
+		case *GrantPrivilege:
+			// Privileges are examined after all DDLs, but objects must be given before GRANT.
+			if !g.config.ManagePrivileges {
+				continue
+			}
+			for _, privilege := range desired.privileges {
+				if !g.isDesiredObject(privilege.objectName) {
+					return ddls, fmt.Errorf("GRANT is performed before CREATE %s '%s': '%s'", strings.ToUpper(privilege.objectType), privilege.objectName, ddl.Statement())
+				}
+			}
+		case *RevokePrivilege:
+			// Privileges are examined after all DDLs.
+		case *CreateServer:
+			// Foreign data is managed only when it's requested, since it may be given by other than this schema.
+			if !g.config.ManageForeignData {
+				continue
+			}
+			if currentServer := findServerByName(g.currentServers, desired.server.name); currentServer == nil {
+				// Server not found, create server.
+				ddls = append(ddls, desired.statement)
+			} else {
+				// Server found. Change the version and options as needed.
+				serverDDLs, err := g.generateDDLsForCreateServer(*currentServer, *desired)
+				if err != nil {
+					return ddls, err
+				}
+				ddls = append(ddls, serverDDLs...)
+			}
+			server := desired.server // copy server
+			g.desiredServers = append(g.desiredServers, &server)
+		case *CreateUserMapping:
+			if !g.config.ManageForeignData {
+				continue
+			}
+			if currentUserMapping := findUserMapping(g.currentUserMappings, desired.userMapping.user, desired.userMapping.server); currentUserMapping == nil {
+				// User mapping not found, create user mapping.
+				ddls = append(ddls, desired.statement)
+			} else {
+				// User mapping found. Change the options as needed.
+				ddls = append(ddls, g.generateDDLsForCreateUserMapping(*currentUserMapping, desired.userMapping)...)
+			}
+			userMapping := desired.userMapping // copy user mapping
+			g.desiredUserMappings = append(g.desiredUserMappings, &userMapping)
+		case *CreateForeignTable:
+			if !g.config.ManageForeignData {
+				continue
+			}
+			if currentTable := findForeignTableByName(g.currentForeignTables, desired.foreignTable.name); currentTable == nil {
+				// Foreign table not found, create foreign table.
+				ddls = append(ddls, desired.statement)
+			} else {
+				// Foreign table found. Change columns and options as needed.
+				tableDDLs, err := g.generateDDLsForCreateForeignTable(*currentTable, *desired)
+				if err != nil {
+					return ddls, err
+				}
+				ddls = append(ddls, tableDDLs...)
+			}
+			foreignTable := desired.foreignTable // copy foreign table
+			g.desiredForeignTables = append(g.desiredForeignTables, &foreignTable)
+		case *CommentOn:
+			commentDDLs, err := g.generateDDLsForCommentOn(*desired)
+			if err != nil {
+				return ddls, err
+			}
+			ddls = append(ddls, commentDDLs...)
+		case *DropTable:
+			if err := g.removeDesiredTable(*desired); err != nil {
+				return ddls, err
+			}
+		case *DropIndex:
+			if err := g.removeDesiredIndex(*desired); err != nil {
+				return ddls, err
+			}
 		default:
 			return nil, fmt.Errorf("unexpected ddl type in generateDDLs: %v", desired)
 		}
 	}
 
+	// Clean up obsoleted views first, since they may refer to tables or columns to be dropped.
+	for _, currentView := range g.currentViews {
+		desiredView := findViewByName(g.desiredViews, currentView.name)
+		if desiredView == nil {
+			ddls = g.appendDropDDL(ddls, g.generateDropView(*currentView))
+			continue
+		}
+		for _, index := range currentView.indexes {
+			if findIndexByName(desiredView.indexes, index.name) == nil {
+				ddls = append(ddls, g.generateDropIndex(currentView.name, index))
+			}
+		}
+	}
+
+	// Clean up obsoleted triggers, since they may refer to tables or columns to be dropped.
+	for _, currentTrigger := range g.currentTriggers {
+		if findTriggerByName(g.desiredTriggers, currentTrigger.name) == nil {
+			ddls = append(ddls, g.generateDropTrigger(*currentTrigger))
+		}
+	}
+
+	// Clean up obsoleted policies, since they may refer to tables or columns to be dropped.
+	for _, currentPolicy := range g.currentPolicies {
+		if findPolicy(g.desiredPolicies, currentPolicy.tableName, currentPolicy.name) == nil {
+			ddls = append(ddls, g.generateDropPolicy(*currentPolicy))
+		}
+	}
+
+	// Clean up obsoleted foreign keys, since they may refer to tables, indexes or columns to be dropped.
+	for _, currentTable := range g.currentTables {
+		desiredTable := findTableByName(g.desiredTables, currentTable.name)
+		for _, foreignKey := range currentTable.foreignKeys {
+			if desiredTable == nil {
+				// The table will be dropped or kept with its foreign keys. But a table referred by the
+				// foreign key may be dropped earlier, so drop the foreign key referring to such a table.
+				if foreignKey.referenceName == currentTable.name || findTableByName(g.desiredTables, foreignKey.referenceName) != nil ||
+					!g.shouldDropTable(foreignKey.referenceName) {
+					continue
+				}
+			} else if containsString(convertForeignKeysToConstraintNames(desiredTable.foreignKeys), foreignKey.constraintName) {
+				continue // Foreign key is expected to exist.
+			}
+			ddls = append(ddls, g.generateDropForeignKey(currentTable.name, foreignKey.constraintName))
+		}
+	}
+
 	// Clean up obsoleted tables, indexes, columns
 	for _, currentTable := range g.currentTables {
 		desiredTable := findTableByName(g.desiredTables, currentTable.name)
 		if desiredTable == nil {
+			// A partition is dropped together with its parent.
+			if currentTable.partitionOf != "" && findTableByName(g.desiredTables, currentTable.partitionOf) == nil {
+				continue
+			}
+			// Partitions managed by a policy are kept until they expire. Detached ones are not managed anymore.
+			ddl := fmt.Sprintf("DROP TABLE %s", g.escapeTableName(currentTable.name))
+			if policy := findPartitionPolicy(g.partitionPolicies, currentTable.name); policy != nil {
+				if !policy.isExpired(currentTable.name, g.now) {
+					continue
+				}
+				if policy.detach {
+					if currentTable.partitionOf == policy.tableName {
+						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DETACH PARTITION %s", g.escapeTableName(policy.tableName), g.escapeTableName(currentTable.name)))
+					}
+					continue
+				}
+				if !g.shouldDropTable(currentTable.name) {
+					g.skippedDDLs = append(g.skippedDDLs, ddl) // Expired partitions are dropped only when it's requested.
+					continue
+				}
+			} else if !g.shouldDropTable(currentTable.name) {
+				continue // Tables not given are kept unless it's requested.
+			}
 			// Obsoleted table found. Drop table.
-			ddls = append(ddls, fmt.Sprintf("DROP TABLE %s", currentTable.name)) // TODO: escape table name
+			ddls = append(ddls, ddl)
 			g.currentTables = removeTableByName(g.currentTables, currentTable.name)
 			continue
 		}
 
-		// Table is expected to exist. Check indexes.
+		// Check PostgreSQL's partition bound, which may be given by `ATTACH PARTITION` after `CREATE TABLE`.
+		if currentTable.partitionOf != desiredTable.partitionOf || currentTable.bound != desiredTable.bound {
+			if currentTable.partitionOf != "" {
+				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DETACH PARTITION %s", g.escapeTableName(currentTable.partitionOf), g.escapeTableName(currentTable.name)))
+			}
+			if desiredTable.partitionOf != "" {
+				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ATTACH PARTITION %s %s", g.escapeTableName(desiredTable.partitionOf), g.escapeTableName(currentTable.name), desiredTable.bound))
+			}
+		}
+
+		// Indexes and columns of a partition are inherited from its parent, and they can't be dropped.
+		if desiredTable.partitionOf != "" {
+			continue
+		}
+
+		// Check indexes.
 		for _, index := range currentTable.indexes {
 			if containsString(convertIndexesToIndexNames(desiredTable.indexes), index.name) {
 				continue // Index is expected to exist.
 			}
+			if g.mode == GeneratorModeMysql && isIndexForForeignKey(index, desiredTable.foreignKeys) {
+				continue // MySQL implicitly creates an index for a foreign key, and it can't be dropped.
+			}
 
 			// Index is obsoleted. Check and drop index as needed.
 			indexDDLs, err := g.generateDDLsForAbsentIndex(index, *currentTable, *desiredTable)
@@ -121,19 +654,175 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 			// TODO: simulate to remove index from `currentTable.indexes`?
 		}
 
-		// Check columns.
+		// Check check constraints, which may refer to columns to be dropped.
+		for _, check := range currentTable.checks {
+			if findCheckByName(desiredTable.checks, check.constraintName) != nil {
+				continue // Check constraint is expected to exist.
+			}
+			ddls = append(ddls, g.generateDropCheck(currentTable.name, check.constraintName))
+		}
+
+		// Check exclusion constraints.
+		for _, exclusion := range currentTable.exclusions {
+			if findExclusionByName(desiredTable.exclusions, exclusion.constraintName) != nil {
+				continue // Exclusion constraint is expected to exist.
+			}
+			ddls = append(ddls, g.generateDropCheck(currentTable.name, exclusion.constraintName))
+		}
+
+		// Check columns. A column kept without EnableDropColumn may still use a type, which can't be dropped then.
+		columns := []Column{}
 		for _, column := range currentTable.columns {
 			if containsString(convertColumnsToColumnNames(desiredTable.columns), column.name) {
+				columns = append(columns, column)
 				continue // Column is expected to exist.
 			}
+			if column.rowPeriod != "" {
+				columns = append(columns, column)
+				continue // Row start and row end columns are dropped by `DROP SYSTEM VERSIONING`.
+			}
 
 			// Column is obsoleted. Drop column.
-			ddl := fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", desiredTable.name, column.name) // TODO: escape
-			ddls = append(ddls, ddl)
-			// TODO: simulate to remove column from `currentTable.columns`?
+			ddl := fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", g.escapeTableName(desiredTable.name), g.escapeSQLName(column.name))
+			if g.config.EnableDropColumn {
+				ddls = append(ddls, ddl)
+			} else {
+				g.skippedDDLs = append(g.skippedDDLs, ddl)
+				columns = append(columns, column)
+			}
+		}
+		currentTable.columns = columns
+
+		// Check identities and serial defaults, which may be given by `ALTER TABLE ... ALTER COLUMN` after `CREATE TABLE`.
+		// Row-level security is given by `ALTER TABLE` as well.
+		if g.mode == GeneratorModePostgres {
+			ddls = append(ddls, g.generateDDLsForRowLevelSecurity(*currentTable, *desiredTable)...)
+			for _, column := range currentTable.columns {
+				desiredColumn := findColumnByName(desiredTable.columns, column.name)
+				if desiredColumn == nil {
+					continue
+				}
+				ddls = append(ddls, g.generateDDLsForIdentity(currentTable.name, *desiredColumn, column.identity, desiredColumn.identity)...)
+				// The sequence of a serial column can't be dropped while the default uses it.
+				if isSerialType(column.typeName) && !isSerialType(desiredColumn.typeName) && desiredColumn.defaultSeq == "" {
+					ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT", g.escapeTableName(currentTable.name), g.escapeSQLName(column.name)))
+				}
+				if !g.areSameDefaults(column.defaultVal, desiredColumn.defaultVal, desiredColumn.typeName) {
+					if desiredColumn.defaultVal == nil {
+						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT", g.escapeTableName(currentTable.name), g.escapeSQLName(column.name)))
+					} else {
+						defaultVal, err := g.generateValue(*desiredColumn.defaultVal)
+						if err != nil {
+							return ddls, err
+						}
+						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET DEFAULT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(column.name), defaultVal))
+					}
+				}
+			}
+		}
+
+		// Check comments. Unlike MySQL, PostgreSQL's comments are not removed by `CREATE TABLE` without them.
+		if g.mode == GeneratorModePostgres {
+			if !areSameComments(currentTable.comment, desiredTable.comment) {
+				ddls = append(ddls, g.generateCommentOn(currentTable.name, "", desiredTable.comment))
+			}
+			for _, column := range currentTable.columns {
+				desiredColumn := findColumnByName(desiredTable.columns, column.name)
+				if desiredColumn != nil && !areSameComments(column.comment, desiredColumn.comment) {
+					ddls = append(ddls, g.generateCommentOn(currentTable.name, column.name, desiredColumn.comment))
+				}
+			}
+		}
+	}
+
+	// Clean up obsoleted foreign tables, user mappings and servers after tables. A server is dropped last,
+	// since foreign tables and user mappings depend on it.
+	if g.config.ManageForeignData {
+		for _, currentTable := range g.currentForeignTables {
+			if findForeignTableByName(g.desiredForeignTables, currentTable.name) == nil {
+				ddls = append(ddls, fmt.Sprintf("DROP FOREIGN TABLE %s", g.escapeTableName(currentTable.name)))
+			}
+		}
+		for _, currentUserMapping := range g.currentUserMappings {
+			if findUserMapping(g.desiredUserMappings, currentUserMapping.user, currentUserMapping.server) == nil {
+				ddls = append(ddls, fmt.Sprintf("DROP USER MAPPING FOR %s SERVER %s", g.escapeRoleName(currentUserMapping.user), g.escapeSQLName(currentUserMapping.server)))
+			}
+		}
+		for _, currentServer := range g.currentServers {
+			if findServerByName(g.desiredServers, currentServer.name) == nil {
+				ddls = append(ddls, fmt.Sprintf("DROP SERVER %s", g.escapeSQLName(currentServer.name)))
+			}
+		}
+	}
+
+	// Clean up obsoleted sequences after tables, which may use them. A sequence owned by a dropped table or column
+	// is dropped with it, and one owned by a kept table or column is kept with it.
+	for _, currentSequence := range g.currentSequences {
+		if findSequenceByName(g.desiredSequences, currentSequence.name) != nil {
+			continue
+		}
+		if ownerTable, ownerColumn := splitOwnedBy(currentSequence.ownedBy); ownerTable != "" {
+			if table := findTableByName(g.desiredTables, ownerTable); table == nil || findColumnByName(table.columns, ownerColumn) == nil {
+				continue
+			}
+		}
+		if isSerialSequence(*currentSequence, g.desiredTables) {
+			continue // The sequence of a serial column is managed by the column.
+		}
+		ddl := fmt.Sprintf("DROP SEQUENCE %s", g.escapeTableName(currentSequence.name))
+		if isSerialSequence(*currentSequence, g.currentTables) {
+			ddls = append(ddls, ddl) // The column is no longer serial, so its implicit sequence goes with it.
+			continue
+		}
+		ddls = g.appendDropDDL(ddls, ddl)
+	}
+
+	// Clean up obsoleted functions last, since tables, views and triggers may refer to them.
+	for _, currentFunction := range g.currentFunctions {
+		if findFunction(g.desiredFunctions, *currentFunction) == nil {
+			ddls = g.appendDropDDL(ddls, g.generateDropFunction(*currentFunction))
+		}
+	}
+
+	// Clean up obsoleted domains and enum types after functions, since tables and functions may use them.
+	// A domain may be based on an enum type.
+	for _, currentDomain := range g.currentDomains {
+		if findDomainByName(g.desiredDomains, currentDomain.name) == nil && !g.isTypeUsed(currentDomain.name) {
+			ddls = g.appendDropDDL(ddls, fmt.Sprintf("DROP DOMAIN %s", g.escapeTableName(currentDomain.name)))
+		}
+	}
+	for _, currentEnum := range g.currentEnums {
+		if findEnumByName(g.desiredEnums, currentEnum.name) == nil && !g.isTypeUsed(currentEnum.name) {
+			ddls = g.appendDropDDL(ddls, fmt.Sprintf("DROP TYPE %s", g.escapeTableName(currentEnum.name)))
+		}
+	}
+
+	// Privileges are managed only when it's requested, since they may be given by other than this schema.
+	if g.config.ManagePrivileges {
+		ddls = append(ddls, g.generateDDLsForPrivileges()...)
+	}
+
+	// Extensions are dropped only when it's requested, since they may be installed by other than this schema.
+	if g.config.DropExtensions {
+		for _, currentExtension := range g.currentExtensions {
+			if findExtensionByName(g.desiredExtensions, currentExtension.name) == nil {
+				ddls = append(ddls, fmt.Sprintf("DROP EXTENSION \"%s\"", strings.Replace(currentExtension.name, `"`, `""`, -1)))
+			}
 		}
 	}
 
+	// Clean up obsoleted schemas last, since any other objects may belong to them.
+	for _, currentSchema := range g.currentSchemas {
+		if findSchemaByName(g.desiredSchemas, currentSchema.name) == nil && !g.hasTableInSchema(currentSchema.name) {
+			ddls = g.appendDropDDL(ddls, fmt.Sprintf("DROP SCHEMA %s", g.escapeSQLName(currentSchema.name)))
+		}
+	}
+
+	// Refresh materialized views after all DDLs, since they may refer to tables modified by them.
+	for _, viewName := range g.refreshedViews {
+		ddls = append(ddls, fmt.Sprintf("REFRESH MATERIALIZED VIEW %s", g.escapeTableName(viewName)))
+	}
+
 	return ddls, nil
 }
 
@@ -141,8 +830,86 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired CreateTable) ([]string, error) {
 	ddls := []string{}
 
-	// Examine each column
+	if g.mode == GeneratorModePostgres {
+		if currentTable.partition != desired.table.partition {
+			return ddls, fmt.Errorf("PARTITION BY of an existing table '%s' can't be changed: '%s'", desired.table.name, desired.statement)
+		}
+		// Changing parents would add or drop inherited columns together with their data, which is not done implicitly.
+		if strings.Join(currentTable.inherits, ",") != strings.Join(desired.table.inherits, ",") {
+			return ddls, fmt.Errorf("INHERITS of an existing table '%s' can't be changed: '%s'", desired.table.name, desired.statement)
+		}
+		// Columns and constraints of a partition are inherited from its parent. Its bound is examined later.
+		if desired.table.partitionOf != "" {
+			return ddls, nil
+		}
+	}
+
+	// Rename columns prior to examining them, which are compared by the new names.
+	for _, desiredColumn := range desired.table.columns {
+		if desiredColumn.renamedFrom == "" || findColumnByName(currentTable.columns, desiredColumn.name) != nil ||
+			findColumnByName(currentTable.columns, desiredColumn.renamedFrom) == nil {
+			continue
+		}
+		ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s RENAME COLUMN %s TO %s", g.escapeTableName(desired.table.name), g.escapeSQLName(desiredColumn.renamedFrom), g.escapeSQLName(desiredColumn.name)))
+		renameColumn(&currentTable, desiredColumn.renamedFrom, desiredColumn.name)
+		renameColumn(findTableByName(g.currentTables, currentTable.name), desiredColumn.renamedFrom, desiredColumn.name)
+	}
+
+	// Examine primary key. If all of its columns are dropped, the primary key is dropped together.
+	currentPrimaryKey := getPrimaryKeyColumns(currentTable)
+	if !containsAnyString(convertColumnsToColumnNames(desired.table.columns), currentPrimaryKey) {
+		currentPrimaryKey = []string{}
+	}
+	desiredPrimaryKey := getPrimaryKeyColumns(desired.table)
+	if primaryKey, ok := g.desiredPrimaryKeys[desired.table.name]; ok && len(desiredPrimaryKey) == 0 {
+		desiredPrimaryKey = convertIndexColumnsToColumnNames(primaryKey.columns)
+	}
+	primaryKeyChanged := strings.Join(currentPrimaryKey, ",") != strings.Join(desiredPrimaryKey, ",")
+	primaryKeyAdded := false
+
+	// Drop primary key prior to changing columns, since columns of a primary key can't be NULL.
+	// MySQL replaces a primary key in a single statement, since an AUTO_INCREMENT column must be a key.
+	if primaryKeyChanged && len(currentPrimaryKey) > 0 {
+		if g.mode == GeneratorModeMysql && len(desiredPrimaryKey) > 0 {
+			if isSubsetOf(desiredPrimaryKey, convertColumnsToColumnNames(currentTable.columns)) {
+				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP PRIMARY KEY, ADD PRIMARY KEY(%s)", g.escapeTableName(desired.table.name), strings.Join(g.escapeSQLNames(desiredPrimaryKey), ", ")))
+				primaryKeyAdded = true
+			}
+		} else {
+			ddls = append(ddls, g.generateDropPrimaryKey(currentTable))
+		}
+	}
+
+	// Examine default charset and collation prior to columns, which follow them if charset is omitted.
+	// Changing charset resets collation, so both are given if specified.
+	if g.mode == GeneratorModeMysql {
+		desiredCharset, hasCharset := desired.table.options["CHARSET"]
+		desiredCollate, hasCollate := desired.table.options["COLLATE"]
+		if (hasCharset && !strings.EqualFold(currentTable.options["CHARSET"], desiredCharset)) ||
+			(hasCollate && !strings.EqualFold(currentTable.options["COLLATE"], desiredCollate)) {
+			ddl := fmt.Sprintf("ALTER TABLE %s DEFAULT", g.escapeTableName(desired.table.name))
+			if hasCharset {
+				ddl += fmt.Sprintf(" CHARACTER SET %s", desiredCharset)
+			}
+			if hasCollate {
+				ddl += fmt.Sprintf(" COLLATE %s", desiredCollate)
+			}
+			ddls = append(ddls, ddl)
+		}
+	}
+
+	// Examine each column. Row start and row end columns are examined with system versioning.
+	// MySQL's columns are placed after the preceding ones with ColumnPosition or ReorderColumns.
+	positioned := g.mode == GeneratorModeMysql && (g.config.ColumnPosition || g.config.ReorderColumns)
+	orderedColumns := findOrderedColumns(currentTable.columns, desired.table.columns)
+	previousColumn := ""
 	for _, desiredColumn := range desired.table.columns {
+		if desiredColumn.rowPeriod != "" {
+			continue
+		}
+		position := g.generateColumnPosition(previousColumn)
+		previousColumn = desiredColumn.name
+
 		currentColumn := findColumnByName(currentTable.columns, desiredColumn.name)
 		if currentColumn == nil {
 			definition, err := g.generateColumnDefinition(desiredColumn) // TODO: Parse DEFAULt NULL and share this with else
@@ -151,61 +918,508 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 			}
 
 			// Column not found, add column.
-			ddl := fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s", desired.table.name, definition) // TODO: escape
+			ddl := fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s", g.escapeTableName(desired.table.name), definition)
+			if positioned {
+				ddl += " " + position
+			}
 			ddls = append(ddls, ddl)
+			if desiredColumn.keyOption == ColumnKeyPrimary {
+				primaryKeyAdded = true
+			}
 		} else {
-			// Column is found, change primary key first as needed.
-			if g.mode == GeneratorModeMysql { // DDL is not compatible. TODO: support postgresql
-				if isPrimaryKey(*currentColumn, currentTable) && !isPrimaryKey(desiredColumn, desired.table) {
-					// TODO: `DROP PRIMARY KEY` should always come earlier than `ADD PRIMARY KEY` regardless of the order of columns
-					ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP PRIMARY KEY", desired.table.name)) // TODO: escape
-					currentColumn.keyOption = desiredColumn.keyOption
+			// `ADD PRIMARY KEY` implies `NOT NULL`.
+			if primaryKeyChanged && containsString(desiredPrimaryKey, currentColumn.name) {
+				currentColumn.notNull = true
+			}
+
+			// A generated column can't change its storage kind in place. Drop and add the column.
+			if !haveSameGeneratedType(currentColumn.generated, desiredColumn.generated) || (g.mode == GeneratorModePostgres && !areSameGenerated(currentColumn.generated, desiredColumn.generated)) {
+				definition, err := g.generateColumnDefinition(desiredColumn)
+				if err != nil {
+					return ddls, err
 				}
-				if !isPrimaryKey(*currentColumn, currentTable) && isPrimaryKey(desiredColumn, desired.table) {
-					ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ADD PRIMARY KEY(%s)", desired.table.name, desiredColumn.name)) // TODO: escape, support multi-columns?
-					currentColumn.notNull = true
-					currentColumn.keyOption = ColumnKeyPrimary
+				if positioned {
+					definition += " " + position
 				}
+				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name)))
+				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s", g.escapeTableName(desired.table.name), definition))
+				// The comment and indexes are dropped together. PostgreSQL's `COMMENT ON` needs to be executed again.
+				setComment(&currentTable, currentColumn.name, nil)
+				currentTable.indexes = g.removeColumnFromIndexes(currentTable.indexes, currentColumn.name)
+				if table := findTableByName(g.currentTables, currentTable.name); table != nil { // for `CREATE INDEX` examined later
+					table.indexes = g.removeColumnFromIndexes(table.indexes, currentColumn.name)
+				}
+				continue
 			}
 
-			// Change column data type as needed.
-			if !haveSameDataType(*currentColumn, desiredColumn) {
+			// Change column data type, generated expression, comment or visibility as needed. PostgreSQL's comment is examined on `COMMENT ON`.
+			// MySQL's column is moved together with ReorderColumns, unless it's kept in the same order.
+			reordered := positioned && g.config.ReorderColumns && !containsString(orderedColumns, desiredColumn.name)
+			if !g.haveSameDataType(*currentColumn, desiredColumn) || !g.haveSameLengthAndScale(*currentColumn, desiredColumn) ||
+				!areSameGenerated(currentColumn.generated, desiredColumn.generated) ||
+				(g.mode == GeneratorModeMysql && !areSameComments(currentColumn.comment, desiredColumn.comment)) ||
+				(g.mode == GeneratorModeMysql && currentColumn.invisible != desiredColumn.invisible) ||
+				(g.mode == GeneratorModeMysql && !g.areSameDefaults(currentColumn.defaultVal, desiredColumn.defaultVal, desiredColumn.typeName)) ||
+				(g.mode == GeneratorModeMysql && !g.areSameDefaults(currentColumn.onUpdate, desiredColumn.onUpdate, desiredColumn.typeName)) ||
+				(g.mode == GeneratorModeMysql && !haveSameCharsetAndCollation(currentTable, *currentColumn, desired.table, desiredColumn)) {
 				definition, err := g.generateColumnDefinition(desiredColumn) // TODO: Parse DEFAULT NULL and share this with else
 				if err != nil {
 					return ddls, err
 				}
 
 				if g.mode == GeneratorModeMysql { // DDL is not compatible. TODO: support PostgreSQL
-					ddl := fmt.Sprintf("ALTER TABLE %s CHANGE COLUMN %s %s", desired.table.name, currentColumn.name, definition) // TODO: escape
+					ddl := fmt.Sprintf("ALTER TABLE %s CHANGE COLUMN %s %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name), definition)
+					if reordered {
+						ddl += " " + position
+					}
 					ddls = append(ddls, ddl)
 				}
+			} else if reordered {
+				definition, err := g.generateColumnDefinition(desiredColumn)
+				if err != nil {
+					return ddls, err
+				}
+				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s MODIFY COLUMN %s %s", g.escapeTableName(desired.table.name), definition, position))
+			}
+
+			// PostgreSQL changes only the length, scale and time zone of the same type like `numeric(12,4)`. TODO: change other types
+			if g.mode == GeneratorModePostgres && normalizeDataType(g.mode, currentColumn.typeName) == normalizeDataType(g.mode, desiredColumn.typeName) &&
+				(!g.haveSameLengthAndScale(*currentColumn, desiredColumn) || currentColumn.timezone != desiredColumn.timezone) {
+				ddl := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s TYPE %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name), g.generateDataType(desiredColumn))
+				ddls = append(ddls, ddl)
 			}
 
 			// TODO: Add unique index if existing column does not have unique flag and there's no unique index!!!!
 		}
 	}
 
+	// Add primary key after adding its columns.
+	if primaryKeyChanged && len(desiredPrimaryKey) > 0 && !primaryKeyAdded {
+		ddl := fmt.Sprintf("ALTER TABLE %s ADD PRIMARY KEY(%s)", g.escapeTableName(desired.table.name), strings.Join(g.escapeSQLNames(desiredPrimaryKey), ", "))
+		if g.mode == GeneratorModeMysql && len(currentPrimaryKey) > 0 {
+			ddl = fmt.Sprintf("ALTER TABLE %s DROP PRIMARY KEY, ADD PRIMARY KEY(%s)", g.escapeTableName(desired.table.name), strings.Join(g.escapeSQLNames(desiredPrimaryKey), ", "))
+		}
+		ddls = append(ddls, ddl)
+	}
+
 	// Examine each index
 	for _, index := range desired.table.indexes {
-		if containsString(convertIndexesToIndexNames(currentTable.indexes), index.name) {
-			// TODO: Compare types and change column type!!!
-		} else {
-			// Index not found, add index.
-			definition, err := g.generateIndexDefinition(index)
-			if err != nil {
-				return ddls, err
+		if index.primary {
+			continue // Primary key is already examined.
+		}
+		if currentIndex := findIndexByName(currentTable.indexes, index.name); currentIndex != nil {
+			if areSameIndexes(*currentIndex, index) {
+				continue // TODO: Compare types and change column type!!!
 			}
-			ddl := fmt.Sprintf("ALTER TABLE %s ADD %s", desired.table.name, definition) // TODO: escape
-			ddls = append(ddls, ddl)
+			if ddl, ok := g.generateAlterIndexVisibility(desired.table.name, *currentIndex, index); ok {
+				ddls = append(ddls, ddl)
+				continue
+			}
+			if ddl, ok := g.generateAlterIndexTablespace(desired.table.name, *currentIndex, index); ok {
+				ddls = append(ddls, ddl)
+				continue
+			}
+			// Index found but it's different. Drop and add index.
+			ddls = append(ddls, g.generateDropIndex(desired.table.name, *currentIndex))
+		} else if renamedIndex := g.findIndexToRename(currentTable, index); renamedIndex != nil {
+			// The same index exists with another name. Rename it instead of rebuilding it.
+			ddls = append(ddls, g.generateRenameIndex(desired.table.name, *renamedIndex, index.name))
+			renameIndex(&currentTable, renamedIndex.name, index.name)
+			renameIndex(findTableByName(g.currentTables, currentTable.name), renamedIndex.name, index.name)
+			continue
 		}
-	}
 
-	return ddls, nil
-}
+		// Index not found, add index.
+		definition, err := g.generateIndexDefinition(index)
+		if err != nil {
+			return ddls, err
+		}
+		ddl := fmt.Sprintf("ALTER TABLE %s ADD %s", g.escapeTableName(desired.table.name), definition)
+		ddls = append(ddls, ddl)
+	}
 
-// Shared by `CREATE INDEX` and `ALTER TABLE ADD INDEX`.
-// This manages `g.currentTables` unlike `generateDDLsForCreateTable`...
-func (g *Generator) generateDDLsForCreateIndex(tableName string, desiredIndex Index, action string, statement string) ([]string, error) {
+	// Examine each foreign key
+	for _, foreignKey := range desired.table.foreignKeys {
+		currentForeignKey := findForeignKeyByName(currentTable.foreignKeys, foreignKey.constraintName)
+		if currentForeignKey != nil && areSameForeignKeys(*currentForeignKey, foreignKey) {
+			continue
+		}
+		if currentForeignKey != nil {
+			if ddl, ok := g.generateAlterForeignKeyDeferrability(desired.table.name, *currentForeignKey, foreignKey); ok {
+				ddls = append(ddls, ddl)
+				continue
+			}
+		}
+		if currentForeignKey != nil {
+			// Foreign key found but it's different. Drop and add foreign key.
+			ddls = append(ddls, g.generateDropForeignKey(desired.table.name, currentForeignKey.constraintName))
+		}
+		ddl := fmt.Sprintf("ALTER TABLE %s ADD %s", g.escapeTableName(desired.table.name), g.generateForeignKeyDefinition(foreignKey))
+		ddls = append(ddls, ddl)
+	}
+
+	// Examine each check constraint
+	for _, check := range desired.table.checks {
+		currentCheck := findCheckByName(currentTable.checks, check.constraintName)
+		if currentCheck != nil && currentCheck.definition == check.definition {
+			continue
+		}
+		if currentCheck != nil {
+			// Check constraint found but its expression is different. Drop and add check constraint.
+			ddls = append(ddls, g.generateDropCheck(desired.table.name, currentCheck.constraintName))
+		}
+		ddl := fmt.Sprintf("ALTER TABLE %s ADD %s", g.escapeTableName(desired.table.name), g.generateCheckDefinition(check))
+		ddls = append(ddls, ddl)
+	}
+
+	// Examine each exclusion constraint
+	for _, exclusion := range desired.table.exclusions {
+		currentExclusion := findExclusionByName(currentTable.exclusions, exclusion.constraintName)
+		if currentExclusion != nil && currentExclusion.definition == exclusion.definition {
+			continue
+		}
+		if currentExclusion != nil {
+			// Exclusion constraint found but it's different. Drop and add exclusion constraint.
+			ddls = append(ddls, g.generateDropCheck(desired.table.name, currentExclusion.constraintName))
+		}
+		ddl := fmt.Sprintf("ALTER TABLE %s ADD %s", g.escapeTableName(desired.table.name), g.generateExclusionDefinition(exclusion))
+		ddls = append(ddls, ddl)
+	}
+
+	// Examine MariaDB's system versioning
+	if g.mode == GeneratorModeMysql && currentTable.systemVersioning != desired.table.systemVersioning {
+		ddl, err := g.generateAlterSystemVersioning(desired.table)
+		if err != nil {
+			return ddls, err
+		}
+		ddls = append(ddls, ddl)
+	}
+
+	// Examine table options. Only options specified in the desired table are managed.
+	if g.mode == GeneratorModeMysql {
+		for _, name := range managedTableOptions {
+			desiredValue, ok := desired.table.options[name]
+			if !ok || strings.EqualFold(currentTable.options[name], desiredValue) {
+				continue
+			}
+			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s %s = %s", g.escapeTableName(desired.table.name), name, desiredValue))
+		}
+
+		// AUTO_INCREMENT is increased by inserts, so it's changed only when the desired one is larger.
+		if desiredValue, ok := desired.table.options["AUTO_INCREMENT"]; ok && g.config.ManageAutoIncrement {
+			desiredAutoIncrement, err := strconv.Atoi(desiredValue)
+			if err != nil {
+				return ddls, fmt.Errorf("invalid AUTO_INCREMENT '%s' of table '%s': '%s'", desiredValue, desired.table.name, desired.statement)
+			}
+			if currentAutoIncrement, _ := strconv.Atoi(currentTable.options["AUTO_INCREMENT"]); desiredAutoIncrement > currentAutoIncrement {
+				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s AUTO_INCREMENT = %d", g.escapeTableName(desired.table.name), desiredAutoIncrement))
+			}
+		}
+	}
+
+	// Examine PostgreSQL's tablespace. A table without TABLESPACE is moved back to the default one.
+	if g.mode == GeneratorModePostgres {
+		desiredTablespace := normalizeTablespace(desired.table.options["TABLESPACE"])
+		if normalizeTablespace(currentTable.options["TABLESPACE"]) != desiredTablespace {
+			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s SET TABLESPACE %s", g.escapeTableName(desired.table.name), generateTablespace(desiredTablespace)))
+		}
+	}
+
+	// Examine partitioning
+	if g.mode == GeneratorModeMysql && currentTable.partition != desired.table.partition {
+		if desired.table.partition == "" {
+			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s REMOVE PARTITIONING", g.escapeTableName(desired.table.name)))
+		} else {
+			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s %s", g.escapeTableName(desired.table.name), desired.table.partition))
+		}
+	}
+
+	// Examine table comment. PostgreSQL's one is examined on `COMMENT ON`.
+	if g.mode == GeneratorModeMysql && !areSameComments(currentTable.comment, desired.table.comment) {
+		comment := ""
+		if desired.table.comment != nil {
+			comment = *desired.table.comment
+		}
+		ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s COMMENT = %s", g.escapeTableName(desired.table.name), g.quoteString(comment)))
+	}
+
+	return ddls, nil
+}
+
+// Like `generateDDLsForCreateIndex`, this manages `g.currentViews` and `g.desiredViews`.
+func (g *Generator) generateDDLsForCreateView(desired CreateView) ([]string, error) {
+	ddls := []string{}
+
+	currentView := findViewByName(g.currentViews, desired.view.name)
+	if currentView == nil {
+		// View not found, create view.
+		ddls = append(ddls, desired.statement)
+		if desired.view.materialized && g.config.RefreshMaterializedViews {
+			g.refreshedViews = append(g.refreshedViews, desired.view.name)
+		}
+		view := desired.view // copy view
+		g.currentViews = append(g.currentViews, &view)
+	} else if currentView.materialized || desired.view.materialized {
+		// A materialized view can't be replaced. Drop and create view, which drops its indexes as well.
+		if currentView.materialized != desired.view.materialized || currentView.definition != desired.view.definition {
+			if currentView.materialized && !g.config.RecreateMaterializedViews {
+				return ddls, fmt.Errorf(
+					"materialized view '%s' needs to be dropped and created to be changed, which is not enabled: '%s'",
+					desired.view.name, desired.statement,
+				)
+			}
+			ddls = append(ddls, g.generateDropView(*currentView))
+			ddls = append(ddls, desired.statement)
+			if desired.view.materialized && g.config.RefreshMaterializedViews {
+				g.refreshedViews = append(g.refreshedViews, desired.view.name)
+			}
+			*currentView = desired.view
+		}
+	} else if currentView.definition != desired.view.definition {
+		// View found but its definition is different. Replace view.
+		ddls = append(ddls, fmt.Sprintf("CREATE OR REPLACE VIEW %s AS %s", g.escapeTableName(desired.view.name), desired.view.definition))
+		currentView.definition = desired.view.definition
+	}
+
+	view := desired.view // copy view
+	g.desiredViews = append(g.desiredViews, &view)
+	return ddls, nil
+}
+
+// For PostgreSQL's `CREATE INDEX` on a materialized view. Like `generateDDLsForCreateIndex`, this manages
+// `g.currentViews` and `g.desiredViews`.
+func (g *Generator) generateDDLsForCreateViewIndex(viewName string, desiredIndex Index, statement string) ([]string, error) {
+	ddls := []string{}
+
+	desiredView := findViewByName(g.desiredViews, viewName)
+	if !desiredView.materialized {
+		return nil, fmt.Errorf("CREATE INDEX is performed for a view which is not materialized '%s': '%s'", viewName, statement)
+	}
+	if findIndexByName(desiredView.indexes, desiredIndex.name) != nil {
+		return nil, fmt.Errorf("index '%s' is doubly created against view '%s': '%s'", desiredIndex.name, viewName, statement)
+	}
+	desiredView.indexes = append(desiredView.indexes, desiredIndex)
+
+	currentView := findViewByName(g.currentViews, viewName)
+	currentIndex := findIndexByName(currentView.indexes, desiredIndex.name)
+	if currentIndex == nil {
+		// Index not found, add index.
+		ddls = append(ddls, statement)
+		currentView.indexes = append(currentView.indexes, desiredIndex)
+	} else if !areSameIndexes(*currentIndex, desiredIndex) {
+		// Index found but it's different. Drop and add index.
+		ddls = append(ddls, g.generateDropIndex(viewName, *currentIndex))
+		ddls = append(ddls, statement)
+		for i, index := range currentView.indexes {
+			if index.name == desiredIndex.name {
+				currentView.indexes[i] = desiredIndex
+			}
+		}
+	}
+	return ddls, nil
+}
+
+// For `ALTER TABLE ADD FOREIGN KEY`. Like `generateDDLsForCreateIndex`, this manages `g.currentTables`.
+func (g *Generator) generateDDLsForAddForeignKey(tableName string, desiredForeignKey ForeignKey, action string, statement string) ([]string, error) {
+	ddls := []string{}
+
+	currentTable := findTableByName(g.currentTables, tableName)
+	if currentTable == nil {
+		return nil, fmt.Errorf("%s is performed for inexistent table '%s': '%s'", action, tableName, statement)
+	}
+	desiredTable := findTableByName(g.desiredTables, tableName)
+	if desiredTable == nil {
+		return nil, fmt.Errorf("%s is performed before create table '%s': '%s'", action, tableName, statement)
+	}
+	if desiredForeignKey.constraintName == "" {
+		desiredForeignKey.constraintName = generateForeignKeyName(g.mode, tableName, desiredForeignKey, desiredTable.foreignKeys)
+	}
+
+	currentForeignKey := findForeignKeyByName(currentTable.foreignKeys, desiredForeignKey.constraintName)
+	if currentForeignKey == nil {
+		// Foreign key not found, add foreign key.
+		ddls = append(ddls, statement)
+		currentTable.foreignKeys = append(currentTable.foreignKeys, desiredForeignKey)
+	} else if !areSameForeignKeys(*currentForeignKey, desiredForeignKey) {
+		if ddl, ok := g.generateAlterForeignKeyDeferrability(currentTable.name, *currentForeignKey, desiredForeignKey); ok {
+			ddls = append(ddls, ddl)
+		} else {
+			// Foreign key found but it's different. Drop and add foreign key.
+			ddls = append(ddls, g.generateDropForeignKey(currentTable.name, currentForeignKey.constraintName))
+			ddls = append(ddls, statement)
+		}
+
+		newForeignKeys := []ForeignKey{}
+		for _, currentForeignKey := range currentTable.foreignKeys {
+			if currentForeignKey.constraintName == desiredForeignKey.constraintName {
+				newForeignKeys = append(newForeignKeys, desiredForeignKey)
+			} else {
+				newForeignKeys = append(newForeignKeys, currentForeignKey)
+			}
+		}
+		currentTable.foreignKeys = newForeignKeys
+	}
+
+	// Examine foreign keys in desiredTable to delete obsoleted foreign keys later
+	if containsString(convertForeignKeysToConstraintNames(desiredTable.foreignKeys), desiredForeignKey.constraintName) {
+		return nil, fmt.Errorf("foreign key '%s' is doubly created against table '%s': '%s'", desiredForeignKey.constraintName, tableName, statement)
+	}
+	desiredTable.foreignKeys = append(desiredTable.foreignKeys, desiredForeignKey)
+
+	return ddls, nil
+}
+
+// For PostgreSQL's `ALTER TABLE ADD CONSTRAINT ... EXCLUDE`. Like `generateDDLsForCreateIndex`, this manages `g.currentTables`.
+func (g *Generator) generateDDLsForAddExclusion(desired AddExclusion) ([]string, error) {
+	ddls := []string{}
+
+	currentTable := findTableByName(g.currentTables, desired.tableName)
+	if currentTable == nil {
+		return nil, fmt.Errorf("ADD EXCLUDE is performed for inexistent table '%s': '%s'", desired.tableName, desired.statement)
+	}
+	desiredTable := findTableByName(g.desiredTables, desired.tableName)
+	if desiredTable == nil {
+		return nil, fmt.Errorf("ADD EXCLUDE is performed before CREATE TABLE '%s': '%s'", desired.tableName, desired.statement)
+	}
+	if findExclusionByName(desiredTable.exclusions, desired.exclusion.constraintName) != nil {
+		return nil, fmt.Errorf("exclusion constraint '%s' is doubly created against table '%s': '%s'", desired.exclusion.constraintName, desired.tableName, desired.statement)
+	}
+
+	// Exclusions are copied since they may be shared by the current and desired tables.
+	currentExclusion := findExclusionByName(currentTable.exclusions, desired.exclusion.constraintName)
+	if currentExclusion == nil {
+		// Exclusion constraint not found, add exclusion constraint.
+		ddls = append(ddls, desired.statement)
+		currentTable.exclusions = append(append([]Exclusion{}, currentTable.exclusions...), desired.exclusion)
+	} else if currentExclusion.definition != desired.exclusion.definition {
+		// Exclusion constraint found but it's different. Drop and add exclusion constraint.
+		ddls = append(ddls, g.generateDropCheck(currentTable.name, currentExclusion.constraintName))
+		ddls = append(ddls, desired.statement)
+
+		newExclusions := []Exclusion{}
+		for _, exclusion := range currentTable.exclusions {
+			if exclusion.constraintName == desired.exclusion.constraintName {
+				newExclusions = append(newExclusions, desired.exclusion)
+			} else {
+				newExclusions = append(newExclusions, exclusion)
+			}
+		}
+		currentTable.exclusions = newExclusions
+	}
+	desiredTable.exclusions = append(append([]Exclusion{}, desiredTable.exclusions...), desired.exclusion)
+
+	return ddls, nil
+}
+
+// For PostgreSQL's `COMMENT ON`. Like `generateDDLsForCreateIndex`, this manages `g.currentTables`.
+func (g *Generator) generateDDLsForCommentOn(desired CommentOn) ([]string, error) {
+	ddls := []string{}
+
+	currentTable := findTableByName(g.currentTables, desired.tableName)
+	if currentTable == nil {
+		return nil, fmt.Errorf("COMMENT ON is performed for inexistent table '%s': '%s'", desired.tableName, desired.statement)
+	}
+	currentComment, err := findComment(*currentTable, desired.columnName)
+	if err != nil {
+		return nil, fmt.Errorf("COMMENT ON is performed for inexistent column '%s': '%s'", desired.columnName, desired.statement)
+	}
+	if !areSameComments(currentComment, desired.comment) {
+		ddls = append(ddls, desired.statement)
+		setComment(currentTable, desired.columnName, desired.comment)
+	}
+
+	// Examine comments in desiredTable to remove obsoleted comments later
+	desiredTable := findTableByName(g.desiredTables, desired.tableName)
+	if desiredTable == nil {
+		return nil, fmt.Errorf("COMMENT ON is performed before create table '%s': '%s'", desired.tableName, desired.statement)
+	}
+	if err := setComment(desiredTable, desired.columnName, desired.comment); err != nil {
+		return nil, fmt.Errorf("COMMENT ON is performed for inexistent column '%s': '%s'", desired.columnName, desired.statement)
+	}
+
+	return ddls, nil
+}
+
+// `DROP TABLE` in desired DDLs just cancels its `CREATE TABLE`. Actual `DROP TABLE` is generated
+// on the clean up of obsoleted tables, so `DROP TABLE IF EXISTS` + `CREATE TABLE` works idempotently.
+func (g *Generator) removeDesiredTable(desired DropTable) error {
+	g.droppedTables = append(g.droppedTables, desired.tableName)
+	if findTableByName(g.desiredTables, desired.tableName) != nil {
+		g.desiredTables = removeTableByName(g.desiredTables, desired.tableName)
+	} else if !desired.ifExists && findTableByName(g.currentTables, desired.tableName) == nil {
+		return fmt.Errorf("DROP TABLE is performed for inexistent table '%s': '%s'", desired.tableName, desired.statement)
+	}
+	return nil
+}
+
+// Tables not given by desired DDLs are dropped only when it's requested or they're given by `DROP TABLE`.
+func (g *Generator) shouldDropTable(tableName string) bool {
+	return g.config.EnableDropTable || containsString(g.droppedTables, tableName)
+}
+
+// Objects other than tables, like views and sequences, are also dropped only when EnableDropTable is given, since they may be
+// created by other than this schema. Otherwise, the DDL is reported as skipped.
+func (g *Generator) appendDropDDL(ddls []string, ddl string) []string {
+	if !g.config.EnableDropTable {
+		g.skippedDDLs = append(g.skippedDDLs, ddl)
+		return ddls
+	}
+	return append(ddls, ddl)
+}
+
+// Return true if a column kept in the database uses the domain or enum type, which can't be dropped then.
+func (g *Generator) isTypeUsed(typeName string) bool {
+	for _, table := range g.currentTables {
+		for _, column := range table.columns {
+			if column.typeName == typeName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Return true if a table kept in the database belongs to the schema, which can't be dropped then.
+func (g *Generator) hasTableInSchema(schemaName string) bool {
+	for _, table := range g.currentTables {
+		if strings.HasPrefix(table.name, schemaName+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// Like `removeDesiredTable`, `DROP INDEX` just removes the index from desired tables.
+func (g *Generator) removeDesiredIndex(desired DropIndex) error {
+	for _, desiredTable := range g.desiredTables {
+		if desired.tableName != "" && desiredTable.name != desired.tableName {
+			continue
+		}
+		if findIndexByName(desiredTable.indexes, desired.indexName) != nil {
+			desiredTable.indexes = removeIndexByName(desiredTable.indexes, desired.indexName)
+			// The dropped index may be renamed to another one given later.
+			g.desiredIndexNames[desiredTable.name] = removeFirstString(g.desiredIndexNames[desiredTable.name], desired.indexName)
+			return nil
+		}
+	}
+
+	if !desired.ifExists {
+		for _, currentTable := range g.currentTables {
+			if desired.tableName != "" && currentTable.name != desired.tableName {
+				continue
+			}
+			if findIndexByName(currentTable.indexes, desired.indexName) != nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("DROP INDEX is performed for inexistent index '%s': '%s'", desired.indexName, desired.statement)
+	}
+	return nil
+}
+
+// Shared by `CREATE INDEX` and `ALTER TABLE ADD INDEX`.
+// This manages `g.currentTables` unlike `generateDDLsForCreateTable`...
+func (g *Generator) generateDDLsForCreateIndex(tableName string, desiredIndex Index, action string, statement string) ([]string, error) {
 	ddls := []string{}
 
 	currentTable := findTableByName(g.currentTables, tableName)
@@ -214,15 +1428,25 @@ func (g *Generator) generateDDLsForCreateIndex(tableName string, desiredIndex In
 	}
 
 	currentIndex := findIndexByName(currentTable.indexes, desiredIndex.name)
-	if currentIndex == nil {
+	if renamedIndex := g.findIndexToRename(*currentTable, desiredIndex); currentIndex == nil && renamedIndex != nil {
+		// The same index exists with another name. Rename it instead of rebuilding it.
+		ddls = append(ddls, g.generateRenameIndex(currentTable.name, *renamedIndex, desiredIndex.name))
+		renameIndex(currentTable, renamedIndex.name, desiredIndex.name)
+	} else if currentIndex == nil {
 		// Index not found, add index.
 		ddls = append(ddls, statement)
 		currentTable.indexes = append(currentTable.indexes, desiredIndex)
 	} else {
-		// Index found. If it's different, drop and add index.
+		// Index found. If it's different, drop and add index unless only the visibility is changed.
 		if !areSameIndexes(*currentIndex, desiredIndex) {
-			ddls = append(ddls, g.generateDropIndex(currentTable.name, currentIndex.name))
-			ddls = append(ddls, statement)
+			if ddl, ok := g.generateAlterIndexVisibility(currentTable.name, *currentIndex, desiredIndex); ok {
+				ddls = append(ddls, ddl)
+			} else if ddl, ok := g.generateAlterIndexTablespace(currentTable.name, *currentIndex, desiredIndex); ok {
+				ddls = append(ddls, ddl)
+			} else {
+				ddls = append(ddls, g.generateDropIndex(currentTable.name, *currentIndex))
+				ddls = append(ddls, statement)
+			}
 
 			newIndexes := []Index{}
 			for _, currentIndex := range currentTable.indexes {
@@ -255,23 +1479,7 @@ func (g *Generator) generateDDLsForAbsentIndex(currentIndex Index, currentTable
 	ddls := []string{}
 
 	if currentIndex.primary {
-		//pp(currentIndex)
-		var primaryKeyColumn *Column
-		for _, column := range desiredTable.columns {
-			if column.keyOption == ColumnKeyPrimary {
-				primaryKeyColumn = &column
-				break
-			}
-		}
-
-		// If nil, it will be `DROP COLUMN`-ed. Ignore it.
-		if primaryKeyColumn != nil && primaryKeyColumn.name != currentIndex.columns[0].column { // TODO: check length of currentIndex.columns
-			// TODO: handle this. Rename primary key column...?
-			return ddls, fmt.Errorf(
-				"primary key column name of '%s' should be '%s' but currently '%s'. This is not handled yet.",
-				currentTable.name, primaryKeyColumn.name, currentIndex.columns[0].column,
-			)
-		}
+		// Primary key is examined by `generateDDLsForCreateTable`.
 	} else if currentIndex.unique {
 		var uniqueKeyColumn *Column
 		for _, column := range desiredTable.columns {
@@ -283,61 +1491,112 @@ func (g *Generator) generateDDLsForAbsentIndex(currentIndex Index, currentTable
 
 		if uniqueKeyColumn == nil {
 			// No unique column. Drop unique key index.
-			ddls = append(ddls, g.generateDropIndex(currentTable.name, currentIndex.name))
+			ddls = append(ddls, g.generateDropIndex(currentTable.name, currentIndex))
 		}
 	} else {
-		ddls = append(ddls, g.generateDropIndex(currentTable.name, currentIndex.name))
+		ddls = append(ddls, g.generateDropIndex(currentTable.name, currentIndex))
 	}
 
 	return ddls, nil
 }
 
-func (g *Generator) generateColumnDefinition(column Column) (string, error) {
-	// TODO: make string concatenation faster?
-	// TODO: consider escape?
-
-	definition := fmt.Sprintf("%s ", column.name)
-
+// Return the data type of the column with its length, enum values and so on, but without attributes like UNSIGNED.
+func (g *Generator) generateDataType(column Column) string {
+	dataType := column.typeName
 	if column.length != nil {
 		if column.scale != nil {
-			definition += fmt.Sprintf("%s(%s, %s) ", column.typeName, string(column.length.raw), string(column.scale.raw))
+			dataType += fmt.Sprintf("(%s, %s)", string(column.length.raw), string(column.scale.raw))
 		} else {
-			definition += fmt.Sprintf("%s(%s) ", column.typeName, string(column.length.raw))
+			dataType += fmt.Sprintf("(%s)", string(column.length.raw))
+		}
+	} else if len(column.enumValues) > 0 {
+		values := []string{}
+		for _, value := range column.enumValues {
+			values = append(values, g.quoteString(value))
+		}
+		dataType += fmt.Sprintf("(%s)", strings.Join(values, ", "))
+	}
+	if column.timezone {
+		dataType += " with time zone"
+	}
+	if column.geometryType != "" {
+		if column.srid != nil {
+			dataType += fmt.Sprintf("(%s,%s)", column.geometryType, string(column.srid.raw))
+		} else {
+			dataType += fmt.Sprintf("(%s)", column.geometryType)
 		}
-	} else {
-		definition += fmt.Sprintf("%s ", column.typeName)
 	}
+	if column.array {
+		dataType += "[]"
+	}
+	return dataType
+}
+
+func (g *Generator) generateColumnDefinition(column Column) (string, error) {
+	// TODO: make string concatenation faster?
+
+	definition := fmt.Sprintf("%s %s ", g.escapeSQLName(column.name), g.generateDataType(column))
 
 	if column.unsigned {
 		definition += "UNSIGNED "
 	}
+	if column.zerofill {
+		definition += "ZEROFILL "
+	}
+	if column.charset != "" {
+		definition += fmt.Sprintf("CHARACTER SET %s ", column.charset)
+	}
+	if column.collate != "" {
+		definition += fmt.Sprintf("COLLATE %s ", column.collate)
+	}
+	if column.geometryType == "" && column.srid != nil {
+		definition += fmt.Sprintf("SRID %s ", string(column.srid.raw))
+	}
+	if column.generated != nil {
+		definition += fmt.Sprintf("GENERATED ALWAYS AS (%s) ", g.escapeExpr(column.generated.expr))
+		if column.generated.stored {
+			definition += "STORED "
+		} else {
+			definition += "VIRTUAL "
+		}
+	}
+	if column.identity != nil {
+		definition += generateIdentityDefinition(column.typeName, *column.identity) + " "
+	}
+	if column.rowPeriod != "" {
+		definition += fmt.Sprintf("GENERATED ALWAYS AS %s ", strings.ToUpper(column.rowPeriod))
+	}
 	if column.notNull {
 		definition += "NOT NULL "
 	}
 
 	if column.defaultVal != nil {
-		switch column.defaultVal.valueType {
-		case ValueTypeStr:
-			definition += fmt.Sprintf("DEFAULT '%s' ", column.defaultVal.strVal)
-		case ValueTypeInt:
-			definition += fmt.Sprintf("DEFAULT %d ", column.defaultVal.intVal)
-		case ValueTypeFloat:
-			definition += fmt.Sprintf("DEFAULT %f ", column.defaultVal.floatVal)
-		case ValueTypeBit:
-			if column.defaultVal.bitVal {
-				definition += "DEFAULT b'1' "
-			} else {
-				definition += "DEFAULT b'0' "
-			}
-		default:
-			return "", fmt.Errorf("unsupported default value type (valueType: '%d') in column: %#v", column.defaultVal.valueType, column)
+		defaultVal, err := g.generateValue(*column.defaultVal)
+		if err != nil {
+			return "", fmt.Errorf("%s in column: %#v", err, column)
+		}
+		definition += fmt.Sprintf("DEFAULT %s ", defaultVal)
+	}
+	if column.onUpdate != nil {
+		onUpdate, err := g.generateValue(*column.onUpdate)
+		if err != nil {
+			return "", fmt.Errorf("%s in column: %#v", err, column)
 		}
+		definition += fmt.Sprintf("ON UPDATE %s ", onUpdate)
+	}
+
+	if column.defaultSeq != "" {
+		definition += fmt.Sprintf("DEFAULT nextval(%s::regclass) ", g.quoteString(g.escapeTableName(column.defaultSeq)))
 	}
 
 	if column.autoIncrement {
 		definition += "AUTO_INCREMENT "
 	}
 
+	if column.invisible {
+		definition += "INVISIBLE "
+	}
+
 	switch column.keyOption {
 	case ColumnKeyNone:
 		// noop
@@ -351,58 +1610,460 @@ func (g *Generator) generateColumnDefinition(column Column) (string, error) {
 		return "", fmt.Errorf("unsupported column key (keyOption: '%d') in column: %#v", column.keyOption, column)
 	}
 
+	// PostgreSQL doesn't have an inline comment. It's generated by `generateCommentOn`.
+	if g.mode == GeneratorModeMysql && column.comment != nil {
+		definition += fmt.Sprintf("COMMENT %s ", g.quoteString(*column.comment))
+	}
+
 	definition = strings.TrimSuffix(definition, " ")
 	return definition, nil
 }
 
+// Generate a value of DEFAULT or ON UPDATE. MySQL needs parentheses for a function except CURRENT_TIMESTAMP.
+func (g *Generator) generateValue(value Value) (string, error) {
+	switch value.valueType {
+	case ValueTypeStr:
+		return g.quoteString(value.strVal), nil
+	case ValueTypeInt:
+		return fmt.Sprintf("%d", value.intVal), nil
+	case ValueTypeFloat:
+		return fmt.Sprintf("%f", value.floatVal), nil
+	case ValueTypeBit:
+		if value.bitVal {
+			return "b'1'", nil
+		}
+		return "b'0'", nil
+	case ValueTypeValArg:
+		return strings.ToUpper(string(value.raw)), nil
+	case ValueTypeExpr:
+		expr := string(value.raw)
+		if strings.HasPrefix(value.exprVal, "current_timestamp") {
+			expr = strings.ToUpper(value.exprVal) // with the precision like CURRENT_TIMESTAMP(6)
+		} else if g.mode == GeneratorModeMysql && !strings.HasPrefix(expr, "(") {
+			expr = fmt.Sprintf("(%s)", expr)
+		}
+		return expr, nil
+	default:
+		return "", fmt.Errorf("unsupported default value type (valueType: '%d')", value.valueType)
+	}
+}
+
+// Return `AFTER <previous column>`, or `FIRST` if there's no previous one.
+func (g *Generator) generateColumnPosition(previous string) string {
+	if previous == "" {
+		return "FIRST"
+	}
+	return fmt.Sprintf("AFTER %s", g.escapeSQLName(previous))
+}
+
+// Return the most columns whose current order is the same as the desired one, which is the longest increasing
+// subsequence of their current positions. Moving only the other ones after their preceding columns makes the order.
+func findOrderedColumns(currentColumns []Column, desiredColumns []Column) []string {
+	names := []string{}
+	positions := []int{}
+	for _, desiredColumn := range desiredColumns {
+		for i, currentColumn := range currentColumns {
+			if currentColumn.name == desiredColumn.name && desiredColumn.rowPeriod == "" {
+				names = append(names, desiredColumn.name)
+				positions = append(positions, i)
+			}
+		}
+	}
+
+	lengths := make([]int, len(positions))  // The length of the longest subsequence ending at each column
+	previous := make([]int, len(positions)) // The preceding column in the subsequence, or -1
+	last := -1
+	for i := range positions {
+		lengths[i], previous[i] = 1, -1
+		for j := 0; j < i; j++ {
+			if positions[j] < positions[i] && lengths[j]+1 > lengths[i] {
+				lengths[i], previous[i] = lengths[j]+1, j
+			}
+		}
+		if last == -1 || lengths[i] > lengths[last] {
+			last = i
+		}
+	}
+
+	ordered := []string{}
+	for i := last; i != -1; i = previous[i] {
+		ordered = append([]string{names[i]}, ordered...)
+	}
+	return ordered
+}
+
 // For CREATE TABLE.
 func (g *Generator) generateIndexDefinition(index Index) (string, error) {
 	definition := index.indexType // indexType is only available on `CREATE TABLE`, but only `generateDDLsForCreateTable` is using this
 
 	columns := []string{}
-	for _, indexColumn := range index.columns {
-		columns = append(columns, indexColumn.column)
+	for _, column := range index.columns {
+		columnDefinition := column.column
+		if !strings.HasPrefix(columnDefinition, "(") { // An expression is given in parentheses
+			columnDefinition = g.escapeSQLName(columnDefinition)
+		} else {
+			columnDefinition = g.escapeExpr(columnDefinition)
+		}
+		if column.length != nil {
+			columnDefinition += fmt.Sprintf("(%s)", string(column.length.raw))
+		}
+		if column.direction == "desc" {
+			columnDefinition += " DESC"
+		}
+		if column.nulls != "" {
+			columnDefinition += " " + strings.ToUpper(column.nulls)
+		}
+		columns = append(columns, columnDefinition)
+	}
+	if index.constraint {
+		definition = fmt.Sprintf("CONSTRAINT %s UNIQUE (%s)", g.escapeSQLName(index.name), strings.Join(columns, ", "))
+		if index.deferrable != "" {
+			definition += " " + strings.ToUpper(index.deferrable)
+		}
+		return definition, nil
 	}
 
 	definition += fmt.Sprintf(
 		" %s(%s)",
-		index.name,
-		strings.Join(columns, ", "), // TODO: escape
+		g.escapeSQLName(index.name),
+		strings.Join(columns, ", "),
 	)
+	if index.parser != "" {
+		definition += fmt.Sprintf(" WITH PARSER %s", index.parser)
+	}
+	if index.invisible {
+		definition += " INVISIBLE"
+	}
 	return definition, nil
 }
 
-func (g *Generator) generateDropIndex(tableName string, indexName string) string {
-	if g.mode == GeneratorModePostgres {
-		return fmt.Sprintf("DROP INDEX %s", indexName) // TODO: escape
-	} else {
-		return fmt.Sprintf("ALTER TABLE %s DROP INDEX %s", tableName, indexName) // TODO: escape
+func (g *Generator) generateForeignKeyDefinition(foreignKey ForeignKey) string {
+	definition := fmt.Sprintf("CONSTRAINT %s FOREIGN KEY ", g.escapeSQLName(foreignKey.constraintName))
+	if g.mode == GeneratorModeMysql && foreignKey.indexName != "" {
+		definition += fmt.Sprintf("%s ", g.escapeSQLName(foreignKey.indexName))
+	}
+	definition += fmt.Sprintf(
+		"(%s) REFERENCES %s (%s)",
+		strings.Join(g.escapeSQLNames(foreignKey.indexColumns), ", "), g.escapeTableName(foreignKey.referenceName),
+		strings.Join(g.escapeSQLNames(foreignKey.referenceColumns), ", "),
+	)
+
+	if foreignKey.onDelete != "" {
+		definition += fmt.Sprintf(" ON DELETE %s", foreignKey.onDelete)
+	}
+	if foreignKey.onUpdate != "" {
+		definition += fmt.Sprintf(" ON UPDATE %s", foreignKey.onUpdate)
+	}
+	if foreignKey.deferrable != "" {
+		definition += " " + strings.ToUpper(foreignKey.deferrable)
 	}
+	return definition
 }
 
-func isPrimaryKey(column Column, table Table) bool {
-	if column.keyOption == ColumnKeyPrimary {
-		return true
+// PostgreSQL can change the deferrability of a foreign key by `ALTER CONSTRAINT`. Return false if anything else is changed.
+func (g *Generator) generateAlterForeignKeyDeferrability(tableName string, currentForeignKey ForeignKey, desiredForeignKey ForeignKey) (string, bool) {
+	currentForeignKey.deferrable = desiredForeignKey.deferrable
+	if g.mode != GeneratorModePostgres || !areSameForeignKeys(currentForeignKey, desiredForeignKey) {
+		return "", false
 	}
 
-	for _, index := range table.indexes {
-		if index.primary && index.columns[0].column == column.name {
-			return true
-		}
+	// DEFERRABLE without INITIALLY DEFERRED makes it INITIALLY IMMEDIATE.
+	deferrability := strings.ToUpper(desiredForeignKey.deferrable)
+	if deferrability == "" {
+		deferrability = "NOT DEFERRABLE"
 	}
-	return false
+	return fmt.Sprintf("ALTER TABLE %s ALTER CONSTRAINT %s %s", g.escapeTableName(tableName), g.escapeSQLName(desiredForeignKey.constraintName), deferrability), true
 }
 
-// Destructively modify table1 to have table2 columns/indexes
-func mergeTable(table1 *Table, table2 Table) {
-	for _, column := range table2.columns {
-		if containsString(convertColumnsToColumnNames(table1.columns), column.name) {
+// MariaDB adds explicit row start and row end columns with their period in the same statement as `ADD SYSTEM VERSIONING`.
+// `DROP SYSTEM VERSIONING` drops them together.
+func (g *Generator) generateAlterSystemVersioning(desiredTable Table) (string, error) {
+	if !desiredTable.systemVersioning {
+		return fmt.Sprintf("ALTER TABLE %s DROP SYSTEM VERSIONING", g.escapeTableName(desiredTable.name)), nil
+	}
+
+	specs := []string{}
+	var rowStart, rowEnd string
+	for _, column := range desiredTable.columns {
+		if column.rowPeriod == "" {
+			continue
+		}
+		definition, err := g.generateColumnDefinition(column)
+		if err != nil {
+			return "", err
+		}
+		specs = append(specs, fmt.Sprintf("ADD COLUMN %s", definition))
+		if column.rowPeriod == "row start" {
+			rowStart = column.name
+		} else {
+			rowEnd = column.name
+		}
+	}
+	if rowStart != "" && rowEnd != "" {
+		specs = append(specs, fmt.Sprintf("ADD PERIOD FOR SYSTEM_TIME(%s, %s)", g.escapeSQLName(rowStart), g.escapeSQLName(rowEnd)))
+	}
+	specs = append(specs, "ADD SYSTEM VERSIONING")
+	return fmt.Sprintf("ALTER TABLE %s %s", g.escapeTableName(desiredTable.name), strings.Join(specs, ", ")), nil
+}
+
+// MySQL can change the visibility of an index by `ALTER INDEX`. Return false if anything else is changed.
+func (g *Generator) generateAlterIndexVisibility(tableName string, currentIndex Index, desiredIndex Index) (string, bool) {
+	currentIndex.invisible = desiredIndex.invisible
+	if g.mode != GeneratorModeMysql || !areSameIndexes(currentIndex, desiredIndex) {
+		return "", false
+	}
+
+	visibility := "VISIBLE"
+	if desiredIndex.invisible {
+		visibility = "INVISIBLE"
+	}
+	return fmt.Sprintf("ALTER TABLE %s ALTER INDEX %s %s", g.escapeTableName(tableName), g.escapeSQLName(desiredIndex.name), visibility), true
+}
+
+// PostgreSQL can move an index to another tablespace by `ALTER INDEX`. Return false if anything else is changed.
+func (g *Generator) generateAlterIndexTablespace(tableName string, currentIndex Index, desiredIndex Index) (string, bool) {
+	currentIndex.tablespace = desiredIndex.tablespace
+	if g.mode != GeneratorModePostgres || !areSameIndexes(currentIndex, desiredIndex) {
+		return "", false
+	}
+	return fmt.Sprintf("ALTER INDEX %s SET TABLESPACE %s", g.escapeTableName(qualifyIndexName(tableName, desiredIndex.name)), generateTablespace(desiredIndex.tablespace)), true
+}
+
+// No TABLESPACE means the default one, which is given explicitly to move a table or an index back to it.
+func generateTablespace(tablespace string) string {
+	if tablespace == "" {
+		return "pg_default"
+	}
+	return tablespace
+}
+
+func (g *Generator) generateCheckDefinition(check Check) string {
+	return fmt.Sprintf("CONSTRAINT %s CHECK (%s)", g.escapeSQLName(check.constraintName), g.escapeExpr(check.definition))
+}
+
+func (g *Generator) generateExclusionDefinition(exclusion Exclusion) string {
+	return fmt.Sprintf("CONSTRAINT %s EXCLUDE %s", g.escapeSQLName(exclusion.constraintName), g.escapeExpr(exclusion.definition))
+}
+
+func (g *Generator) generateDropCheck(tableName string, constraintName string) string {
+	if g.mode == GeneratorModePostgres {
+		return fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(tableName), g.escapeSQLName(constraintName))
+	} else {
+		return fmt.Sprintf("ALTER TABLE %s DROP CHECK %s", g.escapeTableName(tableName), g.escapeSQLName(constraintName))
+	}
+}
+
+func (g *Generator) generateCommentOn(tableName string, columnName string, comment *string) string {
+	target := fmt.Sprintf("TABLE %s", g.escapeTableName(tableName))
+	if columnName != "" {
+		target = fmt.Sprintf("COLUMN %s.%s", g.escapeTableName(tableName), g.escapeSQLName(columnName))
+	}
+
+	if comment == nil {
+		return fmt.Sprintf("COMMENT ON %s IS NULL", target)
+	} else {
+		return fmt.Sprintf("COMMENT ON %s IS %s", target, g.quoteString(*comment))
+	}
+}
+
+// Quote a string literal. PostgreSQL doesn't interpret backslashes by default (standard_conforming_strings).
+func (g *Generator) quoteString(str string) string {
+	if g.mode == GeneratorModePostgres {
+		return "'" + strings.Replace(str, "'", "''", -1) + "'"
+	} else {
+		return "'" + mysqlStringEscaper.Replace(str) + "'"
+	}
+}
+
+func (g *Generator) generateDropForeignKey(tableName string, constraintName string) string {
+	if g.mode == GeneratorModePostgres {
+		return fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(tableName), g.escapeSQLName(constraintName))
+	} else {
+		return fmt.Sprintf("ALTER TABLE %s DROP FOREIGN KEY %s", g.escapeTableName(tableName), g.escapeSQLName(constraintName))
+	}
+}
+
+// DROP FUNCTION needs argument types to identify the function, but not their defaults. MySQL's routine is
+// identified by its name.
+func (g *Generator) generateDropFunction(function Function) string {
+	if g.mode == GeneratorModeMysql {
+		if function.procedure {
+			return fmt.Sprintf("DROP PROCEDURE %s", g.escapeTableName(function.name))
+		}
+		return fmt.Sprintf("DROP FUNCTION %s", g.escapeTableName(function.name))
+	}
+
+	arguments := []string{}
+	for _, argument := range function.arguments {
+		arguments = append(arguments, strings.SplitN(argument, " default ", 2)[0])
+	}
+	return fmt.Sprintf("DROP FUNCTION %s(%s)", g.escapeTableName(function.name), strings.Join(arguments, ", "))
+}
+
+func (g *Generator) generateDropTrigger(trigger Trigger) string {
+	if g.mode == GeneratorModePostgres {
+		return fmt.Sprintf("DROP TRIGGER %s ON %s", g.escapeSQLName(trigger.name), g.escapeTableName(trigger.tableName))
+	}
+	return fmt.Sprintf("DROP TRIGGER %s", g.escapeSQLName(trigger.name))
+}
+
+func (g *Generator) generateDropView(view View) string {
+	if view.materialized {
+		return fmt.Sprintf("DROP MATERIALIZED VIEW %s", g.escapeTableName(view.name))
+	}
+	return fmt.Sprintf("DROP VIEW %s", g.escapeTableName(view.name))
+}
+
+func (g *Generator) generateDropIndex(tableName string, index Index) string {
+	if g.mode == GeneratorModePostgres {
+		if index.constraint {
+			return fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(tableName), g.escapeSQLName(index.name))
+		}
+		return fmt.Sprintf("DROP INDEX %s", g.escapeTableName(qualifyIndexName(tableName, index.name)))
+	} else {
+		return fmt.Sprintf("ALTER TABLE %s DROP INDEX %s", g.escapeTableName(tableName), g.escapeSQLName(index.name))
+	}
+}
+
+func (g *Generator) generateRenameIndex(tableName string, index Index, newName string) string {
+	if g.mode == GeneratorModePostgres {
+		if index.constraint {
+			return fmt.Sprintf("ALTER TABLE %s RENAME CONSTRAINT %s TO %s", g.escapeTableName(tableName), g.escapeSQLName(index.name), g.escapeSQLName(newName))
+		}
+		return fmt.Sprintf("ALTER INDEX %s RENAME TO %s", g.escapeTableName(qualifyIndexName(tableName, index.name)), g.escapeSQLName(newName))
+	} else {
+		return fmt.Sprintf("ALTER TABLE %s RENAME INDEX %s TO %s", g.escapeTableName(tableName), g.escapeSQLName(index.name), g.escapeSQLName(newName))
+	}
+}
+
+// PostgreSQL's index belongs to the schema of its table.
+func qualifyIndexName(tableName string, indexName string) string {
+	if i := strings.LastIndex(tableName, "."); i >= 0 {
+		return tableName[:i+1] + indexName
+	}
+	return indexName
+}
+
+// Find an index which is the same as the desired one except its name, and which is not desired anymore.
+func (g *Generator) findIndexToRename(currentTable Table, desiredIndex Index) *Index {
+	for _, index := range currentTable.indexes {
+		if index.primary || index.constraint != desiredIndex.constraint || containsString(g.desiredIndexNames[currentTable.name], index.name) {
+			continue
+		}
+		if areSameIndexes(index, desiredIndex) {
+			return &index
+		}
+	}
+	return nil
+}
+
+// Simulate `ALTER TABLE ... RENAME TO` on `g.currentTables`. Foreign keys referring to the table follow it.
+func (g *Generator) renameTable(oldName string, newName string) {
+	for _, table := range g.currentTables {
+		if table.name == oldName {
+			table.name = newName
+		}
+		for i, foreignKey := range table.foreignKeys {
+			if foreignKey.referenceName == oldName {
+				table.foreignKeys[i].referenceName = newName
+			}
+		}
+	}
+}
+
+// Simulate `RENAME COLUMN` on the table. Indexes and foreign keys follow the column.
+func renameColumn(table *Table, oldName string, newName string) {
+	columns := []Column{}
+	for _, column := range table.columns {
+		if column.name == oldName {
+			column.name = newName
+		}
+		columns = append(columns, column)
+	}
+	table.columns = columns
+
+	indexes := []Index{}
+	for _, index := range table.indexes {
+		indexColumns := []IndexColumn{}
+		for _, indexColumn := range index.columns {
+			if indexColumn.column == oldName {
+				indexColumn.column = newName
+			}
+			indexColumns = append(indexColumns, indexColumn)
+		}
+		index.columns = indexColumns
+		indexes = append(indexes, index)
+	}
+	table.indexes = indexes
+
+	foreignKeys := []ForeignKey{}
+	for _, foreignKey := range table.foreignKeys {
+		indexColumns := []string{}
+		for _, column := range foreignKey.indexColumns {
+			if column == oldName {
+				column = newName
+			}
+			indexColumns = append(indexColumns, column)
+		}
+		foreignKey.indexColumns = indexColumns
+		foreignKeys = append(foreignKeys, foreignKey)
+	}
+	table.foreignKeys = foreignKeys
+}
+
+// Simulate `generateRenameIndex` on the table.
+func renameIndex(table *Table, oldName string, newName string) {
+	newIndexes := []Index{}
+	for _, index := range table.indexes {
+		if index.name == oldName {
+			index.name = newName
+		}
+		newIndexes = append(newIndexes, index)
+	}
+	table.indexes = newIndexes
+}
+
+func (g *Generator) generateDropPrimaryKey(table Table) string {
+	if g.mode == GeneratorModePostgres {
+		constraintName := fmt.Sprintf("%s_pkey", unqualifiedName(table.name))
+		for _, index := range table.indexes {
+			if index.primary {
+				constraintName = index.name
+			}
+		}
+		return fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(table.name), g.escapeSQLName(constraintName))
+	} else {
+		return fmt.Sprintf("ALTER TABLE %s DROP PRIMARY KEY", g.escapeTableName(table.name))
+	}
+}
+
+// Return columns of a primary key, which is either `PRIMARY KEY (...)` or a column's `PRIMARY KEY`.
+func getPrimaryKeyColumns(table Table) []string {
+	for _, index := range table.indexes {
+		if index.primary {
+			return convertIndexColumnsToColumnNames(index.columns)
+		}
+	}
+
+	columnNames := []string{}
+	for _, column := range table.columns {
+		if column.keyOption == ColumnKeyPrimary {
+			columnNames = append(columnNames, column.name)
+		}
+	}
+	return columnNames
+}
+
+// Destructively modify table1 to have table2 columns/indexes
+func mergeTable(table1 *Table, table2 Table) {
+	for _, column := range table2.columns {
+		if !containsString(convertColumnsToColumnNames(table1.columns), column.name) {
 			table1.columns = append(table1.columns, column)
 		}
 	}
 
 	for _, index := range table2.indexes {
-		if containsString(convertIndexesToIndexNames(table1.indexes), index.name) {
+		if !containsString(convertIndexesToIndexNames(table1.indexes), index.name) {
 			table1.indexes = append(table1.indexes, index)
 		}
 	}
@@ -417,25 +2078,106 @@ func convertDDLsToTables(ddls []DDL) ([]*Table, error) {
 			tables = append(tables, &table)
 		case *CreateIndex:
 			table := findTableByName(tables, stmt.tableName)
-			if table == nil {
+			if table == nil && findViewByName(convertDDLsToViews(ddls), stmt.tableName) != nil {
+				continue // Indexes of materialized views are converted by `convertDDLsToViews`.
+			} else if table == nil {
 				return nil, fmt.Errorf("CREATE INDEX is performed before CREATE TABLE: %s", ddl.Statement())
 			}
 			// TODO: check duplicated creation
 			table.indexes = append(table.indexes, stmt.index)
+		case *AddIndex:
+			table := findTableByName(tables, stmt.tableName)
+			if table == nil {
+				return nil, fmt.Errorf("ADD INDEX is performed before CREATE TABLE: %s", ddl.Statement())
+			}
+			table.indexes = append(table.indexes, stmt.index)
+		case *AddForeignKey:
+			table := findTableByName(tables, stmt.tableName)
+			if table == nil {
+				return nil, fmt.Errorf("ADD FOREIGN KEY is performed before CREATE TABLE: %s", ddl.Statement())
+			}
+			table.foreignKeys = append(table.foreignKeys, stmt.foreignKey)
+		case *AddExclusion:
+			table := findTableByName(tables, stmt.tableName)
+			if table == nil {
+				return nil, fmt.Errorf("ADD EXCLUDE is performed before CREATE TABLE: %s", ddl.Statement())
+			}
+			table.exclusions = append(table.exclusions, stmt.exclusion)
+		case *AddIdentity:
+			table := findTableByName(tables, stmt.tableName)
+			if table == nil {
+				return nil, fmt.Errorf("ADD GENERATED is performed before CREATE TABLE: %s", ddl.Statement())
+			}
+			table.columns = append([]Column{}, table.columns...) // copy columns shared with the DDL
+			if err := modifyColumn(table, stmt.columnName, func(column *Column) {
+				identity := stmt.identity // copy identity
+				column.identity = &identity
+			}); err != nil {
+				return nil, fmt.Errorf("ADD GENERATED is performed for inexistent column '%s': %s", stmt.columnName, ddl.Statement())
+			}
+		case *SetDefaultSequence:
+			table := findTableByName(tables, stmt.tableName)
+			if table == nil {
+				return nil, fmt.Errorf("SET DEFAULT is performed before CREATE TABLE: %s", ddl.Statement())
+			}
+			table.columns = append([]Column{}, table.columns...) // copy columns shared with the DDL
+			if err := modifyColumn(table, stmt.columnName, func(column *Column) {
+				column.defaultSeq = stmt.sequenceName
+				normalizeSerialColumn(table.name, column)
+			}); err != nil {
+				return nil, fmt.Errorf("SET DEFAULT is performed for inexistent column '%s': %s", stmt.columnName, ddl.Statement())
+			}
+		case *CommentOn:
+			table := findTableByName(tables, stmt.tableName)
+			if table == nil {
+				return nil, fmt.Errorf("COMMENT ON is performed before CREATE TABLE: %s", ddl.Statement())
+			}
+			if err := setComment(table, stmt.columnName, stmt.comment); err != nil {
+				return nil, fmt.Errorf("COMMENT ON is performed for inexistent column '%s': %s", stmt.columnName, ddl.Statement())
+			}
+		case *CreateView:
+			// Views are converted by `convertDDLsToViews`.
+		case *CreateTrigger:
+			// Triggers are converted by `convertDDLsToTriggers`.
+		case *CreateFunction:
+			// Functions are converted by `convertDDLsToFunctions`.
+		case *CreateSequence, *AlterSequence:
+			// Sequences are converted by `convertDDLsToSequences`.
+		case *CreateType:
+			// Enum types are converted by `convertDDLsToEnums`.
+		case *CreateDomain:
+			// Domains are converted by `convertDDLsToDomains`.
+		case *CreateSchema:
+			// Schemas are converted by `convertDDLsToSchemas`.
+		case *GrantPrivilege, *RevokePrivilege:
+			// Privileges are converted by `convertDDLsToPrivileges`.
+		case *CreatePolicy:
+			// Policies are converted by `convertDDLsToPolicies`.
+		case *CreateServer, *CreateUserMapping, *CreateForeignTable:
+			// Foreign data is converted by `convertDDLsToServers`, `convertDDLsToUserMappings` and `convertDDLsToForeignTables`.
+		case *SetRowLevelSecurity:
+			table := findTableByName(tables, stmt.tableName)
+			if table == nil {
+				return nil, fmt.Errorf("ROW LEVEL SECURITY is performed before CREATE TABLE: %s", ddl.Statement())
+			}
+			applyRowLevelSecurity(table, stmt.action)
+		case *CreateExtension:
+			// Extensions are converted by `convertDDLsToExtensions`.
+		case *AttachPartition:
+			table := findTableByName(tables, stmt.partitionName)
+			if table == nil {
+				return nil, fmt.Errorf("ATTACH PARTITION is performed before CREATE TABLE: %s", ddl.Statement())
+			}
+			table.partitionOf = stmt.tableName
+			table.bound = stmt.bound
 		case *AddPrimaryKey:
 			table := findTableByName(tables, stmt.tableName)
 			if table == nil {
 				return nil, fmt.Errorf("ADD PRIMARY KEY is performed before CREATE TABLE: %s", ddl.Statement())
 			}
 
-			newColumns := []Column{}
-			for _, column := range table.columns {
-				if column.name == stmt.index.columns[0].column { // TODO: multi-column primary key?
-					column.keyOption = ColumnKeyPrimary
-				}
-				newColumns = append(newColumns, column)
-			}
-			table.columns = newColumns
+			// Keep the constraint name to drop it.
+			table.indexes = append(table.indexes, stmt.index)
 		default:
 			return nil, fmt.Errorf("unexpected ddl type in convertDDLsToTables: %v", stmt)
 		}
@@ -443,6 +2185,98 @@ func convertDDLsToTables(ddls []DDL) ([]*Table, error) {
 	return tables, nil
 }
 
+func convertDDLsToIndexNames(ddls []DDL) map[string][]string {
+	indexNames := map[string][]string{}
+	for _, ddl := range ddls {
+		switch stmt := ddl.(type) {
+		case *CreateTable:
+			indexNames[stmt.table.name] = append(indexNames[stmt.table.name], convertIndexesToIndexNames(stmt.table.indexes)...)
+		case *CreateIndex:
+			indexNames[stmt.tableName] = append(indexNames[stmt.tableName], stmt.index.name)
+		case *AddIndex:
+			indexNames[stmt.tableName] = append(indexNames[stmt.tableName], stmt.index.name)
+		case *AddPrimaryKey:
+			indexNames[stmt.tableName] = append(indexNames[stmt.tableName], stmt.index.name)
+		}
+	}
+	return indexNames
+}
+
+func convertDDLsToPrimaryKeys(ddls []DDL) map[string]Index {
+	primaryKeys := map[string]Index{}
+	for _, ddl := range ddls {
+		if addPrimaryKey, ok := ddl.(*AddPrimaryKey); ok {
+			primaryKeys[addPrimaryKey.tableName] = addPrimaryKey.index
+		}
+	}
+	return primaryKeys
+}
+
+func convertDDLsToViews(ddls []DDL) []*View {
+	views := []*View{}
+	for _, ddl := range ddls {
+		switch stmt := ddl.(type) {
+		case *CreateView:
+			view := stmt.view // copy view
+			views = append(views, &view)
+		case *CreateIndex:
+			if view := findViewByName(views, stmt.tableName); view != nil {
+				view.indexes = append(view.indexes, stmt.index)
+			}
+		}
+	}
+	return views
+}
+
+func convertDDLsToFunctions(ddls []DDL) []*Function {
+	functions := []*Function{}
+	for _, ddl := range ddls {
+		if stmt, ok := ddl.(*CreateFunction); ok {
+			function := stmt.function // copy function
+			functions = append(functions, &function)
+		}
+	}
+	return functions
+}
+
+func findFunction(functions []*Function, target Function) *Function {
+	for _, function := range functions {
+		if function.name == target.name && function.procedure == target.procedure {
+			return function
+		}
+	}
+	return nil
+}
+
+func convertDDLsToTriggers(ddls []DDL) []*Trigger {
+	triggers := []*Trigger{}
+	for _, ddl := range ddls {
+		if stmt, ok := ddl.(*CreateTrigger); ok {
+			trigger := stmt.trigger // copy trigger
+			triggers = append(triggers, &trigger)
+		}
+	}
+	return triggers
+}
+
+func findTriggerByName(triggers []*Trigger, name string) *Trigger {
+	for _, trigger := range triggers {
+		if trigger.name == name {
+			return trigger
+		}
+	}
+	return nil
+}
+
+func findViewByName(views []*View, name string) *View {
+	for _, view := range views {
+		if view.name == name {
+			return view
+		}
+	}
+	return nil
+}
+
 func findTableByName(tables []*Table, name string) *Table {
 	for _, table := range tables {
 		if table.name == name {
@@ -470,11 +2304,149 @@ func findIndexByName(indexes []Index, name string) *Index {
 	return nil
 }
 
-func haveSameDataType(current Column, desired Column) bool {
-	return (normalizeDataType(current.typeName) == normalizeDataType(desired.typeName)) &&
-		(current.unsigned == desired.unsigned) &&
-		(current.notNull == (desired.notNull || desired.keyOption == ColumnKeyPrimary)) && // `PRIMARY KEY` implies `NOT NULL`
-		(current.autoIncrement == desired.autoIncrement)
+func findForeignKeyByName(foreignKeys []ForeignKey, constraintName string) *ForeignKey {
+	for _, foreignKey := range foreignKeys {
+		if foreignKey.constraintName == constraintName {
+			return &foreignKey
+		}
+	}
+	return nil
+}
+
+func findExclusionByName(exclusions []Exclusion, constraintName string) *Exclusion {
+	for _, exclusion := range exclusions {
+		if exclusion.constraintName == constraintName {
+			return &exclusion
+		}
+	}
+	return nil
+}
+
+func findCheckByName(checks []Check, constraintName string) *Check {
+	for _, check := range checks {
+		if check.constraintName == constraintName {
+			return &check
+		}
+	}
+	return nil
+}
+
+// Return a comment of the table when `columnName` is empty, or one of the column.
+func findComment(table Table, columnName string) (*string, error) {
+	if columnName == "" {
+		return table.comment, nil
+	}
+	column := findColumnByName(table.columns, columnName)
+	if column == nil {
+		return nil, fmt.Errorf("column '%s' is not found in table '%s'", columnName, table.name)
+	}
+	return column.comment, nil
+}
+
+// Destructively modify a comment of the table when `columnName` is empty, or one of the column.
+func setComment(table *Table, columnName string, comment *string) error {
+	if columnName == "" {
+		table.comment = comment
+		return nil
+	}
+	for i, column := range table.columns {
+		if column.name == columnName {
+			table.columns[i].comment = comment
+			return nil
+		}
+	}
+	return fmt.Errorf("column '%s' is not found in table '%s'", columnName, table.name)
+}
+
+// Destructively modify the column of the table.
+func modifyColumn(table *Table, columnName string, modify func(column *Column)) error {
+	for i, column := range table.columns {
+		if column.name == columnName {
+			modify(&table.columns[i])
+			return nil
+		}
+	}
+	return fmt.Errorf("column '%s' is not found in table '%s'", columnName, table.name)
+}
+
+// Compare DEFAULT or ON UPDATE of a column whose type is typeName. Literals are compared by their values, since
+// databases show them in various forms like `'0'` of MySQL's integer column or `'1.50'` of a decimal column.
+func (g *Generator) areSameDefaults(current *Value, desired *Value, typeName string) bool {
+	current, desired = omitNullDefault(current), omitNullDefault(desired)
+	currentLiteral, currentOk := normalizeDefaultLiteral(g.mode, current, typeName)
+	desiredLiteral, desiredOk := normalizeDefaultLiteral(g.mode, desired, typeName)
+	if currentOk || desiredOk {
+		return currentOk && desiredOk && currentLiteral == desiredLiteral
+	}
+	if current == nil || desired == nil {
+		return current == desired
+	}
+	return current.valueType == desired.valueType && current.exprVal == desired.exprVal
+}
+
+// DEFAULT NULL is the same as no default, which MySQL shows for a nullable column.
+func omitNullDefault(value *Value) *Value {
+	if value != nil && value.valueType == ValueTypeValArg && strings.EqualFold(string(value.raw), "null") {
+		return nil
+	}
+	return value
+}
+
+// Return a literal default in a canonical form for its type, or false if it's a function call or an expression.
+func normalizeDefaultLiteral(mode GeneratorMode, value *Value, typeName string) (string, bool) {
+	if value == nil {
+		return "", false
+	}
+	typeName = normalizeDataType(mode, strings.ToLower(typeName))
+
+	var literal string
+	switch value.valueType {
+	case ValueTypeStr:
+		literal = value.strVal
+	case ValueTypeInt, ValueTypeFloat:
+		literal = string(value.raw)
+	case ValueTypeBit:
+		literal = "0"
+		if value.bitVal {
+			literal = "1"
+		}
+	case ValueTypeExpr:
+		if value.exprVal != "true" && value.exprVal != "false" { // PostgreSQL's boolean is parsed as an expression
+			return "", false
+		}
+		literal = value.exprVal
+	default:
+		return "", false
+	}
+
+	switch {
+	case containsString(numericDataTypes, typeName) || value.valueType == ValueTypeInt || value.valueType == ValueTypeFloat:
+		if number, ok := new(big.Rat).SetString(literal); ok {
+			return number.RatString(), true
+		}
+	case typeName == "boolean":
+		switch strings.ToLower(literal) {
+		case "t", "true", "y", "yes", "on", "1":
+			return "true", true
+		case "f", "false", "n", "no", "off", "0":
+			return "false", true
+		}
+	case typeName == "datetime" || typeName == "timestamp":
+		if datePattern.MatchString(literal) {
+			return literal + " 00:00:00", true
+		}
+	}
+	return literal, true
+}
+
+func (g *Generator) haveSameDataType(current Column, desired Column) bool {
+	return (normalizeDataType(g.mode, current.typeName) == normalizeDataType(g.mode, desired.typeName)) &&
+		(current.unsigned == (desired.unsigned || desired.zerofill)) && (current.zerofill == desired.zerofill) && // ZEROFILL implies UNSIGNED
+		(current.array == desired.array) && (current.timezone == desired.timezone) &&
+		(current.geometryType == desired.geometryType) && (getSrid(current) == getSrid(desired)) &&
+		(current.notNull == (desired.notNull || desired.keyOption == ColumnKeyPrimary || isSerialType(desired.typeName))) && // `PRIMARY KEY` and serial types imply `NOT NULL`
+		(current.autoIncrement == desired.autoIncrement) &&
+		areSameStrings(current.enumValues, desired.enumValues)
 
 	// TODO: check defaultVal, length, scale
 
@@ -482,8 +2454,130 @@ func haveSameDataType(current Column, desired Column) bool {
 	//	(current.keyOption == desired.keyOption)
 }
 
-func normalizeDataType(dataType string) string {
-	alias, ok := dataTypeAliases[dataType]
+// Display widths of integer types don't change the range of values, and MySQL 8.0.19 omits them in SHOW CREATE TABLE,
+// while MySQL 5.7 fills them like `int(11)`. So they're compared only with StrictDisplayWidth when both are given.
+// Other omitted lengths are their defaults like `datetime(0)` of MySQL and `timestamp(6)` of PostgreSQL.
+// An omitted scale is 0, as `decimal(10)` is the same as `decimal(10,0)`.
+func (g *Generator) haveSameLengthAndScale(current Column, desired Column) bool {
+	typeName := normalizeDataType(g.mode, desired.typeName)
+	if isIntegerType(typeName) {
+		return current.length == nil || desired.length == nil || !g.config.StrictDisplayWidth ||
+			current.length.intVal == desired.length.intVal
+	}
+	return g.getLength(typeName, current) == g.getLength(typeName, desired) && getScale(current) == getScale(desired)
+}
+
+// Return the length of the column, or the default of the type if it's omitted. It's -1 if the type has no default.
+func (g *Generator) getLength(typeName string, column Column) int {
+	if column.length != nil {
+		return column.length.intVal
+	}
+	switch g.mode {
+	case GeneratorModeMysql:
+		switch typeName {
+		case "datetime", "timestamp", "time":
+			return 0
+		case "char", "binary", "bit":
+			return 1
+		case "year":
+			return 4
+		case "decimal":
+			return 10
+		}
+	case GeneratorModePostgres:
+		switch typeName {
+		case "timestamp", "time", "interval":
+			return 6
+		case "character", "bit":
+			return 1
+		}
+	}
+	return -1
+}
+
+func getScale(column Column) int {
+	if column.scale == nil {
+		return 0
+	}
+	return column.scale.intVal
+}
+
+func isIntegerType(typeName string) bool {
+	switch typeName {
+	case "tinyint", "smallint", "mediumint", "int", "integer", "bigint":
+		return true
+	default:
+		return false
+	}
+}
+
+// PostGIS's geometry type without SRID has SRID 0, while MySQL's spatial column without SRID accepts any SRID.
+func getSrid(column Column) string {
+	if column.srid == nil {
+		if column.geometryType != "" {
+			return "0"
+		}
+		return ""
+	}
+	return string(column.srid.raw)
+}
+
+// Compare charset and collation of character columns. Ones omitted in a column fall back to the table's default,
+// and ones unknown on either side are not compared.
+func haveSameCharsetAndCollation(currentTable Table, current Column, desiredTable Table, desired Column) bool {
+	if !isCharacterType(normalizeDataType(GeneratorModeMysql, desired.typeName)) {
+		return true
+	}
+
+	currentCharset, currentCollate := getCharsetAndCollation(currentTable, current)
+	desiredCharset, desiredCollate := getCharsetAndCollation(desiredTable, desired)
+	if currentCharset != "" && desiredCharset != "" && !strings.EqualFold(currentCharset, desiredCharset) {
+		return false
+	}
+	if currentCollate != "" && desiredCollate != "" && !strings.EqualFold(currentCollate, desiredCollate) {
+		return false
+	}
+	return true
+}
+
+// Collation is unknown when only charset is specified in a column, since it's the default one of the charset.
+func getCharsetAndCollation(table Table, column Column) (string, string) {
+	charset, collate := column.charset, column.collate
+	if charset == "" {
+		charset = table.options["CHARSET"]
+		if collate == "" {
+			collate = table.options["COLLATE"]
+		}
+	}
+	return charset, collate
+}
+
+func isCharacterType(typeName string) bool {
+	switch typeName {
+	case "char", "character", "varchar", "character varying", "text", "tinytext", "mediumtext", "longtext", "enum", "set":
+		return true
+	default:
+		return false
+	}
+}
+
+// Both of them are non-generated, VIRTUAL or STORED.
+func haveSameGeneratedType(current *Generated, desired *Generated) bool {
+	if current == nil || desired == nil {
+		return current == nil && desired == nil
+	}
+	return current.stored == desired.stored
+}
+
+func areSameGenerated(current *Generated, desired *Generated) bool {
+	if current == nil || desired == nil {
+		return current == nil && desired == nil
+	}
+	return current.expr == desired.expr && current.stored == desired.stored
+}
+
+func normalizeDataType(mode GeneratorMode, dataType string) string {
+	alias, ok := dataTypeAliases[mode][dataType]
 	if ok {
 		return alias
 	} else {
@@ -498,18 +2592,104 @@ func areSameIndexes(indexA Index, indexB Index) bool {
 	if indexA.primary != indexB.primary {
 		return false
 	}
+	if indexA.deferrable != indexB.deferrable {
+		return false
+	}
+	if indexA.fulltext != indexB.fulltext || indexA.parser != indexB.parser {
+		return false
+	}
+	if indexA.spatial != indexB.spatial {
+		return false
+	}
+	if indexA.invisible != indexB.invisible {
+		return false
+	}
+	if indexA.method != indexB.method || indexA.where != indexB.where {
+		return false
+	}
+	if strings.Join(indexA.include, ",") != strings.Join(indexB.include, ",") {
+		return false
+	}
+	if indexA.tablespace != indexB.tablespace {
+		return false
+	}
 	for len(indexA.columns) != len(indexB.columns) {
 		return false
 	}
 	for i, indexAColumn := range indexA.columns {
-		// TODO: check length?
 		if indexAColumn.column != indexB.columns[i].column {
 			return false
 		}
+		if !areSameIndexLengths(indexAColumn.length, indexB.columns[i].length) {
+			return false
+		}
+		if indexAColumn.direction != indexB.columns[i].direction || indexAColumn.nulls != indexB.columns[i].nulls {
+			return false
+		}
 	}
 	return true
 }
 
+// Compare MySQL's prefix lengths of index columns. nil means the whole column is indexed.
+func areSameIndexLengths(lengthA *Value, lengthB *Value) bool {
+	if lengthA == nil || lengthB == nil {
+		return lengthA == nil && lengthB == nil
+	}
+	return lengthA.intVal == lengthB.intVal
+}
+
+func areSameForeignKeys(foreignKeyA ForeignKey, foreignKeyB ForeignKey) bool {
+	return strings.Join(foreignKeyA.indexColumns, ",") == strings.Join(foreignKeyB.indexColumns, ",") &&
+		foreignKeyA.referenceName == foreignKeyB.referenceName &&
+		strings.Join(foreignKeyA.referenceColumns, ",") == strings.Join(foreignKeyB.referenceColumns, ",") &&
+		foreignKeyA.onDelete == foreignKeyB.onDelete &&
+		foreignKeyA.onUpdate == foreignKeyB.onUpdate &&
+		foreignKeyA.deferrable == foreignKeyB.deferrable
+}
+
+func areSameFunctions(functionA Function, functionB Function) bool {
+	return strings.Join(functionA.arguments, ",") == strings.Join(functionB.arguments, ",") &&
+		functionA.returns == functionB.returns &&
+		functionA.language == functionB.language &&
+		functionA.body == functionB.body &&
+		strings.Join(functionA.options, ",") == strings.Join(functionB.options, ",")
+}
+
+func areSameTriggers(triggerA Trigger, triggerB Trigger) bool {
+	return triggerA.tableName == triggerB.tableName &&
+		triggerA.time == triggerB.time &&
+		strings.Join(triggerA.events, ",") == strings.Join(triggerB.events, ",") &&
+		triggerA.forEach == triggerB.forEach &&
+		triggerA.body == triggerB.body
+}
+
+// Neither MySQL nor PostgreSQL keeps an empty comment, so a `COMMENT` of an empty string is regarded as the same as no comment here.
+func areSameComments(commentA *string, commentB *string) bool {
+	return (commentA == nil || *commentA == "") && (commentB == nil || *commentB == "") ||
+		(commentA != nil && commentB != nil && *commentA == *commentB)
+}
+
+// Check if the index has the foreign key's columns as its prefix.
+func isIndexForForeignKey(index Index, foreignKeys []ForeignKey) bool {
+	for _, foreignKey := range foreignKeys {
+		if len(index.columns) < len(foreignKey.indexColumns) {
+			continue
+		}
+
+		matched := true
+		for i, column := range foreignKey.indexColumns {
+			if index.columns[i].column != column {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 func convertTablesToTableNames(tables []Table) []string {
 	tableNames := []string{}
 	for _, table := range tables {
@@ -526,6 +2706,14 @@ func convertColumnsToColumnNames(columns []Column) []string {
 	return columnNames
 }
 
+func convertIndexColumnsToColumnNames(indexColumns []IndexColumn) []string {
+	columnNames := []string{}
+	for _, indexColumn := range indexColumns {
+		columnNames = append(columnNames, indexColumn.column)
+	}
+	return columnNames
+}
+
 func convertIndexesToIndexNames(indexes []Index) []string {
 	indexNames := []string{}
 	for _, index := range indexes {
@@ -534,6 +2722,26 @@ func convertIndexesToIndexNames(indexes []Index) []string {
 	return indexNames
 }
 
+func convertForeignKeysToConstraintNames(foreignKeys []ForeignKey) []string {
+	constraintNames := []string{}
+	for _, foreignKey := range foreignKeys {
+		constraintNames = append(constraintNames, foreignKey.constraintName)
+	}
+	return constraintNames
+}
+
+func areSameStrings(strsA []string, strsB []string) bool {
+	if len(strsA) != len(strsB) {
+		return false
+	}
+	for i := range strsA {
+		if strsA[i] != strsB[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func containsString(strs []string, str string) bool {
 	for _, s := range strs {
 		if s == str {
@@ -543,6 +2751,65 @@ func containsString(strs []string, str string) bool {
 	return false
 }
 
+// Remove only the first one, since the same name may be given again after it.
+func removeFirstString(strs []string, str string) []string {
+	for i, s := range strs {
+		if s == str {
+			return append(strs[:i:i], strs[i+1:]...)
+		}
+	}
+	return strs
+}
+
+func isSubsetOf(strs []string, superset []string) bool {
+	for _, str := range strs {
+		if !containsString(superset, str) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAnyString(strs []string, targets []string) bool {
+	for _, target := range targets {
+		if containsString(strs, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Dropping a column drops indexes on it. MySQL removes the column from a multi-column index instead, which is
+// dropped and added again if it's different from the desired one.
+func (g *Generator) removeColumnFromIndexes(indexes []Index, columnName string) []Index {
+	ret := []Index{}
+	for _, index := range indexes {
+		columns := []IndexColumn{}
+		for _, column := range index.columns {
+			if column.column != columnName {
+				columns = append(columns, column)
+			}
+		}
+		if len(columns) == len(index.columns) && !containsString(index.include, columnName) {
+			ret = append(ret, index)
+		} else if g.mode == GeneratorModeMysql && len(columns) > 0 {
+			index.columns = columns
+			ret = append(ret, index)
+		}
+	}
+	return ret
+}
+
+func removeIndexByName(indexes []Index, name string) []Index {
+	ret := []Index{}
+	for _, index := range indexes {
+		if index.name != name {
+			ret = append(ret, index)
+		}
+	}
+	return ret
+}
+
 func removeTableByName(tables []*Table, name string) []*Table {
 	removed := false
 	ret := []*Table{}
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDropStatements(t *testing.T) {
+	testCases := []struct {
+		name    string
+		desired string
+		current string
+		ddls    []string
+		err     string
+	}{{
+		name:    "DROP TABLE IF EXISTS of a missing table",
+		desired: "CREATE TABLE t (x int); DROP TABLE IF EXISTS u;",
+		current: "CREATE TABLE t (x int);",
+	}, {
+		name:    "DROP TABLE of a missing table",
+		desired: "CREATE TABLE t (x int); DROP TABLE u;",
+		current: "CREATE TABLE t (x int);",
+		err:     "DROP TABLE is performed for inexistent table 'u': 'DROP TABLE u'",
+	}, {
+		name:    "DROP TABLE IF EXISTS before CREATE TABLE",
+		desired: "DROP TABLE IF EXISTS t; CREATE TABLE t (x int);",
+		current: "CREATE TABLE t (x int);",
+	}, {
+		name:    "DROP TABLE of a current table",
+		desired: "DROP TABLE u; CREATE TABLE t (x int);",
+		current: "CREATE TABLE t (x int); CREATE TABLE u (x int);",
+		ddls:    []string{"DROP TABLE u"},
+	}, {
+		name:    "DROP INDEX IF EXISTS of a missing index",
+		desired: "CREATE TABLE t (x int); DROP INDEX IF EXISTS i;",
+		current: "CREATE TABLE t (x int);",
+	}, {
+		name:    "DROP INDEX of a missing index",
+		desired: "CREATE TABLE t (x int); DROP INDEX i;",
+		current: "CREATE TABLE t (x int);",
+		err:     "DROP INDEX is performed for inexistent index 'i': 'DROP INDEX i'",
+	}, {
+		name:    "DROP INDEX of a current index",
+		desired: "CREATE TABLE t (x int); DROP INDEX i;",
+		current: "CREATE TABLE t (x int); CREATE INDEX i ON t (x);",
+		ddls:    []string{"DROP INDEX i"},
+	}, {
+		name:    "DROP INDEX and CREATE INDEX of the same columns",
+		desired: "CREATE TABLE t (x int); CREATE INDEX a ON t (x); DROP INDEX a; CREATE INDEX b ON t (x);",
+		current: "CREATE TABLE t (x int); CREATE INDEX a ON t (x);",
+		ddls:    []string{"ALTER INDEX a RENAME TO b"},
+	}}
+	for _, tc := range testCases {
+		ddls, _, err := GenerateIdempotentDDLs(GeneratorModePostgres, tc.desired, tc.current, GeneratorConfig{})
+		if tc.err != "" {
+			if err == nil || err.Error() != tc.err {
+				t.Errorf("%s: error %v, want %q", tc.name, err, tc.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+			continue
+		}
+		if got, want := strings.Join(ddls, ";\n"), strings.Join(tc.ddls, ";\n"); got != want {
+			t.Errorf("%s: DDLs:\n%s\nwant:\n%s", tc.name, got, want)
+		}
+	}
+}
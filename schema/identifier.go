@@ -0,0 +1,132 @@
+package schema
+
+import (
+	"strings"
+)
+
+// Words reserved by either MySQL or PostgreSQL, which need to be quoted to be used as identifiers.
+// Quoting a word which is reserved only by the other database is harmless.
+var reservedWords = map[string]bool{
+	"accessible": true, "add": true, "all": true, "alter": true, "analyse": true, "analyze": true, "and": true,
+	"any": true, "array": true, "as": true, "asc": true, "asensitive": true, "asymmetric": true,
+	"authorization": true, "before": true, "between": true, "bigint": true, "binary": true, "blob": true,
+	"both": true, "by": true, "call": true, "cascade": true, "case": true, "cast": true, "change": true,
+	"char": true, "character": true, "check": true, "collate": true, "collation": true, "column": true,
+	"concurrently": true, "condition": true, "constraint": true, "continue": true, "convert": true,
+	"create": true, "cross": true, "cube": true, "cume_dist": true, "current_catalog": true,
+	"current_date": true, "current_role": true, "current_schema": true, "current_time": true,
+	"current_timestamp": true, "current_user": true, "cursor": true, "database": true, "databases": true,
+	"day_hour": true, "day_microsecond": true, "day_minute": true, "day_second": true, "dec": true,
+	"decimal": true, "declare": true, "default": true, "deferrable": true, "delayed": true, "delete": true,
+	"dense_rank": true, "desc": true, "describe": true, "deterministic": true, "distinct": true,
+	"distinctrow": true, "div": true, "do": true, "double": true, "drop": true, "dual": true, "each": true,
+	"else": true, "elseif": true, "empty": true, "enclosed": true, "end": true, "escaped": true,
+	"except": true, "exists": true, "exit": true, "explain": true, "false": true, "fetch": true,
+	"first_value": true, "float": true, "float4": true, "float8": true, "for": true, "force": true,
+	"foreign": true, "freeze": true, "from": true, "full": true, "fulltext": true, "generated": true,
+	"get": true, "grant": true, "group": true, "grouping": true, "groups": true, "having": true,
+	"high_priority": true, "hour_microsecond": true, "hour_minute": true, "hour_second": true, "if": true,
+	"ignore": true, "ilike": true, "in": true, "index": true, "infile": true, "initially": true,
+	"inner": true, "inout": true, "insensitive": true, "insert": true, "int": true, "int1": true,
+	"int2": true, "int3": true, "int4": true, "int8": true, "integer": true, "intersect": true,
+	"interval": true, "into": true, "is": true, "isnull": true, "iterate": true, "join": true,
+	"json_table": true, "key": true, "keys": true, "kill": true, "lag": true, "last_value": true,
+	"lateral": true, "lead": true, "leading": true, "leave": true, "left": true, "like": true, "limit": true,
+	"linear": true, "lines": true, "load": true, "localtime": true, "localtimestamp": true, "lock": true,
+	"long": true, "longblob": true, "longtext": true, "loop": true, "low_priority": true, "match": true,
+	"maxvalue": true, "mediumblob": true, "mediumint": true, "mediumtext": true, "middleint": true,
+	"minute_microsecond": true, "minute_second": true, "mod": true, "modifies": true, "natural": true,
+	"no_write_to_binlog": true, "not": true, "notnull": true, "nth_value": true, "ntile": true, "null": true,
+	"numeric": true, "of": true, "offset": true, "on": true, "only": true, "optimize": true, "option": true,
+	"optionally": true, "or": true, "order": true, "out": true, "outer": true, "outfile": true, "over": true,
+	"overlaps": true, "partition": true, "percent_rank": true, "placing": true, "precision": true,
+	"primary": true, "procedure": true, "purge": true, "range": true, "rank": true, "read": true,
+	"reads": true, "read_write": true, "real": true, "recursive": true, "references": true, "regexp": true,
+	"release": true, "rename": true, "repeat": true, "replace": true, "require": true, "resignal": true,
+	"restrict": true, "return": true, "returning": true, "revoke": true, "right": true, "rlike": true,
+	"row": true, "rows": true, "row_number": true, "schema": true, "schemas": true,
+	"second_microsecond": true, "select": true, "sensitive": true, "separator": true, "session_user": true,
+	"set": true, "show": true, "signal": true, "similar": true, "smallint": true, "some": true,
+	"spatial": true, "specific": true, "sql": true, "sqlexception": true, "sqlstate": true,
+	"sqlwarning": true, "sql_big_result": true, "sql_calc_found_rows": true, "sql_small_result": true,
+	"ssl": true, "starting": true, "stored": true, "straight_join": true, "symmetric": true, "system": true,
+	"table": true, "tablesample": true, "terminated": true, "then": true, "tinyblob": true, "tinyint": true,
+	"tinytext": true, "to": true, "trailing": true, "trigger": true, "true": true, "undo": true,
+	"union": true, "unique": true, "unlock": true, "unsigned": true, "update": true, "usage": true,
+	"use": true, "user": true, "using": true, "utc_date": true, "utc_time": true, "utc_timestamp": true,
+	"values": true, "varbinary": true, "varchar": true, "varcharacter": true, "variadic": true,
+	"varying": true, "verbose": true, "virtual": true, "when": true, "where": true, "while": true,
+	"window": true, "with": true, "write": true, "xor": true, "year_month": true, "zerofill": true,
+}
+
+// Quote an identifier by backquotes for MySQL or double quotes for PostgreSQL if it's a reserved word or it has
+// a character other than letters, digits, `_` and `$`. PostgreSQL's identifier having uppercase letters is also quoted,
+// since it would be folded to lowercase. Other identifiers are kept as they are.
+func (g *Generator) escapeSQLName(name string) string {
+	if !needsQuote(name) && (g.mode != GeneratorModePostgres || strings.ToLower(name) == name) {
+		return name
+	}
+	quote := "`"
+	if g.mode == GeneratorModePostgres {
+		quote = `"`
+	}
+	return quote + strings.Replace(name, quote, quote+quote, -1) + quote
+}
+
+// Quote each part of a table name which may be qualified by its schema like `schema.table`.
+func (g *Generator) escapeTableName(name string) string {
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		parts[i] = g.escapeSQLName(part)
+	}
+	return strings.Join(parts, ".")
+}
+
+// Quote a role name, except for keywords which specify roles like PUBLIC and CURRENT_USER.
+func (g *Generator) escapeRoleName(name string) string {
+	switch name {
+	case "public", "current_role", "current_user", "session_user":
+		return name
+	}
+	return g.escapeSQLName(name)
+}
+
+// Quote each name of a column list like `PRIMARY KEY (a, b)`.
+func (g *Generator) escapeSQLNames(names []string) []string {
+	escaped := []string{}
+	for _, name := range names {
+		escaped = append(escaped, g.escapeSQLName(name))
+	}
+	return escaped
+}
+
+// Expressions are normalized with MySQL's backquotes. PostgreSQL quotes identifiers by double quotes, so
+// backquotes outside string literals are replaced.
+func (g *Generator) escapeExpr(expr string) string {
+	if g.mode != GeneratorModePostgres || !strings.Contains(expr, "`") {
+		return expr
+	}
+	var buf strings.Builder
+	inString := false
+	for _, c := range expr {
+		if c == '\'' {
+			inString = !inString
+		} else if c == '`' && !inString {
+			c = '"'
+		}
+		buf.WriteRune(c)
+	}
+	return buf.String()
+}
+
+func needsQuote(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, c := range name {
+		if !(c == '_' || c == '$' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || (i > 0 && '0' <= c && c <= '9')) {
+			return true
+		}
+	}
+	return reservedWords[strings.ToLower(name)]
+}
@@ -4,6 +4,8 @@ package schema
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -65,35 +67,280 @@ func parseValue(val *sqlparser.SQLVal) *Value {
 	return &ret
 }
 
-func parseTable(stmt *sqlparser.DDL) Table {
+// Functions which are the same as the ones called without parentheses in each database
+var (
+	defaultFunctionAliases = map[GeneratorMode]map[string]string{
+		GeneratorModeMysql: {
+			"now":            "current_timestamp",
+			"localtime":      "current_timestamp",
+			"localtimestamp": "current_timestamp",
+			"curdate":        "current_date",
+			"curtime":        "current_time",
+		},
+		GeneratorModePostgres: {
+			"now":                   "current_timestamp",
+			"transaction_timestamp": "current_timestamp",
+		},
+	}
+	niladicFunctions       = []string{"current_timestamp", "current_date", "current_time", "localtime", "localtimestamp"}
+	defaultFunctionPattern = regexp.MustCompile(`^(\w+)(\((\d*)\))?$`) // A function with an optional precision like `now(6)`
+)
+
+// Parse DEFAULT or ON UPDATE of a column. A function call, including CURRENT_TIMESTAMP without parentheses, is
+// normalized to compare its equivalent spellings like `now()` and `CURRENT_TIMESTAMP`.
+func parseDefaultValue(mode GeneratorMode, val *sqlparser.SQLVal, defaultExpr sqlparser.Expr) *Value {
+	// MySQL's TRUE and FALSE are the integers 1 and 0, which SHOW CREATE TABLE shows like `DEFAULT '1'`.
+	if boolVal, ok := defaultExpr.(sqlparser.BoolVal); ok && mode == GeneratorModeMysql {
+		if boolVal {
+			return parseValue(sqlparser.NewIntVal([]byte("1")))
+		}
+		return parseValue(sqlparser.NewIntVal([]byte("0")))
+	}
+
+	var raw, expr string
+	if defaultExpr != nil {
+		raw = sqlparser.String(defaultExpr)
+		expr = normalizeExpr(defaultExpr)
+	} else if val != nil && val.Type == sqlparser.ValArg && !strings.EqualFold(string(val.Val), "null") {
+		raw = strings.ToUpper(string(val.Val))
+		expr = strings.ToLower(raw)
+	} else {
+		return parseValue(val)
+	}
+
+	// CURRENT_TIMESTAMP may be formatted as a function call. Its precision is kept unless it's the default 0.
+	if match := defaultFunctionPattern.FindStringSubmatch(expr); match != nil {
+		name := match[1]
+		if alias, ok := defaultFunctionAliases[mode][name]; ok {
+			name = alias
+		}
+		if containsString(niladicFunctions, name) {
+			expr = name
+			if precision := match[3]; precision != "" && precision != "0" {
+				expr += fmt.Sprintf("(%s)", precision)
+			}
+		}
+	}
+	return &Value{valueType: ValueTypeExpr, raw: []byte(raw), exprVal: expr}
+}
+
+// pg_dump(1) casts a literal default to the type of its column like `'{}'::jsonb`. The literal is returned without
+// the cast, so that it's the same as the one given without the cast.
+func unwrapDefaultCast(expr sqlparser.Expr, columnType sqlparser.ColumnType) (*sqlparser.SQLVal, bool) {
+	cast, ok := expr.(*sqlparser.TypeCastExpr)
+	if !ok {
+		return nil, false
+	}
+	val, ok := cast.Expr.(*sqlparser.SQLVal)
+	if !ok {
+		return nil, false
+	}
+	if !isSameType(*cast.Type, columnType) {
+		return nil, false
+	}
+	return val, true
+}
+
+// Compare PostgreSQL's types regardless of their aliases. The length like `character varying(10)` is omitted in casts.
+func isSameType(typeA sqlparser.ColumnType, typeB sqlparser.ColumnType) bool {
+	nameA, timezoneA := normalizeTimezone(normalizeDataType(GeneratorModePostgres, strings.TrimPrefix(typeA.Type, "public.")), castBool(typeA.Timezone))
+	nameB, timezoneB := normalizeTimezone(normalizeDataType(GeneratorModePostgres, strings.TrimPrefix(typeB.Type, "public.")), castBool(typeB.Timezone))
+	return nameA == nameB && timezoneA == timezoneB && typeA.Array == typeB.Array
+}
+
+// PostgreSQL's timestamptz and timetz are aliases of timestamp and time WITH TIME ZONE.
+func normalizeTimezone(typeName string, timezone bool) (string, bool) {
+	switch typeName {
+	case "timestamptz":
+		return "timestamp", true
+	case "timetz":
+		return "time", true
+	default:
+		return typeName, timezone
+	}
+}
+
+func parseComment(val *sqlparser.SQLVal) *string {
+	if val == nil {
+		return nil
+	}
+	comment := string(val.Val)
+	return &comment
+}
+
+// Unnamed check constraints of a domain are named like `<domain>_check`, `<domain>_check1` and so on.
+func parseDomain(stmt *sqlparser.DDL) Domain {
+	spec := stmt.DomainSpec
+	domain := Domain{
+		name:     normalizeTableName(GeneratorModePostgres, stmt.Table),
+		dataType: normalizeDataType(GeneratorModePostgres, spec.Type.Type),
+		notNull:  spec.NotNull,
+		checks:   []Check{},
+	}
+	if spec.Type.Length != nil && spec.Type.Scale != nil {
+		domain.dataType += fmt.Sprintf("(%s,%s)", string(spec.Type.Length.Val), string(spec.Type.Scale.Val))
+	} else if spec.Type.Length != nil {
+		domain.dataType += fmt.Sprintf("(%s)", string(spec.Type.Length.Val))
+	}
+	if spec.Default != nil {
+		domain.defaultVal = normalizeExpr(spec.Default)
+	}
+	columnTypes := map[string]*sqlparser.ColumnType{"VALUE": &spec.Type}
+
+	constraintNames := []string{}
+	for _, checkDef := range spec.Checks {
+		constraintNames = append(constraintNames, checkDef.ConstraintName.String())
+	}
+	for i, checkDef := range spec.Checks {
+		constraintName := constraintNames[i]
+		for n := 0; constraintName == ""; n++ {
+			candidate := fmt.Sprintf("%s_check", unqualifiedName(domain.name))
+			if n > 0 {
+				candidate += strconv.Itoa(n)
+			}
+			if !containsString(constraintNames, candidate) {
+				constraintName = candidate
+				constraintNames[i] = candidate
+			}
+		}
+
+		// PostgreSQL shows `VALUE` in uppercase.
+		_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			if colName, ok := node.(*sqlparser.ColName); ok && colName.Qualifier.IsEmpty() && colName.Name.Lowered() == "value" {
+				colName.Name = sqlparser.NewColIdent("VALUE")
+			}
+			return true, nil
+		}, checkDef.Expr)
+
+		domain.checks = append(domain.checks, Check{
+			constraintName: constraintName,
+			definition:     normalizeTypedExpr(checkDef.Expr, columnTypes),
+		})
+	}
+	return domain
+}
+
+// The parser gives quoted values of ENUM or SET without escaping them.
+func parseEnumValues(values []string) []string {
+	unquoted := []string{}
+	for _, value := range values {
+		unquoted = append(unquoted, strings.TrimSuffix(strings.TrimPrefix(value, "'"), "'"))
+	}
+	return unquoted
+}
+
+// Table options are just a string in the parser. Tokenize it again to find `NAME [=] value` pairs like
+// `ENGINE=InnoDB` or `COMMENT '...'`. Names are uppercased, the optional `DEFAULT` is ignored,
+// and `CHARACTER SET` is treated as `CHARSET`. MariaDB's `WITH SYSTEM VERSIONING` is returned separately.
+func parseTableOptions(options string) (map[string]string, *string, bool) {
+	tokenizer := sqlparser.NewStringTokenizer(options, sqlparser.ParserModeMysql)
+	tableOptions := map[string]string{}
+	var comment *string
+	systemVersioning := false
+	for {
+		typ, val := tokenizer.Scan()
+		if typ == 0 || typ == sqlparser.LEX_ERROR {
+			return tableOptions, comment, systemVersioning
+		}
+		name := strings.ToUpper(string(val))
+		if typ == ',' || name == "DEFAULT" {
+			continue
+		}
+		if name == "WITH" {
+			tokenizer.Scan() // SYSTEM
+			tokenizer.Scan() // VERSIONING
+			systemVersioning = true
+			continue
+		}
+		if name == "CHARACTER" {
+			typ, _ = tokenizer.Scan() // SET
+			name = "CHARSET"
+		}
+
+		typ, val = tokenizer.Scan()
+		if typ == '=' {
+			typ, val = tokenizer.Scan()
+		}
+		if typ == 0 || typ == sqlparser.LEX_ERROR {
+			return tableOptions, comment, systemVersioning
+		}
+		if name == "COMMENT" && typ == sqlparser.STRING {
+			value := string(val)
+			comment = &value
+		} else {
+			tableOptions[name] = string(val)
+		}
+	}
+}
+
+func parseTable(mode GeneratorMode, stmt *sqlparser.DDL) Table {
+	tableName := normalizeTableName(mode, stmt.NewName)
+	constraintPrefix := unqualifiedName(tableName) // Constraints are named without the schema
 	columns := []Column{}
 	indexes := []Index{}
+	foreignKeys := []ForeignKey{}
+	checkDefs := []*sqlparser.CheckDefinition{}
+	columnTypes := map[string]*sqlparser.ColumnType{}
+	for _, parsedCol := range stmt.TableSpec.Columns {
+		columnTypes[parsedCol.Name.String()] = &parsedCol.Type
+	}
 
 	for _, parsedCol := range stmt.TableSpec.Columns {
+		defaultVal, defaultExpr := parsedCol.Type.Default, parsedCol.Type.DefaultExpr
+		if val, ok := unwrapDefaultCast(defaultExpr, parsedCol.Type); ok {
+			defaultVal, defaultExpr = val, nil
+		}
+		typeName, timezone := normalizeTimezone(strings.TrimPrefix(parsedCol.Type.Type, "public."), castBool(parsedCol.Type.Timezone)) // pg_dump(1) qualifies user-defined types
 		column := Column{
 			name:          parsedCol.Name.String(),
-			typeName:      parsedCol.Type.Type,
+			typeName:      typeName,
 			unsigned:      castBool(parsedCol.Type.Unsigned),
+			zerofill:      castBool(parsedCol.Type.Zerofill),
 			notNull:       castBool(parsedCol.Type.NotNull),
 			autoIncrement: castBool(parsedCol.Type.Autoincrement),
-			defaultVal:    parseValue(parsedCol.Type.Default),
+			defaultVal:    parseDefaultValue(mode, defaultVal, defaultExpr),
+			onUpdate:      parseDefaultValue(mode, parsedCol.Type.OnUpdate, nil),
 			length:        parseValue(parsedCol.Type.Length),
 			scale:         parseValue(parsedCol.Type.Scale),
+			array:         castBool(parsedCol.Type.Array),
+			timezone:      timezone,
+			geometryType:  parsedCol.Type.GeometryType,
+			srid:          parseValue(parsedCol.Type.Srid),
+			enumValues:    parseEnumValues(parsedCol.Type.EnumValues),
 			keyOption:     ColumnKeyOption(parsedCol.Type.KeyOpt), // FIXME: tight coupling in enum order
+			comment:       parseComment(parsedCol.Type.Comment),
+			generated:     parseGenerated(parsedCol.Type.Generated, columnTypes),
+			identity:      parseIdentity(parsedCol.Type.Identity),
+			charset:       parsedCol.Type.Charset,
+			collate:       parsedCol.Type.Collate,
+			invisible:     castBool(parsedCol.Type.Invisible),
+			rowPeriod:     parsedCol.Type.SystemVersioning,
+		}
+		// MySQL's BOOLEAN is a synonym of tinyint(1), which SHOW CREATE TABLE shows.
+		if mode == GeneratorModeMysql && normalizeDataType(mode, column.typeName) == "boolean" {
+			column.typeName = "tinyint"
+			column.length = parseValue(sqlparser.NewIntVal([]byte("1")))
+		}
+		if parsedCol.Type.DefaultNextval != "" {
+			column.defaultSeq = parsedCol.Type.DefaultNextval
+			normalizeSerialColumn(tableName, &column)
 		}
 		columns = append(columns, column)
+
+		// MySQL parses but ignores a column-level REFERENCES.
+		if mode == GeneratorModePostgres && parsedCol.Type.References != nil {
+			foreignKey := parseForeignKey(mode, parsedCol.Type.References)
+			foreignKey.indexColumns = []string{column.name}
+			foreignKeys = append(foreignKeys, foreignKey)
+		}
+
+		checkDefs = append(checkDefs, parsedCol.Type.Checks...)
 	}
 
 	for _, indexDef := range stmt.TableSpec.Indexes {
 		indexColumns := []IndexColumn{}
 		for _, column := range indexDef.Columns {
-			indexColumns = append(
-				indexColumns,
-				IndexColumn{
-					column: column.Column.String(),
-					length: parseValue(column.Length),
-				},
-			)
+			indexColumns = append(indexColumns, parseIndexColumn(column))
 		}
 
 		index := Index{
@@ -102,53 +349,898 @@ func parseTable(stmt *sqlparser.DDL) Table {
 			columns:   indexColumns,
 			primary:   indexDef.Info.Primary,
 			unique:    indexDef.Info.Unique,
+			// PostgreSQL's table-level UNIQUE is always a constraint.
+			constraint: indexDef.Info.Constraint || (mode == GeneratorModePostgres && indexDef.Info.Unique && !indexDef.Info.Primary),
+			deferrable: indexDef.Deferrable,
+			fulltext:   indexDef.Info.Fulltext,
+			spatial:    indexDef.Info.Spatial,
+			method:     normalizeIndexMethod(mode, ""), // Constraints of PostgreSQL are always btree
+		}
+		for _, option := range indexDef.Options {
+			switch option.Name {
+			case "with parser":
+				index.parser = strings.ToLower(option.Using)
+			case "invisible":
+				index.invisible = true
+			case "visible":
+				index.invisible = false
+			}
+		}
+		if index.name == "" {
+			// Give the same name to an unnamed unique key as databases do.
+			if mode == GeneratorModePostgres {
+				index.name = fmt.Sprintf("%s_%s_key", constraintPrefix, strings.Join(convertIndexColumnsToColumnNames(indexColumns), "_"))
+			} else {
+				index.name = indexColumns[0].column
+			}
 		}
 		indexes = append(indexes, index)
+
+		// Columns of a primary key are implicitly NOT NULL.
+		if index.primary {
+			for i, column := range columns {
+				if containsString(convertIndexColumnsToColumnNames(indexColumns), column.name) {
+					columns[i].notNull = true
+				}
+			}
+		}
+	}
+
+	for _, foreignKeyDef := range stmt.TableSpec.ForeignKeys {
+		foreignKeys = append(foreignKeys, parseForeignKey(mode, foreignKeyDef))
+	}
+
+	for i, foreignKey := range foreignKeys {
+		if foreignKey.constraintName == "" {
+			foreignKeys[i].constraintName = generateForeignKeyName(mode, tableName, foreignKey, foreignKeys)
+		}
+	}
+
+	// Name unnamed check constraints as well. PostgreSQL uses the column name only when just one column is referred.
+	// A duplicated name is numbered like `<table>_<column>_check1`.
+	checks := []Check{}
+	checkDefs = append(checkDefs, stmt.TableSpec.Checks...)
+	constraintNames := []string{}
+	for _, checkDef := range checkDefs {
+		constraintNames = append(constraintNames, checkDef.ConstraintName.String())
+	}
+	mysqlCheckNumber := 0
+	for i, checkDef := range checkDefs {
+		constraintName := constraintNames[i]
+		if constraintName == "" {
+			if mode == GeneratorModePostgres {
+				base := fmt.Sprintf("%s_check", constraintPrefix)
+				if columnNames := findColumnNamesInExpr(checkDef.Expr); len(columnNames) == 1 {
+					base = fmt.Sprintf("%s_%s_check", constraintPrefix, columnNames[0])
+				}
+				for n := 0; constraintName == ""; n++ {
+					candidate := base
+					if n > 0 {
+						candidate += strconv.Itoa(n)
+					}
+					if !containsString(constraintNames, candidate) {
+						constraintName = candidate
+						constraintNames[i] = candidate
+					}
+				}
+			} else {
+				mysqlCheckNumber++
+				constraintName = fmt.Sprintf("%s_chk_%d", constraintPrefix, mysqlCheckNumber)
+			}
+		}
+		checks = append(checks, Check{
+			constraintName: constraintName,
+			definition:     normalizeTypedExpr(checkDef.Expr, columnTypes),
+		})
+	}
+
+	exclusions := []Exclusion{}
+	for _, exclusionDef := range stmt.TableSpec.Exclusions {
+		exclusions = append(exclusions, parseExclusion(tableName, exclusionDef))
+	}
+
+	options, comment, systemVersioning := parseTableOptions(stmt.TableSpec.Options)
+	table := Table{
+		name:             tableName,
+		columns:          columns,
+		indexes:          indexes,
+		foreignKeys:      foreignKeys,
+		checks:           checks,
+		exclusions:       exclusions,
+		comment:          comment,
+		options:          options,
+		partition:        parsePartition(stmt.TableSpec.Partition),
+		systemVersioning: systemVersioning,
+	}
+	if !stmt.TableSpec.Like.IsEmpty() {
+		table.like = normalizeTableName(mode, stmt.TableSpec.Like)
+	}
+	if partitionOf := stmt.TableSpec.PartitionOf; partitionOf != nil {
+		table.partitionOf = normalizeTableName(mode, partitionOf.Parent)
+		table.bound = parsePartitionBound(partitionOf.Bound)
+	}
+	for _, parent := range stmt.TableSpec.Inherits {
+		table.inherits = append(table.inherits, normalizeTableName(mode, parent))
+	}
+	return table
+}
+
+// Normalize `PARTITION BY` to compare it with the one in `SHOW CREATE TABLE`. Options of each partition
+// like ENGINE are ignored, and so is PARTITIONS when the partitions are defined explicitly.
+func parsePartition(partition *sqlparser.PartitionOption) string {
+	if partition == nil {
+		return ""
+	}
+
+	clause := "PARTITION BY "
+	if partition.Linear {
+		clause += "LINEAR "
+	}
+	clause += strings.ToUpper(partition.Type)
+	if partition.Columns {
+		clause += " COLUMNS"
+	}
+	if partition.Algorithm != nil {
+		clause += " ALGORITHM=" + string(partition.Algorithm.Val)
+	}
+
+	if partition.Type == sqlparser.PartitionKeyStr {
+		columnNames := []string{}
+		for _, column := range partition.KeyColumns {
+			columnNames = append(columnNames, column.String())
+		}
+		clause += fmt.Sprintf(" (%s)", strings.Join(columnNames, ", "))
+	} else {
+		clause += fmt.Sprintf(" (%s)", normalizeExprs(partition.Exprs))
+	}
+
+	if len(partition.Definitions) == 0 {
+		if partition.Partitions != nil {
+			clause += " PARTITIONS " + string(partition.Partitions.Val)
+		}
+		return clause
+	}
+
+	definitions := []string{}
+	for _, definition := range partition.Definitions {
+		var values string
+		if definition.In != nil {
+			values = fmt.Sprintf("VALUES IN (%s)", normalizeExprs(definition.In))
+		} else if definition.Maxvalue {
+			values = "VALUES LESS THAN MAXVALUE"
+		} else if tuple, ok := definition.Limit.(sqlparser.ValTuple); ok {
+			values = fmt.Sprintf("VALUES LESS THAN (%s)", normalizeExprs(sqlparser.Exprs(tuple)))
+		} else {
+			values = fmt.Sprintf("VALUES LESS THAN (%s)", normalizeExpr(definition.Limit))
+		}
+		definitions = append(definitions, fmt.Sprintf("PARTITION %s %s", definition.Name.String(), values))
+	}
+	return fmt.Sprintf("%s (%s)", clause, strings.Join(definitions, ", "))
+}
+
+// Normalize PostgreSQL's partition bound to compare it with the one in pg_dump.
+func parsePartitionBound(bound *sqlparser.PartitionBound) string {
+	switch {
+	case bound.Default:
+		return "DEFAULT"
+	case bound.In != nil:
+		return fmt.Sprintf("FOR VALUES IN (%s)", normalizeExprs(bound.In))
+	case bound.Modulus != nil:
+		return fmt.Sprintf("FOR VALUES WITH (MODULUS %s, REMAINDER %s)", string(bound.Modulus.Val), string(bound.Remainder.Val))
+	default:
+		return fmt.Sprintf("FOR VALUES FROM (%s) TO (%s)", normalizeBoundValues(bound.From), normalizeBoundValues(bound.To))
+	}
+}
+
+func normalizeBoundValues(exprs sqlparser.Exprs) string {
+	values := []string{}
+	for _, expr := range exprs {
+		switch expr := expr.(type) {
+		case *sqlparser.MaxValueVal:
+			values = append(values, "MAXVALUE")
+		case *sqlparser.ColName:
+			if expr.Name.Lowered() == "minvalue" {
+				values = append(values, "MINVALUE")
+			} else {
+				values = append(values, normalizeExpr(expr))
+			}
+		default:
+			values = append(values, normalizeExpr(expr))
+		}
+	}
+	return strings.Join(values, ", ")
+}
+
+func normalizeExprs(exprs sqlparser.Exprs) string {
+	normalized := []string{}
+	for _, expr := range exprs {
+		normalized = append(normalized, normalizeExpr(expr))
+	}
+	return strings.Join(normalized, ", ")
+}
+
+// Give the same name to an unnamed foreign key as databases do, not to re-create it on every apply.
+// MySQL uses the number next to the largest one in existing `<table>_ibfk_<number>` names.
+func generateForeignKeyName(mode GeneratorMode, tableName string, foreignKey ForeignKey, foreignKeys []ForeignKey) string {
+	tableName = unqualifiedName(tableName)
+	if mode == GeneratorModePostgres {
+		return fmt.Sprintf("%s_%s_fkey", tableName, strings.Join(foreignKey.indexColumns, "_"))
+	}
+
+	prefix := fmt.Sprintf("%s_ibfk_", tableName)
+	number := 0
+	for _, existingForeignKey := range foreignKeys {
+		if !strings.HasPrefix(existingForeignKey.constraintName, prefix) {
+			continue
+		}
+		existingNumber, err := strconv.Atoi(strings.TrimPrefix(existingForeignKey.constraintName, prefix))
+		if err == nil && existingNumber > number {
+			number = existingNumber
+		}
+	}
+	return fmt.Sprintf("%s%d", prefix, number+1)
+}
+
+func parseForeignKey(mode GeneratorMode, foreignKeyDef *sqlparser.ForeignKeyDefinition) ForeignKey {
+	indexColumns := []string{}
+	for _, indexColumn := range foreignKeyDef.IndexColumns {
+		indexColumns = append(indexColumns, indexColumn.String())
+	}
+
+	referenceColumns := []string{}
+	for _, referenceColumn := range foreignKeyDef.ReferenceColumns {
+		referenceColumns = append(referenceColumns, referenceColumn.String())
+	}
+
+	return ForeignKey{
+		constraintName:   foreignKeyDef.ConstraintName.String(),
+		indexName:        foreignKeyDef.IndexName.String(),
+		indexColumns:     indexColumns,
+		referenceName:    normalizeTableName(mode, foreignKeyDef.ReferenceName),
+		referenceColumns: referenceColumns,
+		onDelete:         normalizeReferenceOption(mode, foreignKeyDef.OnDelete.String()),
+		onUpdate:         normalizeReferenceOption(mode, foreignKeyDef.OnUpdate.String()),
+		deferrable:       foreignKeyDef.Deferrable,
+	}
+}
+
+// An unnamed exclusion constraint is named like `<table>_<column>_<column>_excl` as PostgreSQL does.
+func parseExclusion(tableName string, exclusionDef *sqlparser.ExclusionDefinition) Exclusion {
+	columnNames := []string{}
+	elements := []string{}
+	for _, element := range exclusionDef.Elements {
+		columnNames = append(columnNames, element.Column.String())
+		elements = append(elements, fmt.Sprintf("%s WITH %s", element.Column.String(), element.Operator))
+	}
+
+	definition := fmt.Sprintf("USING %s (%s)", exclusionDef.IndexType.Lowered(), strings.Join(elements, ", "))
+	if exclusionDef.Where != nil {
+		definition += fmt.Sprintf(" WHERE (%s)", normalizeExpr(exclusionDef.Where))
+	}
+	if exclusionDef.Deferrable != "" {
+		definition += " " + strings.ToUpper(exclusionDef.Deferrable)
+	}
+
+	constraintName := exclusionDef.ConstraintName.String()
+	if constraintName == "" {
+		constraintName = fmt.Sprintf("%s_%s_excl", unqualifiedName(tableName), strings.Join(columnNames, "_"))
 	}
+	return Exclusion{constraintName: constraintName, definition: definition}
+}
+
+// Databases don't show a default referential action, so treat it as unspecified not to re-create
+// the foreign key. InnoDB's RESTRICT and NO ACTION are the same, while PostgreSQL's RESTRICT is not deferrable.
+func normalizeReferenceOption(mode GeneratorMode, option string) string {
+	option = strings.ToUpper(option)
+	if option == "NO ACTION" || (mode == GeneratorModeMysql && option == "RESTRICT") {
+		return ""
+	}
+	return option
+}
+
+// Format an expression of CHECK or a generated column to compare it regardless of redundant parentheses,
+// which databases add. Instead, parentheses are added to every operation to keep its precedence.
+// Function names are lowercased as MySQL shows them. PostgreSQL's implicit casts, which pg_dump(1) shows like
+// `' '::text` and `(name)::text` of a varchar column, are removed by unwrapImplicitCast.
+// MySQL's character set introducers like `_utf8mb4'str'` are removed, and PostgreSQL's `= ANY (ARRAY[...])`
+// is formatted as IN as pg_dump(1) shows IN that way.
+func normalizeExpr(expr sqlparser.Expr) string {
+	return normalizeTypedExpr(expr, nil)
+}
+
+// Normalize an expression referring to columns of the types, which tell casts to them added by PostgreSQL.
+func normalizeTypedExpr(expr sqlparser.Expr, columnTypes map[string]*sqlparser.ColumnType) string {
+	buf := sqlparser.NewTrackedBuffer(func(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+		if !formatNormalizedExpr(buf, node, columnTypes) {
+			node.Format(buf)
+		}
+	})
+	buf.Myprintf("%v", expr)
+	return buf.String()
+}
+
+// Format an expression node in the same way regardless of redundant parentheses, casts and cases given by databases.
+// Return false if the node is not normalized.
+func formatNormalizedExpr(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode, columnTypes map[string]*sqlparser.ColumnType) bool {
+	switch node := node.(type) {
+	case *sqlparser.ParenExpr:
+		buf.Myprintf("%v", node.Expr)
+	case *sqlparser.TypeCastExpr:
+		if expr, ok := unwrapImplicitCast(node, columnTypes); ok {
+			buf.Myprintf("%v", expr)
+		} else {
+			// pg_dump(1) qualifies types given by extensions like `public.citext`.
+			typ := *node.Type
+			typ.Type = strings.TrimPrefix(typ.Type, "public.")
+			buf.Myprintf("%v::%v", node.Expr, &typ)
+		}
+	case *sqlparser.FuncExpr:
+		// MySQL shows `json ->> path` as `json_unquote(json_extract(json, path))`.
+		if args, ok := jsonExtractArgs(node); ok {
+			buf.Myprintf("(%v %s %v)", args[0], sqlparser.JSONExtractOp, args[1])
+			return true
+		}
+		if args, ok := funcArgs(node, "json_unquote", 1); ok {
+			if funcExpr, ok := args[0].(*sqlparser.FuncExpr); ok {
+				if args, ok := jsonExtractArgs(funcExpr); ok {
+					buf.Myprintf("(%v %s %v)", args[0], sqlparser.JSONUnquoteExtractOp, args[1])
+					return true
+				}
+			}
+		}
+
+		// pg_dump(1) qualifies functions given by extensions like `public.uuid_generate_v4()`.
+		funcExpr := *node
+		funcExpr.Name = sqlparser.NewColIdent(node.Name.Lowered())
+		if funcExpr.Qualifier.String() == "public" {
+			funcExpr.Qualifier = sqlparser.NewTableIdent("")
+		}
+		funcExpr.Format(buf)
+	case *sqlparser.IntroducerExpr:
+		buf.Myprintf("%v", node.Expr)
+	case *sqlparser.ComparisonExpr:
+		if array, ok := quantifiedArray(node, sqlparser.EqualStr, sqlparser.AnyStr); ok {
+			buf.Myprintf("(%v %s (%v))", node.Left, sqlparser.InStr, unwrapComparedCasts(array.Elements, node.Left, columnTypes))
+		} else if array, ok := quantifiedArray(node, sqlparser.NotEqualStr, sqlparser.AllStr); ok {
+			buf.Myprintf("(%v %s (%v))", node.Left, sqlparser.NotInStr, unwrapComparedCasts(array.Elements, node.Left, columnTypes))
+		} else {
+			comparison := *node
+			comparison.Left = unwrapComparedCast(node.Left, node.Right, columnTypes)
+			comparison.Right = unwrapComparedCast(node.Right, node.Left, columnTypes)
+			buf.Myprintf("(")
+			comparison.Format(buf)
+			buf.Myprintf(")")
+		}
+	case *sqlparser.AndExpr, *sqlparser.OrExpr, *sqlparser.NotExpr, *sqlparser.RangeCond,
+		*sqlparser.IsExpr, *sqlparser.BinaryExpr, *sqlparser.UnaryExpr, *sqlparser.CollateExpr:
+		buf.Myprintf("(")
+		node.Format(buf)
+		buf.Myprintf(")")
+	default:
+		return false
+	}
+	return true
+}
+
+// Return the arguments of `json_extract(json, path)`, which MySQL shows for `json -> path`.
+func jsonExtractArgs(funcExpr *sqlparser.FuncExpr) (sqlparser.Exprs, bool) {
+	return funcArgs(funcExpr, "json_extract", 2)
+}
+
+// Return the arguments of a function call by the name and the number of arguments, which are not aliased.
+func funcArgs(funcExpr *sqlparser.FuncExpr, name string, numArgs int) (sqlparser.Exprs, bool) {
+	if !funcExpr.Qualifier.IsEmpty() || funcExpr.Name.Lowered() != name || funcExpr.Distinct || len(funcExpr.Exprs) != numArgs {
+		return nil, false
+	}
+	args := sqlparser.Exprs{}
+	for _, selectExpr := range funcExpr.Exprs {
+		aliasedExpr, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok || !aliasedExpr.As.IsEmpty() {
+			return nil, false
+		}
+		args = append(args, aliasedExpr.Expr)
+	}
+	return args, true
+}
 
-	return Table{
-		name:    stmt.NewName.Name.String(),
-		columns: columns,
-		indexes: indexes,
+// Return the array of a comparison like `a = ANY (ARRAY['x', 'y'])`, which may be casted like `(ARRAY[...])::text[]`.
+func quantifiedArray(comparison *sqlparser.ComparisonExpr, operator string, quantifier string) (*sqlparser.ArrayConstructor, bool) {
+	quantified, ok := comparison.Right.(*sqlparser.QuantifiedExpr)
+	if !ok || comparison.Operator != operator || quantified.Quantifier != quantifier {
+		return nil, false
 	}
+	expr := unwrapParenExpr(quantified.Expr)
+	if typeCast, ok := expr.(*sqlparser.TypeCastExpr); ok {
+		expr = unwrapParenExpr(typeCast.Expr)
+	}
+	array, ok := expr.(*sqlparser.ArrayConstructor)
+	return array, ok
 }
 
-func parseIndex(stmt *sqlparser.DDL) (Index, error) {
+// PostgreSQL casts string literals and NULL to text types like `'str'::text`, arrays of them like `ARRAY[...]::text[]`,
+// and varchar columns to text like `(name)::text`. A column cast to its own type is also redundant. Return the casted
+// expression if the cast is one of them, and keep any other casts, which change the value. The type of the column is
+// unknown if it's not given, like in an index expression, and then only text is regarded as an implicit cast of it.
+func unwrapImplicitCast(cast *sqlparser.TypeCastExpr, columnTypes map[string]*sqlparser.ColumnType) (sqlparser.Expr, bool) {
+	switch expr := unwrapParenExpr(cast.Expr).(type) {
+	case *sqlparser.SQLVal:
+		return expr, expr.Type == sqlparser.StrVal && !bool(cast.Type.Array) && isImplicitLiteralType(*cast.Type)
+	case *sqlparser.NullVal:
+		return expr, !bool(cast.Type.Array) && isImplicitLiteralType(*cast.Type)
+	case *sqlparser.ArrayConstructor:
+		return expr, bool(cast.Type.Array) && isImplicitLiteralType(*cast.Type)
+	case *sqlparser.ColName:
+		columnType, ok := columnTypes[expr.Name.String()]
+		if !ok {
+			return expr, isTextType(*cast.Type)
+		}
+		return expr, isSameType(*cast.Type, *columnType) || (isTextType(*cast.Type) && !bool(columnType.Array) && isImplicitLiteralType(*columnType))
+	default:
+		return nil, false
+	}
+}
+
+// Remove a cast of a literal compared with a column, which PostgreSQL adds to the literal like `'2020-01-01'::date`
+// for a date column, only if the cast is to the type of the column.
+func unwrapComparedCast(expr sqlparser.Expr, column sqlparser.Expr, columnTypes map[string]*sqlparser.ColumnType) sqlparser.Expr {
+	colName, ok := unwrapParenExpr(column).(*sqlparser.ColName)
+	if !ok {
+		return expr
+	}
+	columnType, ok := columnTypes[colName.Name.String()]
+	if !ok {
+		return expr
+	}
+	cast, ok := unwrapParenExpr(expr).(*sqlparser.TypeCastExpr)
+	if !ok || !isSameType(*cast.Type, *columnType) {
+		return expr
+	}
+	switch literal := unwrapParenExpr(cast.Expr).(type) {
+	case *sqlparser.SQLVal, *sqlparser.NullVal:
+		return literal
+	default:
+		return expr
+	}
+}
+
+func unwrapComparedCasts(exprs sqlparser.Exprs, column sqlparser.Expr, columnTypes map[string]*sqlparser.ColumnType) sqlparser.Exprs {
+	unwrapped := sqlparser.Exprs{}
+	for _, expr := range exprs {
+		unwrapped = append(unwrapped, unwrapComparedCast(expr, column, columnTypes))
+	}
+	return unwrapped
+}
+
+// Types which PostgreSQL gives to string literals implicitly. pg_dump(1) shows a literal argument of text search
+// functions like `'english'::regconfig`.
+func isImplicitLiteralType(typ sqlparser.ColumnType) bool {
+	switch normalizeDataType(GeneratorModePostgres, typ.Type) {
+	case "text", "character varying", "character", "regconfig":
+		return true
+	default:
+		return false
+	}
+}
+
+func isTextType(typ sqlparser.ColumnType) bool {
+	return normalizeDataType(GeneratorModePostgres, typ.Type) == "text" && !bool(typ.Array)
+}
+
+func unwrapParenExpr(expr sqlparser.Expr) sqlparser.Expr {
+	for {
+		paren, ok := expr.(*sqlparser.ParenExpr)
+		if !ok {
+			return expr
+		}
+		expr = paren.Expr
+	}
+}
+
+// Normalize a view definition to compare it with the one rewritten by databases. MySQL qualifies columns and gives
+// them aliases, and PostgreSQL qualifies columns and tables. They're omitted if they're redundant.
+func normalizeView(mode GeneratorMode, stmt sqlparser.SelectStatement) string {
+	tableName := "" // The only table in FROM, which doesn't need to qualify columns
+	if sel, ok := stmt.(*sqlparser.Select); ok && len(sel.From) == 1 {
+		if tableExpr, ok := sel.From[0].(*sqlparser.AliasedTableExpr); ok && tableExpr.As.IsEmpty() {
+			if table, ok := tableExpr.Expr.(sqlparser.TableName); ok {
+				tableName = table.Name.String()
+			}
+		}
+	}
+
+	buf := sqlparser.NewTrackedBuffer(func(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+		if formatNormalizedExpr(buf, node, nil) {
+			return
+		}
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			if tableName != "" && node.Qualifier.Name.String() == tableName {
+				buf.Myprintf("%v", node.Name)
+			} else {
+				node.Format(buf)
+			}
+		case *sqlparser.AliasedExpr:
+			if column, ok := node.Expr.(*sqlparser.ColName); ok && column.Name.Equal(node.As) {
+				buf.Myprintf("%v", node.Expr)
+			} else {
+				node.Format(buf)
+			}
+		case sqlparser.TableName:
+			if mode == GeneratorModePostgres && node.Qualifier.String() == "public" {
+				buf.Myprintf("%v", node.Name)
+			} else {
+				node.Format(buf)
+			}
+		default:
+			node.Format(buf)
+		}
+	})
+	buf.Myprintf("%v", stmt)
+	return buf.String()
+}
+
+var (
+	renamedTablePattern  = regexp.MustCompile(`^\s*--\s*@renamed\s+from=(\S+)\s*$`)
+	renamedColumnPattern = regexp.MustCompile(`^\s*([^\s,(]+)\s.*--\s*@renamed\s+from=(\S+)\s*$`)
+)
+
+// A table is renamed by a comment like `-- @renamed from=old_name` in the lines of comments above CREATE TABLE.
+func parseRenamedTable(mode GeneratorMode, ddl string) string {
+	for _, line := range strings.Split(ddl, "\n") {
+		if match := renamedTablePattern.FindStringSubmatch(line); match != nil {
+			names := []string{}
+			for _, name := range strings.Split(match[1], ".") {
+				names = append(names, unquoteIdentifier(name))
+			}
+			if mode == GeneratorModePostgres && len(names) == 2 && names[0] == "public" {
+				names = names[1:]
+			}
+			return strings.Join(names, ".")
+		}
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "--") {
+			break // CREATE TABLE is started.
+		}
+	}
+	return ""
+}
+
+// A column is renamed by a comment like `-- @renamed from=old_name` at the end of its line in CREATE TABLE.
+func parseRenamedColumns(ddl string, table *Table) error {
+	for _, line := range strings.Split(ddl, "\n") {
+		match := renamedColumnPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		name, oldName := unquoteIdentifier(match[1]), unquoteIdentifier(match[2])
+		found := false
+		for i, column := range table.columns {
+			if column.name == name {
+				table.columns[i].renamedFrom = oldName
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("column '%s' to be renamed from '%s' is not found in table '%s': '%s'", name, oldName, table.name, strings.TrimSpace(line))
+		}
+	}
+	return nil
+}
+
+func unquoteIdentifier(name string) string {
+	return strings.Trim(name, "`\"")
+}
+
+var (
+	// PostgreSQL's type names in function arguments, normalized to short ones since `pg_get_functiondef` shows
+	// long ones like `timestamp with time zone`.
+	functionTypeAliases = []struct {
+		pattern *regexp.Regexp
+		name    string
+	}{
+		{regexp.MustCompile(`\b(integer|int4)\b`), "int"},
+		{regexp.MustCompile(`\bint8\b`), "bigint"},
+		{regexp.MustCompile(`\bint2\b`), "smallint"},
+		{regexp.MustCompile(`\bboolean\b`), "bool"},
+		{regexp.MustCompile(`\bdouble precision\b`), "float8"},
+		{regexp.MustCompile(`\breal\b`), "float4"},
+		{regexp.MustCompile(`\bdecimal\b`), "numeric"},
+		{regexp.MustCompile(`\bcharacter varying\b`), "varchar"},
+		{regexp.MustCompile(`\btimestamp with time zone\b`), "timestamptz"},
+		{regexp.MustCompile(`\btimestamp without time zone\b`), "timestamp"},
+		{regexp.MustCompile(`\btime with time zone\b`), "timetz"},
+		{regexp.MustCompile(`\btime without time zone\b`), "time"},
+	}
+	functionDefaultCastPattern = regexp.MustCompile(`('(?:[^']|'')*')::[a-z ]+$`)
+	functionQuotedValuePattern = regexp.MustCompile(`'([^']*)'`)
+	defaultFunctionOptions     = []string{
+		"volatile", "called on null input", "security invoker", "parallel unsafe", "not leakproof", "rows 1000",
+	}
+
+	// MySQL 5.7 shows the display width of integers, and 8.0 shows the charset of strings in RETURNS.
+	mysqlRoutineReturnsPattern = regexp.MustCompile(`\b(tinyint|smallint|mediumint|int|bigint)\(\d+\)| charset \S+$`)
+	defaultMysqlRoutineOptions = []string{
+		"language sql", "not deterministic", "contains sql", "sql security definer", "comment ''",
+	}
+)
+
+func parseFunction(mode GeneratorMode, stmt *sqlparser.DDL) Function {
+	spec := stmt.FunctionSpec
+	if mode == GeneratorModeMysql {
+		return parseMysqlRoutine(stmt)
+	}
+
+	arguments := []string{}
+	for _, argument := range spec.Arguments {
+		argument = strings.TrimPrefix(argument, "in ")
+		argument = strings.Replace(argument, " = ", " default ", 1)
+		arguments = append(arguments, functionDefaultCastPattern.ReplaceAllString(normalizeFunctionType(argument), "$1"))
+	}
+
+	options := []string{}
+	for _, option := range spec.Options {
+		option = strings.TrimPrefix(option, "external ")
+		switch {
+		case option == "returns null on null input":
+			option = "strict"
+		case strings.HasPrefix(option, "set "):
+			// PostgreSQL shows `SET name = value` as `SET name TO 'value'`.
+			option = strings.Replace(option, " = ", " to ", 1)
+			option = functionQuotedValuePattern.ReplaceAllString(option, "$1")
+		}
+		// COST is 1 for C functions and 100 for others by default.
+		isDefaultCost := option == "cost 100" && spec.Language != "c" && spec.Language != "internal" ||
+			option == "cost 1" && (spec.Language == "c" || spec.Language == "internal")
+		if !containsString(defaultFunctionOptions, option) && !isDefaultCost {
+			options = append(options, option)
+		}
+	}
+	sort.Strings(options)
+
+	return Function{
+		name:      normalizeTableName(mode, stmt.Table),
+		arguments: arguments,
+		returns:   normalizeFunctionType(spec.Returns),
+		language:  spec.Language,
+		body:      strings.TrimSpace(spec.Body),
+		options:   options,
+	}
+}
+
+// MySQL keeps arguments and the body as they are, but the characteristics and the return type are normalized.
+func parseMysqlRoutine(stmt *sqlparser.DDL) Function {
+	spec := stmt.FunctionSpec
+
+	arguments := []string{}
+	for _, argument := range spec.Arguments {
+		arguments = append(arguments, strings.TrimPrefix(argument, "in "))
+	}
+
+	options := []string{}
+	for _, option := range spec.Options {
+		if !containsString(defaultMysqlRoutineOptions, option) {
+			options = append(options, option)
+		}
+	}
+	sort.Strings(options)
+
+	return Function{
+		name:      stmt.Table.Name.String(),
+		arguments: arguments,
+		returns:   mysqlRoutineReturnsPattern.ReplaceAllString(normalizeFunctionType(spec.Returns), "$1"),
+		body:      strings.Join(strings.Fields(spec.Body), " "),
+		options:   options,
+		procedure: stmt.Action == sqlparser.CreateProcedureStr,
+	}
+}
+
+func normalizeFunctionType(str string) string {
+	for _, alias := range functionTypeAliases {
+		str = alias.pattern.ReplaceAllString(str, alias.name)
+	}
+	return str
+}
+
+func parseTrigger(mode GeneratorMode, stmt *sqlparser.DDL) Trigger {
+	spec := stmt.TriggerSpec
+	events := append([]string{}, spec.Events...)
+	sort.Strings(events)
+
+	forEach := spec.ForEach
+	if forEach == "" {
+		forEach = "statement" // PostgreSQL's default
+	}
+
+	return Trigger{
+		name:      spec.Name.String(),
+		tableName: normalizeTableName(mode, stmt.Table),
+		time:      spec.Time,
+		events:    events,
+		forEach:   forEach,
+		body:      normalizeTriggerBody(mode, spec),
+	}
+}
+
+// Normalize a trigger body to compare it with the one given by databases. MySQL keeps the body as it is, and
+// PostgreSQL qualifies the function and rewrites the condition.
+func normalizeTriggerBody(mode GeneratorMode, spec *sqlparser.TriggerSpec) string {
+	if spec.Execute == nil {
+		return strings.Join(strings.Fields(spec.Body), " ")
+	}
+
+	buf := sqlparser.NewTrackedBuffer(func(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+		if formatNormalizedExpr(buf, node, nil) {
+			return
+		}
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			// PostgreSQL lowercases NEW and OLD.
+			column := *node
+			if qualifier := column.Qualifier.Name.String(); strings.EqualFold(qualifier, "new") || strings.EqualFold(qualifier, "old") {
+				column.Qualifier.Name = sqlparser.NewTableIdent(strings.ToLower(qualifier))
+			}
+			column.Format(buf)
+		default:
+			node.Format(buf)
+		}
+	})
+	if spec.When != nil {
+		buf.Myprintf("when %v ", spec.When)
+	}
+	execute := *spec.Execute
+	if mode == GeneratorModePostgres && execute.Qualifier.String() == "public" {
+		execute.Qualifier = sqlparser.NewTableIdent("")
+	}
+	buf.Myprintf("execute function %v", &execute)
+	return buf.String()
+}
+
+func parseGenerated(gen *sqlparser.GeneratedColumn, columnTypes map[string]*sqlparser.ColumnType) *Generated {
+	if gen == nil {
+		return nil
+	}
+	return &Generated{
+		expr:   normalizeTypedExpr(gen.Expr, columnTypes),
+		stored: gen.Type == sqlparser.StoredStr, // VIRTUAL is the default
+	}
+}
+
+func parseIdentity(spec *sqlparser.IdentitySpec) *Identity {
+	if spec == nil {
+		return nil
+	}
+	identity := Identity{behavior: spec.Behavior}
+	if spec.Sequence != nil {
+		applySequenceSpec(&identity.sequence, spec.Sequence)
+	}
+	return &identity
+}
+
+// Return unique column names referred in the expression.
+func findColumnNamesInExpr(expr sqlparser.Expr) []string {
+	columnNames := []string{}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if colName, ok := node.(*sqlparser.ColName); ok {
+			columnName := colName.Name.String()
+			if !containsString(columnNames, columnName) {
+				columnNames = append(columnNames, columnName)
+			}
+		}
+		return true, nil
+	}, expr)
+	return columnNames
+}
+
+func parseIndex(mode GeneratorMode, stmt *sqlparser.DDL) (Index, error) {
 	if stmt.IndexSpec == nil {
 		return Index{}, fmt.Errorf("stmt.IndexSpec was null on parseIndex: %#v", stmt)
 	}
 
 	indexColumns := []IndexColumn{}
-	for _, colIdent := range stmt.IndexCols {
-		indexColumns = append(
-			indexColumns,
-			IndexColumn{
-				column: colIdent.String(),
-				length: nil,
-			},
-		)
+	for _, indexColumn := range stmt.IndexCols {
+		indexColumns = append(indexColumns, parseIndexColumn(indexColumn))
+	}
+
+	include := []string{}
+	for _, column := range stmt.IndexSpec.Include {
+		include = append(include, column.String())
+	}
+
+	name := stmt.IndexSpec.Name.String()
+	if name == "" {
+		name = generateIndexName(normalizeTableName(mode, stmt.Table), stmt.IndexCols, include)
 	}
 
 	return Index{
-		name:      stmt.IndexSpec.Name.String(),
-		indexType: "", // not supported in parser yet
-		columns:   indexColumns,
-		primary:   false, // not supported in parser yet
-		unique:    stmt.IndexSpec.Unique,
+		name:       name,
+		indexType:  "", // not supported in parser yet
+		columns:    indexColumns,
+		primary:    stmt.IndexSpec.Primary,
+		unique:     stmt.IndexSpec.Unique,
+		constraint: stmt.IndexSpec.Constraint,
+		deferrable: stmt.IndexSpec.Deferrable,
+		fulltext:   stmt.IndexSpec.Fulltext,
+		parser:     strings.ToLower(stmt.IndexSpec.Parser),
+		spatial:    stmt.IndexSpec.Spatial,
+		invisible:  stmt.IndexSpec.Invisible,
+		method:     normalizeIndexMethod(mode, stmt.IndexSpec.Type.Lowered()),
+		include:    include,
+		where:      normalizeIndexWhere(stmt.IndexSpec.Where),
+		tablespace: normalizeTablespace(stmt.IndexSpec.Tablespace),
 	}, nil
 }
 
-// Parse DDL like `CREATE TABLE` or `ALTER TABLE`.
-// This doesn't support destructive DDL like `DROP TABLE`.
-func parseDDL(mode GeneratorMode, ddl string) (DDL, error) {
-	var parserMode sqlparser.ParserMode
-	if mode == GeneratorModePostgres {
-		parserMode = sqlparser.ParserModePostgres
-	} else {
-		parserMode = sqlparser.ParserModeMysql
+// An expression is kept in parentheses like `(lower(email))`, as MySQL's functional key part is written.
+func parseIndexColumn(indexColumn *sqlparser.IndexColumn) IndexColumn {
+	column := IndexColumn{column: indexColumn.Column.String(), length: parseValue(indexColumn.Length)}
+	if indexColumn.Expr != nil {
+		column = IndexColumn{column: fmt.Sprintf("(%s)", normalizeExpr(indexColumn.Expr))}
 	}
 
-	stmt, err := sqlparser.ParseWithMode(ddl, parserMode)
+	column.direction = indexColumn.Direction
+	if column.direction == "" {
+		column.direction = sqlparser.AscScr
+	}
+	// NULLS LAST is the default for ASC, and NULLS FIRST is the default for DESC.
+	if (column.direction == sqlparser.AscScr && indexColumn.NullsOrder != "nulls last") ||
+		(column.direction == sqlparser.DescScr && indexColumn.NullsOrder != "nulls first") {
+		column.nulls = indexColumn.NullsOrder
+	}
+	return column
+}
+
+// PostgreSQL names an unnamed index like `<table>_<column>_idx`, where an expression is named after its function
+// like `lower`, or `expr` if it's not a function call. INCLUDE columns follow the key columns.
+func generateIndexName(tableName string, indexColumns []*sqlparser.IndexColumn, include []string) string {
+	names := []string{}
+	for _, indexColumn := range indexColumns {
+		switch expr := indexColumn.Expr.(type) {
+		case nil:
+			names = append(names, indexColumn.Column.String())
+		case *sqlparser.FuncExpr:
+			names = append(names, expr.Name.Lowered())
+		default:
+			names = append(names, "expr")
+		}
+	}
+	names = append(names, include...)
+	return fmt.Sprintf("%s_%s_idx", unqualifiedName(tableName), strings.Join(names, "_"))
+}
+
+// PostgreSQL's index without USING is btree. MySQL's USING is not managed.
+func normalizeIndexMethod(mode GeneratorMode, method string) string {
+	if mode != GeneratorModePostgres {
+		return ""
+	}
+	if method == "" {
+		return "btree"
+	}
+	return method
+}
+
+// PostgreSQL's pg_default is the same as no TABLESPACE, which pg_dump doesn't give.
+func normalizeTablespace(tablespace string) string {
+	if strings.ToLower(tablespace) == "pg_default" {
+		return ""
+	}
+	return tablespace
+}
+
+func normalizeIndexWhere(where sqlparser.Expr) string {
+	if where == nil {
+		return ""
+	}
+	return normalizeExpr(where)
+}
+
+// Parse DDL like `CREATE TABLE`, `ALTER TABLE` or `DROP TABLE`. Unquoted identifiers are lowercased if foldIdentifiers
+// is true, as PostgreSQL does.
+func parseDDL(mode GeneratorMode, ddl string, foldIdentifiers bool) (DDL, error) {
+	parse := sqlparser.ParseWithMode
+	if foldIdentifiers {
+		parse = sqlparser.ParseFoldedWithMode
+	}
+	stmt, err := parse(ddl, convertParserMode(mode))
 	if err != nil {
 		return nil, err
 	}
@@ -157,43 +1249,191 @@ func parseDDL(mode GeneratorMode, ddl string) (DDL, error) {
 	case *sqlparser.DDL:
 		if stmt.Action == "create" {
 			// TODO: handle other create DDL as error?
+			table := parseTable(mode, stmt)
+			table.renamedFrom = parseRenamedTable(mode, ddl)
+			if err := parseRenamedColumns(ddl, &table); err != nil {
+				return nil, err
+			}
 			return &CreateTable{
 				statement: ddl,
-				table:     parseTable(stmt),
+				table:     table,
 			}, nil
 		} else if stmt.Action == "create index" {
-			index, err := parseIndex(stmt)
+			index, err := parseIndex(mode, stmt)
 			if err != nil {
 				return nil, err
 			}
 			return &CreateIndex{
 				statement: ddl,
-				tableName: stmt.Table.Name.String(),
+				tableName: normalizeTableName(mode, stmt.Table),
 				index:     index,
 			}, nil
 		} else if stmt.Action == "add index" {
-			index, err := parseIndex(stmt)
+			index, err := parseIndex(mode, stmt)
 			if err != nil {
 				return nil, err
 			}
 			return &AddIndex{
 				statement: ddl,
-				tableName: stmt.Table.Name.String(),
+				tableName: normalizeTableName(mode, stmt.Table),
 				index:     index,
 			}, nil
 		} else if stmt.Action == "add primary key" {
-			index, err := parseIndex(stmt)
+			index, err := parseIndex(mode, stmt)
 			if err != nil {
 				return nil, err
 			}
 			return &AddPrimaryKey{
 				statement: ddl,
-				tableName: stmt.Table.Name.String(),
+				tableName: normalizeTableName(mode, stmt.Table),
 				index:     index,
 			}, nil
+		} else if stmt.Action == "add foreign key" {
+			// An unnamed foreign key is named by the generator, which knows existing foreign keys.
+			return &AddForeignKey{
+				statement:  ddl,
+				tableName:  normalizeTableName(mode, stmt.Table),
+				foreignKey: parseForeignKey(mode, stmt.ForeignKey),
+			}, nil
+		} else if stmt.Action == "add exclusion" {
+			tableName := normalizeTableName(mode, stmt.Table)
+			return &AddExclusion{
+				statement: ddl,
+				tableName: tableName,
+				exclusion: parseExclusion(tableName, stmt.Exclusion),
+			}, nil
+		} else if stmt.Action == "drop" {
+			return &DropTable{
+				statement: ddl,
+				tableName: normalizeTableName(mode, stmt.Table),
+				ifExists:  stmt.IfExists,
+			}, nil
+		} else if stmt.Action == "drop index" {
+			return &DropIndex{
+				statement: ddl,
+				tableName: normalizeTableName(mode, stmt.Table),
+				indexName: stmt.IndexSpec.Name.String(),
+				ifExists:  stmt.IfExists,
+			}, nil
+		} else if stmt.Action == "create view" {
+			return &CreateView{
+				statement: ddl,
+				view: View{
+					name:         normalizeTableName(mode, stmt.NewName),
+					definition:   normalizeView(mode, stmt.ViewExpr),
+					materialized: stmt.Materialized,
+				},
+			}, nil
+		} else if stmt.Action == "create function" || stmt.Action == "create procedure" {
+			return &CreateFunction{
+				statement: ddl,
+				function:  parseFunction(mode, stmt),
+			}, nil
+		} else if stmt.Action == "create trigger" {
+			return &CreateTrigger{
+				statement: ddl,
+				trigger:   parseTrigger(mode, stmt),
+			}, nil
+		} else if stmt.Action == "alter column" && stmt.AlterColumn.DefaultNextval != "" {
+			return &SetDefaultSequence{
+				statement:    ddl,
+				tableName:    normalizeTableName(mode, stmt.Table),
+				columnName:   stmt.AlterColumn.Column.String(),
+				sequenceName: stmt.AlterColumn.DefaultNextval,
+			}, nil
+		} else if stmt.Action == "alter column" {
+			return &AddIdentity{
+				statement:  ddl,
+				tableName:  normalizeTableName(mode, stmt.Table),
+				columnName: stmt.AlterColumn.Column.String(),
+				identity:   *parseIdentity(stmt.AlterColumn.Identity),
+			}, nil
+		} else if stmt.Action == "create type" {
+			return &CreateType{
+				statement: ddl,
+				enum:      Enum{name: normalizeTableName(mode, stmt.Table), labels: parseEnumValues(stmt.EnumValues)},
+			}, nil
+		} else if stmt.Action == "create domain" {
+			return &CreateDomain{
+				statement: ddl,
+				domain:    parseDomain(stmt),
+			}, nil
+		} else if stmt.Action == "create schema" {
+			return &CreateSchema{
+				statement: ddl,
+				schema:    Schema{name: stmt.Table.Name.String()},
+			}, nil
+		} else if stmt.Action == "create extension" {
+			return &CreateExtension{
+				statement: ddl,
+				extension: Extension{name: stmt.Table.Name.String()},
+			}, nil
+		} else if stmt.Action == "create sequence" {
+			sequence := Sequence{name: normalizeTableName(mode, stmt.Table)}
+			applySequenceSpec(&sequence, stmt.SequenceSpec)
+			return &CreateSequence{
+				statement: ddl,
+				sequence:  sequence,
+			}, nil
+		} else if stmt.Action == "alter sequence" {
+			return &AlterSequence{
+				statement: ddl,
+				name:      normalizeTableName(mode, stmt.Table),
+				spec:      stmt.SequenceSpec,
+			}, nil
+		} else if stmt.Action == "attach partition" {
+			return &AttachPartition{
+				statement:     ddl,
+				tableName:     normalizeTableName(mode, stmt.Table),
+				partitionName: normalizeTableName(mode, stmt.PartitionSpec.Table),
+				bound:         parsePartitionBound(stmt.PartitionSpec.Bound),
+			}, nil
+		} else if stmt.Action == "row level security" {
+			return &SetRowLevelSecurity{
+				statement: ddl,
+				tableName: normalizeTableName(mode, stmt.Table),
+				action:    stmt.RowLevelSecurity,
+			}, nil
+		} else if stmt.Action == "create policy" {
+			return &CreatePolicy{
+				statement: ddl,
+				policy:    parsePolicy(mode, stmt),
+			}, nil
+		} else if stmt.Action == "create server" {
+			return &CreateServer{
+				statement: ddl,
+				server:    parseServer(stmt),
+			}, nil
+		} else if stmt.Action == "create user mapping" {
+			return &CreateUserMapping{
+				statement:   ddl,
+				userMapping: parseUserMapping(stmt),
+			}, nil
+		} else if stmt.Action == "create foreign table" {
+			return &CreateForeignTable{
+				statement:    ddl,
+				foreignTable: parseForeignTable(mode, stmt),
+			}, nil
+		} else if stmt.Action == "grant" {
+			return &GrantPrivilege{
+				statement:  ddl,
+				privileges: parsePrivileges(mode, stmt),
+			}, nil
+		} else if stmt.Action == "revoke" {
+			return &RevokePrivilege{
+				statement:  ddl,
+				privileges: parsePrivileges(mode, stmt),
+			}, nil
+		} else if stmt.Action == "comment" {
+			return &CommentOn{
+				statement:  ddl,
+				tableName:  normalizeTableName(mode, stmt.Table),
+				columnName: stmt.CommentSpec.Column.String(),
+				comment:    parseComment(stmt.CommentSpec.Comment),
+			}, nil
 		} else {
 			return nil, fmt.Errorf(
-				"unsupported type of DDL action (only 'CREATE TABLE', 'CREATE INDEX' and 'ALTER TABLE ADD INDEX' are supported) '%s': %s",
+				"unsupported type of DDL action (only 'CREATE TABLE', 'CREATE INDEX', 'CREATE VIEW', 'CREATE FUNCTION', 'CREATE PROCEDURE', 'CREATE TRIGGER', 'CREATE SEQUENCE', 'CREATE TYPE', 'CREATE DOMAIN', 'CREATE EXTENSION', 'CREATE SCHEMA', 'CREATE POLICY', 'CREATE SERVER', 'CREATE USER MAPPING', 'CREATE FOREIGN TABLE', 'GRANT', 'REVOKE', 'ALTER SEQUENCE', 'ALTER TABLE ADD INDEX', 'ALTER TABLE ADD FOREIGN KEY', 'ALTER TABLE ADD EXCLUDE', 'ALTER TABLE ATTACH PARTITION', 'ALTER TABLE ALTER COLUMN ADD GENERATED', 'ALTER TABLE ALTER COLUMN SET DEFAULT nextval', 'ALTER TABLE ENABLE ROW LEVEL SECURITY', 'DROP TABLE', 'DROP INDEX' and 'COMMENT ON' are supported) '%s': %s",
 				stmt.Action, ddl,
 			)
 		}
@@ -202,10 +1442,13 @@ func parseDDL(mode GeneratorMode, ddl string) (DDL, error) {
 	}
 }
 
-// Parse `ddls`, which is expected to `;`-concatenated DDLs
-// and not to include destructive DDL.
-func parseDDLs(mode GeneratorMode, str string) ([]DDL, error) {
-	ddls := strings.Split(str, ";")
+// Parse `ddls`, which is expected to `;`-concatenated DDLs.
+func parseDDLs(mode GeneratorMode, str string, foldIdentifiers bool) ([]DDL, error) {
+	// Split by tokenizer to ignore `;` in string literals, comments, etc.
+	ddls, err := sqlparser.SplitStatementToPiecesWithMode(str, convertParserMode(mode))
+	if err != nil {
+		return nil, err
+	}
 	result := []DDL{}
 
 	for _, ddl := range ddls {
@@ -214,11 +1457,119 @@ func parseDDLs(mode GeneratorMode, str string) ([]DDL, error) {
 			continue
 		}
 
-		parsed, err := parseDDL(mode, ddl)
+		parsed, err := parseDDL(mode, ddl, foldIdentifiers)
 		if err != nil {
 			return result, err
 		}
+		if createTable, ok := parsed.(*CreateTable); ok && createTable.table.like != "" {
+			if err := expandLikeTable(mode, createTable, result); err != nil {
+				return result, err
+			}
+		}
 		result = append(result, parsed)
 	}
 	return result, nil
 }
+
+// Expand `CREATE TABLE ... LIKE` to a copy of the table with its indexes given before it. Like MySQL, foreign keys
+// and AUTO_INCREMENT are not copied, and check constraints are renamed after the new table.
+func expandLikeTable(mode GeneratorMode, createTable *CreateTable, ddls []DDL) error {
+	if mode != GeneratorModeMysql {
+		return fmt.Errorf("CREATE TABLE ... LIKE is supported only for MySQL: '%s'", createTable.statement)
+	}
+
+	var source *Table
+	indexes := []Index{}
+	for _, ddl := range ddls {
+		switch stmt := ddl.(type) {
+		case *CreateTable:
+			if stmt.table.name == createTable.table.like {
+				table := stmt.table // copy table
+				source = &table
+				indexes = append([]Index{}, table.indexes...)
+			}
+		case *CreateIndex:
+			if stmt.tableName == createTable.table.like {
+				indexes = append(indexes, withIndexType(stmt.index))
+			}
+		case *AddIndex:
+			if stmt.tableName == createTable.table.like {
+				indexes = append(indexes, withIndexType(stmt.index))
+			}
+		case *AddPrimaryKey:
+			if stmt.tableName == createTable.table.like {
+				indexes = append(indexes, withIndexType(stmt.index))
+			}
+		}
+	}
+	if source == nil {
+		return fmt.Errorf("CREATE TABLE ... LIKE is performed before CREATE TABLE '%s': '%s'", createTable.table.like, createTable.statement)
+	}
+
+	table := createTable.table
+	table.columns = []Column{}
+	for _, column := range source.columns {
+		column.renamedFrom = ""
+		table.columns = append(table.columns, column)
+	}
+	table.indexes = indexes
+	table.checks = []Check{}
+	for i, check := range source.checks {
+		check.constraintName = fmt.Sprintf("%s_chk_%d", table.name, i+1)
+		table.checks = append(table.checks, check)
+	}
+	table.options = map[string]string{}
+	for name, value := range source.options {
+		if name != "AUTO_INCREMENT" {
+			table.options[name] = value
+		}
+	}
+	table.comment = source.comment
+	table.partition = source.partition
+	table.systemVersioning = source.systemVersioning
+	table.like = ""
+	createTable.table = table
+	return nil
+}
+
+// An index given by `CREATE INDEX` or `ALTER TABLE` doesn't have the type of `CREATE TABLE`, which is needed to
+// add it to a table copied by `CREATE TABLE ... LIKE`.
+func withIndexType(index Index) Index {
+	if index.indexType != "" {
+		return index
+	}
+	if index.primary {
+		index.indexType = "primary key"
+	} else if index.fulltext {
+		index.indexType = "fulltext key"
+	} else if index.spatial {
+		index.indexType = "spatial key"
+	} else if index.unique {
+		index.indexType = "unique key"
+	} else {
+		index.indexType = "key"
+	}
+	return index
+}
+
+// Qualify the name by its schema unless it's PostgreSQL's public schema. MySQL's qualifier is a database,
+// which is not managed.
+func normalizeTableName(mode GeneratorMode, tableName sqlparser.TableName) string {
+	if mode == GeneratorModePostgres && !tableName.Qualifier.IsEmpty() && tableName.Qualifier.String() != "public" {
+		return tableName.Qualifier.String() + "." + tableName.Name.String()
+	}
+	return tableName.Name.String()
+}
+
+// Return the name without its schema.
+func unqualifiedName(name string) string {
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+func convertParserMode(mode GeneratorMode) sqlparser.ParserMode {
+	if mode == GeneratorModePostgres {
+		return sqlparser.ParserModePostgres
+	} else {
+		return sqlparser.ParserModeMysql
+	}
+}
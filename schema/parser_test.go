@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestImplicitCast(t *testing.T) {
+	testCases := []struct {
+		name    string
+		desired string
+		current string
+		ddls    []string
+	}{{
+		name:    "a literal casted to the type of the compared column",
+		desired: "CREATE TABLE t (d date CHECK (d > '2020-01-01'));",
+		current: "CREATE TABLE t (d date, CONSTRAINT t_d_check CHECK ((d > '2020-01-01'::date)));",
+	}, {
+		name:    "a literal casted to another type than the compared column",
+		desired: "CREATE TABLE t (d date CHECK (d > '2020-01-01'::timestamp));",
+		current: "CREATE TABLE t (d date, CONSTRAINT t_d_check CHECK ((d > '2020-01-01'::date)));",
+		ddls: []string{
+			"ALTER TABLE t DROP CONSTRAINT t_d_check",
+			"ALTER TABLE t ADD CONSTRAINT t_d_check CHECK ((d > '2020-01-01'::timestamp))",
+		},
+	}, {
+		name:    "literals in IN casted to the type of the column",
+		desired: "CREATE TABLE t (d date CHECK (d IN ('2020-01-01', '2020-01-02')));",
+		current: "CREATE TABLE t (d date, CONSTRAINT t_d_check CHECK ((d = ANY (ARRAY['2020-01-01'::date, '2020-01-02'::date]))));",
+	}, {
+		name:    "varchar columns and literals casted to text",
+		desired: "CREATE TABLE t (a varchar(20), b text GENERATED ALWAYS AS (a || ' ') STORED, CHECK (a <> ''));",
+		current: "CREATE TABLE t (a character varying(20), b text GENERATED ALWAYS AS (((a)::text || ' '::text)) STORED, " +
+			"CONSTRAINT t_a_check CHECK (((a)::text <> ''::text)));",
+	}, {
+		name:    "a column casted to another type",
+		desired: "CREATE TABLE t (price numeric, p integer GENERATED ALWAYS AS ((price)::int) STORED);",
+		current: "CREATE TABLE t (price numeric, p integer GENERATED ALWAYS AS (price) STORED);",
+		ddls: []string{
+			"ALTER TABLE t DROP COLUMN p",
+			"ALTER TABLE t ADD COLUMN p integer GENERATED ALWAYS AS (price::int) STORED",
+		},
+	}, {
+		name:    "a column casted in an index expression",
+		desired: "CREATE TABLE t (price numeric); CREATE INDEX i ON t ((price::int));",
+		current: "CREATE TABLE t (price numeric); CREATE INDEX i ON t USING btree (price);",
+		ddls: []string{
+			"DROP INDEX i",
+			"CREATE INDEX i ON t ((price::int))",
+		},
+	}, {
+		name:    "a text search configuration casted to regconfig",
+		desired: "CREATE TABLE t (title varchar(100)); CREATE INDEX i ON t USING gin (to_tsvector('english', title));",
+		current: "CREATE TABLE t (title character varying(100)); CREATE INDEX i ON t USING gin (to_tsvector('english'::regconfig, (title)::text));",
+	}}
+	for _, tc := range testCases {
+		ddls, _, err := GenerateIdempotentDDLs(GeneratorModePostgres, tc.desired, tc.current, GeneratorConfig{})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+			continue
+		}
+		if got, want := strings.Join(ddls, ";\n"), strings.Join(tc.ddls, ";\n"); got != want {
+			t.Errorf("%s: DDLs:\n%s\nwant:\n%s", tc.name, got, want)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/k0kubun/sqldef/sqlparser"
+)
+
+var partitionPolicyPattern = regexp.MustCompile(`^--\s*sqldef:partition(?:\s+(.*))?$`)
+
+// Time-based partitions of PostgreSQL's `PARTITION BY RANGE` table, given by a comment like
+// `-- sqldef:partition measurement interval=month lookahead=3 retention=12 expire=detach`.
+// Partitions are named `<table>_p<YYYYMMDD>` after the start of their range.
+type PartitionPolicy struct {
+	tableName string
+	interval  string // day, week, month or year
+	lookahead int    // The number of future partitions created in advance
+	retention int    // The number of past partitions kept. -1 keeps all of them.
+	detach    bool   // Detach expired partitions instead of dropping them
+}
+
+// Policies are given only by comments, not by the same text in string literals or function bodies.
+func parsePartitionPolicies(mode GeneratorMode, sql string) ([]PartitionPolicy, error) {
+	policies := []PartitionPolicy{}
+	tokenizer := sqlparser.NewStringTokenizer(sql, convertParserMode(mode))
+	for {
+		tkn, val := tokenizer.Scan()
+		if tkn == 0 || tkn == sqlparser.LEX_ERROR {
+			break // A syntax error is given by the parser.
+		}
+		match := partitionPolicyPattern.FindStringSubmatch(strings.TrimSpace(string(val)))
+		if tkn != sqlparser.COMMENT || match == nil {
+			continue
+		}
+
+		fields := strings.Fields(match[1])
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("table name is not given in partition policy: %s", match[0])
+		}
+
+		policy := PartitionPolicy{tableName: fields[0], interval: "month", lookahead: 1, retention: -1}
+		for _, field := range fields[1:] {
+			pair := strings.SplitN(field, "=", 2)
+			if len(pair) != 2 {
+				return nil, fmt.Errorf("unexpected option '%s' in partition policy: %s", field, match[0])
+			}
+
+			var err error
+			switch name, value := pair[0], pair[1]; name {
+			case "interval":
+				if value != "day" && value != "week" && value != "month" && value != "year" {
+					return nil, fmt.Errorf("interval must be day, week, month or year, but got '%s': %s", value, match[0])
+				}
+				policy.interval = value
+			case "lookahead":
+				policy.lookahead, err = strconv.Atoi(value)
+			case "retention":
+				policy.retention, err = strconv.Atoi(value)
+			case "expire":
+				if value != "drop" && value != "detach" {
+					return nil, fmt.Errorf("expire must be drop or detach, but got '%s': %s", value, match[0])
+				}
+				policy.detach = value == "detach"
+			default:
+				return nil, fmt.Errorf("unexpected option '%s' in partition policy: %s", name, match[0])
+			}
+			if err != nil || policy.lookahead < 0 || policy.retention < -1 {
+				return nil, fmt.Errorf("invalid number is given to '%s' in partition policy: %s", field, match[0])
+			}
+		}
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
+
+// Generate `CREATE TABLE ... PARTITION OF` of the current range and lookahead ones, which are not defined explicitly.
+func (p PartitionPolicy) generatePartitionDDLs(mode GeneratorMode, desiredDDLs []DDL, now time.Time) ([]DDL, error) {
+	parent := findCreateTableByName(desiredDDLs, p.tableName)
+	if parent == nil {
+		return nil, fmt.Errorf("partition policy is given for inexistent table '%s'", p.tableName)
+	}
+	if !strings.HasPrefix(parent.table.partition, "PARTITION BY RANGE ") {
+		return nil, fmt.Errorf("partition policy is given for table '%s' without PARTITION BY RANGE", p.tableName)
+	}
+
+	g := Generator{mode: mode} // to escape names
+	ddls := []DDL{}
+	start := p.truncate(now)
+	for i := 0; i <= p.lookahead; i++ {
+		name := p.partitionName(start)
+		end := p.add(start, 1)
+		if findCreateTableByName(desiredDDLs, name) == nil {
+			ddl, err := parseDDL(mode, fmt.Sprintf(
+				"CREATE TABLE %s PARTITION OF %s FOR VALUES FROM ('%s') TO ('%s')",
+				g.escapeTableName(name), g.escapeTableName(p.tableName), start.Format("2006-01-02"), end.Format("2006-01-02"),
+			), false)
+			if err != nil {
+				return nil, err
+			}
+			ddls = append(ddls, ddl)
+		}
+		start = end
+	}
+	return ddls, nil
+}
+
+// Return true if the table is a partition managed by the policy and its range ended before the retention.
+func (p PartitionPolicy) isExpired(tableName string, now time.Time) bool {
+	start, ok := p.parsePartitionName(tableName)
+	if !ok || p.retention < 0 {
+		return false
+	}
+
+	return start.Before(p.add(p.truncate(now), -p.retention))
+}
+
+func (p PartitionPolicy) partitionName(start time.Time) string {
+	return fmt.Sprintf("%s_p%s", p.tableName, start.Format("20060102"))
+}
+
+func (p PartitionPolicy) parsePartitionName(tableName string) (time.Time, bool) {
+	prefix := p.tableName + "_p"
+	if !strings.HasPrefix(tableName, prefix) {
+		return time.Time{}, false
+	}
+	start, err := time.Parse("20060102", strings.TrimPrefix(tableName, prefix))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return start, true
+}
+
+// Return the start of the range including the time.
+func (p PartitionPolicy) truncate(t time.Time) time.Time {
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch p.interval {
+	case "week":
+		return date.AddDate(0, 0, -(int(date.Weekday())+6)%7) // Monday
+	case "month":
+		return date.AddDate(0, 0, 1-date.Day())
+	case "year":
+		return date.AddDate(0, 0, 1-date.YearDay())
+	default:
+		return date
+	}
+}
+
+func (p PartitionPolicy) add(start time.Time, n int) time.Time {
+	switch p.interval {
+	case "week":
+		return start.AddDate(0, 0, 7*n)
+	case "month":
+		return start.AddDate(0, n, 0)
+	case "year":
+		return start.AddDate(n, 0, 0)
+	default:
+		return start.AddDate(0, 0, n)
+	}
+}
+
+func findPartitionPolicy(policies []PartitionPolicy, tableName string) *PartitionPolicy {
+	for _, policy := range policies {
+		if _, ok := policy.parsePartitionName(tableName); ok {
+			return &policy
+		}
+	}
+	return nil
+}
+
+func findCreateTableByName(ddls []DDL, tableName string) *CreateTable {
+	for _, ddl := range ddls {
+		if createTable, ok := ddl.(*CreateTable); ok && createTable.table.name == tableName {
+			return createTable
+		}
+	}
+	return nil
+}
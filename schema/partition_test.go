@@ -0,0 +1,129 @@
+package schema
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const measurement = `
+CREATE TABLE measurement (
+  city_id integer NOT NULL,
+  logdate date NOT NULL
+) PARTITION BY RANGE (logdate);
+`
+
+func TestPartitionPolicy(t *testing.T) {
+	now := time.Date(2024, 2, 15, 12, 0, 0, 0, time.UTC) // Thursday
+	testCases := []struct {
+		name    string
+		policy  string
+		current string
+		config  GeneratorConfig
+		ddls    []string
+		skipped []string
+	}{{
+		name:   "monthly partitions are created with lookahead",
+		policy: "-- sqldef:partition measurement interval=month lookahead=2",
+		ddls: []string{
+			"CREATE TABLE measurement_p20240201 PARTITION OF measurement FOR VALUES FROM ('2024-02-01') TO ('2024-03-01')",
+			"CREATE TABLE measurement_p20240301 PARTITION OF measurement FOR VALUES FROM ('2024-03-01') TO ('2024-04-01')",
+			"CREATE TABLE measurement_p20240401 PARTITION OF measurement FOR VALUES FROM ('2024-04-01') TO ('2024-05-01')",
+		},
+	}, {
+		name:   "weekly partitions start on Monday",
+		policy: "-- sqldef:partition measurement interval=week lookahead=0",
+		ddls: []string{
+			"CREATE TABLE measurement_p20240212 PARTITION OF measurement FOR VALUES FROM ('2024-02-12') TO ('2024-02-19')",
+		},
+	}, {
+		name:   "yearly partitions start on January 1st",
+		policy: "-- sqldef:partition measurement interval=year lookahead=0",
+		ddls: []string{
+			"CREATE TABLE measurement_p20240101 PARTITION OF measurement FOR VALUES FROM ('2024-01-01') TO ('2025-01-01')",
+		},
+	}, {
+		name:    "expired partitions are dropped with EnableDropTable",
+		policy:  "-- sqldef:partition measurement interval=month lookahead=0 retention=1",
+		current: currentPartitions("20231101", "20231201", "20240101", "20240201"),
+		config:  GeneratorConfig{EnableDropTable: true},
+		ddls: []string{
+			"DROP TABLE measurement_p20231101",
+			"DROP TABLE measurement_p20231201",
+		},
+	}, {
+		name:    "expired partitions are not dropped without EnableDropTable",
+		policy:  "-- sqldef:partition measurement interval=month lookahead=0 retention=1",
+		current: currentPartitions("20231201", "20240101", "20240201"),
+		skipped: []string{"DROP TABLE measurement_p20231201"},
+	}, {
+		name:    "expired partitions are detached",
+		policy:  "-- sqldef:partition measurement interval=month lookahead=0 retention=1 expire=detach",
+		current: currentPartitions("20231201", "20240101", "20240201"),
+		ddls: []string{
+			"ALTER TABLE measurement DETACH PARTITION measurement_p20231201",
+		},
+	}, {
+		name:    "partitions are kept without retention",
+		policy:  "-- sqldef:partition measurement interval=month lookahead=0",
+		current: currentPartitions("20200101", "20240201"),
+		config:  GeneratorConfig{EnableDropTable: true},
+	}, {
+		name: "policy in a function body is ignored",
+		policy: "CREATE FUNCTION f() RETURNS text AS $$\n" +
+			"-- sqldef:partition measurement interval=day\n" +
+			"SELECT 'x';\n" +
+			"$$ LANGUAGE sql;\n" +
+			"CREATE FUNCTION g() RETURNS text AS $$ SELECT '-- sqldef:partition measurement' $$ LANGUAGE sql;",
+		current: "CREATE FUNCTION f() RETURNS text AS $$\n" +
+			"-- sqldef:partition measurement interval=day\n" +
+			"SELECT 'x';\n" +
+			"$$ LANGUAGE sql;\n" +
+			"CREATE FUNCTION g() RETURNS text AS $$ SELECT '-- sqldef:partition measurement' $$ LANGUAGE sql;\n" +
+			measurement,
+	}}
+	for _, tc := range testCases {
+		current := tc.current
+		if current == "" {
+			current = measurement
+		}
+		tc.config.Now = now
+		ddls, skipped, err := GenerateIdempotentDDLs(GeneratorModePostgres, tc.policy+measurement, current, tc.config)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+			continue
+		}
+		if got, want := strings.Join(ddls, ";\n"), strings.Join(tc.ddls, ";\n"); got != want {
+			t.Errorf("%s: DDLs:\n%s\nwant:\n%s", tc.name, got, want)
+		}
+		if got, want := strings.Join(skipped, ";\n"), strings.Join(tc.skipped, ";\n"); got != want {
+			t.Errorf("%s: skipped DDLs:\n%s\nwant:\n%s", tc.name, got, want)
+		}
+	}
+}
+
+func TestPartitionPolicyError(t *testing.T) {
+	for _, policy := range []string{
+		"-- sqldef:partition",
+		"-- sqldef:partition measurement interval=hour",
+		"-- sqldef:partition measurement lookahead=-1",
+		"-- sqldef:partition measurement expire=truncate",
+		"-- sqldef:partition measurement unknown=1",
+		"-- sqldef:partition unknown",
+	} {
+		_, _, err := GenerateIdempotentDDLs(GeneratorModePostgres, policy+measurement, measurement, GeneratorConfig{})
+		if err == nil {
+			t.Errorf("expected an error for '%s'", policy)
+		}
+	}
+}
+
+func currentPartitions(starts ...string) string {
+	current := measurement
+	for _, start := range starts {
+		from, _ := time.Parse("20060102", start)
+		current += "CREATE TABLE measurement_p" + start + " PARTITION OF measurement FOR VALUES FROM ('" +
+			from.Format("2006-01-02") + "') TO ('" + from.AddDate(0, 1, 0).Format("2006-01-02") + "');\n"
+	}
+	return current
+}
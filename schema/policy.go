@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/k0kubun/sqldef/sqlparser"
+)
+
+func parsePolicy(mode GeneratorMode, stmt *sqlparser.DDL) Policy {
+	spec := stmt.PolicySpec
+	roles := append([]string{}, spec.Roles...)
+	if len(roles) == 0 {
+		roles = []string{"public"}
+	}
+	sort.Strings(roles)
+
+	policy := Policy{
+		name:       spec.Name.String(),
+		tableName:  normalizeTableName(mode, stmt.Table),
+		permissive: spec.Permissive,
+		command:    spec.Command,
+		roles:      roles,
+	}
+	if spec.Using != nil {
+		policy.using = normalizeExpr(spec.Using)
+	}
+	if spec.WithCheck != nil {
+		policy.withCheck = normalizeExpr(spec.WithCheck)
+	}
+	return policy
+}
+
+// Apply `ALTER TABLE ... ROW LEVEL SECURITY` to the table.
+func applyRowLevelSecurity(table *Table, action string) {
+	switch action {
+	case "enable":
+		table.rowSecurity = true
+	case "disable":
+		table.rowSecurity = false
+	case "force":
+		table.forceRowSecurity = true
+	case "no force":
+		table.forceRowSecurity = false
+	}
+}
+
+// Generate `ALTER TABLE ... ROW LEVEL SECURITY` to change row-level security of the table.
+func (g *Generator) generateDDLsForRowLevelSecurity(currentTable Table, desiredTable Table) []string {
+	ddls := []string{}
+	if currentTable.rowSecurity != desiredTable.rowSecurity {
+		action := "DISABLE"
+		if desiredTable.rowSecurity {
+			action = "ENABLE"
+		}
+		ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s %s ROW LEVEL SECURITY", g.escapeTableName(currentTable.name), action))
+	}
+	if currentTable.forceRowSecurity != desiredTable.forceRowSecurity {
+		action := "NO FORCE"
+		if desiredTable.forceRowSecurity {
+			action = "FORCE"
+		}
+		ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s %s ROW LEVEL SECURITY", g.escapeTableName(currentTable.name), action))
+	}
+	return ddls
+}
+
+func (g *Generator) generateDropPolicy(policy Policy) string {
+	return fmt.Sprintf("DROP POLICY %s ON %s", g.escapeSQLName(policy.name), g.escapeTableName(policy.tableName))
+}
+
+func areSamePolicies(policyA Policy, policyB Policy) bool {
+	return policyA.permissive == policyB.permissive && policyA.command == policyB.command &&
+		strings.Join(policyA.roles, ",") == strings.Join(policyB.roles, ",") &&
+		policyA.using == policyB.using && policyA.withCheck == policyB.withCheck
+}
+
+func convertDDLsToPolicies(ddls []DDL) []*Policy {
+	policies := []*Policy{}
+	for _, ddl := range ddls {
+		if createPolicy, ok := ddl.(*CreatePolicy); ok {
+			policy := createPolicy.policy // copy policy
+			policies = append(policies, &policy)
+		}
+	}
+	return policies
+}
+
+// A policy name is unique in its table.
+func findPolicy(policies []*Policy, tableName string, name string) *Policy {
+	for _, policy := range policies {
+		if policy.tableName == tableName && policy.name == name {
+			return policy
+		}
+	}
+	return nil
+}
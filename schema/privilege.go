@@ -0,0 +1,169 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/k0kubun/sqldef/sqlparser"
+)
+
+// Privileges given by ALL PRIVILEGES for each type of objects
+var allPrivileges = map[string][]string{
+	"table":    {"select", "insert", "update", "delete", "truncate", "references", "trigger"},
+	"sequence": {"usage", "select", "update"},
+}
+
+// Expand `GRANT` or `REVOKE` to a privilege for each grantee and privilege.
+func parsePrivileges(mode GeneratorMode, stmt *sqlparser.DDL) []Privilege {
+	spec := stmt.GrantSpec
+	names := []string{}
+	for _, name := range spec.Privileges {
+		if name == "all" {
+			names = append(names, allPrivileges[spec.ObjectType]...)
+		} else {
+			names = append(names, name)
+		}
+	}
+
+	privileges := []Privilege{}
+	for _, grantee := range spec.Grantees {
+		for _, name := range names {
+			privileges = append(privileges, Privilege{
+				objectType:  spec.ObjectType,
+				objectName:  normalizeTableName(mode, stmt.Table),
+				grantee:     grantee,
+				privilege:   name,
+				grantOption: spec.WithGrantOption,
+			})
+		}
+	}
+	return privileges
+}
+
+// Generate `GRANT` and `REVOKE` to make privileges the desired ones. Privileges of objects which are not desired
+// are not revoked, since they're dropped together.
+func (g *Generator) generateDDLsForPrivileges() []string {
+	revokes := []Privilege{}
+	revokedOptions := []Privilege{}
+	for _, currentPrivilege := range g.currentPrivileges {
+		if !g.isDesiredObject(currentPrivilege.objectName) {
+			continue
+		}
+		if desiredPrivilege := findPrivilege(g.desiredPrivileges, currentPrivilege); desiredPrivilege == nil {
+			revokes = append(revokes, currentPrivilege)
+		} else if currentPrivilege.grantOption && !desiredPrivilege.grantOption {
+			revokedOptions = append(revokedOptions, currentPrivilege)
+		}
+	}
+
+	grants := []Privilege{}
+	for _, desiredPrivilege := range g.desiredPrivileges {
+		if currentPrivilege := findPrivilege(g.currentPrivileges, desiredPrivilege); currentPrivilege == nil ||
+			(desiredPrivilege.grantOption && !currentPrivilege.grantOption) {
+			grants = append(grants, desiredPrivilege)
+		}
+	}
+
+	ddls := []string{}
+	for _, group := range groupPrivileges(revokes) {
+		ddls = append(ddls, fmt.Sprintf("REVOKE %s FROM %s", g.formatPrivileges(group), g.escapeRoleName(group[0].grantee)))
+	}
+	for _, group := range groupPrivileges(revokedOptions) {
+		ddls = append(ddls, fmt.Sprintf("REVOKE GRANT OPTION FOR %s FROM %s", g.formatPrivileges(group), g.escapeRoleName(group[0].grantee)))
+	}
+	for _, group := range groupPrivileges(grants) {
+		ddl := fmt.Sprintf("GRANT %s TO %s", g.formatPrivileges(group), g.escapeRoleName(group[0].grantee))
+		if group[0].grantOption {
+			ddl += " WITH GRANT OPTION"
+		}
+		ddls = append(ddls, ddl)
+	}
+	return ddls
+}
+
+// Return true if the table, view or sequence is given by desired DDLs, including the sequence of a serial column.
+func (g *Generator) isDesiredObject(name string) bool {
+	if findTableByName(g.desiredTables, name) != nil || findViewByName(g.desiredViews, name) != nil ||
+		findSequenceByName(g.desiredSequences, name) != nil {
+		return true
+	}
+	for _, table := range g.desiredTables {
+		for _, column := range table.columns {
+			if isSerialType(column.typeName) && serialSequenceName(table.name, column.name) == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Group privileges by the object, the grantee and the grant option, which can be given by a single statement.
+func groupPrivileges(privileges []Privilege) [][]Privilege {
+	groups := [][]Privilege{}
+	indexes := map[string]int{}
+	for _, privilege := range privileges {
+		key := fmt.Sprintf("%s %s %s %t", privilege.objectType, privilege.objectName, privilege.grantee, privilege.grantOption)
+		if i, ok := indexes[key]; ok {
+			groups[i] = append(groups[i], privilege)
+		} else {
+			indexes[key] = len(groups)
+			groups = append(groups, []Privilege{privilege})
+		}
+	}
+	return groups
+}
+
+// Return `SELECT, INSERT ON TABLE name` of grouped privileges.
+func (g *Generator) formatPrivileges(privileges []Privilege) string {
+	names := []string{}
+	for _, privilege := range privileges {
+		names = append(names, strings.ToUpper(privilege.privilege))
+	}
+	return fmt.Sprintf("%s ON %s %s", strings.Join(names, ", "), strings.ToUpper(privileges[0].objectType), g.escapeTableName(privileges[0].objectName))
+}
+
+// Apply `GRANT` and `REVOKE` in order. A privilege granted again with GRANT OPTION keeps it.
+func convertDDLsToPrivileges(ddls []DDL) []Privilege {
+	privileges := []Privilege{}
+	for _, ddl := range ddls {
+		switch stmt := ddl.(type) {
+		case *GrantPrivilege:
+			for _, privilege := range stmt.privileges {
+				if existing := findPrivilege(privileges, privilege); existing != nil {
+					existing.grantOption = existing.grantOption || privilege.grantOption
+				} else {
+					privileges = append(privileges, privilege)
+				}
+			}
+		case *RevokePrivilege:
+			for _, privilege := range stmt.privileges {
+				privileges = removePrivilege(privileges, privilege)
+			}
+		}
+	}
+	return privileges
+}
+
+func removePrivilege(privileges []Privilege, target Privilege) []Privilege {
+	result := []Privilege{}
+	for _, privilege := range privileges {
+		if !isSamePrivilegeTarget(privilege, target) {
+			result = append(result, privilege)
+		}
+	}
+	return result
+}
+
+func findPrivilege(privileges []Privilege, target Privilege) *Privilege {
+	for i := range privileges {
+		if isSamePrivilegeTarget(privileges[i], target) {
+			return &privileges[i]
+		}
+	}
+	return nil
+}
+
+// Compare privileges regardless of the grant option.
+func isSamePrivilegeTarget(a Privilege, b Privilege) bool {
+	return a.objectType == b.objectType && a.objectName == b.objectName && a.grantee == b.grantee && a.privilege == b.privilege
+}
@@ -0,0 +1,21 @@
+package schema
+
+func convertDDLsToSchemas(ddls []DDL) []*Schema {
+	schemas := []*Schema{}
+	for _, ddl := range ddls {
+		if createSchema, ok := ddl.(*CreateSchema); ok {
+			schema := createSchema.schema // copy schema
+			schemas = append(schemas, &schema)
+		}
+	}
+	return schemas
+}
+
+func findSchemaByName(schemas []*Schema, name string) *Schema {
+	for _, schema := range schemas {
+		if schema.name == name {
+			return schema
+		}
+	}
+	return nil
+}
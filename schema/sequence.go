@@ -0,0 +1,281 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/k0kubun/sqldef/sqlparser"
+)
+
+var (
+	sequenceTypeAliases = map[string]string{
+		"int2": "smallint",
+		"int":  "integer",
+		"int4": "integer",
+		"int8": "bigint",
+	}
+	serialTypes = map[string]string{
+		"smallint": "smallserial",
+		"integer":  "serial",
+		"bigint":   "bigserial",
+	}
+	sequenceTypeLimits = map[string][2]string{
+		"smallint": {"-32768", "32767"},
+		"integer":  {"-2147483648", "2147483647"},
+		"bigint":   {"-9223372036854775808", "9223372036854775807"},
+	}
+)
+
+// Apply options of `CREATE SEQUENCE` or `ALTER SEQUENCE` to the sequence. Unspecified options are kept.
+func applySequenceSpec(sequence *Sequence, spec *sqlparser.SequenceSpec) {
+	if spec.Type != "" {
+		sequence.dataType = normalizeSequenceType(spec.Type)
+	}
+	if spec.IncrementBy != "" {
+		sequence.incrementBy = spec.IncrementBy
+	}
+	if spec.MinValue != "" || spec.NoMinValue {
+		sequence.minValue = spec.MinValue
+	}
+	if spec.MaxValue != "" || spec.NoMaxValue {
+		sequence.maxValue = spec.MaxValue
+	}
+	if spec.StartWith != "" {
+		sequence.startWith = spec.StartWith
+	}
+	if spec.Cache != "" {
+		sequence.cache = spec.Cache
+	}
+	if spec.Cycle != "" {
+		sequence.cycle = spec.Cycle == "cycle"
+	}
+	if spec.OwnedBy != nil {
+		// OWNED BY may be qualified by a schema like `public.users.id`.
+		if spec.OwnedBy.Qualifier.IsEmpty() {
+			sequence.ownedBy = "" // OWNED BY NONE
+		} else {
+			sequence.ownedBy = normalizeTableName(GeneratorModePostgres, spec.OwnedBy.Qualifier) + "." + spec.OwnedBy.Name.String()
+		}
+	}
+}
+
+func normalizeSequenceType(dataType string) string {
+	if alias, ok := sequenceTypeAliases[dataType]; ok {
+		return alias
+	}
+	return dataType
+}
+
+// Fill the defaults of options, which depend on the type and the direction of the sequence.
+func normalizeSequence(sequence Sequence) Sequence {
+	if sequence.dataType == "" {
+		sequence.dataType = "bigint"
+	}
+	if sequence.incrementBy == "" {
+		sequence.incrementBy = "1"
+	}
+	limits := sequenceTypeLimits[sequence.dataType]
+	descending := strings.HasPrefix(sequence.incrementBy, "-")
+	if sequence.minValue == "" {
+		if descending {
+			sequence.minValue = limits[0]
+		} else {
+			sequence.minValue = "1"
+		}
+	}
+	if sequence.maxValue == "" {
+		if descending {
+			sequence.maxValue = "-1"
+		} else {
+			sequence.maxValue = limits[1]
+		}
+	}
+	if sequence.startWith == "" {
+		if descending {
+			sequence.startWith = sequence.maxValue
+		} else {
+			sequence.startWith = sequence.minValue
+		}
+	}
+	if sequence.cache == "" {
+		sequence.cache = "1"
+	}
+	return sequence
+}
+
+// Generate `ALTER SEQUENCE` to change the options which are different from the current ones.
+func (g *Generator) generateDDLsForAlterSequence(currentSequence Sequence, desiredSequence Sequence) []string {
+	current := normalizeSequence(currentSequence)
+	desired := normalizeSequence(desiredSequence)
+
+	options := []string{}
+	if current.dataType != desired.dataType {
+		options = append(options, fmt.Sprintf("AS %s", desired.dataType))
+	}
+	options = append(options, generateSequenceOptions(currentSequence, desiredSequence)...)
+	if current.ownedBy != desired.ownedBy {
+		if desired.ownedBy == "" {
+			options = append(options, "OWNED BY NONE")
+		} else {
+			options = append(options, fmt.Sprintf("OWNED BY %s", g.escapeTableName(desired.ownedBy)))
+		}
+	}
+
+	if len(options) == 0 {
+		return []string{}
+	}
+	return []string{fmt.Sprintf("ALTER SEQUENCE %s %s", g.escapeTableName(desired.name), strings.Join(options, " "))}
+}
+
+// Generate `ALTER TABLE ... ALTER COLUMN` to add, change or drop the identity of the column.
+func (g *Generator) generateDDLsForIdentity(tableName string, column Column, currentIdentity *Identity, desiredIdentity *Identity) []string {
+	prefix := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s", g.escapeTableName(tableName), g.escapeSQLName(column.name))
+	if currentIdentity == nil && desiredIdentity == nil {
+		return []string{}
+	} else if desiredIdentity == nil {
+		return []string{prefix + " DROP IDENTITY"}
+	}
+
+	if currentIdentity == nil {
+		return []string{fmt.Sprintf("%s ADD %s", prefix, generateIdentityDefinition(column.typeName, *desiredIdentity))}
+	}
+
+	// The sequence of an identity column has the type of the column.
+	desiredSequence := desiredIdentity.sequence
+	desiredSequence.dataType = normalizeSequenceType(column.typeName)
+	currentSequence := currentIdentity.sequence
+	currentSequence.dataType = desiredSequence.dataType
+	options := []string{}
+	if currentIdentity.behavior != desiredIdentity.behavior {
+		options = append(options, fmt.Sprintf("SET GENERATED %s", strings.ToUpper(desiredIdentity.behavior)))
+	}
+	for _, option := range generateSequenceOptions(currentSequence, desiredSequence) {
+		options = append(options, "SET "+option)
+	}
+
+	if len(options) == 0 {
+		return []string{}
+	}
+	return []string{fmt.Sprintf("%s %s", prefix, strings.Join(options, " "))}
+}
+
+// Return `GENERATED ... AS IDENTITY` with the options of the sequence which are not defaults.
+func generateIdentityDefinition(typeName string, identity Identity) string {
+	definition := fmt.Sprintf("GENERATED %s AS IDENTITY", strings.ToUpper(identity.behavior))
+	sequence := identity.sequence
+	sequence.dataType = normalizeSequenceType(typeName)
+	if options := generateSequenceOptions(Sequence{dataType: sequence.dataType}, sequence); len(options) > 0 {
+		definition += fmt.Sprintf(" (%s)", strings.Join(options, " "))
+	}
+	return definition
+}
+
+// Return options which are different from the current ones, except the type and the owner.
+func generateSequenceOptions(currentSequence Sequence, desiredSequence Sequence) []string {
+	current := normalizeSequence(currentSequence)
+	desired := normalizeSequence(desiredSequence)
+
+	options := []string{}
+	if current.incrementBy != desired.incrementBy {
+		options = append(options, fmt.Sprintf("INCREMENT BY %s", desired.incrementBy))
+	}
+	if current.minValue != desired.minValue {
+		if desiredSequence.minValue == "" {
+			options = append(options, "NO MINVALUE")
+		} else {
+			options = append(options, fmt.Sprintf("MINVALUE %s", desired.minValue))
+		}
+	}
+	if current.maxValue != desired.maxValue {
+		if desiredSequence.maxValue == "" {
+			options = append(options, "NO MAXVALUE")
+		} else {
+			options = append(options, fmt.Sprintf("MAXVALUE %s", desired.maxValue))
+		}
+	}
+	if current.startWith != desired.startWith {
+		options = append(options, fmt.Sprintf("START WITH %s", desired.startWith))
+	}
+	if current.cache != desired.cache {
+		options = append(options, fmt.Sprintf("CACHE %s", desired.cache))
+	}
+	if current.cycle != desired.cycle {
+		if desired.cycle {
+			options = append(options, "CYCLE")
+		} else {
+			options = append(options, "NO CYCLE")
+		}
+	}
+	return options
+}
+
+// A serial column is an integer column with `DEFAULT nextval('<table>_<column>_seq')`. Normalize the default to
+// the serial type, so that it's the same as the one given by `serial` in the schema.
+func normalizeSerialColumn(tableName string, column *Column) {
+	serialType, ok := serialTypes[normalizeDataType(GeneratorModePostgres, column.typeName)]
+	if !ok || !column.notNull || strings.TrimPrefix(column.defaultSeq, "public.") != serialSequenceName(tableName, column.name) {
+		return
+	}
+	column.typeName = serialType
+	column.defaultSeq = ""
+}
+
+func serialSequenceName(tableName string, columnName string) string {
+	return fmt.Sprintf("%s_%s_seq", tableName, columnName)
+}
+
+func isSerialType(typeName string) bool {
+	typeName = normalizeDataType(GeneratorModePostgres, typeName)
+	return typeName == "smallserial" || typeName == "serial" || typeName == "bigserial"
+}
+
+// Return true if the sequence is the implicit one of a serial column in the tables.
+func isSerialSequence(sequence Sequence, tables []*Table) bool {
+	tableName, columnName := splitOwnedBy(sequence.ownedBy)
+	if tableName == "" || sequence.name != serialSequenceName(tableName, columnName) {
+		return false
+	}
+	table := findTableByName(tables, tableName)
+	if table == nil {
+		return false
+	}
+	column := findColumnByName(table.columns, columnName)
+	return column != nil && isSerialType(column.typeName)
+}
+
+// Split OWNED BY into the table, which may be qualified by its schema, and the column.
+func splitOwnedBy(ownedBy string) (string, string) {
+	i := strings.LastIndex(ownedBy, ".")
+	if i < 0 {
+		return "", ""
+	}
+	return ownedBy[:i], ownedBy[i+1:]
+}
+
+// Convert `CREATE SEQUENCE` and `ALTER SEQUENCE` to sequences with all options applied.
+func convertDDLsToSequences(ddls []DDL) ([]*Sequence, error) {
+	sequences := []*Sequence{}
+	for _, ddl := range ddls {
+		switch stmt := ddl.(type) {
+		case *CreateSequence:
+			sequence := stmt.sequence // copy sequence
+			sequences = append(sequences, &sequence)
+		case *AlterSequence:
+			sequence := findSequenceByName(sequences, stmt.name)
+			if sequence == nil {
+				return nil, fmt.Errorf("ALTER SEQUENCE is performed before CREATE SEQUENCE: %s", ddl.Statement())
+			}
+			applySequenceSpec(sequence, stmt.spec)
+		}
+	}
+	return sequences, nil
+}
+
+func findSequenceByName(sequences []*Sequence, name string) *Sequence {
+	for _, sequence := range sequences {
+		if sequence.name == name {
+			return sequence
+		}
+	}
+	return nil
+}
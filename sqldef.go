@@ -3,28 +3,93 @@ package sqldef
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
 
 	"github.com/k0kubun/sqldef/adapter"
 	"github.com/k0kubun/sqldef/schema"
 )
 
+var includePattern = regexp.MustCompile(`(?m)^[ \t]*(?:--[ \t]*sqldef:include|\\i)[ \t]+(\S+)[ \t]*$`)
+var fileNamePattern = regexp.MustCompile(`[^A-Za-z0-9_.-]`)
+var envPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
 type Options struct {
-	SqlFile string
-	DryRun  bool
-	Export  bool
+	SqlFile          string
+	ExpandEnv        bool
+	Template         bool
+	TemplateVars     string
+	DryRun           bool
+	Check            bool
+	Confirm          bool
+	Format           string
+	Export           bool
+	OutputDir        string
+	PlanOut          string
+	Plan             string
+	RollbackOut      string
+	MigrationDir     string
+	MigrationFormat  string
+	BeforeApply      []string
+	AfterApply       []string
+	LockRetries      int
+	RetryInterval    time.Duration
+	EnableDropTable  bool
+	EnableDropColumn bool
+	CaseInsensitive  bool
+	SkipTables       []string
+	TargetTables     []string
+
+	// MySQL only
+	ManageAutoIncrement bool
+	StrictDisplayWidth  bool
+	ColumnPosition      bool
+	ReorderColumns      bool
+	CombineAlterTables  bool
+	LockWaitTimeout     uint
+
+	// PostgreSQL only
+	RecreateMaterializedViews bool
+	RefreshMaterializedViews  bool
+	DropExtensions            bool
+	ManagePrivileges          bool
+	ManageForeignData         bool
+	NoTransaction             bool
+	LockTimeout               string
+	StatementTimeout          string
 }
 
 // Main function shared by `mysqldef` and `psqldef`
 func Run(generatorMode schema.GeneratorMode, db adapter.Database, options *Options) {
-	currentDDLs, err := adapter.DumpDDLs(db)
+	if options.Format == "json" && !options.DryRun && !options.Check {
+		log.Fatal("--format json is supported only with --dry-run or --check")
+	}
+	config, err := generatorConfig(options)
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	allObjects, err := adapter.DumpObjects(db)
+	if err != nil {
+		log.Fatal(err)
+	}
+	objects := filterObjects(generatorMode, allObjects, config)
+	if options.Export && options.OutputDir != "" {
+		if err := exportFiles(objects, options.OutputDir); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	currentDDLs := adapter.JoinObjects(objects)
+
 	if options.Export {
 		if currentDDLs == "" {
 			fmt.Printf("-- No table exists --\n")
@@ -34,33 +99,252 @@ func Run(generatorMode schema.GeneratorMode, db adapter.Database, options *Optio
 		return
 	}
 
+	// A plan is fingerprinted by all objects including ones not managed by --target-table and --skip-table,
+	// since its DDLs may depend on them.
+	fingerprintedDDLs := adapter.JoinObjects(allObjects)
+	if options.Plan != "" {
+		ddls, err := readPlanFile(options.Plan, fingerprintedDDLs)
+		if err != nil {
+			log.Fatalf("Failed to read '%s': %s", options.Plan, err)
+		}
+		if len(ddls) == 0 {
+			fmt.Println("-- Nothing is modified --")
+		} else if options.DryRun {
+			showDDLs("-- dry run --", ddls)
+		} else {
+			runDDLs(generatorMode, db, options, currentDDLs, ddls)
+		}
+		return
+	}
+
 	sql, err := readFile(options.SqlFile)
 	if err != nil {
 		log.Fatalf("Failed to read '%s': %s", options.SqlFile, err)
 	}
 	desiredDDLs := string(sql)
+	if options.ExpandEnv {
+		desiredDDLs, err = expandEnv(desiredDDLs)
+		if err != nil {
+			log.Fatalf("Failed to read '%s': %s", options.SqlFile, err)
+		}
+	}
+	if options.Template {
+		desiredDDLs, err = renderTemplate(desiredDDLs, options.TemplateVars)
+		if err != nil {
+			log.Fatalf("Failed to render '%s': %s", options.SqlFile, err)
+		}
+	}
 
-	ddls, err := schema.GenerateIdempotentDDLs(generatorMode, desiredDDLs, currentDDLs)
+	ddls, skippedDDLs, err := schema.GenerateIdempotentDDLs(generatorMode, desiredDDLs, currentDDLs, config)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
+	if options.MigrationDir != "" {
+		for _, ddl := range skippedDDLs {
+			fmt.Printf("-- Skipped: %s;\n", ddl)
+		}
+		if len(ddls) == 0 {
+			fmt.Println("-- Nothing is modified --")
+			return
+		}
+		path, err := writeMigrationFile(options.MigrationDir, options.MigrationFormat, ddls, time.Now())
+		if err != nil {
+			log.Fatal(err)
+		}
+		showDDLs(fmt.Sprintf("-- Migration is written to '%s' --", path), ddls)
+		return
+	}
+	if options.PlanOut != "" {
+		if err := writePlanFile(options.PlanOut, ddls, skippedDDLs, fingerprintedDDLs); err != nil {
+			log.Fatal(err)
+		}
+		for _, ddl := range skippedDDLs {
+			fmt.Printf("-- Skipped: %s;\n", ddl)
+		}
+		showDDLs(fmt.Sprintf("-- Plan is written to '%s' --", options.PlanOut), ddls)
+		return
+	}
+	if options.Format == "json" {
+		if err := showPlan(ddls, skippedDDLs); err != nil {
+			log.Fatal(err)
+		}
+		if options.Check && len(ddls) > 0 {
+			os.Exit(2)
+		}
+		return
+	}
+	for _, ddl := range skippedDDLs {
+		fmt.Printf("-- Skipped: %s;\n", ddl)
+	}
 	if len(ddls) == 0 {
 		fmt.Println("-- Nothing is modified --")
 		return
 	}
 
 	if options.DryRun {
-		showDDLs(ddls)
+		showDDLs("-- dry run --", ddls)
 		return
 	}
 
-	err = adapter.RunDDLs(db, ddls)
+	// Exit with 2 like `terraform plan -detailed-exitcode`, since 1 is for errors.
+	if options.Check {
+		showDDLs("-- Schema is different --", ddls)
+		os.Exit(2)
+	}
+
+	runDDLs(generatorMode, db, options, currentDDLs, ddls)
+}
+
+func generatorConfig(options *Options) (schema.GeneratorConfig, error) {
+	skipTables, err := compileTablePatterns("--skip-table", options.SkipTables)
+	if err != nil {
+		return schema.GeneratorConfig{}, err
+	}
+	targetTables, err := compileTablePatterns("--target-table", options.TargetTables)
+	if err != nil {
+		return schema.GeneratorConfig{}, err
+	}
+
+	return schema.GeneratorConfig{
+		RecreateMaterializedViews: options.RecreateMaterializedViews,
+		RefreshMaterializedViews:  options.RefreshMaterializedViews,
+		DropExtensions:            options.DropExtensions,
+		EnableDropTable:           options.EnableDropTable,
+		EnableDropColumn:          options.EnableDropColumn,
+		ManageAutoIncrement:       options.ManageAutoIncrement,
+		StrictDisplayWidth:        options.StrictDisplayWidth,
+		ManagePrivileges:          options.ManagePrivileges,
+		ManageForeignData:         options.ManageForeignData,
+		CaseInsensitive:           options.CaseInsensitive,
+		ColumnPosition:            options.ColumnPosition,
+		ReorderColumns:            options.ReorderColumns,
+		CombineAlterTables:        options.CombineAlterTables,
+		SkipTables:                skipTables,
+		TargetTables:              targetTables,
+	}, nil
+}
+
+func compileTablePatterns(flag string, patterns []string) ([]*regexp.Regexp, error) {
+	regexps := []*regexp.Regexp{}
+	for _, pattern := range patterns {
+		// A pattern is matched with the whole table name, not to match `users` by `user`.
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s '%s': %s", flag, pattern, err)
+		}
+		regexps = append(regexps, re)
+	}
+	return regexps, nil
+}
+
+// Dump objects managed by --target-table and --skip-table.
+func dumpObjects(generatorMode schema.GeneratorMode, db adapter.Database, config schema.GeneratorConfig) ([]adapter.Object, error) {
+	objects, err := adapter.DumpObjects(db)
+	if err != nil {
+		return nil, err
+	}
+	return filterObjects(generatorMode, objects, config), nil
+}
+
+// Remove tables which are not managed, and other objects than tables when --target-table is given. Triggers are
+// kept since their tables are not known here, and ones for tables not managed are ignored by the generator.
+func filterObjects(generatorMode schema.GeneratorMode, objects []adapter.Object, config schema.GeneratorConfig) []adapter.Object {
+	filtered := []adapter.Object{}
+	for _, object := range objects {
+		name := object.Name
+		if generatorMode == schema.GeneratorModePostgres {
+			name = strings.TrimPrefix(name, "public.")
+		}
+		if object.Kind == "table" && !config.ManagesTable(name) {
+			continue
+		}
+		if object.Kind != "table" && object.Kind != "trigger" && len(config.TargetTables) > 0 {
+			continue
+		}
+		filtered = append(filtered, object)
+	}
+	return filtered
+}
+
+// Run DDLs, and write ones to roll them back by --rollback-out.
+func runDDLs(generatorMode schema.GeneratorMode, db adapter.Database, options *Options, currentDDLs string, ddls []string) {
+	if options.Confirm && !confirmDDLs(ddls) {
+		fmt.Println("-- Canceled --")
+		os.Exit(1)
+	}
+
+	// MySQL commits each DDL implicitly, which can't be rolled back to a savepoint to retry it.
+	config := adapter.RunConfig{
+		Transactional: generatorMode == schema.GeneratorModePostgres && !options.NoTransaction,
+		LockRetries:   options.LockRetries,
+		RetryInterval: options.RetryInterval,
+	}
+	err := adapter.RunDDLs(db, withHooks(options, ddls), config)
+	if options.RollbackOut != "" {
+		if rollbackErr := writeRollbackFile(generatorMode, db, options, currentDDLs); rollbackErr != nil {
+			if err != nil {
+				log.Print(err)
+			}
+			log.Fatalf("Failed to write '%s': %s", options.RollbackOut, rollbackErr)
+		}
+	}
 	if err != nil {
 		log.Fatal(err)
 	}
 }
 
+// Add SQL given by --before-apply and --after-apply around DDLs, to run them in the same transaction and connection
+// like `SET SESSION lock_wait_timeout = 5`. Timeouts of the session are set first, so that a DDL waiting for a lock
+// fails fast instead of blocking queries behind it.
+func withHooks(options *Options, ddls []string) []string {
+	hooked := []string{}
+	if options.LockWaitTimeout > 0 {
+		hooked = append(hooked, fmt.Sprintf("SET SESSION lock_wait_timeout = %d", options.LockWaitTimeout))
+	}
+	if options.LockTimeout != "" {
+		hooked = append(hooked, fmt.Sprintf("SET lock_timeout = '%s'", strings.Replace(options.LockTimeout, "'", "''", -1)))
+	}
+	if options.StatementTimeout != "" {
+		hooked = append(hooked, fmt.Sprintf("SET statement_timeout = '%s'", strings.Replace(options.StatementTimeout, "'", "''", -1)))
+	}
+	for _, sql := range options.BeforeApply {
+		hooked = append(hooked, strings.TrimRight(strings.TrimSpace(sql), ";"))
+	}
+	hooked = append(hooked, ddls...)
+	for _, sql := range options.AfterApply {
+		hooked = append(hooked, strings.TrimRight(strings.TrimSpace(sql), ";"))
+	}
+	return hooked
+}
+
+// Write DDLs to get back the schema before running DDLs. They're generated from the schema dumped after running DDLs,
+// so that they're correct even if some of DDLs fail.
+func writeRollbackFile(generatorMode schema.GeneratorMode, db adapter.Database, options *Options, beforeDDLs string) error {
+	config, err := generatorConfig(options)
+	if err != nil {
+		return err
+	}
+	objects, err := dumpObjects(generatorMode, db, config)
+	if err != nil {
+		return err
+	}
+	afterDDLs := adapter.JoinObjects(objects)
+
+	config.EnableDropTable = true
+	config.EnableDropColumn = true
+	ddls, _, err := schema.GenerateIdempotentDDLs(generatorMode, beforeDDLs, afterDDLs, config)
+	if err != nil {
+		return err
+	}
+
+	var buffer bytes.Buffer
+	for _, ddl := range ddls {
+		buffer.WriteString(ddl + ";\n")
+	}
+	return ioutil.WriteFile(options.RollbackOut, buffer.Bytes(), 0644)
+}
+
 func readFile(filepath string) (string, error) {
 	var content string
 	var err error
@@ -70,16 +354,16 @@ func readFile(filepath string) (string, error) {
 			return "", fmt.Errorf("stdin is not piped")
 		}
 
-		var buffer bytes.Buffer
-		scanner := bufio.NewScanner(os.Stdin)
-		for scanner.Scan() {
-			buffer.WriteString(scanner.Text())
+		var buf []byte
+		buf, err = ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return "", err
 		}
-		content = buffer.String()
+		content, err = expandIncludes(string(buf), ".", []string{})
+	} else if stat, statErr := os.Stat(filepath); statErr == nil && stat.IsDir() {
+		content, err = readDirectory(filepath)
 	} else {
-		var buf []byte
-		buf, err = ioutil.ReadFile(filepath)
-		content = string(buf)
+		content, err = readSchemaFile(filepath, []string{})
 	}
 
 	if err != nil {
@@ -88,8 +372,176 @@ func readFile(filepath string) (string, error) {
 	return content, nil
 }
 
-func showDDLs(ddls []string) {
-	fmt.Println("-- dry run --")
+// Concatenate `*.sql` files in the directory and its subdirectories in lexical order.
+func readDirectory(dirpath string) (string, error) {
+	var buffer bytes.Buffer
+	err := filepath.Walk(dirpath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ".sql" {
+			return nil
+		}
+		content, err := readSchemaFile(path, []string{})
+		if err != nil {
+			return err
+		}
+		buffer.WriteString(terminateStatements(content))
+		buffer.WriteString("\n")
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+// Read the schema file, whose `-- sqldef:include <path>` or psql's `\i <path>` lines are replaced with the file
+// relative to it. Files which are being read are given to detect a cycle.
+func readSchemaFile(path string, including []string) (string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	if containsPath(including, absPath) {
+		return "", fmt.Errorf("'%s' is included recursively", path)
+	}
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return expandIncludes(string(buf), filepath.Dir(path), append(including, absPath))
+}
+
+func expandIncludes(content string, dirpath string, including []string) (string, error) {
+	var includeErr error
+	expanded := includePattern.ReplaceAllStringFunc(content, func(line string) string {
+		path := includePattern.FindStringSubmatch(line)[1]
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dirpath, path)
+		}
+		included, err := readSchemaFile(path, including)
+		if err != nil && includeErr == nil {
+			includeErr = err
+		}
+		return terminateStatements(included)
+	})
+	if includeErr != nil {
+		return "", includeErr
+	}
+	return expanded, nil
+}
+
+// Terminate the last statement by `;` unless it's terminated, so that it's not joined with the next file.
+func terminateStatements(content string) string {
+	content = strings.TrimSpace(content)
+	if content != "" && !strings.HasSuffix(content, ";") {
+		content += ";"
+	}
+	return content
+}
+
+func containsPath(paths []string, path string) bool {
+	for _, p := range paths {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
+
+// Replace `${VAR}` with the environment variable. Only the braced form is replaced, since `$1` and `$$` are
+// used by PostgreSQL. An unset variable is an error rather than an empty string, not to define a broken schema.
+func expandEnv(content string) (string, error) {
+	var expandErr error
+	expanded := envPattern.ReplaceAllStringFunc(content, func(placeholder string) string {
+		name := envPattern.FindStringSubmatch(placeholder)[1]
+		value, ok := os.LookupEnv(name)
+		if !ok && expandErr == nil {
+			expandErr = fmt.Errorf("environment variable '%s' is not set", name)
+		}
+		return value
+	})
+	if expandErr != nil {
+		return "", expandErr
+	}
+	return expanded, nil
+}
+
+// Run the schema SQL through text/template with values in the JSON file, like `{{ .collation }}`. A missing
+// value is an error, as it's for `${VAR}`.
+func renderTemplate(content string, varsFile string) (string, error) {
+	vars := map[string]interface{}{}
+	if varsFile != "" {
+		buf, err := ioutil.ReadFile(varsFile)
+		if err != nil {
+			return "", err
+		}
+		if err := json.Unmarshal(buf, &vars); err != nil {
+			return "", fmt.Errorf("failed to parse '%s': %s", varsFile, err)
+		}
+	}
+
+	tmpl, err := template.New("schema").Option("missingkey=error").Parse(content)
+	if err != nil {
+		return "", err
+	}
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, vars); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+// Write each object into a file like `7_tables/users.sql` in the directory. Kinds are numbered, so that --file
+// reads them in the order to create them. Stale `*.sql` files of the kinds are removed not to create dropped objects.
+func exportFiles(objects []adapter.Object, dirpath string) error {
+	for i, kind := range adapter.ObjectKinds {
+		kindDir := filepath.Join(dirpath, fmt.Sprintf("%d_%ss", i+1, kind))
+		stalePaths, err := filepath.Glob(filepath.Join(kindDir, "*.sql"))
+		if err != nil {
+			return err
+		}
+		for _, path := range stalePaths {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+		}
+
+		for _, object := range objects {
+			if object.Kind != kind {
+				continue
+			}
+			if err := os.MkdirAll(kindDir, 0755); err != nil {
+				return err
+			}
+			path := filepath.Join(kindDir, fileNamePattern.ReplaceAllLiteralString(object.Name, "_")+".sql")
+			if err := ioutil.WriteFile(path, []byte(object.DDL+";\n"), 0644); err != nil {
+				return err
+			}
+			fmt.Println(path)
+		}
+	}
+	return nil
+}
+
+// Ask whether to run DDLs. It's answered yes when stdin is not a terminal, e.g. the schema is given by stdin.
+// A terminal is checked in the same way as `readFile`, since it has to be portable.
+func confirmDDLs(ddls []string) bool {
+	showDDLs("-- Confirm --", ddls)
+	stat, err := os.Stdin.Stat()
+	if err != nil || (stat.Mode()&os.ModeCharDevice) == 0 {
+		return true
+	}
+
+	fmt.Print("Run the above DDLs? [y/N] ")
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+func showDDLs(header string, ddls []string) {
+	fmt.Println(header)
 	for _, ddl := range ddls {
 		fmt.Printf("%s;\n", ddl)
 	}
@@ -49,7 +49,17 @@ func Parse(sql string) (Statement, error) {
 }
 
 func ParseWithMode(sql string, mode ParserMode) (Statement, error) {
+	return parseWithTokenizer(sql, NewStringTokenizer(sql, mode))
+}
+
+// ParseFoldedWithMode is the same as ParseWithMode except it lowercases unquoted identifiers.
+func ParseFoldedWithMode(sql string, mode ParserMode) (Statement, error) {
 	tokenizer := NewStringTokenizer(sql, mode)
+	tokenizer.FoldIdentifiers = true
+	return parseWithTokenizer(sql, tokenizer)
+}
+
+func parseWithTokenizer(sql string, tokenizer *Tokenizer) (Statement, error) {
 	if yyParse(tokenizer) != 0 {
 		if tokenizer.partialDDL != nil {
 			tokenizer.ParseTree = tokenizer.partialDDL
@@ -121,15 +131,42 @@ func SplitStatement(blob string) (string, string, error) {
 // SplitStatementToPieces split raw sql statement that may have multi sql pieces to sql pieces
 // returns the sql pieces blob contains; or error if sql cannot be parsed
 func SplitStatementToPieces(blob string) (pieces []string, err error) {
+	return SplitStatementToPiecesWithMode(blob, ParserModeMysql)
+}
+
+// SplitStatementToPiecesWithMode is SplitStatementToPieces for the given ParserMode.
+// Semicolons in quoted strings, comments, PostgreSQL's dollar-quoted strings and BEGIN ... END blocks
+// like MySQL's trigger body are not treated as separators.
+func SplitStatementToPiecesWithMode(blob string, mode ParserMode) (pieces []string, err error) {
 	pieces = make([]string, 0, 16)
-	tokenizer := NewStringTokenizer(blob, ParserModeMysql) // TODO: Switch mode
+	tokenizer := NewStringTokenizer(blob, mode)
 
 	tkn := 0
+	var val []byte
+	prev := 0
+	depth := 0 // The depth of BEGIN ... END and CASE ... END
 	var stmt string
 	stmtBegin := 0
 	for {
-		tkn, _ = tokenizer.Scan()
-		if tkn == ';' {
+		tkn, val = tokenizer.Scan()
+		if tkn == COMMENT {
+			continue
+		}
+		switch {
+		case tkn == BEGIN, tkn == CASE && prev != END:
+			depth++
+		case tkn == END && depth > 0:
+			depth--
+		case prev == END && isCompoundStatementEnd(tkn, val):
+			depth++ // END IF, END LOOP, etc. don't close BEGIN
+		case tkn == ';' && prev == BEGIN:
+			depth-- // BEGIN of a transaction
+		}
+		prev = tkn
+
+		if tkn == ';' && depth > 0 {
+			continue
+		} else if tkn == ';' {
 			stmt = blob[stmtBegin : tokenizer.Position-2]
 			pieces = append(pieces, stmt)
 			stmtBegin = tokenizer.Position - 1
@@ -149,6 +186,22 @@ func SplitStatementToPieces(blob string) (pieces []string, err error) {
 	return
 }
 
+// Return true for IF, LOOP, REPEAT and WHILE following END, which don't close BEGIN or CASE.
+func isCompoundStatementEnd(tkn int, val []byte) bool {
+	if tkn == IF {
+		return true
+	}
+	if tkn != UNUSED {
+		return false
+	}
+	switch strings.ToLower(string(val)) {
+	case "loop", "repeat", "while":
+		return true
+	default:
+		return false
+	}
+}
+
 // SQLNode defines the interface for all nodes
 // generated by the parser.
 type SQLNode interface {
@@ -654,17 +707,47 @@ func (node *DBDDL) walkSubtree(visit Visit) error {
 // NewName is set for AlterStr, CreateStr, RenameStr.
 // VindexSpec is set for CreateVindexStr, DropVindexStr, AddColVindexStr, DropColVindexStr
 // VindexCols is set for AddColVindexStr
+// CommentSpec is set for CommentStr
+// TriggerSpec is set for CreateTriggerStr
+// FunctionSpec is set for CreateFunctionStr, CreateProcedureStr
+// SequenceSpec is set for CreateSequenceStr, AlterSequenceStr
+// AlterColumn is set for AlterColumnStr
+// EnumValues is set for CreateTypeStr
+// DomainSpec is set for CreateDomainStr
+// ExtensionOptions is set for CreateExtensionStr
+// GrantSpec is set for GrantStr, RevokeStr
+// RowLevelSecurity is set for RowLevelSecurityStr
+// PolicySpec is set for CreatePolicyStr
+// Exclusion is set for AddExclusionStr
 type DDL struct {
-	Action        string
-	Table         TableName
-	NewName       TableName
-	IfExists      bool
-	TableSpec     *TableSpec
-	PartitionSpec *PartitionSpec
-	IndexSpec     *IndexSpec
-	IndexCols     []ColIdent
-	VindexSpec    *VindexSpec
-	VindexCols    []ColIdent
+	Action           string
+	Table            TableName
+	NewName          TableName
+	IfExists         bool
+	TableSpec        *TableSpec
+	PartitionSpec    *PartitionSpec
+	IndexSpec        *IndexSpec
+	IndexCols        []*IndexColumn
+	ForeignKey       *ForeignKeyDefinition
+	Exclusion        *ExclusionDefinition
+	CommentSpec      *CommentSpec
+	TriggerSpec      *TriggerSpec
+	FunctionSpec     *FunctionSpec
+	SequenceSpec     *SequenceSpec
+	AlterColumn      *AlterColumnSpec
+	EnumValues       []string
+	DomainSpec       *DomainSpec
+	ExtensionOptions []string
+	GrantSpec        *GrantSpec
+	RowLevelSecurity string // enable, disable, force or no force
+	PolicySpec       *PolicySpec
+	ForeignSpec      *ForeignSpec
+	VindexSpec       *VindexSpec
+	VindexCols       []ColIdent
+	ViewExpr         SelectStatement // CREATE VIEW
+	OrReplace        bool            // CREATE OR REPLACE VIEW or FUNCTION
+	Materialized     bool            // CREATE MATERIALIZED VIEW
+	WithNoData       bool            // CREATE MATERIALIZED VIEW ... WITH NO DATA
 }
 
 // DDL strings.
@@ -680,6 +763,50 @@ const (
 	AddIndexStr      = "add index"
 	CreateIndexStr   = "create index"
 	AddPrimaryKeyStr = "add primary key"
+	AddForeignKeyStr = "add foreign key"
+	DropIndexStr     = "drop index"
+	CommentStr       = "comment"
+	CreateViewStr    = "create view"
+	CreateTriggerStr = "create trigger"
+
+	CreateFunctionStr = "create function"
+
+	// MySQL's `CREATE PROCEDURE`
+	CreateProcedureStr = "create procedure"
+
+	// PostgreSQL's `CREATE SEQUENCE` and `ALTER SEQUENCE`
+	CreateSequenceStr = "create sequence"
+	AlterSequenceStr  = "alter sequence"
+
+	// PostgreSQL's `CREATE TYPE ... AS ENUM` and `CREATE DOMAIN`
+	CreateTypeStr   = "create type"
+	CreateDomainStr = "create domain"
+
+	// PostgreSQL's `CREATE EXTENSION` and `CREATE SCHEMA`
+	CreateExtensionStr = "create extension"
+	CreateSchemaStr    = "create schema"
+
+	// PostgreSQL's `GRANT` and `REVOKE` of tables and sequences
+	GrantStr  = "grant"
+	RevokeStr = "revoke"
+
+	// PostgreSQL's `ALTER TABLE ... ADD CONSTRAINT ... EXCLUDE`
+	AddExclusionStr = "add exclusion"
+
+	// PostgreSQL's `ALTER TABLE ... ENABLE ROW LEVEL SECURITY` and `CREATE POLICY`
+	RowLevelSecurityStr = "row level security"
+	CreatePolicyStr     = "create policy"
+
+	// PostgreSQL's `CREATE SERVER`, `CREATE USER MAPPING` and `CREATE FOREIGN TABLE`
+	CreateServerStr       = "create server"
+	CreateUserMappingStr  = "create user mapping"
+	CreateForeignTableStr = "create foreign table"
+
+	// PostgreSQL's `ALTER TABLE ... ALTER COLUMN ... ADD GENERATED ... AS IDENTITY` or `SET DEFAULT nextval(...)`
+	AlterColumnStr = "alter column"
+
+	// PostgreSQL's `ALTER TABLE parent ATTACH PARTITION child FOR VALUES ...`
+	AttachPartitionStr = "attach partition"
 
 	// Vindex DDL param to specify the owner of a vindex
 	VindexOwnerStr = "owner"
@@ -725,6 +852,124 @@ func (node *DDL) Format(buf *TrackedBuffer) {
 		}
 	case DropColVindexStr:
 		buf.Myprintf("alter table %v %s %v", node.Table, node.Action, node.VindexSpec.Name)
+	case AddForeignKeyStr:
+		buf.Myprintf("alter table %v add %v", node.Table, node.ForeignKey)
+	case AddExclusionStr:
+		buf.Myprintf("alter table %v add %v", node.Table, node.Exclusion)
+	case DropIndexStr:
+		exists := ""
+		if node.IfExists {
+			exists = " if exists"
+		}
+		buf.Myprintf("%s%s %v", node.Action, exists, node.IndexSpec.Name)
+		if !node.Table.IsEmpty() {
+			buf.Myprintf(" on %v", node.Table)
+		}
+	case AttachPartitionStr:
+		buf.Myprintf("alter table %v %v", node.Table, node.PartitionSpec)
+	case CreateViewStr:
+		if node.Materialized {
+			buf.Myprintf("create materialized view %v as %v", node.NewName, node.ViewExpr)
+			if node.WithNoData {
+				buf.Myprintf(" with no data")
+			}
+		} else if node.OrReplace {
+			buf.Myprintf("create or replace view %v as %v", node.NewName, node.ViewExpr)
+		} else {
+			buf.Myprintf("create view %v as %v", node.NewName, node.ViewExpr)
+		}
+	case CreateFunctionStr, CreateProcedureStr:
+		spec := node.FunctionSpec
+		if node.OrReplace {
+			buf.Myprintf("create or replace function %v", node.Table)
+		} else {
+			buf.Myprintf("%s %v", node.Action, node.Table)
+		}
+		buf.Myprintf("(%s)", strings.Join(spec.Arguments, ", "))
+		if spec.Returns != "" {
+			buf.Myprintf(" returns %s", spec.Returns)
+		}
+		if spec.Language != "" {
+			buf.Myprintf(" language %s as $$%s$$", spec.Language, spec.Body)
+		}
+		for _, option := range spec.Options {
+			buf.Myprintf(" %s", option)
+		}
+		if spec.Language == "" {
+			// MySQL's routine body comes last.
+			buf.Myprintf(" %s", spec.Body)
+		}
+	case CreateTriggerStr:
+		spec := node.TriggerSpec
+		buf.Myprintf("%s %v %s %s on %v", node.Action, spec.Name, spec.Time, strings.Join(spec.Events, " or "), node.Table)
+		if spec.ForEach != "" {
+			buf.Myprintf(" for each %s", spec.ForEach)
+		}
+		if spec.When != nil {
+			buf.Myprintf(" when (%v)", spec.When)
+		}
+		if spec.Execute != nil {
+			buf.Myprintf(" execute function %v", spec.Execute)
+		} else {
+			buf.Myprintf(" %s", spec.Body)
+		}
+	case CreateSequenceStr, AlterSequenceStr:
+		buf.Myprintf("%s %v%v", node.Action, node.Table, node.SequenceSpec)
+	case CreateTypeStr:
+		buf.Myprintf("%s %v as enum (%s)", node.Action, node.Table, strings.Join(node.EnumValues, ", "))
+	case CreateDomainStr:
+		buf.Myprintf("%s %v%v", node.Action, node.Table, node.DomainSpec)
+	case CreateServerStr:
+		spec := node.ForeignSpec
+		buf.Myprintf("%s %v", node.Action, node.Table)
+		if spec.Type != nil {
+			buf.Myprintf(" type %v", spec.Type)
+		}
+		if spec.Version != nil {
+			buf.Myprintf(" version %v", spec.Version)
+		}
+		buf.Myprintf(" foreign data wrapper %v%v", spec.Wrapper, spec.Options)
+	case CreateUserMappingStr:
+		buf.Myprintf("%s for %v server %v%v", node.Action, node.ForeignSpec.User, node.ForeignSpec.Server, node.ForeignSpec.Options)
+	case CreateForeignTableStr:
+		buf.Myprintf("%s %v %v server %v%v", node.Action, node.NewName, node.TableSpec, node.ForeignSpec.Server, node.ForeignSpec.Options)
+	case RowLevelSecurityStr:
+		buf.Myprintf("alter table %v %s row level security", node.Table, node.RowLevelSecurity)
+	case CreatePolicyStr:
+		buf.Myprintf("create policy %v on %v%v", node.PolicySpec.Name, node.Table, node.PolicySpec)
+	case GrantStr:
+		spec := node.GrantSpec
+		buf.Myprintf("grant %s on %s %v to %s", strings.Join(spec.Privileges, ", "), spec.ObjectType, node.Table, strings.Join(spec.Grantees, ", "))
+		if spec.WithGrantOption {
+			buf.Myprintf(" with grant option")
+		}
+	case RevokeStr:
+		spec := node.GrantSpec
+		buf.Myprintf("revoke %s on %s %v from %s", strings.Join(spec.Privileges, ", "), spec.ObjectType, node.Table, strings.Join(spec.Grantees, ", "))
+	case CreateSchemaStr:
+		buf.Myprintf("%s %v", node.Action, node.Table)
+	case CreateExtensionStr:
+		buf.Myprintf("%s %v", node.Action, node.Table)
+		if len(node.ExtensionOptions) > 0 {
+			buf.Myprintf(" with %s", strings.Join(node.ExtensionOptions, " "))
+		}
+	case AlterColumnStr:
+		if node.AlterColumn.Identity != nil {
+			buf.Myprintf("alter table %v alter column %v add %v", node.Table, node.AlterColumn.Column, node.AlterColumn.Identity)
+		} else {
+			buf.Myprintf("alter table %v alter column %v set default nextval(%v::regclass)", node.Table, node.AlterColumn.Column, NewStrVal([]byte(node.AlterColumn.DefaultNextval)))
+		}
+	case CommentStr:
+		if node.CommentSpec.Column.IsEmpty() {
+			buf.Myprintf("%s on table %v is ", node.Action, node.Table)
+		} else {
+			buf.Myprintf("%s on column %v.%v is ", node.Action, node.Table, node.CommentSpec.Column)
+		}
+		if node.CommentSpec.Comment == nil {
+			buf.Myprintf("null")
+		} else {
+			buf.Myprintf("%v", node.CommentSpec.Comment)
+		}
 	default:
 		buf.Myprintf("%s table %v", node.Action, node.Table)
 	}
@@ -751,6 +996,8 @@ type PartitionSpec struct {
 	Action      string
 	Name        ColIdent
 	Definitions []*PartitionDefinition
+	Table       TableName       // ATTACH PARTITION
+	Bound       *PartitionBound // ATTACH PARTITION
 }
 
 // Format formats the node.
@@ -764,6 +1011,8 @@ func (node *PartitionSpec) Format(buf *TrackedBuffer) {
 			prefix = ", "
 		}
 		buf.Myprintf(")")
+	case AttachPartitionStr:
+		buf.Myprintf("%s %v %v", node.Action, node.Table, node.Bound)
 	default:
 		panic("unimplemented")
 	}
@@ -781,23 +1030,30 @@ func (node *PartitionSpec) walkSubtree(visit Visit) error {
 			return err
 		}
 	}
-	return nil
+	return Walk(visit, node.Table, node.Bound)
 }
 
 // PartitionDefinition describes a very minimal partition definition
 type PartitionDefinition struct {
 	Name     ColIdent
-	Limit    Expr
-	Maxvalue bool
+	Limit    Expr  // VALUES LESS THAN
+	Maxvalue bool  // VALUES LESS THAN MAXVALUE
+	In       Exprs // VALUES IN
+	Options  string
 }
 
 // Format formats the node
 func (node *PartitionDefinition) Format(buf *TrackedBuffer) {
-	if !node.Maxvalue {
+	if node.In != nil {
+		buf.Myprintf("partition %v values in (%v)", node.Name, node.In)
+	} else if tuple, ok := node.Limit.(ValTuple); ok {
+		buf.Myprintf("partition %v values less than %v", node.Name, tuple)
+	} else if !node.Maxvalue {
 		buf.Myprintf("partition %v values less than (%v)", node.Name, node.Limit)
 	} else {
 		buf.Myprintf("partition %v values less than (maxvalue)", node.Name)
 	}
+	buf.Myprintf("%s", node.Options)
 }
 
 func (node *PartitionDefinition) walkSubtree(visit Visit) error {
@@ -808,18 +1064,365 @@ func (node *PartitionDefinition) walkSubtree(visit Visit) error {
 		visit,
 		node.Name,
 		node.Limit,
+		node.In,
 	)
 }
 
+// PartitionOption describes `PARTITION BY` in a CREATE TABLE statement.
+// Exprs is used for RANGE, LIST and HASH, and KeyColumns is used for KEY.
+type PartitionOption struct {
+	Type        string
+	Linear      bool
+	Columns     bool // RANGE COLUMNS or LIST COLUMNS
+	Algorithm   *SQLVal
+	Exprs       Exprs
+	KeyColumns  Columns
+	Partitions  *SQLVal
+	Definitions []*PartitionDefinition
+}
+
+// PartitionOption.Type
+const (
+	PartitionRangeStr = "range"
+	PartitionListStr  = "list"
+	PartitionHashStr  = "hash"
+	PartitionKeyStr   = "key"
+)
+
+func (node *PartitionOption) isValidType() bool {
+	switch node.Type {
+	case PartitionRangeStr, PartitionListStr, PartitionHashStr:
+		return true
+	default:
+		return false
+	}
+}
+
+// Format formats the node.
+func (node *PartitionOption) Format(buf *TrackedBuffer) {
+	buf.Myprintf("partition by ")
+	if node.Linear {
+		buf.Myprintf("linear ")
+	}
+	buf.Myprintf("%s", node.Type)
+	if node.Columns {
+		buf.Myprintf(" columns")
+	}
+	if node.Algorithm != nil {
+		buf.Myprintf(" algorithm=%v", node.Algorithm)
+	}
+	if node.Type == PartitionKeyStr && len(node.KeyColumns) == 0 {
+		buf.Myprintf(" ()")
+	} else if node.Type == PartitionKeyStr {
+		buf.Myprintf(" %v", node.KeyColumns)
+	} else {
+		buf.Myprintf(" (%v)", node.Exprs)
+	}
+	if node.Partitions != nil {
+		buf.Myprintf(" partitions %v", node.Partitions)
+	}
+	if node.Definitions != nil {
+		buf.Myprintf(" (")
+		for i, definition := range node.Definitions {
+			if i > 0 {
+				buf.Myprintf(", ")
+			}
+			buf.Myprintf("%v", definition)
+		}
+		buf.Myprintf(")")
+	}
+}
+
+func (node *PartitionOption) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	for _, definition := range node.Definitions {
+		if err := Walk(visit, definition); err != nil {
+			return err
+		}
+	}
+	return Walk(visit, node.Exprs, node.KeyColumns)
+}
+
+// PartitionOf describes PostgreSQL's `PARTITION OF` in a CREATE TABLE statement.
+type PartitionOf struct {
+	Parent TableName
+	Bound  *PartitionBound
+}
+
+// Format formats the node.
+func (node *PartitionOf) Format(buf *TrackedBuffer) {
+	buf.Myprintf("partition of %v %v", node.Parent, node.Bound)
+}
+
+func (node *PartitionOf) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Parent, node.Bound)
+}
+
+// TriggerSpec describes a trigger of CREATE TRIGGER.
+type TriggerSpec struct {
+	Name    ColIdent
+	Time    string    // before, after or instead of
+	Events  []string  // insert, update, update of <columns>, delete or truncate
+	ForEach string    // row or statement. Empty if it's not specified.
+	When    Expr      // PostgreSQL's WHEN condition
+	Execute *FuncExpr // PostgreSQL's EXECUTE FUNCTION
+	Body    string    // MySQL's trigger body, which is not parsed
+}
+
+// SequenceSpec describes options of CREATE SEQUENCE or ALTER SEQUENCE. Each field is empty if it's not specified.
+type SequenceSpec struct {
+	Type        string
+	IncrementBy string
+	MinValue    string
+	NoMinValue  bool
+	MaxValue    string
+	NoMaxValue  bool
+	StartWith   string
+	Cache       string
+	Cycle       string // cycle or no cycle
+	OwnedBy     *ColName
+}
+
+// Format formats the node.
+func (node *SequenceSpec) Format(buf *TrackedBuffer) {
+	if node.Type != "" {
+		buf.Myprintf(" as %s", node.Type)
+	}
+	if node.IncrementBy != "" {
+		buf.Myprintf(" increment by %s", node.IncrementBy)
+	}
+	if node.MinValue != "" {
+		buf.Myprintf(" minvalue %s", node.MinValue)
+	} else if node.NoMinValue {
+		buf.Myprintf(" no minvalue")
+	}
+	if node.MaxValue != "" {
+		buf.Myprintf(" maxvalue %s", node.MaxValue)
+	} else if node.NoMaxValue {
+		buf.Myprintf(" no maxvalue")
+	}
+	if node.StartWith != "" {
+		buf.Myprintf(" start with %s", node.StartWith)
+	}
+	if node.Cache != "" {
+		buf.Myprintf(" cache %s", node.Cache)
+	}
+	if node.Cycle != "" {
+		buf.Myprintf(" %s", node.Cycle)
+	}
+	if node.OwnedBy != nil {
+		buf.Myprintf(" owned by %v", node.OwnedBy)
+	}
+}
+
+func (node *SequenceSpec) walkSubtree(visit Visit) error {
+	if node == nil || node.OwnedBy == nil {
+		return nil
+	}
+	return Walk(visit, node.OwnedBy)
+}
+
+// DomainSpec describes the base type and constraints of PostgreSQL's CREATE DOMAIN.
+type DomainSpec struct {
+	Type    ColumnType
+	Default Expr
+	NotNull bool
+	Checks  []*CheckDefinition
+}
+
+// Format formats the node.
+func (node *DomainSpec) Format(buf *TrackedBuffer) {
+	buf.Myprintf(" as %v", &node.Type)
+	if node.Default != nil {
+		buf.Myprintf(" default %v", node.Default)
+	}
+	if node.NotNull {
+		buf.Myprintf(" not null")
+	}
+	for _, check := range node.Checks {
+		buf.Myprintf(" %v", check)
+	}
+}
+
+func (node *DomainSpec) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, &node.Type, node.Default)
+}
+
+// ForeignSpec describes PostgreSQL's CREATE SERVER, CREATE USER MAPPING and CREATE FOREIGN TABLE after their names.
+type ForeignSpec struct {
+	Type    *SQLVal  // CREATE SERVER's TYPE, or nil
+	Version *SQLVal  // CREATE SERVER's VERSION, or nil
+	Wrapper ColIdent // CREATE SERVER's FOREIGN DATA WRAPPER
+	User    ColIdent // CREATE USER MAPPING's FOR
+	Server  ColIdent // SERVER of CREATE USER MAPPING and CREATE FOREIGN TABLE
+	Options ForeignOptions
+}
+
+// ForeignOption describes an option given by OPTIONS of PostgreSQL's foreign data.
+type ForeignOption struct {
+	Name  ColIdent
+	Value *SQLVal
+}
+
+// ForeignOptions is OPTIONS of PostgreSQL's foreign data.
+type ForeignOptions []*ForeignOption
+
+// Format formats the node.
+func (node ForeignOptions) Format(buf *TrackedBuffer) {
+	if len(node) == 0 {
+		return
+	}
+	buf.Myprintf(" options (")
+	for i, option := range node {
+		if i > 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v %v", option.Name, option.Value)
+	}
+	buf.Myprintf(")")
+}
+
+func (node ForeignOptions) walkSubtree(visit Visit) error {
+	for _, option := range node {
+		if err := Walk(visit, option.Name, option.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GrantSpec describes privileges and grantees of PostgreSQL's GRANT and REVOKE, which are lowercased.
+type GrantSpec struct {
+	Privileges      []string // "all" is given for ALL PRIVILEGES
+	ObjectType      string   // table or sequence
+	Grantees        []string
+	WithGrantOption bool
+}
+
+// PolicySpec describes PostgreSQL's CREATE POLICY after the table name.
+type PolicySpec struct {
+	Name       ColIdent
+	Permissive string // permissive or restrictive
+	Command    string // all, select, insert, update or delete
+	Roles      []string
+	Using      Expr
+	WithCheck  Expr
+}
+
+// Format formats the node.
+func (node *PolicySpec) Format(buf *TrackedBuffer) {
+	buf.Myprintf(" as %s for %s", node.Permissive, node.Command)
+	if len(node.Roles) > 0 {
+		buf.Myprintf(" to %s", strings.Join(node.Roles, ", "))
+	}
+	if node.Using != nil {
+		buf.Myprintf(" using (%v)", node.Using)
+	}
+	if node.WithCheck != nil {
+		buf.Myprintf(" with check (%v)", node.WithCheck)
+	}
+}
+
+func (node *PolicySpec) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Using, node.WithCheck)
+}
+
+// IdentitySpec describes `GENERATED { ALWAYS | BY DEFAULT } AS IDENTITY [ ( sequence_options ) ]` of PostgreSQL.
+type IdentitySpec struct {
+	Behavior string        // always or by default
+	Sequence *SequenceSpec // nil if options are not specified
+}
+
+// Format formats the node.
+func (node *IdentitySpec) Format(buf *TrackedBuffer) {
+	buf.Myprintf("generated %s as identity", node.Behavior)
+	if node.Sequence != nil {
+		buf.Myprintf(" (%s)", strings.TrimPrefix(String(node.Sequence), " "))
+	}
+}
+
+func (node *IdentitySpec) walkSubtree(visit Visit) error {
+	return nil
+}
+
+// AlterColumnSpec describes a column changed by `ALTER TABLE ... ALTER COLUMN`.
+type AlterColumnSpec struct {
+	Column         ColIdent
+	Identity       *IdentitySpec // ADD GENERATED ... AS IDENTITY
+	DefaultNextval string        // SET DEFAULT nextval('sequence'::regclass)
+}
+
+// PartitionBound describes PostgreSQL's `FOR VALUES` or `DEFAULT` of a partition.
+type PartitionBound struct {
+	From      Exprs
+	To        Exprs
+	In        Exprs
+	Modulus   *SQLVal
+	Remainder *SQLVal
+	Default   bool
+}
+
+// Format formats the node.
+func (node *PartitionBound) Format(buf *TrackedBuffer) {
+	switch {
+	case node.Default:
+		buf.Myprintf("default")
+	case node.In != nil:
+		buf.Myprintf("for values in (%v)", node.In)
+	case node.Modulus != nil:
+		buf.Myprintf("for values with (modulus %v, remainder %v)", node.Modulus, node.Remainder)
+	default:
+		buf.Myprintf("for values from (%v) to (%v)", node.From, node.To)
+	}
+}
+
+func (node *PartitionBound) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.From, node.To, node.In)
+}
+
 // TableSpec describes the structure of a table from a CREATE TABLE statement
 type TableSpec struct {
-	Columns []*ColumnDefinition
-	Indexes []*IndexDefinition
-	Options string
+	Columns     []*ColumnDefinition
+	Indexes     []*IndexDefinition
+	ForeignKeys []*ForeignKeyDefinition
+	Checks      []*CheckDefinition
+	Exclusions  []*ExclusionDefinition // PostgreSQL's EXCLUDE constraints
+	Options     string
+	Partition   *PartitionOption
+	PartitionOf *PartitionOf      // Columns are inherited from the parent if this is given.
+	Inherits    TableNames        // PostgreSQL's parents given by INHERITS, whose columns are not listed in Columns.
+	Period      *PeriodDefinition // MariaDB's PERIOD FOR SYSTEM_TIME of a system-versioned table
+	Like        TableName         // MySQL's `CREATE TABLE ... LIKE`, whose definition is copied from the table.
 }
 
 // Format formats the node.
 func (ts *TableSpec) Format(buf *TrackedBuffer) {
+	if !ts.Like.IsEmpty() {
+		buf.Myprintf("like %v", ts.Like)
+		return
+	}
+	if ts.PartitionOf != nil {
+		buf.Myprintf("%v", ts.PartitionOf)
+		if ts.Partition != nil {
+			buf.Myprintf(" %v", ts.Partition)
+		}
+		return
+	}
+
 	buf.Myprintf("(\n")
 	for i, col := range ts.Columns {
 		if i == 0 {
@@ -831,8 +1434,27 @@ func (ts *TableSpec) Format(buf *TrackedBuffer) {
 	for _, idx := range ts.Indexes {
 		buf.Myprintf(",\n\t%v", idx)
 	}
+	for _, fk := range ts.ForeignKeys {
+		buf.Myprintf(",\n\t%v", fk)
+	}
+	for _, check := range ts.Checks {
+		buf.Myprintf(",\n\t%v", check)
+	}
+	for _, exclusion := range ts.Exclusions {
+		buf.Myprintf(",\n\t%v", exclusion)
+	}
+	if ts.Period != nil {
+		buf.Myprintf(",\n\t%v", ts.Period)
+	}
 
-	buf.Myprintf("\n)%s", strings.Replace(ts.Options, ", ", ",\n  ", -1))
+	buf.Myprintf("\n)")
+	if len(ts.Inherits) > 0 {
+		buf.Myprintf(" inherits (%v)", ts.Inherits)
+	}
+	buf.Myprintf("%s", strings.Replace(ts.Options, ", ", ",\n  ", -1))
+	if ts.Partition != nil {
+		buf.Myprintf(" %v", ts.Partition)
+	}
 }
 
 // AddColumn appends the given column to the list in the spec
@@ -845,6 +1467,21 @@ func (ts *TableSpec) AddIndex(id *IndexDefinition) {
 	ts.Indexes = append(ts.Indexes, id)
 }
 
+// AddForeignKey appends the given foreign key to the list in the spec
+func (ts *TableSpec) AddForeignKey(fk *ForeignKeyDefinition) {
+	ts.ForeignKeys = append(ts.ForeignKeys, fk)
+}
+
+// AddCheck appends the given check constraint to the list in the spec
+func (ts *TableSpec) AddCheck(check *CheckDefinition) {
+	ts.Checks = append(ts.Checks, check)
+}
+
+// AddExclusion appends the given exclusion constraint to the list in the spec
+func (ts *TableSpec) AddExclusion(exclusion *ExclusionDefinition) {
+	ts.Exclusions = append(ts.Exclusions, exclusion)
+}
+
 func (ts *TableSpec) walkSubtree(visit Visit) error {
 	if ts == nil {
 		return nil
@@ -862,7 +1499,25 @@ func (ts *TableSpec) walkSubtree(visit Visit) error {
 		}
 	}
 
-	return nil
+	for _, n := range ts.ForeignKeys {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+
+	for _, n := range ts.Checks {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+
+	for _, n := range ts.Exclusions {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+
+	return Walk(visit, ts.Partition, ts.PartitionOf, ts.Inherits, ts.Period, ts.Like)
 }
 
 // ColumnDefinition describes a column in a CREATE TABLE statement
@@ -899,6 +1554,16 @@ type ColumnType struct {
 	Default       *SQLVal
 	OnUpdate      *SQLVal
 	Comment       *SQLVal
+	Invisible     BoolVal // MySQL's INVISIBLE column
+
+	// MariaDB's GENERATED ALWAYS AS ROW START or ROW END of a system-versioned table
+	SystemVersioning string
+
+	// PostgreSQL's DEFAULT nextval('sequence'), given the sequence name
+	DefaultNextval string
+
+	// DEFAULT given by a function call like now(), or MySQL's parenthesized expression
+	DefaultExpr Expr
 
 	// Numeric field options
 	Length   *SQLVal
@@ -913,8 +1578,52 @@ type ColumnType struct {
 	// Enum values
 	EnumValues []string
 
+	// PostgreSQL's array type like `text[]`
+	Array BoolVal
+
+	// PostgreSQL's time or timestamp WITH TIME ZONE
+	Timezone BoolVal
+
+	// Spatial field options. The geometry type is PostGIS's one like `geometry(Point,4326)`, and SRID is given by
+	// either MySQL's `SRID 4326` or the PostGIS's type.
+	GeometryType string
+	Srid         *SQLVal
+
 	// Key specification
 	KeyOpt ColumnKeyOption
+
+	// Inline foreign key. ConstraintName and IndexColumns are not set.
+	References *ForeignKeyDefinition
+
+	// Inline check constraints
+	Checks []*CheckDefinition
+
+	// Generated column
+	Generated *GeneratedColumn
+
+	// PostgreSQL's identity column
+	Identity *IdentitySpec
+}
+
+// Return the sequence name of nextval('sequence') or nextval('sequence'::regclass), which is given by a
+// function call since the grammar can't distinguish it from other functions until its arguments.
+func nextvalSequence(expr Expr) (string, bool) {
+	funcExpr, ok := expr.(*FuncExpr)
+	if !ok || !funcExpr.Qualifier.IsEmpty() || funcExpr.Name.Lowered() != "nextval" || len(funcExpr.Exprs) != 1 {
+		return "", false
+	}
+	aliasedExpr, ok := funcExpr.Exprs[0].(*AliasedExpr)
+	if !ok {
+		return "", false
+	}
+	arg := aliasedExpr.Expr
+	if typeCast, ok := arg.(*TypeCastExpr); ok && strings.ToLower(typeCast.Type.Type) == "regclass" {
+		arg = typeCast.Expr
+	}
+	if val, ok := arg.(*SQLVal); ok && val.Type == StrVal {
+		return string(val.Val), true
+	}
+	return "", false
 }
 
 // Format returns a canonical string representation of the type and all relevant options
@@ -932,6 +1641,20 @@ func (ct *ColumnType) Format(buf *TrackedBuffer) {
 		buf.Myprintf("(%s)", strings.Join(ct.EnumValues, ", "))
 	}
 
+	if ct.GeometryType != "" && ct.Srid != nil {
+		buf.Myprintf("(%s,%v)", ct.GeometryType, ct.Srid)
+	} else if ct.GeometryType != "" {
+		buf.Myprintf("(%s)", ct.GeometryType)
+	}
+
+	if ct.Timezone {
+		buf.Myprintf(" with time zone")
+	}
+
+	if ct.Array {
+		buf.Myprintf("[]")
+	}
+
 	opts := make([]string, 0, 16)
 	if ct.Unsigned {
 		opts = append(opts, keywordStrings[UNSIGNED])
@@ -945,18 +1668,39 @@ func (ct *ColumnType) Format(buf *TrackedBuffer) {
 	if ct.Collate != "" {
 		opts = append(opts, keywordStrings[COLLATE], ct.Collate)
 	}
+	if ct.GeometryType == "" && ct.Srid != nil {
+		opts = append(opts, keywordStrings[SRID], String(ct.Srid))
+	}
+	if ct.Generated != nil {
+		opts = append(opts, String(ct.Generated))
+	}
+	if ct.Identity != nil {
+		opts = append(opts, String(ct.Identity))
+	}
+	if ct.SystemVersioning != "" {
+		opts = append(opts, "generated always as", ct.SystemVersioning)
+	}
 	if ct.NotNull {
 		opts = append(opts, keywordStrings[NOT], keywordStrings[NULL])
 	}
 	if ct.Default != nil {
 		opts = append(opts, keywordStrings[DEFAULT], String(ct.Default))
 	}
+	if ct.DefaultExpr != nil {
+		opts = append(opts, keywordStrings[DEFAULT], String(ct.DefaultExpr))
+	}
+	if ct.DefaultNextval != "" {
+		opts = append(opts, keywordStrings[DEFAULT], fmt.Sprintf("nextval(%s::regclass)", String(NewStrVal([]byte(ct.DefaultNextval)))))
+	}
 	if ct.OnUpdate != nil {
 		opts = append(opts, keywordStrings[ON], keywordStrings[UPDATE], String(ct.OnUpdate))
 	}
 	if ct.Autoincrement {
 		opts = append(opts, keywordStrings[AUTO_INCREMENT])
 	}
+	if ct.Invisible {
+		opts = append(opts, keywordStrings[INVISIBLE])
+	}
 	if ct.Comment != nil {
 		opts = append(opts, keywordStrings[COMMENT_KEYWORD], String(ct.Comment))
 	}
@@ -975,6 +1719,13 @@ func (ct *ColumnType) Format(buf *TrackedBuffer) {
 	if ct.KeyOpt == colKey {
 		opts = append(opts, keywordStrings[KEY])
 	}
+	for _, check := range ct.Checks {
+		opts = append(opts, String(check))
+	}
+	if ct.References != nil {
+		opts = append(opts, keywordStrings[REFERENCES], String(ct.References.ReferenceName)+" "+String(Columns(ct.References.ReferenceColumns)))
+		opts = append(opts, ct.References.formatReferenceOptions()...)
+	}
 
 	if len(opts) != 0 {
 		buf.Myprintf(" %s", strings.Join(opts, " "))
@@ -1109,9 +1860,10 @@ func (ct *ColumnType) walkSubtree(visit Visit) error {
 
 // IndexDefinition describes an index in a CREATE TABLE statement
 type IndexDefinition struct {
-	Info    *IndexInfo
-	Columns []*IndexColumn
-	Options []*IndexOption
+	Info       *IndexInfo
+	Columns    []*IndexColumn
+	Options    []*IndexOption
+	Deferrable string // PostgreSQL's deferrability of a unique constraint
 }
 
 // Format formats the node.
@@ -1119,13 +1871,22 @@ func (idx *IndexDefinition) Format(buf *TrackedBuffer) {
 	buf.Myprintf("%v (", idx.Info)
 	for i, col := range idx.Columns {
 		if i != 0 {
-			buf.Myprintf(", %v", col.Column)
+			buf.Myprintf(", ")
+		}
+		if col.Expr != nil {
+			buf.Myprintf("(%v)", col.Expr)
 		} else {
 			buf.Myprintf("%v", col.Column)
 		}
 		if col.Length != nil {
 			buf.Myprintf("(%v)", col.Length)
 		}
+		if col.Direction == DescScr {
+			buf.Myprintf(" %s", col.Direction)
+		}
+		if col.NullsOrder != "" {
+			buf.Myprintf(" %s", col.NullsOrder)
+		}
 	}
 	buf.Myprintf(")")
 
@@ -1133,10 +1894,13 @@ func (idx *IndexDefinition) Format(buf *TrackedBuffer) {
 		buf.Myprintf(" %s", opt.Name)
 		if opt.Using != "" {
 			buf.Myprintf(" %s", opt.Using)
-		} else {
+		} else if opt.Value != nil {
 			buf.Myprintf(" %v", opt.Value)
 		}
 	}
+	if idx.Deferrable != "" {
+		buf.Myprintf(" %s", idx.Deferrable)
+	}
 }
 
 func (idx *IndexDefinition) walkSubtree(visit Visit) error {
@@ -1153,18 +1917,211 @@ func (idx *IndexDefinition) walkSubtree(visit Visit) error {
 	return nil
 }
 
+// ForeignKeyDefinition describes a foreign key in a CREATE TABLE or ALTER TABLE statement
+type ForeignKeyDefinition struct {
+	ConstraintName   ColIdent
+	IndexName        ColIdent
+	IndexColumns     []ColIdent
+	ReferenceName    TableName
+	ReferenceColumns []ColIdent
+	OnDelete         ColIdent
+	OnUpdate         ColIdent
+	Deferrable       string // PostgreSQL's deferrability
+}
+
+// Format formats the node.
+func (fk *ForeignKeyDefinition) Format(buf *TrackedBuffer) {
+	if !fk.ConstraintName.IsEmpty() {
+		buf.Myprintf("constraint %v ", fk.ConstraintName)
+	}
+	buf.Myprintf("foreign key ")
+	if !fk.IndexName.IsEmpty() {
+		buf.Myprintf("%v ", fk.IndexName)
+	}
+	buf.Myprintf("%v references %v %v", Columns(fk.IndexColumns), fk.ReferenceName, Columns(fk.ReferenceColumns))
+	for _, opt := range fk.formatReferenceOptions() {
+		buf.Myprintf(" %s", opt)
+	}
+}
+
+func (fk *ForeignKeyDefinition) formatReferenceOptions() []string {
+	opts := []string{}
+	if !fk.OnDelete.IsEmpty() {
+		opts = append(opts, keywordStrings[ON], keywordStrings[DELETE], fk.OnDelete.String())
+	}
+	if !fk.OnUpdate.IsEmpty() {
+		opts = append(opts, keywordStrings[ON], keywordStrings[UPDATE], fk.OnUpdate.String())
+	}
+	if fk.Deferrable != "" {
+		opts = append(opts, fk.Deferrable)
+	}
+	return opts
+}
+
+func (fk *ForeignKeyDefinition) walkSubtree(visit Visit) error {
+	if fk == nil {
+		return nil
+	}
+	if err := Walk(visit, fk.ConstraintName, fk.IndexName, Columns(fk.IndexColumns), fk.ReferenceName); err != nil {
+		return err
+	}
+	return Walk(visit, Columns(fk.ReferenceColumns))
+}
+
+// Deferrability of PostgreSQL's constraints. NOT DEFERRABLE, which is the default, is normalized to an empty string.
+const (
+	DeferrableStr = "deferrable" // DEFERRABLE INITIALLY IMMEDIATE
+	DeferredStr   = "deferrable initially deferred"
+)
+
+// Normalize lowercased words like `deferrable initially deferred` to DeferrableStr, DeferredStr or an empty string.
+// INITIALLY DEFERRED implies DEFERRABLE.
+func normalizeDeferrability(words []string) (string, error) {
+	switch deferrability := strings.Join(words, " "); deferrability {
+	case "deferrable", "deferrable initially immediate":
+		return DeferrableStr, nil
+	case "deferrable initially deferred", "initially deferred":
+		return DeferredStr, nil
+	case "not deferrable", "initially immediate", "not deferrable initially immediate":
+		return "", nil
+	default:
+		return "", fmt.Errorf("unexpected deferrability of constraint: %s", deferrability)
+	}
+}
+
+// CheckDefinition describes a check constraint in a CREATE TABLE statement
+type CheckDefinition struct {
+	ConstraintName ColIdent
+	Expr           Expr
+}
+
+// Format formats the node.
+func (check *CheckDefinition) Format(buf *TrackedBuffer) {
+	if !check.ConstraintName.IsEmpty() {
+		buf.Myprintf("constraint %v ", check.ConstraintName)
+	}
+	buf.Myprintf("check (%v)", check.Expr)
+}
+
+func (check *CheckDefinition) walkSubtree(visit Visit) error {
+	if check == nil {
+		return nil
+	}
+	return Walk(visit, check.ConstraintName, check.Expr)
+}
+
+// PeriodDefinition describes MariaDB's `PERIOD FOR SYSTEM_TIME (start, end)`
+type PeriodDefinition struct {
+	Name  ColIdent
+	Start ColIdent
+	End   ColIdent
+}
+
+// PeriodDefinition strings of the row start and row end columns.
+const (
+	RowStartStr = "row start"
+	RowEndStr   = "row end"
+)
+
+// Format formats the node.
+func (period *PeriodDefinition) Format(buf *TrackedBuffer) {
+	buf.Myprintf("period for %v (%v, %v)", period.Name, period.Start, period.End)
+}
+
+func (period *PeriodDefinition) walkSubtree(visit Visit) error {
+	if period == nil {
+		return nil
+	}
+	return Walk(visit, period.Name, period.Start, period.End)
+}
+
+// ExclusionDefinition describes PostgreSQL's `EXCLUDE USING gist (column WITH operator, ...)` constraint
+type ExclusionDefinition struct {
+	ConstraintName ColIdent
+	IndexType      ColIdent
+	Elements       []ExclusionElement
+	Where          Expr   // The predicate of a partial exclusion constraint, or nil
+	Deferrable     string // PostgreSQL's deferrability
+}
+
+// ExclusionElement is a column compared by the operator in an exclusion constraint
+type ExclusionElement struct {
+	Column   ColIdent
+	Operator string
+}
+
+// Format formats the node.
+func (exclusion *ExclusionDefinition) Format(buf *TrackedBuffer) {
+	if !exclusion.ConstraintName.IsEmpty() {
+		buf.Myprintf("constraint %v ", exclusion.ConstraintName)
+	}
+	buf.Myprintf("exclude using %v (", exclusion.IndexType)
+	for i, element := range exclusion.Elements {
+		if i > 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v with %s", element.Column, element.Operator)
+	}
+	buf.Myprintf(")")
+	if exclusion.Where != nil {
+		buf.Myprintf(" where (%v)", exclusion.Where)
+	}
+	if exclusion.Deferrable != "" {
+		buf.Myprintf(" %s", exclusion.Deferrable)
+	}
+}
+
+func (exclusion *ExclusionDefinition) walkSubtree(visit Visit) error {
+	if exclusion == nil {
+		return nil
+	}
+	return Walk(visit, exclusion.ConstraintName, exclusion.IndexType, exclusion.Where)
+}
+
+// GeneratedColumn describes `GENERATED ALWAYS AS (expr)` of a column definition.
+// Type is empty when neither VIRTUAL nor STORED is specified.
+type GeneratedColumn struct {
+	Expr Expr
+	Type string
+}
+
+// GeneratedColumn strings.
+const (
+	VirtualStr = "virtual"
+	StoredStr  = "stored"
+)
+
+// Format formats the node.
+func (gen *GeneratedColumn) Format(buf *TrackedBuffer) {
+	buf.Myprintf("generated always as (%v)", gen.Expr)
+	if gen.Type != "" {
+		buf.Myprintf(" %s", gen.Type)
+	}
+}
+
+func (gen *GeneratedColumn) walkSubtree(visit Visit) error {
+	if gen == nil {
+		return nil
+	}
+	return Walk(visit, gen.Expr)
+}
+
 // IndexInfo describes the name and type of an index in a CREATE TABLE statement
 type IndexInfo struct {
-	Type    string
-	Name    ColIdent
-	Primary bool
-	Spatial bool
-	Unique  bool
+	Type       string
+	Name       ColIdent
+	Primary    bool
+	Spatial    bool
+	Fulltext   bool
+	Unique     bool
+	Constraint bool // Defined as `CONSTRAINT name UNIQUE`
 }
 
 // Format formats the node.
 func (ii *IndexInfo) Format(buf *TrackedBuffer) {
-	if ii.Primary {
+	if ii.Constraint {
+		buf.Myprintf("constraint %v %s", ii.Name, ii.Type)
+	} else if ii.Primary || ii.Name.IsEmpty() {
 		buf.Myprintf("%s", ii.Type)
 	} else {
 		buf.Myprintf("%s %v", ii.Type, ii.Name)
@@ -1175,10 +2132,14 @@ func (ii *IndexInfo) walkSubtree(visit Visit) error {
 	return Walk(visit, ii.Name)
 }
 
-// IndexColumn describes a column in an index definition with optional length
+// IndexColumn describes a column in an index definition with optional length, or an expression
 type IndexColumn struct {
 	Column ColIdent
 	Length *SQLVal
+	Expr   Expr // MySQL's functional key part or PostgreSQL's expression. Column is empty if this is given.
+
+	Direction  string // AscScr or DescScr
+	NullsOrder string // PostgreSQL's "nulls first" or "nulls last". Empty if it's not given.
 }
 
 // LengthScaleOption is used for types that have an optional length
@@ -1188,11 +2149,11 @@ type LengthScaleOption struct {
 	Scale  *SQLVal
 }
 
-// IndexOption is used for trailing options for indexes: COMMENT, KEY_BLOCK_SIZE, USING
+// IndexOption is used for trailing options for indexes: COMMENT, KEY_BLOCK_SIZE, USING, WITH PARSER, VISIBLE and INVISIBLE
 type IndexOption struct {
 	Name  string
 	Value *SQLVal
-	Using string
+	Using string // The identifier given to USING or WITH PARSER
 }
 
 // ColumnKeyOption indicates whether or not the given column is defined as an
@@ -1209,10 +2170,26 @@ const (
 )
 
 type IndexSpec struct {
-	Name    ColIdent
-	Type    ColIdent
-	Unique  bool
-	Primary bool
+	Name       ColIdent
+	Type       ColIdent
+	Unique     bool
+	Primary    bool
+	Constraint bool
+	Deferrable string  // PostgreSQL's deferrability of a unique constraint
+	Fulltext   bool    // MySQL's FULLTEXT index
+	Parser     string  // MySQL's WITH PARSER of a fulltext index
+	Spatial    bool    // MySQL's SPATIAL index
+	Invisible  bool    // MySQL's INVISIBLE index
+	Include    Columns // PostgreSQL's INCLUDE columns of a covering index
+	Tablespace string  // PostgreSQL's TABLESPACE, or empty for the default
+	Where      Expr    // PostgreSQL's predicate of a partial index, or nil
+}
+
+// CommentSpec defines a comment for PostgreSQL's COMMENT ON statement.
+// Column is empty for COMMENT ON TABLE, and Comment is nil for `IS NULL`.
+type CommentSpec struct {
+	Column  ColIdent
+	Comment *SQLVal
 }
 
 // VindexSpec defines a vindex for a CREATE VINDEX or DROP VINDEX statement
@@ -1937,6 +2914,7 @@ func (*IsExpr) iExpr()           {}
 func (*ExistsExpr) iExpr()       {}
 func (*SQLVal) iExpr()           {}
 func (*NullVal) iExpr()          {}
+func (*MaxValueVal) iExpr()      {}
 func (BoolVal) iExpr()           {}
 func (*ColName) iExpr()          {}
 func (ValTuple) iExpr()          {}
@@ -1950,6 +2928,10 @@ func (*FuncExpr) iExpr()         {}
 func (*CaseExpr) iExpr()         {}
 func (*ValuesFuncExpr) iExpr()   {}
 func (*ConvertExpr) iExpr()      {}
+func (*TypeCastExpr) iExpr()     {}
+func (*IntroducerExpr) iExpr()   {}
+func (*ArrayConstructor) iExpr() {}
+func (*QuantifiedExpr) iExpr()   {}
 func (*SubstrExpr) iExpr()       {}
 func (*ConvertUsingExpr) iExpr() {}
 func (*MatchExpr) iExpr()        {}
@@ -2288,6 +3270,10 @@ const (
 type SQLVal struct {
 	Type ValType
 	Val  []byte
+
+	// PostgreSQL's string, which is formatted by doubling quotes since standard_conforming_strings
+	// treats backslashes literally.
+	Postgres bool
 }
 
 // NewStrVal builds a new StrVal.
@@ -2329,7 +3315,11 @@ func NewValArg(in []byte) *SQLVal {
 func (node *SQLVal) Format(buf *TrackedBuffer) {
 	switch node.Type {
 	case StrVal:
-		sqltypes.MakeTrusted(sqltypes.VarBinary, node.Val).EncodeSQL(buf)
+		if node.Postgres {
+			buf.WriteString("'" + strings.Replace(string(node.Val), "'", "''", -1) + "'")
+		} else {
+			sqltypes.MakeTrusted(sqltypes.VarBinary, node.Val).EncodeSQL(buf)
+		}
 	case IntVal, FloatVal, HexNum:
 		buf.Myprintf("%s", []byte(node.Val))
 	case HexVal:
@@ -2377,6 +3367,22 @@ func (node *NullVal) replace(from, to Expr) bool {
 	return false
 }
 
+// MaxValueVal represents MAXVALUE in PostgreSQL's partition bound.
+type MaxValueVal struct{}
+
+// Format formats the node.
+func (node *MaxValueVal) Format(buf *TrackedBuffer) {
+	buf.Myprintf("maxvalue")
+}
+
+func (node *MaxValueVal) walkSubtree(visit Visit) error {
+	return nil
+}
+
+func (node *MaxValueVal) replace(from, to Expr) bool {
+	return false
+}
+
 // BoolVal is true or false.
 type BoolVal bool
 
@@ -2531,6 +3537,7 @@ const (
 	ModStr        = "%"
 	ShiftLeftStr  = "<<"
 	ShiftRightStr = ">>"
+	ConcatStr     = "||" // PostgreSQL's string concatenation
 )
 
 // Format formats the node.
@@ -2842,6 +3849,113 @@ func (node *ConvertExpr) replace(from, to Expr) bool {
 	return replaceExprs(from, to, &node.Expr)
 }
 
+// TypeCastExpr represents PostgreSQL's `expr::type`.
+type TypeCastExpr struct {
+	Expr Expr
+	Type *ColumnType
+}
+
+// Format formats the node.
+func (node *TypeCastExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v::%v", node.Expr, node.Type)
+}
+
+func (node *TypeCastExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Expr,
+	)
+}
+
+func (node *TypeCastExpr) replace(from, to Expr) bool {
+	return replaceExprs(from, to, &node.Expr)
+}
+
+// IntroducerExpr represents MySQL's string literal with a character set introducer like `_utf8mb4'str'`.
+type IntroducerExpr struct {
+	CharacterSet string
+	Expr         Expr
+}
+
+// Format formats the node.
+func (node *IntroducerExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%s%v", node.CharacterSet, node.Expr)
+}
+
+func (node *IntroducerExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Expr,
+	)
+}
+
+func (node *IntroducerExpr) replace(from, to Expr) bool {
+	return replaceExprs(from, to, &node.Expr)
+}
+
+// ArrayConstructor represents PostgreSQL's `ARRAY[expr, ...]`.
+type ArrayConstructor struct {
+	Elements Exprs
+}
+
+// Format formats the node.
+func (node *ArrayConstructor) Format(buf *TrackedBuffer) {
+	buf.Myprintf("array[%v]", node.Elements)
+}
+
+func (node *ArrayConstructor) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Elements)
+}
+
+func (node *ArrayConstructor) replace(from, to Expr) bool {
+	for i := range node.Elements {
+		if replaceExprs(from, to, &node.Elements[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// QuantifiedExpr represents the right side of a comparison like `= ANY (expr)` and `<> ALL (expr)`.
+type QuantifiedExpr struct {
+	Quantifier string
+	Expr       Expr
+}
+
+// QuantifiedExpr.Quantifier
+const (
+	AnyStr = "any"
+	AllStr = "all"
+)
+
+// Format formats the node.
+func (node *QuantifiedExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%s (%v)", node.Quantifier, node.Expr)
+}
+
+func (node *QuantifiedExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Expr,
+	)
+}
+
+func (node *QuantifiedExpr) replace(from, to Expr) bool {
+	return replaceExprs(from, to, &node.Expr)
+}
+
 // ConvertUsingExpr represents a call to CONVERT(expr USING charset).
 type ConvertUsingExpr struct {
 	Expr Expr
@@ -608,6 +608,12 @@ func TestSplitStatementToPieces(t *testing.T) {
 	}, {
 		input:  "select * from table1;--comment;\nselect * from table2;",
 		output: "select * from table1;--comment;\nselect * from table2",
+	}, {
+		input:  "select 'a''b;' from table1; select 'a\\';b' from table2;",
+		output: "select 'a''b;' from table1; select 'a\\';b' from table2",
+	}, {
+		input:  "select `a;b` from table1 /* ; */; select 1 # ;\n;",
+		output: "select `a;b` from table1 /* ; */; select 1 # ;\n",
 	}, {
 		input: "CREATE TABLE `total_data` (`id` int(11) NOT NULL AUTO_INCREMENT COMMENT 'id', " +
 			"`region` varchar(32) NOT NULL COMMENT 'region name, like zh; th; kepler'," +
@@ -634,3 +640,65 @@ func TestSplitStatementToPieces(t *testing.T) {
 		}
 	}
 }
+
+func TestSplitStatementToPiecesPostgres(t *testing.T) {
+	testcases := []struct {
+		input  string
+		output []string
+	}{{
+		input:  "select 1; select 2;",
+		output: []string{"select 1", " select 2"},
+	}, {
+		input:  "select 'a\\'; select \"b;\";",
+		output: []string{"select 'a\\'", " select \"b;\""},
+	}, {
+		input:  "create function f() returns int as $$ begin; return 1; end; $$ language plpgsql; select 1;",
+		output: []string{"create function f() returns int as $$ begin; return 1; end; $$ language plpgsql", " select 1"},
+	}, {
+		input:  "create function f() returns int as $func$ select '$$;'; $func$ language sql; select 1;",
+		output: []string{"create function f() returns int as $func$ select '$$;'; $func$ language sql", " select 1"},
+	}}
+
+	for _, tcase := range testcases {
+		stmtPieces, err := SplitStatementToPiecesWithMode(tcase.input, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(stmtPieces, tcase.output) {
+			t.Errorf("out: %q, want %q", stmtPieces, tcase.output)
+		}
+	}
+}
+
+func TestSplitStatementToPiecesBeginEnd(t *testing.T) {
+	testcases := []struct {
+		input  string
+		output []string
+	}{{
+		input:  "create trigger a before insert on t for each row begin set new.b = 1; set new.c = 2; end; select 1;",
+		output: []string{"create trigger a before insert on t for each row begin set new.b = 1; set new.c = 2; end", " select 1"},
+	}, {
+		input:  "create trigger a before insert on t for each row begin if new.b then set new.b = 1; end if; end; select 1;",
+		output: []string{"create trigger a before insert on t for each row begin if new.b then set new.b = 1; end if; end", " select 1"},
+	}, {
+		input:  "select case when a then 1 end from t; select 1;",
+		output: []string{"select case when a then 1 end from t", " select 1"},
+	}, {
+		input:  "begin; select 1; commit;",
+		output: []string{"begin", " select 1", " commit"},
+	}}
+
+	for _, tcase := range testcases {
+		stmtPieces, err := SplitStatementToPieces(tcase.input)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(stmtPieces, tcase.output) {
+			t.Errorf("out: %q, want %q", stmtPieces, tcase.output)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package sqlparser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/k0kubun/sqldef/sqlparser/dependency/bytes2"
+)
+
+// FunctionSpec describes CREATE FUNCTION or MySQL's CREATE PROCEDURE after its name. Since argument types and
+// options have too many variations to parse, they're kept as tokens, with keywords and unquoted identifiers lowercased.
+type FunctionSpec struct {
+	Arguments []string // Each argument like "a integer default 1"
+	Returns   string
+	Language  string   // Empty for MySQL, whose LANGUAGE SQL is kept in Options
+	Body      string   // The content of AS for PostgreSQL, or the routine body for MySQL, which is not parsed
+	Options   []string // Other options like "immutable" or "security definer"
+}
+
+// Keywords starting an option of CREATE FUNCTION
+var functionOptionKeywords = map[string]bool{
+	"as":        true,
+	"called":    true,
+	"cost":      true,
+	"external":  true,
+	"immutable": true,
+	"language":  true,
+	"leakproof": true,
+	"not":       true,
+	"parallel":  true,
+	"returns":   true,
+	"rows":      true,
+	"security":  true,
+	"set":       true,
+	"stable":    true,
+	"strict":    true,
+	"support":   true,
+	"transform": true,
+	"volatile":  true,
+	"window":    true,
+}
+
+// The number of tokens in each characteristic of MySQL's CREATE FUNCTION or CREATE PROCEDURE, by its first keyword
+var mysqlRoutineCharacteristicLengths = map[string]int{
+	"comment":       2, // COMMENT 'string'
+	"contains":      2, // CONTAINS SQL
+	"deterministic": 1,
+	"language":      2, // LANGUAGE SQL
+	"modifies":      3, // MODIFIES SQL DATA
+	"no":            2, // NO SQL
+	"not":           2, // NOT DETERMINISTIC
+	"reads":         3, // READS SQL DATA
+	"sql":           3, // SQL SECURITY { DEFINER | INVOKER }
+}
+
+type functionToken struct {
+	typ   int
+	start int    // The position of the token in the SQL
+	val   []byte // The content of a string
+	text  string // Lowercased unless it's a quoted identifier. A string is always single-quoted.
+}
+
+// parseFunctionDefinition parses `(arguments) RETURNS type LANGUAGE name AS 'body' ...` of CREATE FUNCTION,
+// or `(arguments) [RETURNS type] [characteristic ...] body` of MySQL's CREATE FUNCTION and CREATE PROCEDURE.
+func parseFunctionDefinition(sql string, mode ParserMode) (*FunctionSpec, error) {
+	tokens, err := scanFunctionTokens(sql, mode)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 || tokens[0].typ != '(' {
+		return nil, fmt.Errorf("expected arguments of CREATE FUNCTION: %s", sql)
+	}
+
+	spec := &FunctionSpec{Arguments: []string{}, Options: []string{}}
+
+	// Arguments
+	depth := 0
+	argStart := 1
+	i := 0
+	for ; i < len(tokens); i++ {
+		switch tokens[i].typ {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+		if (depth == 1 && tokens[i].typ == ',') || depth == 0 {
+			if i > argStart {
+				spec.Arguments = append(spec.Arguments, joinFunctionTokens(tokens[argStart:i]))
+			}
+			argStart = i + 1
+		}
+		if depth == 0 {
+			break
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unterminated arguments of CREATE FUNCTION: %s", sql)
+	}
+	if mode == ParserModeMysql {
+		return parseMysqlRoutineDefinition(sql, tokens[i+1:], spec)
+	}
+
+	// Options
+	for i++; i < len(tokens); {
+		end := i + 1
+		for depth = 0; end < len(tokens); end++ {
+			switch tokens[end].typ {
+			case '(':
+				depth++
+			case ')':
+				depth--
+			}
+			if depth == 0 && functionOptionKeywords[tokens[end].text] {
+				break
+			}
+		}
+
+		switch option := tokens[i:end]; {
+		case option[0].text == "returns" && len(option) > 1 && option[1].text != "null":
+			spec.Returns = joinFunctionTokens(option[1:])
+		case option[0].text == "language" && len(option) == 2:
+			if option[1].typ == STRING {
+				spec.Language = strings.ToLower(string(option[1].val))
+			} else {
+				spec.Language = option[1].text
+			}
+		case option[0].text == "as" && len(option) >= 2 && option[1].typ == STRING:
+			// A C function has an object file and a link symbol.
+			bodies := []string{}
+			for _, token := range option[1:] {
+				if token.typ == STRING {
+					bodies = append(bodies, string(token.val))
+				}
+			}
+			spec.Body = strings.Join(bodies, ", ")
+		default:
+			spec.Options = append(spec.Options, joinFunctionTokens(option))
+		}
+		i = end
+	}
+
+	if spec.Body == "" {
+		return nil, fmt.Errorf("expected AS of CREATE FUNCTION: %s", sql)
+	}
+	return spec, nil
+}
+
+// MySQL's routine body is a statement without AS, which follows RETURNS and characteristics.
+func parseMysqlRoutineDefinition(sql string, tokens []functionToken, spec *FunctionSpec) (*FunctionSpec, error) {
+	i := 0
+	if len(tokens) > 1 && tokens[0].text == "returns" {
+		// A type name with optional length and attributes like `varchar(10) charset utf8mb4`
+		end := 2
+		if end < len(tokens) && tokens[end].typ == '(' {
+			for end < len(tokens) && tokens[end].typ != ')' {
+				end++
+			}
+			end++
+		}
+		for end < len(tokens) {
+			switch tokens[end].text {
+			case "unsigned", "signed", "zerofill", "binary":
+				end++
+				continue
+			case "charset", "collate":
+				end += 2
+				continue
+			case "character":
+				end += 3
+				continue
+			}
+			break
+		}
+		if end > len(tokens) {
+			end = len(tokens)
+		}
+		spec.Returns = joinFunctionTokens(tokens[1:end])
+		i = end
+	}
+
+	for i < len(tokens) {
+		length, ok := mysqlRoutineCharacteristicLengths[tokens[i].text]
+		if !ok || i+length > len(tokens) {
+			break
+		}
+		spec.Options = append(spec.Options, joinFunctionTokens(tokens[i:i+length]))
+		i += length
+	}
+
+	if i == len(tokens) {
+		return nil, fmt.Errorf("expected the body of CREATE FUNCTION or CREATE PROCEDURE: %s", sql)
+	}
+	spec.Body = strings.TrimSpace(sql[tokens[i].start:])
+	return spec, nil
+}
+
+func scanFunctionTokens(sql string, mode ParserMode) ([]functionToken, error) {
+	tokens := []functionToken{}
+	tkn := NewStringTokenizer(sql, mode)
+	for {
+		typ, val := tkn.Scan()
+		switch typ {
+		case 0, eofChar, ';':
+			return tokens, nil
+		case LEX_ERROR:
+			return nil, fmt.Errorf("failed to tokenize CREATE FUNCTION at position %d: %s", tkn.Position, sql)
+		case COMMENT:
+			continue
+		}
+
+		text := sql[tkn.tokenStart : tkn.Position-1]
+		if typ == STRING {
+			text = "'" + strings.Replace(string(val), "'", "''", -1) + "'"
+		} else if !strings.HasPrefix(text, `"`) && !strings.HasPrefix(text, "`") {
+			text = strings.ToLower(text)
+		}
+		tokens = append(tokens, functionToken{typ: typ, start: tkn.tokenStart, val: val, text: text})
+	}
+}
+
+// Join tokens by spaces, except around parentheses, commas, dots and casts.
+func joinFunctionTokens(tokens []functionToken) string {
+	buf := &bytes2.Buffer{}
+	for i, token := range tokens {
+		if i > 0 {
+			prev := tokens[i-1].text
+			if prev != "(" && prev != "." && prev != "::" &&
+				token.text != "(" && token.text != ")" && token.text != "," && token.text != "." && token.text != "::" {
+				buf.WriteString(" ")
+			}
+		}
+		buf.WriteString(token.text)
+	}
+	return buf.String()
+}
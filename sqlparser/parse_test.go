@@ -949,11 +949,34 @@ var (
 		input:  "create spatial index a using foo on b",
 		output: "alter table b",
 	}, {
-		input:  "create view a",
-		output: "create table a",
+		input:  "create index a on b ((lower(c)), d)",
+		output: "alter table b",
 	}, {
-		input:  "create or replace view a",
-		output: "create table a",
+		input:  "create unique index concurrently a on b (c)",
+		output: "alter table b",
+	}, {
+		input:  "create index concurrently on b (c)",
+		output: "alter table b",
+	}, {
+		input: "create view a as select * from t",
+	}, {
+		input: "create or replace view a as select id, name from t where id > 1",
+	}, {
+		input: "create materialized view a as select id from t",
+	}, {
+		input:  "create materialized view a as select id from t with data",
+		output: "create materialized view a as select id from t",
+	}, {
+		input: "create materialized view a as select id from t with no data",
+	}, {
+		input: "create trigger a before insert on t for each row set NEW.b = now()",
+	}, {
+		input: "create trigger a after update on t for each row begin insert into u values (NEW.id); end",
+	}, {
+		input:  "create trigger a after insert or delete on t for each statement execute procedure f()",
+		output: "create trigger a after insert or delete on t for each statement execute function f()",
+	}, {
+		input: "create trigger a before update of b, c on t for each row when (new.b > 1) execute function public.f('x')",
 	}, {
 		input:  "alter view a",
 		output: "alter table a",
@@ -970,8 +993,9 @@ var (
 		input:  "drop view if exists a",
 		output: "drop table if exists a",
 	}, {
-		input:  "drop index b on a",
-		output: "alter table a",
+		input: "drop index b on a",
+	}, {
+		input: "drop index if exists b",
 	}, {
 		input:  "analyze table a",
 		output: "alter table a",
@@ -1369,7 +1393,7 @@ func TestCaseSensitivity(t *testing.T) {
 		output: "drop table if exists B",
 	}, {
 		input:  "drop index b on A",
-		output: "alter table A",
+		output: "drop index b on A",
 	}, {
 		input: "select a from B",
 	}, {
@@ -1404,8 +1428,8 @@ func TestCaseSensitivity(t *testing.T) {
 		input:  "CREATE TABLE A (\n\t`A` int\n)",
 		output: "create table A (\n\tA int\n)",
 	}, {
-		input:  "create view A",
-		output: "create table a",
+		input:  "create view A as select B from C",
+		output: "create view a as select B from C",
 	}, {
 		input:  "alter view A",
 		output: "alter table a",
@@ -1753,6 +1777,15 @@ func TestCreateTable(t *testing.T) {
 			"	s5 bit(1) default B'0'\n" +
 			")",
 
+		// test function defaults
+		"create table t (\n" +
+			"	t1 datetime default now(),\n" +
+			"	t2 date default current_date,\n" +
+			"	t3 datetime default now() on update now(),\n" +
+			"	t4 datetime(6) default current_timestamp(6) on update current_timestamp(6),\n" +
+			"	u1 varchar(36) default (uuid())\n" +
+			")",
+
 		// test key field options
 		"create table t (\n" +
 			"	id int auto_increment primary key,\n" +
@@ -1763,6 +1796,12 @@ func TestCreateTable(t *testing.T) {
 			"	time2 timestamp default current_timestamp on update current_timestamp\n" +
 			")",
 
+		// test invisible columns
+		"create table t (\n" +
+			"	id int,\n" +
+			"	secret varchar(255) invisible comment 'hidden'\n" +
+			")",
+
 		// test defining indexes separately
 		"create table t (\n" +
 			"	id int auto_increment,\n" +
@@ -1773,6 +1812,7 @@ func TestCreateTable(t *testing.T) {
 			"	status_nonkeyword varchar,\n" +
 			"	primary key (id),\n" +
 			"	spatial key geom (geom),\n" +
+			"	fulltext key ft_full_name (full_name) with parser ngram,\n" +
 			"	unique key by_username (username),\n" +
 			"	unique by_username2 (username),\n" +
 			"	unique index by_username3 (username),\n" +
@@ -1780,6 +1820,29 @@ func TestCreateTable(t *testing.T) {
 			"	key by_full_name (full_name)\n" +
 			")",
 
+		// test functional key parts and prefix lengths
+		"create table t (\n" +
+			"	id int,\n" +
+			"	email varchar(255),\n" +
+			"	key by_lower_email ((lower(email)), id),\n" +
+			"	key by_email_prefix (email(10))\n" +
+			")",
+
+		// test invisible indexes
+		"create table t (\n" +
+			"	id int,\n" +
+			"	email varchar(255),\n" +
+			"	key by_email (email) invisible,\n" +
+			"	key by_id (id) visible\n" +
+			")",
+
+		// test descending key parts
+		"create table t (\n" +
+			"	id int,\n" +
+			"	created_at datetime,\n" +
+			"	key by_created_at (created_at desc, id)\n" +
+			")",
+
 		// test that indexes support USING <id>
 		"create table t (\n" +
 			"	id int auto_increment,\n" +
@@ -1849,6 +1912,9 @@ func TestCreateTable(t *testing.T) {
 			"  stats_sample_pages 1,\n" +
 			"  tablespace tablespace_name storage disk,\n" +
 			"  tablespace tablespace_name\n",
+
+		// copy the definition of another table
+		"create table t like s",
 	}
 	for _, sql := range validSQL {
 		sql = strings.TrimSpace(sql)
@@ -1879,6 +1945,24 @@ func TestCreateTable(t *testing.T) {
 		input  string
 		output string
 	}{{
+		// test foreign keys
+		input: "create table t (\n" +
+			"	id int,\n" +
+			"	user_id int references users (id) on delete cascade,\n" +
+			"	group_id int,\n" +
+			"	tenant_id int,\n" +
+			"	foreign key (group_id) references `groups` (id),\n" +
+			"	constraint t_tenant_fk foreign key idx_tenant (tenant_id, group_id) references tenants (id, group_id) on update set null on delete no action\n" +
+			")",
+		output: "create table t (\n" +
+			"	id int,\n" +
+			"	user_id int references users (id) on delete cascade,\n" +
+			"	group_id int,\n" +
+			"	tenant_id int,\n" +
+			"	foreign key (group_id) references groups (id),\n" +
+			"	constraint t_tenant_fk foreign key idx_tenant (tenant_id, group_id) references tenants (id, group_id) on delete no action on update set null\n" +
+			")",
+	}, {
 		// test key_block_size
 		input: "create table t (\n" +
 			"	id int auto_increment,\n" +
@@ -1894,6 +1978,107 @@ func TestCreateTable(t *testing.T) {
 			"	unique key by_username2 (username) key_block_size 8,\n" +
 			"	unique by_username3 (username) key_block_size 4\n" +
 			")",
+	}, {
+		// test unique constraints
+		input: "create table t (\n" +
+			"	a int,\n" +
+			"	b int,\n" +
+			"	unique (a),\n" +
+			"	constraint t_uq unique (a, b),\n" +
+			"	CONSTRAINT t_uq2 UNIQUE KEY (b, a)\n" +
+			")",
+		output: "create table t (\n" +
+			"	a int,\n" +
+			"	b int,\n" +
+			"	unique (a),\n" +
+			"	constraint t_uq unique (a, b),\n" +
+			"	constraint t_uq2 unique key (b, a)\n" +
+			")",
+	}, {
+		// test check constraints
+		input: "create table t (\n" +
+			"	age int check (age > 0),\n" +
+			"	height int constraint t_height_check check ((height > 0)),\n" +
+			"	check (age < height AND (height < 300)),\n" +
+			"	CONSTRAINT t_chk CHECK (age <> 10)\n" +
+			")",
+		output: "create table t (\n" +
+			"	age int check (age > 0),\n" +
+			"	height int constraint t_height_check check ((height > 0)),\n" +
+			"	check (age < height and (height < 300)),\n" +
+			"	constraint t_chk check (age != 10)\n" +
+			")",
+	}, {
+		// test check constraints dumped by MySQL 8.0, and multiple ones of a column
+		input: "create table t (\n" +
+			"	age int CHECK (age > 0) CHECK (age < 200),\n" +
+			"	CONSTRAINT t_chk_1 CHECK ((`name` <> _utf8mb4'x'))\n" +
+			")",
+		output: "create table t (\n" +
+			"	age int check (age > 0) check (age < 200),\n" +
+			"	constraint t_chk_1 check ((name != _utf8mb4'x'))\n" +
+			")",
+	}, {
+		// test generated columns
+		input: "create table t (\n" +
+			"	a int,\n" +
+			"	b int GENERATED ALWAYS AS ((a + 1)) VIRTUAL,\n" +
+			"	c varchar(10) AS (concat(a, 'x')) STORED NOT NULL,\n" +
+			"	d int as (a * 2)\n" +
+			")",
+		output: "create table t (\n" +
+			"	a int,\n" +
+			"	b int generated always as ((a + 1)) virtual,\n" +
+			"	c varchar(10) generated always as (concat(a, 'x')) stored not null,\n" +
+			"	d int generated always as (a * 2)\n" +
+			")",
+	}, {
+		// test generated columns dumped by MySQL 8.0
+		input: "create table t (\n" +
+			"	a varchar(10),\n" +
+			"	b varchar(20) GENERATED ALWAYS AS (concat(`a`,_utf8mb4' ')) STORED\n" +
+			")",
+		output: "create table t (\n" +
+			"	a varchar(10),\n" +
+			"	b varchar(20) generated always as (concat(a, _utf8mb4' ')) stored\n" +
+			")",
+	}, {
+		// test partitioning
+		input: "create table t (\n" +
+			"	id int,\n" +
+			"	created_at date\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=latin1\n" +
+			"/*!50100 PARTITION BY RANGE (year(created_at))\n" +
+			"(PARTITION p0 VALUES LESS THAN (2010) ENGINE = InnoDB,\n" +
+			" PARTITION p1 VALUES LESS THAN MAXVALUE ENGINE = InnoDB) */",
+		output: "create table t (\n" +
+			"	id int,\n" +
+			"	created_at date\n" +
+			") ENGINE=InnoDB default charset=latin1 partition by range (year(created_at)) " +
+			"(partition p0 values less than (2010) ENGINE = InnoDB, partition p1 values less than (maxvalue) ENGINE = InnoDB)",
+	}, {
+		input:  "create table t (id int, c varchar(3)) partition by list columns (id, c) (partition a values in ((1, 'x')), partition b values in ((2, 'y'), (3, 'z')))",
+		output: "create table t (\n\tid int,\n\tc varchar(3)\n) partition by list columns (id, c) (partition a values in ((1, 'x')), partition b values in ((2, 'y'), (3, 'z')))",
+	}, {
+		input:  "create table t (id int) partition by range columns (id, id) (partition p0 values less than (1, 2))",
+		output: "create table t (\n\tid int\n) partition by range columns (id, id) (partition p0 values less than (1, 2))",
+	}, {
+		input:  "create table t (id int) partition by linear hash (id) partitions 4",
+		output: "create table t (\n\tid int\n) partition by linear hash (id) partitions 4",
+	}, {
+		input:  "create table t (id int) partition by linear key algorithm=2 (id) partitions 4",
+		output: "create table t (\n\tid int\n) partition by linear key algorithm=2 (id) partitions 4",
+	}, {
+		input:  "create table t (id int) partition by key () partitions 2",
+		output: "create table t (\n\tid int\n) partition by key () partitions 2",
+	}, {
+		// test escaped comments
+		input: "create table t (\n" +
+			"	id int comment 'it''s an \\\\id\\n'\n" +
+			") comment='it''s a table'",
+		output: "create table t (\n" +
+			"	id int comment 'it\\'s an \\\\id\\n'\n" +
+			") comment='it\\'s a table'",
 	},
 	}
 	for _, tcase := range testCases {
@@ -1908,6 +2093,704 @@ func TestCreateTable(t *testing.T) {
 	}
 }
 
+func TestCommentOn(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input:  "COMMENT ON TABLE public.users IS 'it''s a table'",
+		output: "comment on table public.users is 'it''s a table'",
+	}, {
+		input:  "COMMENT ON COLUMN public.users.name IS 'multi\nline'",
+		output: "comment on column public.users.name is 'multi\nline'",
+	}, {
+		input:  "COMMENT ON COLUMN users.name IS NULL",
+		output: "comment on column users.name is null",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree.(*DDL)), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
+func TestPostgresGeneratedColumn(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input: "CREATE TABLE public.users (\n" +
+			"    first_name character varying(20),\n" +
+			"    full_name text GENERATED ALWAYS AS (((first_name)::text || ' '::text)) STORED\n" +
+			")",
+		output: "create table public.users (\n" +
+			"	first_name character varying(20),\n" +
+			"	full_name text generated always as (((first_name)::text || ' '::text)) stored\n" +
+			")",
+	}, {
+		input: "create table t (\n" +
+			"	a integer,\n" +
+			"	b bigint generated always as (a::bigint * 2 + b::numeric(10)) stored\n" +
+			")",
+		output: "create table t (\n" +
+			"	a integer,\n" +
+			"	b bigint generated always as (a::bigint * 2 + b::numeric(10)) stored\n" +
+			")",
+	}, {
+		input: "CREATE TABLE public.posts (\n" +
+			"    search tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, COALESCE(title, ''::text)), 'A'::\"char\")) STORED\n" +
+			")",
+		output: "create table public.posts (\n" +
+			"	search tsvector generated always as (setweight(to_tsvector('english'::regconfig, COALESCE(title, ''::text)), 'A'::char)) stored\n" +
+			")",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree.(*DDL)), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
+func TestPostgresForeignData(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input:  "CREATE SERVER remote TYPE 'postgresql' VERSION '12' FOREIGN DATA WRAPPER postgres_fdw OPTIONS (host 'localhost', port '5432')",
+		output: "create server remote type 'postgresql' version '12' foreign data wrapper postgres_fdw options (host 'localhost', port '5432')",
+	}, {
+		input:  "CREATE SERVER remote FOREIGN DATA WRAPPER file_fdw",
+		output: "create server remote foreign data wrapper file_fdw",
+	}, {
+		input:  "CREATE USER MAPPING FOR postgres SERVER remote OPTIONS (user 'app', password 'secret')",
+		output: "create user mapping for postgres server remote options (user 'app', password 'secret')",
+	}, {
+		input:  "CREATE USER MAPPING FOR public SERVER remote",
+		output: "create user mapping for public server remote",
+	}, {
+		input: "CREATE FOREIGN TABLE public.remote_users (\n" +
+			"    id integer NOT NULL\n" +
+			")\n" +
+			"SERVER remote\n" +
+			"OPTIONS (\n" +
+			"    schema_name 'public',\n" +
+			"    table_name 'users'\n" +
+			")",
+		output: "create foreign table public.remote_users (\n" +
+			"	id integer not null\n" +
+			") server remote options (schema_name 'public', table_name 'users')",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree.(*DDL)), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
+func TestPostgresPartition(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input: "CREATE TABLE public.measurement (\n" +
+			"    city_id integer NOT NULL,\n" +
+			"    logdate date NOT NULL\n" +
+			")\n" +
+			"PARTITION BY RANGE (logdate)",
+		output: "create table public.measurement (\n" +
+			"	city_id integer not null,\n" +
+			"	logdate date not null\n" +
+			") partition by range (logdate)",
+	}, {
+		input:  "CREATE TABLE public.measurement_y2006m02 PARTITION OF public.measurement\nFOR VALUES FROM ('2006-02-01') TO ('2006-03-01')",
+		output: "create table public.measurement_y2006m02 partition of public.measurement for values from ('2006-02-01') to ('2006-03-01')",
+	}, {
+		input:  "create table m partition of measurement for values from (MINVALUE, 1) to (MAXVALUE, MAXVALUE)",
+		output: "create table m partition of measurement for values from (MINVALUE, 1) to (maxvalue, maxvalue)",
+	}, {
+		input:  "create table m partition of measurement for values in (1, 2) partition by hash (logdate)",
+		output: "create table m partition of measurement for values in (1, 2) partition by hash (logdate)",
+	}, {
+		input:  "create table m partition of measurement for values with (MODULUS 4, REMAINDER 0)",
+		output: "create table m partition of measurement for values with (modulus 4, remainder 0)",
+	}, {
+		input:  "create table m partition of measurement default",
+		output: "create table m partition of measurement default",
+	}, {
+		input:  "ALTER TABLE ONLY public.measurement ATTACH PARTITION public.m FOR VALUES IN (1)",
+		output: "alter table public.measurement attach partition public.m for values in (1)",
+	}, {
+		input: "CREATE TABLE public.capitals (\n" +
+			"    state text\n" +
+			")\n" +
+			"INHERITS (public.cities, public.places)",
+		output: "create table public.capitals (\n" +
+			"	state text\n" +
+			") inherits (public.cities, public.places)",
+	}, {
+		input:  "CREATE TABLE public.capitals (\n)\nINHERITS (public.cities)",
+		output: "create table public.capitals (\n\n) inherits (public.cities)",
+	}, {
+		input:  "CREATE TABLE public.capitals (\n    state text\n)\nINHERITS (public.cities) TABLESPACE fastdisk",
+		output: "create table public.capitals (\n\tstate text\n) inherits (public.cities) tablespace fastdisk",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree.(*DDL)), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
+func TestPostgresEnum(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input:  "CREATE TYPE public.mood AS ENUM (\n    'happy',\n    'sad'\n)",
+		output: "create type public.mood as enum ('happy', 'sad')",
+	}, {
+		input:  "create table users (id bigint, current_mood public.mood not null, previous_mood mood)",
+		output: "create table users (\n\tid bigint,\n\tcurrent_mood public.mood not null,\n\tprevious_mood mood\n)",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree.(*DDL)), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
+func TestPostgresDomain(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input:  "CREATE DOMAIN positive AS integer DEFAULT 1 NOT NULL CONSTRAINT positive_check CHECK ((VALUE > 0))",
+		output: "create domain positive as integer default 1 not null constraint positive_check check ((VALUE > 0))",
+	}, {
+		input:  "create domain code varchar(10) null check (value <> '') check (value <> 'none')",
+		output: "create domain code as varchar(10) check (value != '') check (value != 'none')",
+	}, {
+		input:  "create table sites (id bigint, domain text)",
+		output: "create table sites (\n\tid bigint,\n\t`domain` text\n)",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree.(*DDL)), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
+func TestPostgresSchema(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input:  "CREATE SCHEMA IF NOT EXISTS app",
+		output: "create schema app",
+	}, {
+		input:  "create table app.users (id bigint references app.posts (id))",
+		output: "create table app.users (\n\tid bigint references app.posts (id)\n)",
+	}, {
+		input:  "ALTER SEQUENCE app.users_id_seq OWNED BY app.users.id",
+		output: "alter sequence app.users_id_seq owned by app.users.id",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree.(*DDL)), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
+func TestPostgresPolicy(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input:  "ALTER TABLE public.users ENABLE ROW LEVEL SECURITY",
+		output: "alter table public.users enable row level security",
+	}, {
+		input:  "alter table only users no force row level security",
+		output: "alter table users no force row level security",
+	}, {
+		input:  "CREATE POLICY users_owner ON public.users FOR SELECT TO app, admin USING ((owner = CURRENT_USER))",
+		output: "create policy users_owner on public.users as permissive for select to app, admin using ((owner = current_user()))",
+	}, {
+		input:  "create policy p on users as restrictive with check (id > 0)",
+		output: "create policy p on users as restrictive for all with check (id > 0)",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree.(*DDL)), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
+func TestPostgresExclusion(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input:  "CREATE TABLE reservations (room int, during tsrange, EXCLUDE USING gist (room WITH =, during WITH &&))",
+		output: "create table reservations (\n\troom int,\n\tduring tsrange,\n\texclude using gist (room with =, during with &&)\n)",
+	}, {
+		input:  "ALTER TABLE ONLY public.reservations ADD CONSTRAINT reservations_during_excl EXCLUDE USING gist (during WITH &&) WHERE ((room <> 0))",
+		output: "alter table public.reservations add constraint reservations_during_excl exclude using gist (during with &&) where ((room != 0))",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree.(*DDL)), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
+func TestPostgresDeferrable(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input:  "CREATE TABLE a (id int, b int REFERENCES b (id) DEFERRABLE INITIALLY DEFERRED, CONSTRAINT a_id_key UNIQUE (id) DEFERRABLE INITIALLY IMMEDIATE)",
+		output: "create table a (\n\tid int,\n\tb int references b (id) deferrable initially deferred,\n\tconstraint a_id_key unique (id) deferrable\n)",
+	}, {
+		input:  "ALTER TABLE ONLY public.a ADD CONSTRAINT a_b_fkey FOREIGN KEY (b) REFERENCES public.b(id) ON DELETE CASCADE INITIALLY DEFERRED",
+		output: "alter table public.a add constraint a_b_fkey foreign key (b) references public.b (id) on delete cascade deferrable initially deferred",
+	}, {
+		input:  "alter table a add constraint a_b_fkey foreign key (b) references b (id) not deferrable",
+		output: "alter table a add constraint a_b_fkey foreign key (b) references b (id)",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree.(*DDL)), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+
+	if _, err := ParseWithMode("CREATE TABLE a (b int REFERENCES b (id) DEFERRABLE DEFERRED)", ParserModePostgres); err == nil {
+		t.Errorf("expected an error for an invalid deferrability")
+	}
+}
+
+func TestPostgresIndexColumnOrder(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input:  "CREATE TABLE a (id int, b int, UNIQUE (id DESC NULLS LAST, b ASC))",
+		output: "create table a (\n\tid int,\n\tb int,\n\tunique (id desc nulls last, b)\n)",
+	}, {
+		input:  "CREATE TABLE a (id int, b int, UNIQUE (id NULLS FIRST))",
+		output: "create table a (\n\tid int,\n\tb int,\n\tunique (id nulls first)\n)",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree.(*DDL)), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+
+	if _, err := ParseWithMode("CREATE INDEX a ON b (c NULLS MIDDLE)", ParserModePostgres); err == nil {
+		t.Errorf("expected an error for an invalid nulls order")
+	}
+}
+
+func TestPostgresFunctionDefault(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input:  "CREATE TABLE a (id uuid DEFAULT gen_random_uuid(), t timestamp DEFAULT CURRENT_TIMESTAMP)",
+		output: "create table a (\n\tid uuid default gen_random_uuid(),\n\tt timestamp default current_timestamp\n)",
+	}, {
+		input:  "CREATE TABLE a (id integer DEFAULT nextval('a_id_seq'), t timestamp DEFAULT public.now())",
+		output: "create table a (\n\tid integer default nextval('a_id_seq'::regclass),\n\tt timestamp default public.now()\n)",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree.(*DDL)), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
+func TestPostgresArrayType(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input:  "CREATE TABLE a (tags text[], matrix integer[][] NOT NULL, codes varchar(10)[3])",
+		output: "create table a (\n\ttags text[],\n\tmatrix integer[] not null,\n\tcodes varchar(10)[]\n)",
+	}, {
+		input:  "CREATE TABLE a (ids bigint ARRAY, points integer ARRAY[4])",
+		output: "create table a (\n\tids bigint[],\n\tpoints integer[]\n)",
+	}, {
+		input:  "CREATE TABLE a (tags text[] CHECK (tags <> '{}'::text[]))",
+		output: "create table a (\n\ttags text[] check (tags != '{}'::text[])\n)",
+	}, {
+		input:  "CREATE TABLE a (kind text, CONSTRAINT a_kind_check CHECK ((kind = ANY (ARRAY['x'::text, 'y'::text]))))",
+		output: "create table a (\n\tkind text,\n\tconstraint a_kind_check check ((kind = any (array['x'::text, 'y'::text])))\n)",
+	}, {
+		input:  "CREATE TABLE a (kind varchar(10), CONSTRAINT a_kind_check CHECK (((kind)::text <> ALL ((ARRAY['x'::character varying])::text[]))))",
+		output: "create table a (\n\tkind varchar(10),\n\tconstraint a_kind_check check (((kind)::text != all ((array['x'::character varying])::text[])))\n)",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
+func TestSpatialSrid(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+		mode   ParserMode
+	}{{
+		input:  "CREATE TABLE a (location point NOT NULL /*!80003 SRID 4326 */)",
+		output: "create table a (\n\tlocation point srid 4326 not null\n)",
+		mode:   ParserModeMysql,
+	}, {
+		input:  "CREATE TABLE a (location geometry(Point, 4326), area public.geography(MultiPolygon))",
+		output: "create table a (\n\tlocation geometry(point,4326),\n\tarea public.geography(multipolygon)\n)",
+		mode:   ParserModePostgres,
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, tcase.mode)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
+func TestSystemVersioning(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input:  "CREATE TABLE t (x int) WITH SYSTEM VERSIONING",
+		output: "create table t (\n\tx int\n) with SYSTEM VERSIONING",
+	}, {
+		input: "CREATE TABLE `t` (\n" +
+			"  `x` int(11) DEFAULT NULL,\n" +
+			"  `row_start` timestamp(6) GENERATED ALWAYS AS ROW START INVISIBLE,\n" +
+			"  `row_end` timestamp(6) GENERATED ALWAYS AS ROW END INVISIBLE,\n" +
+			"  PERIOD FOR SYSTEM_TIME (`row_start`, `row_end`)\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=latin1 WITH SYSTEM VERSIONING",
+		output: "create table t (\n" +
+			"\tx int(11) default null,\n" +
+			"\trow_start timestamp(6) generated always as row start invisible,\n" +
+			"\trow_end timestamp(6) generated always as row end invisible,\n" +
+			"\tperiod for SYSTEM_TIME (row_start, row_end)\n" +
+			") ENGINE=InnoDB default charset=latin1 with SYSTEM VERSIONING",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, ParserModeMysql)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
+func TestPostgresTimeZone(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input:  "CREATE TABLE a (created_at timestamp(3) with time zone, updated_at timestamp without time zone, starts_at time WITH TIME ZONE)",
+		output: "create table a (\n\tcreated_at timestamp(3) with time zone,\n\tupdated_at timestamp,\n\tstarts_at time with time zone\n)",
+	}, {
+		input:  "CREATE TABLE a (created_at timestamptz(3) DEFAULT '2020-01-01 00:00:00+00'::timestamp with time zone)",
+		output: "create table a (\n\tcreated_at timestamptz(3) default '2020-01-01 00:00:00+00'::timestamp with time zone\n)",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
+func TestTypeAliases(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+		mode   ParserMode
+	}{{
+		input:  "CREATE TABLE a (id int8, count int4, flag int2, ratio float8 DEFAULT '1.5'::double precision, score double precision, code bpchar(3))",
+		output: "create table a (\n\tid int8,\n\tcount int4,\n\tflag int2,\n\tratio float8 default '1.5'::double precision,\n\tscore double precision,\n\tcode bpchar(3)\n)",
+		mode:   ParserModePostgres,
+	}, {
+		input:  "CREATE TABLE a (id int8 unsigned, count int4(11), price dec(10,2), ratio float4, score double precision)",
+		output: "create table a (\n\tid int8 unsigned,\n\tcount int4(11),\n\tprice dec(10,2),\n\tratio float4,\n\tscore double precision\n)",
+		mode:   ParserModeMysql,
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, tcase.mode)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
+func TestPostgresDefaultCast(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input:  "CREATE TABLE a (data jsonb DEFAULT '{}'::jsonb NOT NULL, name character varying(10) DEFAULT 'x'::character varying)",
+		output: "create table a (\n\tdata jsonb not null default '{}'::jsonb,\n\tname character varying(10) default 'x'::character varying\n)",
+	}, {
+		input:  "CREATE TABLE a (mood public.mood DEFAULT 'ok'::public.mood, tags text[] DEFAULT '{}'::text[])",
+		output: "create table a (\n\tmood public.mood default 'ok'::public.mood,\n\ttags text[] default '{}'::text[]\n)",
+	}, {
+		input:  "CREATE TABLE a (count integer DEFAULT 0::integer, ratio real DEFAULT 1.5::real, diff integer DEFAULT -1, delta numeric DEFAULT -0.5)",
+		output: "create table a (\n\tcount integer default 0::integer,\n\tratio real default 1.5::real,\n\tdiff integer default -1,\n\tdelta numeric default -0.5\n)",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
+func TestPostgresGrant(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input:  "GRANT SELECT,INSERT ON TABLE public.users TO app",
+		output: "grant select, insert on table public.users to app",
+	}, {
+		input:  "grant all privileges on users to app, Readonly with grant option",
+		output: "grant all on table users to app, readonly with grant option",
+	}, {
+		input:  "GRANT SELECT,USAGE ON SEQUENCE public.users_id_seq TO app",
+		output: "grant select, usage on sequence public.users_id_seq to app",
+	}, {
+		input:  "REVOKE ALL ON TABLE public.users FROM PUBLIC",
+		output: "revoke all on table public.users from public",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree.(*DDL)), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
+func TestPostgresExtension(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input:  "CREATE EXTENSION IF NOT EXISTS pgcrypto",
+		output: "create extension pgcrypto",
+	}, {
+		input:  "create extension pgcrypto with schema public cascade",
+		output: "create extension pgcrypto with schema public cascade",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree.(*DDL)), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
+func TestPostgresIdentity(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input:  "create table users (id integer generated always as identity primary key)",
+		output: "create table users (\n\tid integer generated always as identity primary key\n)",
+	}, {
+		input:  "create table users (id bigint GENERATED BY DEFAULT AS IDENTITY (START WITH 10 INCREMENT BY 2))",
+		output: "create table users (\n\tid bigint generated by default as identity (increment by 2 start with 10)\n)",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree.(*DDL)), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
+func TestPostgresSequence(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input:  "CREATE SEQUENCE public.users_id_seq\n    AS integer\n    START WITH 1\n    INCREMENT BY 1\n    NO MINVALUE\n    NO MAXVALUE\n    CACHE 1",
+		output: "create sequence public.users_id_seq as integer increment by 1 no minvalue no maxvalue start with 1 cache 1",
+	}, {
+		input:  "create sequence s increment -2 minvalue -100 maxvalue 10 start 5 cycle owned by users.id",
+		output: "create sequence s increment by -2 minvalue -100 maxvalue 10 start with 5 cycle owned by users.id",
+	}, {
+		input:  "create sequence s",
+		output: "create sequence s",
+	}, {
+		input:  "ALTER SEQUENCE public.users_id_seq OWNED BY public.users.id",
+		output: "alter sequence public.users_id_seq owned by public.users.id",
+	}, {
+		input:  "alter sequence s no cycle owned by none",
+		output: "alter sequence s no cycle owned by none",
+	}, {
+		input:  "ALTER TABLE ONLY public.users ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (\n    START WITH 1\n    INCREMENT BY 1\n    NO MINVALUE\n    NO MAXVALUE\n    CACHE 1\n)",
+		output: "alter table public.users alter column id add generated always as identity (increment by 1 no minvalue no maxvalue start with 1 cache 1)",
+	}, {
+		input:  "alter table users alter column id add generated by default as identity",
+		output: "alter table users alter column id add generated by default as identity",
+	}, {
+		input:  "ALTER TABLE ONLY public.users ALTER COLUMN id SET DEFAULT nextval('public.users_id_seq'::regclass)",
+		output: "alter table public.users alter column id set default nextval('public.users_id_seq'::regclass)",
+	}, {
+		input:  "create table users (id bigint DEFAULT nextval('users_id_seq') NOT NULL, serial smallserial)",
+		output: "create table users (\n\tid bigint not null default nextval('users_id_seq'::regclass),\n\t`serial` smallserial\n)",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree.(*DDL)), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
+func TestCreateFunction(t *testing.T) {
+	testCases := []struct {
+		mode   ParserMode
+		input  string
+		output string
+	}{{
+		mode:   ParserModePostgres,
+		input:  "CREATE FUNCTION f(a int, b Text DEFAULT $x$y$x$) RETURNS trigger AS $$ begin; return new; end; $$ LANGUAGE plpgsql IMMUTABLE",
+		output: "create function f(a int, b text default 'y') returns trigger language plpgsql as $$ begin; return new; end; $$ immutable",
+	}, {
+		mode:   ParserModePostgres,
+		input:  "create or replace function public.f(numeric(10, 2)) returns table(a int) language sql security definer as 'select 1'",
+		output: "create or replace function public.f(numeric(10, 2)) returns table(a int) language sql as $$select 1$$ security definer",
+	}, {
+		mode:   ParserModeMysql,
+		input:  "CREATE FUNCTION `add_numbers`(a INT, b INT) RETURNS int(11)\n    DETERMINISTIC\nRETURN a + b",
+		output: "create function add_numbers(a int, b int) returns int(11) deterministic RETURN a + b",
+	}, {
+		mode:   ParserModeMysql,
+		input:  "create function f() returns varchar(10) character set utf8mb4 comment 'x' reads sql data begin return 'a'; end",
+		output: "create function f() returns varchar(10) character set utf8mb4 comment 'x' reads sql data begin return 'a'; end",
+	}, {
+		mode:   ParserModeMysql,
+		input:  "CREATE PROCEDURE p(IN x int, OUT y int) SQL SECURITY INVOKER BEGIN\n  IF x > 1 THEN SET y = x; END IF;\nEND",
+		output: "create procedure p(in x int, out y int) sql security invoker BEGIN\n  IF x > 1 THEN SET y = x; END IF;\nEND",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseWithMode(tcase.input, tcase.mode)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree.(*DDL)), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+	}
+}
+
 func TestCreateTableEscaped(t *testing.T) {
 	testCases := []struct {
 		input  string
@@ -6,6 +6,7 @@ package sqlparser
 import __yyfmt__ "fmt"
 
 //line parser.y:18
+
 func setParseTree(yylex interface{}, stmt Statement) {
 	yylex.(*Tokenizer).ParseTree = stmt
 }
@@ -18,6 +19,13 @@ func setDDL(yylex interface{}, ddl *DDL) {
 	yylex.(*Tokenizer).partialDDL = ddl
 }
 
+// A string of PostgreSQL is formatted as it's scanned, keeping backslashes.
+func newScannedStrVal(yylex interface{}, in []byte) *SQLVal {
+	val := NewStrVal(in)
+	val.Postgres = yylex.(*Tokenizer).mode == ParserModePostgres
+	return val
+}
+
 func incNesting(yylex interface{}) bool {
 	yylex.(*Tokenizer).nesting++
 	if yylex.(*Tokenizer).nesting == 200 {
@@ -37,68 +45,92 @@ func forceEOF(yylex interface{}) {
 	yylex.(*Tokenizer).ForceEOF = true
 }
 
-//line parser.y:53
+// skipToEnd returns the rest of the statement from the token which has been read as a lookahead,
+// and forces the lexer to end. This is used for a statement which can't be parsed, like MySQL's trigger body.
+func skipToEnd(yylex interface{}) string {
+	return yylex.(*Tokenizer).skipToEnd()
+}
+
+//line parser.y:66
 type yySymType struct {
-	yys               int
-	empty             struct{}
-	statement         Statement
-	selStmt           SelectStatement
-	ddl               *DDL
-	ins               *Insert
-	byt               byte
-	bytes             []byte
-	bytes2            [][]byte
-	str               string
-	strs              []string
-	selectExprs       SelectExprs
-	selectExpr        SelectExpr
-	columns           Columns
-	partitions        Partitions
-	colName           *ColName
-	tableExprs        TableExprs
-	tableExpr         TableExpr
-	joinCondition     JoinCondition
-	tableName         TableName
-	tableNames        TableNames
-	indexHints        *IndexHints
-	expr              Expr
-	exprs             Exprs
-	boolVal           BoolVal
-	colTuple          ColTuple
-	values            Values
-	valTuple          ValTuple
-	subquery          *Subquery
-	whens             []*When
-	when              *When
-	orderBy           OrderBy
-	order             *Order
-	limit             *Limit
-	updateExprs       UpdateExprs
-	setExprs          SetExprs
-	updateExpr        *UpdateExpr
-	setExpr           *SetExpr
-	colIdent          ColIdent
-	tableIdent        TableIdent
-	convertType       *ConvertType
-	aliasedTableName  *AliasedTableExpr
-	TableSpec         *TableSpec
-	columnType        ColumnType
-	colKeyOpt         ColumnKeyOption
-	optVal            *SQLVal
-	LengthScaleOption LengthScaleOption
-	columnDefinition  *ColumnDefinition
-	indexDefinition   *IndexDefinition
-	indexInfo         *IndexInfo
-	indexOption       *IndexOption
-	indexOptions      []*IndexOption
-	indexColumn       *IndexColumn
-	indexColumns      []*IndexColumn
-	partDefs          []*PartitionDefinition
-	partDef           *PartitionDefinition
-	partSpec          *PartitionSpec
-	vindexParam       VindexParam
-	vindexParams      []VindexParam
-	showFilter        *ShowFilter
+	yys                  int
+	empty                struct{}
+	statement            Statement
+	selStmt              SelectStatement
+	ddl                  *DDL
+	ins                  *Insert
+	byt                  byte
+	bytes                []byte
+	bytes2               [][]byte
+	str                  string
+	strs                 []string
+	selectExprs          SelectExprs
+	selectExpr           SelectExpr
+	columns              Columns
+	partitions           Partitions
+	colName              *ColName
+	tableExprs           TableExprs
+	tableExpr            TableExpr
+	joinCondition        JoinCondition
+	tableName            TableName
+	tableNames           TableNames
+	indexHints           *IndexHints
+	expr                 Expr
+	exprs                Exprs
+	boolVal              BoolVal
+	colTuple             ColTuple
+	values               Values
+	valTuple             ValTuple
+	subquery             *Subquery
+	whens                []*When
+	when                 *When
+	orderBy              OrderBy
+	order                *Order
+	limit                *Limit
+	updateExprs          UpdateExprs
+	setExprs             SetExprs
+	updateExpr           *UpdateExpr
+	setExpr              *SetExpr
+	colIdent             ColIdent
+	tableIdent           TableIdent
+	convertType          *ConvertType
+	aliasedTableName     *AliasedTableExpr
+	TableSpec            *TableSpec
+	columnType           ColumnType
+	colKeyOpt            ColumnKeyOption
+	optVal               *SQLVal
+	LengthScaleOption    LengthScaleOption
+	columnDefinition     *ColumnDefinition
+	indexDefinition      *IndexDefinition
+	indexInfo            *IndexInfo
+	indexOption          *IndexOption
+	indexOptions         []*IndexOption
+	indexColumn          *IndexColumn
+	indexColumns         []*IndexColumn
+	foreignKeyDefinition *ForeignKeyDefinition
+	checkDefinition      *CheckDefinition
+	exclusionDefinition  *ExclusionDefinition
+	periodDefinition     *PeriodDefinition
+	exclusionElement     ExclusionElement
+	exclusionElements    []ExclusionElement
+	partDefs             []*PartitionDefinition
+	partDef              *PartitionDefinition
+	partSpec             *PartitionSpec
+	partOption           *PartitionOption
+	partBound            *PartitionBound
+	triggerSpec          *TriggerSpec
+	funcExpr             *FuncExpr
+	functionSpec         *FunctionSpec
+	sequenceSpec         *SequenceSpec
+	identitySpec         *IdentitySpec
+	domainSpec           *DomainSpec
+	policySpec           *PolicySpec
+	foreignSpec          *ForeignSpec
+	foreignOption        *ForeignOption
+	foreignOptions       ForeignOptions
+	vindexParam          VindexParam
+	vindexParams         []VindexParam
+	showFilter           *ShowFilter
 }
 
 const LEX_ERROR = 57346
@@ -118,219 +150,259 @@ const LIMIT = 57359
 const OFFSET = 57360
 const FOR = 57361
 const ALL = 57362
-const DISTINCT = 57363
-const AS = 57364
-const EXISTS = 57365
-const ASC = 57366
-const DESC = 57367
-const INTO = 57368
-const DUPLICATE = 57369
-const DEFAULT = 57370
-const SET = 57371
-const LOCK = 57372
-const KEYS = 57373
-const VALUES = 57374
-const LAST_INSERT_ID = 57375
-const NEXT = 57376
-const VALUE = 57377
-const SHARE = 57378
-const MODE = 57379
-const SQL_NO_CACHE = 57380
-const SQL_CACHE = 57381
-const JOIN = 57382
-const STRAIGHT_JOIN = 57383
-const LEFT = 57384
-const RIGHT = 57385
-const INNER = 57386
-const OUTER = 57387
-const CROSS = 57388
-const NATURAL = 57389
-const USE = 57390
-const FORCE = 57391
-const ON = 57392
-const USING = 57393
-const ID = 57394
-const HEX = 57395
-const STRING = 57396
-const INTEGRAL = 57397
-const FLOAT = 57398
-const HEXNUM = 57399
-const VALUE_ARG = 57400
-const LIST_ARG = 57401
-const COMMENT = 57402
-const COMMENT_KEYWORD = 57403
-const BIT_LITERAL = 57404
-const NULL = 57405
-const TRUE = 57406
-const FALSE = 57407
-const OR = 57408
-const AND = 57409
-const NOT = 57410
-const BETWEEN = 57411
-const CASE = 57412
-const WHEN = 57413
-const THEN = 57414
-const ELSE = 57415
-const END = 57416
-const LE = 57417
-const GE = 57418
-const NE = 57419
-const NULL_SAFE_EQUAL = 57420
-const IS = 57421
-const LIKE = 57422
-const REGEXP = 57423
-const IN = 57424
-const SHIFT_LEFT = 57425
-const SHIFT_RIGHT = 57426
-const DIV = 57427
-const MOD = 57428
-const UNARY = 57429
-const COLLATE = 57430
-const BINARY = 57431
-const UNDERSCORE_BINARY = 57432
-const INTERVAL = 57433
-const JSON_EXTRACT_OP = 57434
-const JSON_UNQUOTE_EXTRACT_OP = 57435
-const CREATE = 57436
-const ALTER = 57437
-const DROP = 57438
-const RENAME = 57439
-const ANALYZE = 57440
-const ADD = 57441
-const SCHEMA = 57442
-const TABLE = 57443
-const INDEX = 57444
-const VIEW = 57445
-const TO = 57446
-const IGNORE = 57447
-const IF = 57448
-const PRIMARY = 57449
-const COLUMN = 57450
-const CONSTRAINT = 57451
-const SPATIAL = 57452
-const FULLTEXT = 57453
-const FOREIGN = 57454
-const KEY_BLOCK_SIZE = 57455
-const UNIQUE = 57456
-const KEY = 57457
-const SHOW = 57458
-const DESCRIBE = 57459
-const EXPLAIN = 57460
-const DATE = 57461
-const ESCAPE = 57462
-const REPAIR = 57463
-const OPTIMIZE = 57464
-const TRUNCATE = 57465
-const MAXVALUE = 57466
-const PARTITION = 57467
-const REORGANIZE = 57468
-const LESS = 57469
-const THAN = 57470
-const PROCEDURE = 57471
-const TRIGGER = 57472
-const VINDEX = 57473
-const VINDEXES = 57474
-const STATUS = 57475
-const VARIABLES = 57476
-const BEGIN = 57477
-const START = 57478
-const TRANSACTION = 57479
-const COMMIT = 57480
-const ROLLBACK = 57481
-const BIT = 57482
-const TINYINT = 57483
-const SMALLINT = 57484
-const MEDIUMINT = 57485
-const INT = 57486
-const INTEGER = 57487
-const BIGINT = 57488
-const INTNUM = 57489
-const REAL = 57490
-const DOUBLE = 57491
-const FLOAT_TYPE = 57492
-const DECIMAL = 57493
-const NUMERIC = 57494
-const TIME = 57495
-const TIMESTAMP = 57496
-const DATETIME = 57497
-const YEAR = 57498
-const CHAR = 57499
-const VARCHAR = 57500
-const VARYING = 57501
-const BOOL = 57502
-const CHARACTER = 57503
-const VARBINARY = 57504
-const NCHAR = 57505
-const TEXT = 57506
-const TINYTEXT = 57507
-const MEDIUMTEXT = 57508
-const LONGTEXT = 57509
-const BLOB = 57510
-const TINYBLOB = 57511
-const MEDIUMBLOB = 57512
-const LONGBLOB = 57513
-const JSON = 57514
-const ENUM = 57515
-const GEOMETRY = 57516
-const POINT = 57517
-const LINESTRING = 57518
-const POLYGON = 57519
-const GEOMETRYCOLLECTION = 57520
-const MULTIPOINT = 57521
-const MULTILINESTRING = 57522
-const MULTIPOLYGON = 57523
-const NULLX = 57524
-const AUTO_INCREMENT = 57525
-const APPROXNUM = 57526
-const SIGNED = 57527
-const UNSIGNED = 57528
-const ZEROFILL = 57529
-const DATABASES = 57530
-const TABLES = 57531
-const VITESS_KEYSPACES = 57532
-const VITESS_SHARDS = 57533
-const VITESS_TABLETS = 57534
-const VSCHEMA_TABLES = 57535
-const EXTENDED = 57536
-const FULL = 57537
-const PROCESSLIST = 57538
-const NAMES = 57539
-const CHARSET = 57540
-const GLOBAL = 57541
-const SESSION = 57542
-const ISOLATION = 57543
-const LEVEL = 57544
-const READ = 57545
-const WRITE = 57546
-const ONLY = 57547
-const REPEATABLE = 57548
-const COMMITTED = 57549
-const UNCOMMITTED = 57550
-const SERIALIZABLE = 57551
-const CURRENT_TIMESTAMP = 57552
-const DATABASE = 57553
-const CURRENT_DATE = 57554
-const CURRENT_TIME = 57555
-const LOCALTIME = 57556
-const LOCALTIMESTAMP = 57557
-const UTC_DATE = 57558
-const UTC_TIME = 57559
-const UTC_TIMESTAMP = 57560
-const REPLACE = 57561
-const CONVERT = 57562
-const CAST = 57563
-const SUBSTR = 57564
-const SUBSTRING = 57565
-const GROUP_CONCAT = 57566
-const SEPARATOR = 57567
-const MATCH = 57568
-const AGAINST = 57569
-const BOOLEAN = 57570
-const LANGUAGE = 57571
-const WITH = 57572
-const QUERY = 57573
-const EXPANSION = 57574
-const UNUSED = 57575
+const ANY = 57363
+const DISTINCT = 57364
+const AS = 57365
+const EXISTS = 57366
+const ASC = 57367
+const DESC = 57368
+const INTO = 57369
+const DUPLICATE = 57370
+const DEFAULT = 57371
+const SET = 57372
+const LOCK = 57373
+const KEYS = 57374
+const VALUES = 57375
+const LAST_INSERT_ID = 57376
+const NEXT = 57377
+const VALUE = 57378
+const SHARE = 57379
+const MODE = 57380
+const SQL_NO_CACHE = 57381
+const SQL_CACHE = 57382
+const PARTITION = 57383
+const END_OF_TABLE_OPTIONS = 57384
+const END_OF_TYPECAST_TYPE = 57385
+const WITH = 57386
+const WITHOUT = 57387
+const NO_ALIAS = 57388
+const VIEW_AS_NAME = 57389
+const END_OF_DEFERRABILITY = 57390
+const NO_CONCURRENTLY = 57391
+const CONCURRENTLY = 57392
+const ID = 57393
+const NO = 57394
+const START = 57395
+const JOIN = 57396
+const STRAIGHT_JOIN = 57397
+const LEFT = 57398
+const RIGHT = 57399
+const INNER = 57400
+const OUTER = 57401
+const CROSS = 57402
+const NATURAL = 57403
+const USE = 57404
+const FORCE = 57405
+const ON = 57406
+const USING = 57407
+const HEX = 57408
+const STRING = 57409
+const UNDERSCORE_CHARSET = 57410
+const INTEGRAL = 57411
+const FLOAT = 57412
+const HEXNUM = 57413
+const VALUE_ARG = 57414
+const LIST_ARG = 57415
+const COMMENT = 57416
+const COMMENT_KEYWORD = 57417
+const BIT_LITERAL = 57418
+const NULL = 57419
+const TRUE = 57420
+const FALSE = 57421
+const OR = 57422
+const AND = 57423
+const NOT = 57424
+const BETWEEN = 57425
+const CASE = 57426
+const WHEN = 57427
+const THEN = 57428
+const ELSE = 57429
+const END = 57430
+const LE = 57431
+const GE = 57432
+const NE = 57433
+const NULL_SAFE_EQUAL = 57434
+const IS = 57435
+const LIKE = 57436
+const REGEXP = 57437
+const IN = 57438
+const CONCAT = 57439
+const SHIFT_LEFT = 57440
+const SHIFT_RIGHT = 57441
+const DIV = 57442
+const MOD = 57443
+const UNARY = 57444
+const COLLATE = 57445
+const BINARY = 57446
+const UNDERSCORE_BINARY = 57447
+const INTERVAL = 57448
+const TYPECAST = 57449
+const JSON_EXTRACT_OP = 57450
+const JSON_UNQUOTE_EXTRACT_OP = 57451
+const CREATE = 57452
+const ALTER = 57453
+const DROP = 57454
+const RENAME = 57455
+const ANALYZE = 57456
+const ADD = 57457
+const SCHEMA = 57458
+const TABLE = 57459
+const INDEX = 57460
+const VIEW = 57461
+const DOMAIN = 57462
+const TO = 57463
+const IGNORE = 57464
+const IF = 57465
+const PRIMARY = 57466
+const COLUMN = 57467
+const CONSTRAINT = 57468
+const SPATIAL = 57469
+const FULLTEXT = 57470
+const FOREIGN = 57471
+const KEY_BLOCK_SIZE = 57472
+const REFERENCES = 57473
+const CASCADE = 57474
+const RESTRICT = 57475
+const ACTION = 57476
+const CHECK = 57477
+const GRANT = 57478
+const REVOKE = 57479
+const GENERATED = 57480
+const ALWAYS = 57481
+const VIRTUAL = 57482
+const STORED = 57483
+const VISIBLE = 57484
+const INVISIBLE = 57485
+const ARRAY = 57486
+const UNIQUE = 57487
+const KEY = 57488
+const SHOW = 57489
+const DESCRIBE = 57490
+const EXPLAIN = 57491
+const DATE = 57492
+const ESCAPE = 57493
+const REPAIR = 57494
+const OPTIMIZE = 57495
+const TRUNCATE = 57496
+const MAXVALUE = 57497
+const REORGANIZE = 57498
+const LESS = 57499
+const THAN = 57500
+const PROCEDURE = 57501
+const TRIGGER = 57502
+const EXECUTE = 57503
+const BEFORE = 57504
+const EACH = 57505
+const INHERITS = 57506
+const SERVER = 57507
+const TABLESPACE = 57508
+const VINDEX = 57509
+const VINDEXES = 57510
+const STATUS = 57511
+const VARIABLES = 57512
+const BEGIN = 57513
+const TRANSACTION = 57514
+const COMMIT = 57515
+const ROLLBACK = 57516
+const BIT = 57517
+const TINYINT = 57518
+const SMALLINT = 57519
+const MEDIUMINT = 57520
+const INT = 57521
+const INTEGER = 57522
+const BIGINT = 57523
+const INTNUM = 57524
+const SMALLSERIAL = 57525
+const SERIAL = 57526
+const BIGSERIAL = 57527
+const REAL = 57528
+const DOUBLE = 57529
+const PRECISION = 57530
+const FLOAT_TYPE = 57531
+const DECIMAL = 57532
+const NUMERIC = 57533
+const TIME = 57534
+const TIMESTAMP = 57535
+const DATETIME = 57536
+const YEAR = 57537
+const CHAR = 57538
+const VARCHAR = 57539
+const VARYING = 57540
+const BOOL = 57541
+const CHARACTER = 57542
+const VARBINARY = 57543
+const NCHAR = 57544
+const TEXT = 57545
+const TINYTEXT = 57546
+const MEDIUMTEXT = 57547
+const LONGTEXT = 57548
+const BLOB = 57549
+const TINYBLOB = 57550
+const MEDIUMBLOB = 57551
+const LONGBLOB = 57552
+const JSON = 57553
+const ENUM = 57554
+const GEOMETRY = 57555
+const POINT = 57556
+const LINESTRING = 57557
+const POLYGON = 57558
+const GEOMETRYCOLLECTION = 57559
+const MULTIPOINT = 57560
+const MULTILINESTRING = 57561
+const MULTIPOLYGON = 57562
+const NULLX = 57563
+const AUTO_INCREMENT = 57564
+const APPROXNUM = 57565
+const SIGNED = 57566
+const UNSIGNED = 57567
+const ZEROFILL = 57568
+const SRID = 57569
+const DATABASES = 57570
+const TABLES = 57571
+const VITESS_KEYSPACES = 57572
+const VITESS_SHARDS = 57573
+const VITESS_TABLETS = 57574
+const VSCHEMA_TABLES = 57575
+const EXTENDED = 57576
+const FULL = 57577
+const PROCESSLIST = 57578
+const NAMES = 57579
+const CHARSET = 57580
+const GLOBAL = 57581
+const SESSION = 57582
+const ISOLATION = 57583
+const LEVEL = 57584
+const READ = 57585
+const WRITE = 57586
+const ONLY = 57587
+const REPEATABLE = 57588
+const COMMITTED = 57589
+const UNCOMMITTED = 57590
+const SERIALIZABLE = 57591
+const CURRENT_TIMESTAMP = 57592
+const DATABASE = 57593
+const CURRENT_DATE = 57594
+const CURRENT_USER = 57595
+const CURRENT_TIME = 57596
+const LOCALTIME = 57597
+const LOCALTIMESTAMP = 57598
+const UTC_DATE = 57599
+const UTC_TIME = 57600
+const UTC_TIMESTAMP = 57601
+const REPLACE = 57602
+const CONVERT = 57603
+const CAST = 57604
+const SUBSTR = 57605
+const SUBSTRING = 57606
+const GROUP_CONCAT = 57607
+const SEPARATOR = 57608
+const MATCH = 57609
+const AGAINST = 57610
+const BOOLEAN = 57611
+const LANGUAGE = 57612
+const QUERY = 57613
+const EXPANSION = 57614
+const UNUSED = 57615
 
 var yyToknames = [...]string{
 	"$end",
@@ -353,6 +425,7 @@ var yyToknames = [...]string{
 	"OFFSET",
 	"FOR",
 	"ALL",
+	"ANY",
 	"DISTINCT",
 	"AS",
 	"EXISTS",
@@ -372,6 +445,19 @@ var yyToknames = [...]string{
 	"MODE",
 	"SQL_NO_CACHE",
 	"SQL_CACHE",
+	"PARTITION",
+	"END_OF_TABLE_OPTIONS",
+	"END_OF_TYPECAST_TYPE",
+	"WITH",
+	"WITHOUT",
+	"NO_ALIAS",
+	"VIEW_AS_NAME",
+	"END_OF_DEFERRABILITY",
+	"NO_CONCURRENTLY",
+	"CONCURRENTLY",
+	"ID",
+	"NO",
+	"START",
 	"JOIN",
 	"STRAIGHT_JOIN",
 	"LEFT",
@@ -387,9 +473,9 @@ var yyToknames = [...]string{
 	"'('",
 	"','",
 	"')'",
-	"ID",
 	"HEX",
 	"STRING",
+	"UNDERSCORE_CHARSET",
 	"INTEGRAL",
 	"FLOAT",
 	"HEXNUM",
@@ -423,6 +509,7 @@ var yyToknames = [...]string{
 	"REGEXP",
 	"IN",
 	"'|'",
+	"CONCAT",
 	"'&'",
 	"SHIFT_LEFT",
 	"SHIFT_RIGHT",
@@ -440,6 +527,7 @@ var yyToknames = [...]string{
 	"BINARY",
 	"UNDERSCORE_BINARY",
 	"INTERVAL",
+	"TYPECAST",
 	"'.'",
 	"JSON_EXTRACT_OP",
 	"JSON_UNQUOTE_EXTRACT_OP",
@@ -453,6 +541,7 @@ var yyToknames = [...]string{
 	"TABLE",
 	"INDEX",
 	"VIEW",
+	"DOMAIN",
 	"TO",
 	"IGNORE",
 	"IF",
@@ -463,6 +552,20 @@ var yyToknames = [...]string{
 	"FULLTEXT",
 	"FOREIGN",
 	"KEY_BLOCK_SIZE",
+	"REFERENCES",
+	"CASCADE",
+	"RESTRICT",
+	"ACTION",
+	"CHECK",
+	"GRANT",
+	"REVOKE",
+	"GENERATED",
+	"ALWAYS",
+	"VIRTUAL",
+	"STORED",
+	"VISIBLE",
+	"INVISIBLE",
+	"ARRAY",
 	"UNIQUE",
 	"KEY",
 	"SHOW",
@@ -474,18 +577,22 @@ var yyToknames = [...]string{
 	"OPTIMIZE",
 	"TRUNCATE",
 	"MAXVALUE",
-	"PARTITION",
 	"REORGANIZE",
 	"LESS",
 	"THAN",
 	"PROCEDURE",
 	"TRIGGER",
+	"EXECUTE",
+	"BEFORE",
+	"EACH",
+	"INHERITS",
+	"SERVER",
+	"TABLESPACE",
 	"VINDEX",
 	"VINDEXES",
 	"STATUS",
 	"VARIABLES",
 	"BEGIN",
-	"START",
 	"TRANSACTION",
 	"COMMIT",
 	"ROLLBACK",
@@ -497,8 +604,12 @@ var yyToknames = [...]string{
 	"INTEGER",
 	"BIGINT",
 	"INTNUM",
+	"SMALLSERIAL",
+	"SERIAL",
+	"BIGSERIAL",
 	"REAL",
 	"DOUBLE",
+	"PRECISION",
 	"FLOAT_TYPE",
 	"DECIMAL",
 	"NUMERIC",
@@ -537,6 +648,7 @@ var yyToknames = [...]string{
 	"SIGNED",
 	"UNSIGNED",
 	"ZEROFILL",
+	"SRID",
 	"DATABASES",
 	"TABLES",
 	"VITESS_KEYSPACES",
@@ -562,6 +674,7 @@ var yyToknames = [...]string{
 	"CURRENT_TIMESTAMP",
 	"DATABASE",
 	"CURRENT_DATE",
+	"CURRENT_USER",
 	"CURRENT_TIME",
 	"LOCALTIME",
 	"LOCALTIMESTAMP",
@@ -579,12 +692,14 @@ var yyToknames = [...]string{
 	"AGAINST",
 	"BOOLEAN",
 	"LANGUAGE",
-	"WITH",
 	"QUERY",
 	"EXPANSION",
 	"UNUSED",
 	"';'",
+	"'['",
+	"']'",
 }
+
 var yyStatenames = [...]string{}
 
 const yyEofCode = 1
@@ -597,3570 +712,6686 @@ var yyExca = [...]int{
 	1, -1,
 	-2, 0,
 	-1, 3,
-	5, 27,
+	5, 29,
 	-2, 4,
-	-1, 36,
-	150, 278,
-	151, 278,
-	-2, 268,
-	-1, 237,
-	108, 597,
-	-2, 593,
-	-1, 238,
-	108, 598,
-	-2, 594,
-	-1, 307,
-	79, 758,
-	-2, 58,
-	-1, 308,
-	79, 720,
-	-2, 59,
-	-1, 313,
-	79, 703,
-	-2, 564,
-	-1, 315,
-	79, 741,
-	-2, 566,
-	-1, 577,
-	51, 41,
-	53, 41,
-	-2, 43,
-	-1, 716,
-	108, 600,
-	-2, 596,
-	-1, 929,
-	5, 28,
-	-2, 410,
-	-1, 954,
-	5, 27,
-	-2, 539,
-	-1, 1198,
-	5, 28,
-	-2, 540,
-	-1, 1244,
-	5, 27,
-	-2, 542,
-	-1, 1306,
-	5, 28,
-	-2, 543,
+	-1, 41,
+	186, 532,
+	187, 532,
+	-2, 522,
+	-1, 287,
+	124, 860,
+	-2, 856,
+	-1, 288,
+	124, 861,
+	-2, 857,
+	-1, 360,
+	93, 1041,
+	-2, 60,
+	-1, 361,
+	93, 999,
+	-2, 61,
+	-1, 366,
+	93, 979,
+	-2, 827,
+	-1, 368,
+	93, 1022,
+	-2, 829,
+	-1, 666,
+	65, 43,
+	67, 43,
+	-2, 45,
+	-1, 795,
+	11, 860,
+	124, 860,
+	138, 860,
+	-2, 474,
+	-1, 842,
+	124, 863,
+	-2, 859,
+	-1, 987,
+	66, 368,
+	-2, 1048,
+	-1, 990,
+	66, 374,
+	-2, 995,
+	-1, 1058,
+	5, 29,
+	-2, 73,
+	-1, 1096,
+	51, 1092,
+	-2, 850,
+	-1, 1161,
+	5, 30,
+	-2, 668,
+	-1, 1185,
+	5, 29,
+	-2, 802,
+	-1, 1310,
+	5, 29,
+	-2, 1088,
+	-1, 1543,
+	5, 29,
+	-2, 74,
+	-1, 1629,
+	5, 30,
+	-2, 803,
+	-1, 1757,
+	5, 29,
+	-2, 805,
+	-1, 1962,
+	5, 30,
+	-2, 806,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 10887
+const yyLast = 20068
 
 var yyAct = [...]int{
-
-	238, 1296, 653, 524, 1255, 1133, 796, 1105, 870, 778,
-	1106, 571, 216, 818, 864, 1079, 850, 242, 267, 1025,
-	1204, 741, 817, 1102, 814, 312, 921, 210, 1016, 973,
-	779, 569, 751, 957, 748, 87, 53, 718, 87, 66,
-	962, 587, 767, 457, 463, 244, 523, 3, 860, 412,
-	268, 47, 586, 306, 828, 558, 573, 775, 469, 293,
-	477, 225, 87, 87, 317, 903, 303, 301, 87, 294,
-	317, 211, 212, 213, 214, 538, 87, 240, 87, 52,
-	1331, 1319, 1329, 1304, 87, 1327, 871, 1318, 1303, 1097,
-	1192, 416, 229, 292, 215, 994, 995, 996, 47, 981,
-	437, 1137, 980, 999, 997, 982, 221, 297, 444, 1128,
-	1129, 1127, 298, 82, 78, 79, 80, 452, 68, 810,
-	811, 750, 588, 1005, 589, 809, 683, 841, 1233, 851,
-	887, 1181, 1179, 684, 209, 843, 1328, 1080, 448, 449,
-	1297, 1325, 1057, 886, 1279, 490, 489, 499, 500, 492,
-	493, 494, 495, 496, 497, 498, 491, 57, 776, 501,
-	1298, 413, 991, 439, 1054, 441, 71, 72, 1082, 67,
-	891, 1241, 309, 1166, 1256, 1157, 1002, 829, 1001, 885,
-	73, 1270, 59, 60, 61, 62, 63, 1258, 1158, 87,
-	830, 438, 440, 317, 317, 317, 317, 69, 317, 988,
-	1084, 426, 1088, 419, 1083, 317, 1081, 797, 799, 455,
-	76, 75, 1086, 76, 662, 652, 972, 971, 970, 414,
-	422, 1085, 188, 77, 81, 829, 829, 882, 879, 880,
-	825, 878, 317, 826, 1087, 1089, 1058, 827, 830, 830,
-	513, 514, 1284, 443, 443, 443, 443, 1201, 443, 1066,
-	898, 851, 937, 1257, 915, 443, 465, 889, 892, 846,
-	690, 1055, 481, 1053, 432, 998, 1143, 491, 466, 815,
-	501, 501, 47, 436, 1056, 687, 476, 70, 1289, 1062,
-	1155, 798, 725, 960, 590, 511, 1302, 510, 474, 768,
-	512, 1099, 87, 884, 235, 656, 723, 724, 722, 87,
-	87, 87, 1280, 1034, 476, 317, 425, 768, 993, 944,
-	418, 317, 912, 913, 914, 883, 1144, 522, 471, 526,
-	527, 528, 529, 530, 531, 532, 533, 534, 899, 537,
-	539, 539, 539, 539, 539, 539, 539, 539, 547, 548,
-	549, 550, 1308, 1220, 297, 50, 475, 474, 833, 570,
-	475, 474, 888, 1101, 1061, 721, 540, 541, 542, 543,
-	544, 545, 546, 476, 74, 890, 1290, 476, 578, 1219,
-	834, 584, 1035, 1031, 1020, 1036, 1033, 1032, 1240, 73,
-	467, 1019, 420, 421, 839, 1006, 831, 428, 429, 430,
-	1037, 832, 933, 742, 932, 743, 1030, 1217, 490, 489,
-	499, 500, 492, 493, 494, 495, 496, 497, 498, 491,
-	475, 474, 501, 309, 1167, 317, 317, 1017, 1003, 1248,
-	1326, 456, 87, 87, 317, 291, 87, 476, 1287, 87,
-	1136, 693, 694, 87, 1135, 317, 317, 317, 317, 317,
-	317, 317, 317, 992, 836, 922, 21, 1314, 456, 317,
-	317, 838, 837, 983, 87, 1248, 1311, 1263, 671, 494,
-	495, 496, 497, 498, 491, 873, 443, 501, 744, 317,
-	708, 710, 711, 87, 443, 709, 475, 474, 695, 317,
-	1248, 1310, 1248, 1309, 689, 443, 443, 443, 443, 443,
-	443, 443, 443, 476, 1248, 1294, 1248, 1264, 669, 443,
-	443, 668, 220, 719, 1248, 456, 1262, 716, 489, 499,
-	500, 492, 493, 494, 495, 496, 497, 498, 491, 688,
-	835, 501, 317, 257, 256, 259, 260, 261, 262, 697,
-	720, 934, 258, 263, 667, 475, 474, 1248, 1249, 712,
-	515, 516, 517, 518, 519, 520, 521, 714, 657, 760,
-	763, 655, 476, 87, 581, 769, 87, 87, 87, 87,
-	87, 1213, 1212, 47, 1124, 456, 1200, 456, 87, 1150,
-	1149, 87, 780, 755, 434, 87, 427, 526, 475, 474,
-	87, 87, 413, 772, 317, 1146, 1147, 1146, 1145, 1138,
-	715, 745, 746, 765, 582, 476, 580, 317, 927, 456,
-	554, 297, 297, 297, 297, 297, 298, 298, 298, 298,
-	298, 804, 50, 23, 1044, 781, 297, 755, 784, 555,
-	456, 570, 793, 800, 555, 297, 782, 783, 23, 785,
-	298, 801, 852, 853, 854, 802, 952, 806, 807, 953,
-	753, 456, 842, 597, 596, 958, 753, 822, 266, 756,
-	757, 54, 959, 87, 1243, 764, 317, 1069, 317, 50,
-	939, 87, 1103, 87, 866, 958, 87, 317, 959, 771,
-	1196, 773, 774, 936, 50, 803, 696, 580, 1045, 555,
-	1154, 1152, 1151, 1047, 1040, 1041, 1048, 1043, 1042, 927,
-	1050, 1046, 309, 555, 23, 862, 863, 1148, 984, 927,
-	808, 1049, 938, 927, 583, 819, 443, 1039, 443, 958,
-	691, 1316, 311, 1267, 654, 935, 716, 443, 417, 222,
-	1266, 490, 489, 499, 500, 492, 493, 494, 495, 496,
-	497, 498, 491, 752, 754, 501, 904, 1265, 1227, 905,
-	50, 719, 843, 865, 1118, 987, 963, 964, 1153, 770,
-	499, 500, 492, 493, 494, 495, 496, 497, 498, 491,
-	917, 861, 501, 856, 855, 50, 916, 65, 720, 1103,
-	492, 493, 494, 495, 496, 497, 498, 491, 717, 795,
-	501, 726, 727, 728, 729, 730, 731, 732, 733, 734,
-	735, 736, 737, 738, 739, 740, 867, 868, 966, 715,
-	665, 317, 453, 790, 87, 703, 969, 968, 791, 943,
-	560, 563, 564, 565, 561, 787, 562, 566, 317, 786,
-	963, 964, 954, 788, 911, 967, 955, 956, 789, 1324,
-	792, 985, 564, 565, 1317, 976, 975, 1065, 977, 226,
-	227, 311, 311, 311, 311, 900, 311, 470, 1322, 297,
-	978, 910, 909, 311, 298, 458, 1012, 595, 435, 1194,
-	468, 1007, 1008, 1228, 1010, 875, 459, 664, 87, 317,
-	317, 926, 317, 568, 470, 1011, 217, 1013, 1014, 1015,
-	479, 989, 990, 223, 224, 1018, 908, 941, 1273, 218,
-	1231, 54, 1188, 456, 907, 1272, 317, 959, 472, 87,
-	87, 1281, 1000, 686, 56, 1027, 58, 87, 560, 563,
-	564, 565, 561, 1029, 562, 566, 317, 1156, 1028, 579,
-	443, 51, 1, 1038, 872, 1024, 819, 881, 231, 490,
-	489, 499, 500, 492, 493, 494, 495, 496, 497, 498,
-	491, 1295, 1254, 501, 1132, 1073, 443, 824, 816, 411,
-	1091, 1072, 64, 311, 924, 1090, 317, 317, 925, 592,
-	1078, 1288, 1104, 716, 823, 929, 930, 931, 598, 1004,
-	780, 840, 1107, 604, 940, 602, 780, 603, 1098, 946,
-	1026, 947, 948, 949, 950, 317, 1112, 317, 317, 1114,
-	600, 606, 605, 601, 1113, 599, 196, 304, 567, 591,
-	1131, 473, 1109, 1052, 1108, 1051, 47, 1130, 877, 1060,
-	1126, 682, 1125, 897, 451, 198, 509, 906, 918, 919,
-	920, 1120, 1121, 1122, 1071, 979, 1141, 310, 1110, 692,
-	462, 317, 1139, 1140, 1271, 1142, 1230, 942, 535, 766,
-	317, 243, 707, 255, 252, 254, 1094, 253, 698, 951,
-	483, 241, 87, 233, 296, 551, 559, 1159, 317, 557,
-	556, 965, 961, 650, 311, 295, 1161, 317, 1068, 1191,
-	87, 1278, 311, 702, 25, 55, 228, 19, 18, 17,
-	1164, 20, 16, 311, 311, 311, 311, 311, 311, 311,
-	311, 15, 14, 819, 29, 819, 13, 311, 311, 1177,
-	12, 1170, 1169, 11, 10, 9, 8, 7, 6, 5,
-	4, 219, 22, 2, 0, 297, 0, 699, 0, 317,
-	298, 317, 317, 317, 87, 317, 1203, 479, 1195, 0,
-	311, 317, 0, 0, 0, 1209, 1077, 0, 1211, 0,
-	0, 1206, 1207, 1208, 985, 0, 460, 464, 1190, 0,
-	0, 0, 317, 317, 87, 317, 317, 317, 0, 0,
-	0, 0, 1216, 482, 1218, 0, 1215, 317, 0, 1225,
-	747, 0, 1221, 0, 1224, 1071, 0, 0, 0, 0,
-	761, 761, 0, 1123, 0, 0, 761, 0, 0, 0,
-	0, 0, 1232, 0, 0, 0, 0, 525, 0, 0,
-	0, 317, 317, 761, 0, 0, 536, 1242, 0, 0,
-	0, 0, 0, 0, 317, 1107, 1253, 1075, 1076, 1259,
-	0, 0, 0, 0, 0, 0, 442, 0, 317, 0,
-	1092, 1093, 311, 1095, 1096, 0, 0, 0, 0, 819,
-	0, 1260, 0, 1261, 0, 311, 1244, 1108, 1268, 317,
-	1245, 1282, 844, 845, 847, 848, 849, 0, 0, 1286,
-	0, 0, 1107, 1026, 819, 0, 317, 317, 317, 857,
-	858, 859, 0, 299, 0, 0, 0, 1300, 0, 1269,
-	0, 0, 1171, 0, 0, 317, 1291, 1292, 1293, 1173,
-	0, 1305, 1283, 0, 1108, 0, 47, 1312, 317, 780,
-	1182, 1183, 1184, 0, 311, 1187, 311, 0, 84, 0,
-	461, 0, 0, 0, 0, 311, 1321, 317, 1197, 1198,
-	1199, 1320, 1202, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 302, 1323, 0, 311,
-	0, 415, 0, 0, 0, 85, 0, 0, 208, 423,
-	0, 424, 0, 194, 0, 0, 0, 431, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1172,
-	232, 0, 85, 85, 0, 0, 0, 204, 85, 1330,
-	0, 0, 0, 0, 0, 0, 85, 0, 85, 0,
-	0, 0, 0, 0, 85, 0, 0, 0, 0, 0,
-	0, 1239, 0, 705, 706, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1250, 1251, 1252, 0,
-	445, 446, 447, 0, 450, 0, 0, 189, 0, 0,
-	0, 454, 0, 191, 0, 0, 0, 0, 0, 0,
-	197, 193, 0, 0, 1274, 1275, 1276, 1277, 0, 974,
-	23, 24, 48, 26, 27, 525, 0, 0, 758, 759,
-	0, 0, 433, 0, 0, 0, 311, 0, 195, 42,
-	0, 199, 0, 28, 0, 0, 0, 0, 0, 0,
-	1234, 1235, 0, 1236, 1237, 1238, 0, 0, 1301, 1009,
-	0, 0, 37, 1306, 0, 0, 50, 0, 0, 85,
-	190, 0, 0, 0, 0, 1313, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1022, 311, 813,
-	311, 0, 0, 0, 0, 0, 0, 192, 0, 200,
-	201, 202, 203, 207, 0, 0, 0, 0, 206, 205,
-	0, 0, 1334, 1335, 311, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 30, 31, 33, 32, 35,
-	0, 0, 0, 0, 311, 553, 0, 0, 0, 0,
-	0, 0, 0, 0, 577, 0, 0, 36, 43, 44,
-	0, 0, 45, 46, 34, 0, 311, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 38, 39, 0, 40,
-	41, 761, 85, 0, 1111, 974, 0, 761, 0, 85,
-	575, 85, 0, 0, 0, 0, 0, 901, 902, 0,
-	464, 0, 0, 0, 0, 1332, 0, 0, 0, 0,
-	0, 0, 0, 311, 0, 311, 1134, 0, 0, 0,
-	0, 0, 651, 0, 0, 0, 0, 0, 0, 0,
-	661, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 672, 673, 674, 675, 676, 677, 678, 679, 0,
-	0, 0, 0, 0, 0, 680, 681, 0, 0, 1160,
-	49, 0, 928, 0, 0, 0, 0, 0, 1162, 0,
-	0, 0, 0, 0, 0, 658, 659, 945, 0, 663,
-	0, 0, 666, 0, 0, 0, 1165, 0, 0, 0,
-	1185, 456, 0, 0, 0, 311, 0, 0, 0, 0,
-	0, 0, 0, 1174, 1175, 0, 1176, 685, 0, 1178,
-	0, 1180, 85, 85, 0, 0, 85, 0, 0, 85,
-	0, 0, 0, 670, 0, 0, 704, 490, 489, 499,
-	500, 492, 493, 494, 495, 496, 497, 498, 491, 0,
-	0, 501, 0, 0, 85, 0, 0, 1205, 0, 1205,
-	1205, 1205, 0, 1210, 0, 0, 0, 1214, 0, 311,
-	0, 0, 0, 85, 0, 0, 0, 0, 0, 0,
-	0, 0, 670, 0, 0, 0, 0, 0, 0, 0,
-	1205, 1222, 0, 311, 311, 1226, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 777, 1189, 0, 0,
-	0, 0, 0, 232, 0, 0, 0, 0, 232, 232,
-	0, 0, 762, 762, 232, 0, 0, 0, 762, 1246,
-	1247, 0, 0, 0, 805, 0, 0, 0, 232, 232,
-	232, 232, 1134, 85, 0, 762, 85, 85, 85, 85,
-	85, 0, 0, 1100, 0, 0, 1205, 0, 794, 0,
-	0, 85, 874, 0, 876, 575, 0, 0, 1115, 1116,
-	85, 85, 1117, 896, 0, 1119, 0, 1285, 490, 489,
-	499, 500, 492, 493, 494, 495, 496, 497, 498, 491,
-	0, 456, 501, 0, 1205, 1205, 1205, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 869, 0, 0, 0,
-	761, 0, 0, 1307, 893, 0, 894, 0, 0, 895,
-	0, 0, 0, 1186, 0, 0, 1315, 490, 489, 499,
-	500, 492, 493, 494, 495, 496, 497, 498, 491, 1074,
-	0, 501, 0, 85, 0, 1205, 0, 0, 0, 0,
-	0, 85, 0, 85, 0, 0, 85, 0, 0, 490,
-	489, 499, 500, 492, 493, 494, 495, 496, 497, 498,
-	491, 1168, 0, 501, 0, 0, 0, 0, 0, 0,
-	0, 670, 0, 0, 0, 0, 624, 0, 0, 0,
-	0, 0, 0, 232, 490, 489, 499, 500, 492, 493,
-	494, 495, 496, 497, 498, 491, 0, 0, 501, 0,
-	1193, 0, 0, 0, 0, 0, 0, 525, 0, 0,
-	0, 0, 0, 0, 0, 485, 0, 488, 0, 0,
-	0, 0, 0, 502, 503, 504, 505, 506, 507, 508,
-	232, 486, 487, 484, 490, 489, 499, 500, 492, 493,
-	494, 495, 496, 497, 498, 491, 232, 923, 501, 0,
-	0, 0, 612, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1023, 490, 489, 499,
-	500, 492, 493, 494, 495, 496, 497, 498, 491, 0,
-	0, 501, 0, 625, 85, 0, 0, 0, 0, 0,
-	0, 0, 1059, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 638, 639, 640, 641, 642, 643,
-	644, 1021, 645, 646, 647, 648, 649, 626, 627, 628,
-	629, 609, 611, 0, 607, 610, 613, 0, 614, 615,
-	616, 617, 618, 619, 620, 621, 622, 623, 630, 631,
-	632, 633, 634, 635, 636, 637, 0, 0, 85, 0,
-	1067, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1299, 525, 0, 0, 0, 0, 0, 0, 1063,
-	1064, 0, 0, 0, 0, 0, 0, 85, 0, 0,
-	0, 0, 608, 0, 0, 0, 0, 232, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 232, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 670, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 762, 0, 0, 0, 0, 0, 762,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1163, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1223, 0, 0,
-	0, 0, 0, 0, 575, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 85, 0, 0, 0, 0, 0,
-	0, 0, 400, 390, 0, 361, 402, 339, 353, 410,
-	354, 355, 383, 325, 369, 138, 351, 0, 342, 320,
-	348, 321, 340, 363, 106, 338, 392, 372, 119, 408,
-	122, 377, 0, 154, 131, 0, 0, 365, 394, 367,
-	388, 360, 384, 330, 376, 403, 352, 380, 404, 0,
-	0, 0, 316, 0, 820, 821, 0, 0, 0, 0,
-	0, 98, 0, 379, 399, 350, 382, 319, 378, 0,
-	323, 326, 409, 397, 345, 346, 986, 0, 0, 0,
-	0, 0, 0, 364, 368, 385, 358, 0, 0, 0,
-	0, 0, 0, 0, 0, 343, 0, 375, 0, 0,
-	0, 327, 324, 0, 362, 0, 0, 0, 329, 0,
-	344, 386, 0, 318, 389, 395, 359, 177, 398, 357,
-	356, 143, 762, 101, 157, 111, 110, 120, 401, 366,
-	393, 341, 349, 102, 347, 149, 139, 169, 374, 140,
-	148, 123, 161, 144, 168, 178, 179, 159, 176, 89,
-	158, 167, 99, 151, 91, 165, 156, 129, 115, 116,
-	90, 0, 147, 105, 109, 104, 137, 162, 163, 103,
-	186, 95, 174, 175, 93, 96, 173, 136, 160, 166,
-	130, 127, 92, 164, 128, 126, 118, 107, 112, 141,
-	125, 142, 113, 133, 132, 134, 0, 322, 0, 155,
-	171, 187, 337, 396, 180, 181, 182, 183, 0, 0,
-	0, 135, 97, 114, 152, 117, 124, 146, 185, 381,
-	150, 100, 170, 153, 333, 336, 331, 332, 370, 371,
-	405, 406, 407, 387, 328, 0, 334, 335, 0, 391,
-	373, 88, 94, 121, 184, 145, 108, 172, 400, 390,
-	0, 361, 402, 339, 353, 410, 354, 355, 383, 325,
-	369, 138, 351, 0, 342, 320, 348, 321, 340, 363,
-	106, 338, 392, 372, 119, 408, 122, 377, 0, 154,
-	131, 0, 0, 365, 394, 367, 388, 360, 384, 330,
-	376, 403, 352, 380, 404, 0, 0, 0, 316, 0,
-	820, 821, 0, 0, 0, 0, 0, 98, 0, 379,
-	399, 350, 382, 319, 378, 0, 323, 326, 409, 397,
-	345, 346, 0, 0, 0, 0, 0, 0, 0, 364,
-	368, 385, 358, 0, 0, 0, 0, 0, 0, 0,
-	0, 343, 0, 375, 0, 0, 0, 327, 324, 0,
-	362, 0, 0, 0, 329, 0, 344, 386, 0, 318,
-	389, 395, 359, 177, 398, 357, 356, 143, 0, 101,
-	157, 111, 110, 120, 401, 366, 393, 341, 349, 102,
-	347, 149, 139, 169, 374, 140, 148, 123, 161, 144,
-	168, 178, 179, 159, 176, 89, 158, 167, 99, 151,
-	91, 165, 156, 129, 115, 116, 90, 0, 147, 105,
-	109, 104, 137, 162, 163, 103, 186, 95, 174, 175,
-	93, 96, 173, 136, 160, 166, 130, 127, 92, 164,
-	128, 126, 118, 107, 112, 141, 125, 142, 113, 133,
-	132, 134, 0, 322, 0, 155, 171, 187, 337, 396,
-	180, 181, 182, 183, 0, 0, 0, 135, 97, 114,
-	152, 117, 124, 146, 185, 381, 150, 100, 170, 153,
-	333, 336, 331, 332, 370, 371, 405, 406, 407, 387,
-	328, 0, 334, 335, 0, 391, 373, 88, 94, 121,
-	184, 145, 108, 172, 400, 390, 0, 361, 402, 339,
-	353, 410, 354, 355, 383, 325, 369, 138, 351, 0,
-	342, 320, 348, 321, 340, 363, 106, 338, 392, 372,
-	119, 408, 122, 377, 0, 154, 131, 0, 0, 365,
-	394, 367, 388, 360, 384, 330, 376, 403, 352, 380,
-	404, 0, 0, 0, 316, 0, 0, 0, 0, 0,
-	0, 0, 0, 98, 0, 379, 399, 350, 382, 319,
-	378, 0, 323, 326, 409, 397, 345, 346, 0, 0,
-	0, 0, 0, 0, 0, 364, 368, 385, 358, 0,
-	0, 0, 0, 0, 0, 1070, 0, 343, 0, 375,
-	0, 0, 0, 327, 324, 0, 362, 0, 0, 0,
-	329, 0, 344, 386, 0, 318, 389, 395, 359, 177,
-	398, 357, 356, 143, 0, 101, 157, 111, 110, 120,
-	401, 366, 393, 341, 349, 102, 347, 149, 139, 169,
-	374, 140, 148, 123, 161, 144, 168, 178, 179, 159,
-	176, 89, 158, 167, 99, 151, 91, 165, 156, 129,
-	115, 116, 90, 0, 147, 105, 109, 104, 137, 162,
-	163, 103, 186, 95, 174, 175, 93, 96, 173, 136,
-	160, 166, 130, 127, 92, 164, 128, 126, 118, 107,
-	112, 141, 125, 142, 113, 133, 132, 134, 0, 322,
-	0, 155, 171, 187, 337, 396, 180, 181, 182, 183,
-	0, 0, 0, 135, 97, 114, 152, 117, 124, 146,
-	185, 381, 150, 100, 170, 153, 333, 336, 331, 332,
-	370, 371, 405, 406, 407, 387, 328, 0, 334, 335,
-	0, 391, 373, 88, 94, 121, 184, 145, 108, 172,
-	400, 390, 0, 361, 402, 339, 353, 410, 354, 355,
-	383, 325, 369, 138, 351, 0, 342, 320, 348, 321,
-	340, 363, 106, 338, 392, 372, 119, 408, 122, 377,
-	0, 154, 131, 0, 0, 365, 394, 367, 388, 360,
-	384, 330, 376, 403, 352, 380, 404, 50, 0, 0,
-	316, 0, 0, 0, 0, 0, 0, 0, 0, 98,
-	0, 379, 399, 350, 382, 319, 378, 0, 323, 326,
-	409, 397, 345, 346, 0, 0, 0, 0, 0, 0,
-	0, 364, 368, 385, 358, 0, 0, 0, 0, 0,
-	0, 0, 0, 343, 0, 375, 0, 0, 0, 327,
-	324, 0, 362, 0, 0, 0, 329, 0, 344, 386,
-	0, 318, 389, 395, 359, 177, 398, 357, 356, 143,
-	0, 101, 157, 111, 110, 120, 401, 366, 393, 341,
-	349, 102, 347, 149, 139, 169, 374, 140, 148, 123,
-	161, 144, 168, 178, 179, 159, 176, 89, 158, 167,
-	99, 151, 91, 165, 156, 129, 115, 116, 90, 0,
-	147, 105, 109, 104, 137, 162, 163, 103, 186, 95,
-	174, 175, 93, 96, 173, 136, 160, 166, 130, 127,
-	92, 164, 128, 126, 118, 107, 112, 141, 125, 142,
-	113, 133, 132, 134, 0, 322, 0, 155, 171, 187,
-	337, 396, 180, 181, 182, 183, 0, 0, 0, 135,
-	97, 114, 152, 117, 124, 146, 185, 381, 150, 100,
-	170, 153, 333, 336, 331, 332, 370, 371, 405, 406,
-	407, 387, 328, 0, 334, 335, 0, 391, 373, 88,
-	94, 121, 184, 145, 108, 172, 400, 390, 0, 361,
-	402, 339, 353, 410, 354, 355, 383, 325, 369, 138,
-	351, 0, 342, 320, 348, 321, 340, 363, 106, 338,
-	392, 372, 119, 408, 122, 377, 0, 154, 131, 0,
-	0, 365, 394, 367, 388, 360, 384, 330, 376, 403,
-	352, 380, 404, 0, 0, 0, 237, 0, 0, 0,
-	0, 0, 0, 0, 0, 98, 0, 379, 399, 350,
-	382, 319, 378, 0, 323, 326, 409, 397, 345, 346,
-	0, 0, 0, 0, 0, 0, 0, 364, 368, 385,
-	358, 0, 0, 0, 0, 0, 0, 713, 0, 343,
-	0, 375, 0, 0, 0, 327, 324, 0, 362, 0,
-	0, 0, 329, 0, 344, 386, 0, 318, 389, 395,
-	359, 177, 398, 357, 356, 143, 0, 101, 157, 111,
-	110, 120, 401, 366, 393, 341, 349, 102, 347, 149,
-	139, 169, 374, 140, 148, 123, 161, 144, 168, 178,
-	179, 159, 176, 89, 158, 167, 99, 151, 91, 165,
-	156, 129, 115, 116, 90, 0, 147, 105, 109, 104,
-	137, 162, 163, 103, 186, 95, 174, 175, 93, 96,
-	173, 136, 160, 166, 130, 127, 92, 164, 128, 126,
-	118, 107, 112, 141, 125, 142, 113, 133, 132, 134,
-	0, 322, 0, 155, 171, 187, 337, 396, 180, 181,
-	182, 183, 0, 0, 0, 135, 97, 114, 152, 117,
-	124, 146, 185, 381, 150, 100, 170, 153, 333, 336,
-	331, 332, 370, 371, 405, 406, 407, 387, 328, 0,
-	334, 335, 0, 391, 373, 88, 94, 121, 184, 145,
-	108, 172, 400, 390, 0, 361, 402, 339, 353, 410,
-	354, 355, 383, 325, 369, 138, 351, 0, 342, 320,
-	348, 321, 340, 363, 106, 338, 392, 372, 119, 408,
-	122, 377, 0, 154, 131, 0, 0, 365, 394, 367,
-	388, 360, 384, 330, 376, 403, 352, 380, 404, 0,
-	0, 0, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 379, 399, 350, 382, 319, 378, 0,
-	323, 326, 409, 397, 345, 346, 0, 0, 0, 0,
-	0, 0, 0, 364, 368, 385, 358, 0, 0, 0,
-	0, 0, 0, 0, 0, 343, 0, 375, 0, 0,
-	0, 327, 324, 0, 362, 0, 0, 0, 329, 0,
-	344, 386, 0, 318, 389, 395, 359, 177, 398, 357,
-	356, 143, 0, 101, 157, 111, 110, 120, 401, 366,
-	393, 341, 349, 102, 347, 149, 139, 169, 374, 140,
-	148, 123, 161, 144, 168, 178, 179, 159, 176, 89,
-	158, 167, 99, 151, 91, 165, 156, 129, 115, 116,
-	90, 0, 147, 105, 109, 104, 137, 162, 163, 103,
-	186, 95, 174, 175, 93, 96, 173, 136, 160, 166,
-	130, 127, 92, 164, 128, 126, 118, 107, 112, 141,
-	125, 142, 113, 133, 132, 134, 0, 322, 0, 155,
-	171, 187, 337, 396, 180, 181, 182, 183, 0, 0,
-	0, 135, 97, 114, 152, 117, 124, 146, 185, 381,
-	150, 100, 170, 153, 333, 336, 331, 332, 370, 371,
-	405, 406, 407, 387, 328, 0, 334, 335, 0, 391,
-	373, 88, 94, 121, 184, 145, 108, 172, 400, 390,
-	0, 361, 402, 339, 353, 410, 354, 355, 383, 325,
-	369, 138, 351, 0, 342, 320, 348, 321, 340, 363,
-	106, 338, 392, 372, 119, 408, 122, 377, 0, 154,
-	131, 0, 0, 365, 394, 367, 388, 360, 384, 330,
-	376, 403, 352, 380, 404, 0, 0, 0, 237, 0,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 379,
-	399, 350, 382, 319, 378, 0, 323, 326, 409, 397,
-	345, 346, 0, 0, 0, 0, 0, 0, 0, 364,
-	368, 385, 358, 0, 0, 0, 0, 0, 0, 0,
-	0, 343, 0, 375, 0, 0, 0, 327, 324, 0,
-	362, 0, 0, 0, 329, 0, 344, 386, 0, 318,
-	389, 395, 359, 177, 398, 357, 356, 143, 0, 101,
-	157, 111, 110, 120, 401, 366, 393, 341, 349, 102,
-	347, 149, 139, 169, 374, 140, 148, 123, 161, 144,
-	168, 178, 179, 159, 176, 89, 158, 167, 99, 151,
-	91, 165, 156, 129, 115, 116, 90, 0, 147, 105,
-	109, 104, 137, 162, 163, 103, 186, 95, 174, 175,
-	93, 96, 173, 136, 160, 166, 130, 127, 92, 164,
-	128, 126, 118, 107, 112, 141, 125, 142, 113, 133,
-	132, 134, 0, 322, 0, 155, 171, 187, 337, 396,
-	180, 181, 182, 183, 0, 0, 0, 135, 97, 114,
-	152, 117, 124, 146, 185, 381, 150, 100, 170, 153,
-	333, 336, 331, 332, 370, 371, 405, 406, 407, 387,
-	328, 0, 334, 335, 0, 391, 373, 88, 94, 121,
-	184, 145, 108, 172, 400, 390, 0, 361, 402, 339,
-	353, 410, 354, 355, 383, 325, 369, 138, 351, 0,
-	342, 320, 348, 321, 340, 363, 106, 338, 392, 372,
-	119, 408, 122, 377, 0, 154, 131, 0, 0, 365,
-	394, 367, 388, 360, 384, 330, 376, 403, 352, 380,
-	404, 0, 0, 0, 316, 0, 0, 0, 0, 0,
-	0, 0, 0, 98, 0, 379, 399, 350, 382, 319,
-	378, 0, 323, 326, 409, 397, 345, 346, 0, 0,
-	0, 0, 0, 0, 0, 364, 368, 385, 358, 0,
-	0, 0, 0, 0, 0, 0, 0, 343, 0, 375,
-	0, 0, 0, 327, 324, 0, 362, 0, 0, 0,
-	329, 0, 344, 386, 0, 318, 389, 395, 359, 177,
-	398, 357, 356, 143, 0, 101, 157, 111, 110, 120,
-	401, 366, 393, 341, 349, 102, 347, 149, 139, 169,
-	374, 140, 148, 123, 161, 144, 168, 178, 179, 159,
-	176, 89, 158, 167, 99, 151, 91, 165, 156, 129,
-	115, 116, 90, 0, 147, 105, 109, 104, 137, 162,
-	163, 103, 186, 95, 174, 175, 93, 314, 173, 136,
-	160, 166, 130, 127, 92, 164, 128, 126, 118, 107,
-	112, 141, 125, 142, 113, 133, 132, 134, 0, 322,
-	0, 155, 171, 187, 337, 396, 180, 181, 182, 183,
-	0, 0, 0, 315, 313, 114, 152, 117, 124, 146,
-	185, 381, 150, 100, 170, 153, 333, 336, 331, 332,
-	370, 371, 405, 406, 407, 387, 328, 0, 334, 335,
-	0, 391, 373, 88, 94, 121, 184, 145, 108, 172,
-	400, 390, 0, 361, 402, 339, 353, 410, 354, 355,
-	383, 325, 369, 138, 351, 0, 342, 320, 348, 321,
-	340, 363, 106, 338, 392, 372, 119, 408, 122, 377,
-	0, 154, 131, 0, 0, 365, 394, 367, 388, 360,
-	384, 330, 376, 403, 352, 380, 404, 0, 0, 0,
-	86, 0, 0, 0, 0, 0, 0, 0, 0, 98,
-	0, 379, 399, 350, 382, 319, 378, 0, 323, 326,
-	409, 397, 345, 346, 0, 0, 0, 0, 0, 0,
-	0, 364, 368, 385, 358, 0, 0, 0, 0, 0,
-	0, 0, 0, 343, 0, 375, 0, 0, 0, 327,
-	324, 0, 362, 0, 0, 0, 329, 0, 344, 386,
-	0, 318, 389, 395, 359, 177, 398, 357, 356, 143,
-	0, 101, 157, 111, 110, 120, 401, 366, 393, 341,
-	349, 102, 347, 149, 139, 169, 374, 140, 148, 123,
-	161, 144, 168, 178, 179, 159, 176, 89, 158, 167,
-	99, 151, 91, 165, 156, 129, 115, 116, 90, 0,
-	147, 105, 109, 104, 137, 162, 163, 103, 186, 95,
-	174, 175, 93, 96, 173, 136, 160, 166, 130, 127,
-	92, 164, 128, 126, 118, 107, 112, 141, 125, 142,
-	113, 133, 132, 134, 0, 322, 0, 155, 171, 187,
-	337, 396, 180, 181, 182, 183, 0, 0, 0, 135,
-	97, 114, 152, 117, 124, 146, 185, 381, 150, 100,
-	170, 153, 333, 336, 331, 332, 370, 371, 405, 406,
-	407, 387, 328, 0, 334, 335, 0, 391, 373, 88,
-	94, 121, 184, 145, 108, 172, 400, 390, 0, 361,
-	402, 339, 353, 410, 354, 355, 383, 325, 369, 138,
-	351, 0, 342, 320, 348, 321, 340, 363, 106, 338,
-	392, 372, 119, 408, 122, 377, 0, 154, 131, 0,
-	0, 365, 394, 367, 388, 360, 384, 330, 376, 403,
-	352, 380, 404, 0, 0, 0, 316, 0, 0, 0,
-	0, 0, 0, 0, 0, 98, 0, 379, 399, 350,
-	382, 319, 378, 0, 323, 326, 409, 397, 345, 346,
-	0, 0, 0, 0, 0, 0, 0, 364, 368, 385,
-	358, 0, 0, 0, 0, 0, 0, 0, 0, 343,
-	0, 375, 0, 0, 0, 327, 324, 0, 362, 0,
-	0, 0, 329, 0, 344, 386, 0, 318, 389, 395,
-	359, 177, 398, 357, 356, 143, 0, 101, 157, 111,
-	110, 120, 401, 366, 393, 341, 349, 102, 347, 149,
-	139, 169, 374, 140, 148, 123, 161, 144, 168, 178,
-	179, 159, 176, 89, 158, 585, 99, 151, 91, 165,
-	156, 129, 115, 116, 90, 0, 147, 105, 109, 104,
-	137, 162, 163, 103, 186, 95, 174, 175, 93, 314,
-	173, 136, 160, 166, 130, 127, 92, 164, 128, 126,
-	118, 107, 112, 141, 125, 142, 113, 133, 132, 134,
-	0, 322, 0, 155, 171, 187, 337, 396, 180, 181,
-	182, 183, 0, 0, 0, 315, 313, 114, 152, 117,
-	124, 146, 185, 381, 150, 100, 170, 153, 333, 336,
-	331, 332, 370, 371, 405, 406, 407, 387, 328, 0,
-	334, 335, 0, 391, 373, 88, 94, 121, 184, 145,
-	108, 172, 400, 390, 0, 361, 402, 339, 353, 410,
-	354, 355, 383, 325, 369, 138, 351, 0, 342, 320,
-	348, 321, 340, 363, 106, 338, 392, 372, 119, 408,
-	122, 377, 0, 154, 131, 0, 0, 365, 394, 367,
-	388, 360, 384, 330, 376, 403, 352, 380, 404, 0,
-	0, 0, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 379, 399, 350, 382, 319, 378, 0,
-	323, 326, 409, 397, 345, 346, 0, 0, 0, 0,
-	0, 0, 0, 364, 368, 385, 358, 0, 0, 0,
-	0, 0, 0, 0, 0, 343, 0, 375, 0, 0,
-	0, 327, 324, 0, 362, 0, 0, 0, 329, 0,
-	344, 386, 0, 318, 389, 395, 359, 177, 398, 357,
-	356, 143, 0, 101, 157, 111, 110, 120, 401, 366,
-	393, 341, 349, 102, 347, 149, 139, 169, 374, 140,
-	148, 123, 161, 144, 168, 178, 179, 159, 176, 89,
-	158, 305, 99, 151, 91, 165, 156, 129, 115, 116,
-	90, 0, 147, 105, 109, 104, 137, 162, 163, 103,
-	186, 95, 174, 175, 93, 314, 173, 136, 160, 166,
-	130, 127, 92, 164, 128, 126, 118, 107, 112, 141,
-	125, 142, 113, 133, 132, 134, 0, 322, 0, 155,
-	171, 187, 337, 396, 180, 181, 182, 183, 0, 0,
-	0, 315, 313, 308, 307, 117, 124, 146, 185, 381,
-	150, 100, 170, 153, 333, 336, 331, 332, 370, 371,
-	405, 406, 407, 387, 328, 0, 334, 335, 0, 391,
-	373, 88, 94, 121, 184, 145, 108, 172, 138, 0,
-	0, 749, 0, 239, 0, 0, 0, 106, 236, 0,
-	0, 119, 278, 122, 0, 0, 154, 131, 0, 0,
-	0, 0, 269, 270, 0, 0, 0, 0, 0, 0,
-	0, 0, 50, 0, 0, 237, 257, 256, 259, 260,
-	261, 262, 0, 0, 98, 258, 263, 264, 265, 0,
-	0, 234, 250, 0, 277, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 247, 248, 230, 0, 0, 0,
-	289, 0, 249, 0, 0, 245, 246, 251, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	177, 0, 0, 287, 143, 0, 101, 157, 111, 110,
-	120, 0, 0, 0, 0, 0, 102, 0, 149, 139,
-	169, 0, 140, 148, 123, 161, 144, 168, 178, 179,
-	159, 176, 89, 158, 167, 99, 151, 91, 165, 156,
-	129, 115, 116, 90, 0, 147, 105, 109, 104, 137,
-	162, 163, 103, 186, 95, 174, 175, 93, 96, 173,
-	136, 160, 166, 130, 127, 92, 164, 128, 126, 118,
-	107, 112, 141, 125, 142, 113, 133, 132, 134, 0,
-	0, 0, 155, 171, 187, 0, 0, 180, 181, 182,
-	183, 0, 0, 0, 135, 97, 114, 152, 117, 124,
-	146, 185, 0, 150, 100, 170, 153, 279, 288, 285,
-	286, 283, 284, 282, 281, 280, 290, 271, 272, 273,
-	274, 276, 0, 275, 88, 94, 121, 184, 145, 108,
-	172, 138, 0, 0, 0, 0, 239, 0, 0, 0,
-	106, 236, 0, 0, 119, 278, 122, 0, 0, 154,
-	131, 0, 0, 0, 0, 269, 270, 0, 0, 0,
-	0, 0, 0, 0, 0, 50, 0, 456, 237, 257,
-	256, 259, 260, 261, 262, 0, 0, 98, 258, 263,
-	264, 265, 0, 0, 234, 250, 0, 277, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 247, 248, 0,
-	0, 0, 0, 289, 0, 249, 0, 0, 245, 246,
-	251, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 177, 0, 0, 287, 143, 0, 101,
-	157, 111, 110, 120, 0, 0, 0, 0, 0, 102,
-	0, 149, 139, 169, 0, 140, 148, 123, 161, 144,
-	168, 178, 179, 159, 176, 89, 158, 167, 99, 151,
-	91, 165, 156, 129, 115, 116, 90, 0, 147, 105,
-	109, 104, 137, 162, 163, 103, 186, 95, 174, 175,
-	93, 96, 173, 136, 160, 166, 130, 127, 92, 164,
-	128, 126, 118, 107, 112, 141, 125, 142, 113, 133,
-	132, 134, 0, 0, 0, 155, 171, 187, 0, 0,
-	180, 181, 182, 183, 0, 0, 0, 135, 97, 114,
-	152, 117, 124, 146, 185, 0, 150, 100, 170, 153,
-	279, 288, 285, 286, 283, 284, 282, 281, 280, 290,
-	271, 272, 273, 274, 276, 0, 275, 88, 94, 121,
-	184, 145, 108, 172, 138, 0, 0, 0, 0, 239,
-	0, 0, 0, 106, 236, 0, 0, 119, 278, 122,
-	0, 0, 154, 131, 0, 0, 0, 0, 269, 270,
-	0, 0, 0, 0, 0, 0, 0, 0, 50, 0,
-	0, 237, 257, 256, 259, 260, 261, 262, 0, 0,
-	98, 258, 263, 264, 265, 0, 0, 234, 250, 0,
-	277, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	247, 248, 230, 0, 0, 0, 289, 0, 249, 0,
-	0, 245, 246, 251, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 177, 0, 0, 287,
-	143, 0, 101, 157, 111, 110, 120, 0, 0, 0,
-	0, 0, 102, 0, 149, 139, 169, 0, 140, 148,
-	123, 161, 144, 168, 178, 179, 159, 176, 89, 158,
-	167, 99, 151, 91, 165, 156, 129, 115, 116, 90,
-	0, 147, 105, 109, 104, 137, 162, 163, 103, 186,
-	95, 174, 175, 93, 96, 173, 136, 160, 166, 130,
-	127, 92, 164, 128, 126, 118, 107, 112, 141, 125,
-	142, 113, 133, 132, 134, 0, 0, 0, 155, 171,
-	187, 0, 0, 180, 181, 182, 183, 0, 0, 0,
-	135, 97, 114, 152, 117, 124, 146, 185, 0, 150,
-	100, 170, 153, 279, 288, 285, 286, 283, 284, 282,
-	281, 280, 290, 271, 272, 273, 274, 276, 0, 275,
-	88, 94, 121, 184, 145, 108, 172, 138, 0, 0,
-	0, 0, 239, 0, 0, 0, 106, 236, 0, 0,
-	119, 278, 122, 0, 0, 154, 131, 0, 0, 0,
-	0, 269, 270, 0, 0, 0, 0, 0, 0, 812,
-	0, 50, 0, 0, 237, 257, 256, 259, 260, 261,
-	262, 0, 0, 98, 258, 263, 264, 265, 0, 0,
-	234, 250, 0, 277, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 247, 248, 0, 0, 0, 0, 289,
-	0, 249, 0, 0, 245, 246, 251, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
-	0, 0, 287, 143, 0, 101, 157, 111, 110, 120,
-	0, 0, 0, 0, 0, 102, 0, 149, 139, 169,
-	0, 140, 148, 123, 161, 144, 168, 178, 179, 159,
-	176, 89, 158, 167, 99, 151, 91, 165, 156, 129,
-	115, 116, 90, 0, 147, 105, 109, 104, 137, 162,
-	163, 103, 186, 95, 174, 175, 93, 96, 173, 136,
-	160, 166, 130, 127, 92, 164, 128, 126, 118, 107,
-	112, 141, 125, 142, 113, 133, 132, 134, 0, 0,
-	0, 155, 171, 187, 0, 0, 180, 181, 182, 183,
-	0, 0, 0, 135, 97, 114, 152, 117, 124, 146,
-	185, 0, 150, 100, 170, 153, 279, 288, 285, 286,
-	283, 284, 282, 281, 280, 290, 271, 272, 273, 274,
-	276, 23, 275, 88, 94, 121, 184, 145, 108, 172,
-	0, 0, 0, 138, 0, 0, 0, 0, 239, 0,
-	0, 0, 106, 236, 0, 0, 119, 278, 122, 0,
-	0, 154, 131, 0, 0, 0, 0, 269, 270, 0,
-	0, 0, 0, 0, 0, 0, 0, 50, 0, 0,
-	237, 257, 256, 259, 260, 261, 262, 0, 0, 98,
-	258, 263, 264, 265, 0, 0, 234, 250, 0, 277,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
-	248, 0, 0, 0, 0, 289, 0, 249, 0, 0,
-	245, 246, 251, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 177, 0, 0, 287, 143,
-	0, 101, 157, 111, 110, 120, 0, 0, 0, 0,
-	0, 102, 0, 149, 139, 169, 0, 140, 148, 123,
-	161, 144, 168, 178, 179, 159, 176, 89, 158, 167,
-	99, 151, 91, 165, 156, 129, 115, 116, 90, 0,
-	147, 105, 109, 104, 137, 162, 163, 103, 186, 95,
-	174, 175, 93, 96, 173, 136, 160, 166, 130, 127,
-	92, 164, 128, 126, 118, 107, 112, 141, 125, 142,
-	113, 133, 132, 134, 0, 0, 0, 155, 171, 187,
-	0, 0, 180, 181, 182, 183, 0, 0, 0, 135,
-	97, 114, 152, 117, 124, 146, 185, 0, 150, 100,
-	170, 153, 279, 288, 285, 286, 283, 284, 282, 281,
-	280, 290, 271, 272, 273, 274, 276, 0, 275, 88,
-	94, 121, 184, 145, 108, 172, 138, 0, 0, 0,
-	0, 239, 0, 0, 0, 106, 236, 0, 0, 119,
-	278, 122, 0, 0, 154, 131, 0, 0, 0, 0,
-	269, 270, 0, 0, 0, 0, 0, 0, 0, 0,
-	50, 0, 0, 237, 257, 256, 259, 260, 261, 262,
-	0, 0, 98, 258, 263, 264, 265, 0, 0, 234,
-	250, 0, 277, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 247, 248, 0, 0, 0, 0, 289, 0,
-	249, 0, 0, 245, 246, 251, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
-	0, 287, 143, 0, 101, 157, 111, 110, 120, 0,
-	0, 0, 0, 0, 102, 0, 149, 139, 169, 0,
-	140, 148, 123, 161, 144, 168, 178, 179, 159, 176,
-	89, 158, 167, 99, 151, 91, 165, 156, 129, 115,
-	116, 90, 0, 147, 105, 109, 104, 137, 162, 163,
-	103, 186, 95, 174, 175, 93, 96, 173, 136, 160,
-	166, 130, 127, 92, 164, 128, 126, 118, 107, 112,
-	141, 125, 142, 113, 133, 132, 134, 0, 0, 0,
-	155, 171, 187, 0, 0, 180, 181, 182, 183, 0,
-	0, 0, 135, 97, 114, 152, 117, 124, 146, 185,
-	0, 150, 100, 170, 153, 279, 288, 285, 286, 283,
-	284, 282, 281, 280, 290, 271, 272, 273, 274, 276,
-	138, 275, 88, 94, 121, 184, 145, 108, 172, 106,
-	0, 0, 0, 119, 278, 122, 0, 0, 154, 131,
-	0, 0, 0, 0, 269, 270, 0, 0, 0, 0,
-	0, 0, 0, 0, 50, 0, 0, 237, 257, 256,
-	259, 260, 261, 262, 0, 0, 98, 258, 263, 264,
-	265, 0, 0, 0, 250, 0, 277, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 247, 248, 0, 0,
-	0, 0, 289, 0, 249, 0, 0, 245, 246, 251,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 177, 0, 0, 287, 143, 0, 101, 157,
-	111, 110, 120, 0, 0, 0, 0, 0, 102, 0,
-	149, 139, 169, 1333, 140, 148, 123, 161, 144, 168,
-	178, 179, 159, 176, 89, 158, 167, 99, 151, 91,
-	165, 156, 129, 115, 116, 90, 0, 147, 105, 109,
-	104, 137, 162, 163, 103, 186, 95, 174, 175, 93,
-	96, 173, 136, 160, 166, 130, 127, 92, 164, 128,
-	126, 118, 107, 112, 141, 125, 142, 113, 133, 132,
-	134, 0, 0, 0, 155, 171, 187, 0, 0, 180,
-	181, 182, 183, 0, 0, 0, 135, 97, 114, 152,
-	117, 124, 146, 185, 0, 150, 100, 170, 153, 279,
-	288, 285, 286, 283, 284, 282, 281, 280, 290, 271,
-	272, 273, 274, 276, 138, 275, 88, 94, 121, 184,
-	145, 108, 172, 106, 0, 0, 0, 119, 278, 122,
-	0, 0, 154, 131, 0, 0, 0, 0, 269, 270,
-	0, 0, 0, 0, 0, 0, 0, 0, 50, 0,
-	0, 237, 257, 256, 259, 260, 261, 262, 0, 0,
-	98, 258, 263, 264, 265, 0, 0, 0, 250, 0,
-	277, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	247, 248, 0, 0, 0, 0, 289, 0, 249, 0,
-	0, 245, 246, 251, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 177, 0, 0, 287,
-	143, 0, 101, 157, 111, 110, 120, 0, 0, 0,
-	0, 0, 102, 0, 149, 139, 169, 0, 140, 148,
-	123, 161, 144, 168, 178, 179, 159, 176, 89, 158,
-	167, 99, 151, 91, 165, 156, 129, 115, 116, 90,
-	0, 147, 105, 109, 104, 137, 162, 163, 103, 186,
-	95, 174, 175, 93, 96, 173, 136, 160, 166, 130,
-	127, 92, 164, 128, 126, 118, 107, 112, 141, 125,
-	142, 113, 133, 132, 134, 0, 0, 0, 155, 171,
-	187, 0, 0, 180, 181, 182, 183, 0, 0, 0,
-	135, 97, 114, 152, 117, 124, 146, 185, 0, 150,
-	100, 170, 153, 279, 288, 285, 286, 283, 284, 282,
-	281, 280, 290, 271, 272, 273, 274, 276, 138, 275,
-	88, 94, 121, 184, 145, 108, 172, 106, 0, 0,
-	0, 119, 0, 122, 0, 0, 154, 131, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 316, 0, 0, 0, 0,
-	0, 0, 0, 0, 98, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	490, 489, 499, 500, 492, 493, 494, 495, 496, 497,
-	498, 491, 0, 0, 501, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	177, 0, 0, 0, 143, 0, 101, 157, 111, 110,
-	120, 0, 0, 0, 0, 0, 102, 0, 149, 139,
-	169, 0, 140, 148, 123, 161, 144, 168, 178, 179,
-	159, 176, 89, 158, 167, 99, 151, 91, 165, 156,
-	129, 115, 116, 90, 0, 147, 105, 109, 104, 137,
-	162, 163, 103, 186, 95, 174, 175, 93, 96, 173,
-	136, 160, 166, 130, 127, 92, 164, 128, 126, 118,
-	107, 112, 141, 125, 142, 113, 133, 132, 134, 0,
-	0, 0, 155, 171, 187, 0, 0, 180, 181, 182,
-	183, 0, 0, 0, 135, 97, 114, 152, 117, 124,
-	146, 185, 0, 150, 100, 170, 153, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 88, 94, 121, 184, 145, 108,
-	172, 138, 0, 0, 0, 478, 0, 0, 0, 0,
-	106, 0, 0, 0, 119, 0, 122, 0, 0, 154,
-	131, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 316, 0,
-	480, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 475, 474, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 476,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 177, 0, 0, 0, 143, 0, 101,
-	157, 111, 110, 120, 0, 0, 0, 0, 0, 102,
-	0, 149, 139, 169, 0, 140, 148, 123, 161, 144,
-	168, 178, 179, 159, 176, 89, 158, 167, 99, 151,
-	91, 165, 156, 129, 115, 116, 90, 0, 147, 105,
-	109, 104, 137, 162, 163, 103, 186, 95, 174, 175,
-	93, 96, 173, 136, 160, 166, 130, 127, 92, 164,
-	128, 126, 118, 107, 112, 141, 125, 142, 113, 133,
-	132, 134, 0, 0, 0, 155, 171, 187, 0, 0,
-	180, 181, 182, 183, 0, 0, 0, 135, 97, 114,
-	152, 117, 124, 146, 185, 0, 150, 100, 170, 153,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 88, 94, 121,
-	184, 145, 108, 172, 138, 0, 0, 0, 574, 0,
-	0, 0, 0, 106, 0, 0, 0, 119, 0, 122,
-	0, 0, 154, 131, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 86, 0, 576, 0, 0, 0, 0, 0, 0,
-	98, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 177, 0, 0, 0,
-	143, 0, 101, 157, 111, 110, 120, 0, 0, 0,
-	0, 0, 102, 0, 149, 139, 169, 0, 140, 148,
-	123, 161, 144, 168, 178, 179, 159, 176, 89, 158,
-	167, 99, 151, 91, 165, 156, 129, 115, 116, 90,
-	0, 147, 105, 109, 104, 137, 162, 163, 103, 186,
-	95, 174, 175, 93, 96, 173, 136, 160, 166, 130,
-	127, 92, 164, 128, 126, 118, 107, 112, 141, 125,
-	142, 113, 133, 132, 134, 0, 0, 0, 155, 171,
-	187, 0, 0, 180, 181, 182, 183, 0, 0, 0,
-	135, 97, 114, 152, 117, 124, 146, 185, 0, 150,
-	100, 170, 153, 0, 0, 0, 23, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 138, 0,
-	88, 94, 121, 184, 145, 108, 172, 106, 0, 0,
-	0, 119, 0, 122, 0, 0, 154, 131, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 50, 0, 0, 316, 0, 0, 0, 0,
-	0, 0, 0, 0, 98, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	177, 0, 0, 0, 143, 0, 101, 157, 111, 110,
-	120, 0, 0, 0, 0, 0, 102, 0, 149, 139,
-	169, 0, 140, 148, 123, 161, 144, 168, 178, 179,
-	159, 176, 89, 158, 167, 99, 151, 91, 165, 156,
-	129, 115, 116, 90, 0, 147, 105, 109, 104, 137,
-	162, 163, 103, 186, 95, 174, 175, 93, 96, 173,
-	136, 160, 166, 130, 127, 92, 164, 128, 126, 118,
-	107, 112, 141, 125, 142, 113, 133, 132, 134, 0,
-	0, 0, 155, 171, 187, 0, 0, 180, 181, 182,
-	183, 0, 0, 0, 135, 97, 114, 152, 117, 124,
-	146, 185, 0, 150, 100, 170, 153, 0, 0, 0,
-	23, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 138, 0, 88, 94, 121, 184, 145, 108,
-	172, 106, 0, 0, 0, 119, 0, 122, 0, 0,
-	154, 131, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 50, 0, 0, 86,
-	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 177, 0, 0, 0, 143, 0,
-	101, 157, 111, 110, 120, 0, 0, 0, 0, 0,
-	102, 0, 149, 139, 169, 0, 140, 148, 123, 161,
-	144, 168, 178, 179, 159, 176, 89, 158, 167, 99,
-	151, 91, 165, 156, 129, 115, 116, 90, 0, 147,
-	105, 109, 104, 137, 162, 163, 103, 186, 95, 174,
-	175, 93, 96, 173, 136, 160, 166, 130, 127, 92,
-	164, 128, 126, 118, 107, 112, 141, 125, 142, 113,
-	133, 132, 134, 0, 0, 0, 155, 171, 187, 0,
-	0, 180, 181, 182, 183, 0, 0, 0, 135, 97,
-	114, 152, 117, 124, 146, 185, 138, 150, 100, 170,
-	153, 0, 0, 0, 0, 106, 0, 0, 0, 119,
-	0, 122, 0, 0, 154, 131, 0, 0, 88, 94,
-	121, 184, 145, 108, 172, 0, 0, 0, 0, 0,
-	0, 0, 0, 316, 0, 0, 700, 0, 0, 701,
-	0, 0, 98, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
-	0, 0, 143, 0, 101, 157, 111, 110, 120, 0,
-	0, 0, 0, 0, 102, 0, 149, 139, 169, 0,
-	140, 148, 123, 161, 144, 168, 178, 179, 159, 176,
-	89, 158, 167, 99, 151, 91, 165, 156, 129, 115,
-	116, 90, 0, 147, 105, 109, 104, 137, 162, 163,
-	103, 186, 95, 174, 175, 93, 96, 173, 136, 160,
-	166, 130, 127, 92, 164, 128, 126, 118, 107, 112,
-	141, 125, 142, 113, 133, 132, 134, 0, 0, 0,
-	155, 171, 187, 0, 0, 180, 181, 182, 183, 0,
-	0, 0, 135, 97, 114, 152, 117, 124, 146, 185,
-	138, 150, 100, 170, 153, 0, 0, 0, 0, 106,
-	594, 0, 0, 119, 0, 122, 0, 0, 154, 131,
-	0, 0, 88, 94, 121, 184, 145, 108, 172, 0,
-	0, 0, 0, 0, 0, 0, 0, 316, 0, 593,
-	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 177, 0, 0, 0, 143, 0, 101, 157,
-	111, 110, 120, 0, 0, 0, 0, 0, 102, 0,
-	149, 139, 169, 0, 140, 148, 123, 161, 144, 168,
-	178, 179, 159, 176, 89, 158, 167, 99, 151, 91,
-	165, 156, 129, 115, 116, 90, 0, 147, 105, 109,
-	104, 137, 162, 163, 103, 186, 95, 174, 175, 93,
-	96, 173, 136, 160, 166, 130, 127, 92, 164, 128,
-	126, 118, 107, 112, 141, 125, 142, 113, 133, 132,
-	134, 0, 0, 0, 155, 171, 187, 0, 0, 180,
-	181, 182, 183, 0, 0, 0, 135, 97, 114, 152,
-	117, 124, 146, 185, 0, 150, 100, 170, 153, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 88, 94, 121, 184,
-	145, 108, 172, 138, 0, 0, 0, 574, 0, 0,
-	0, 0, 106, 0, 0, 0, 119, 0, 122, 0,
-	0, 154, 131, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	86, 0, 576, 0, 0, 0, 0, 0, 0, 98,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 177, 0, 0, 0, 143,
-	0, 101, 157, 111, 110, 120, 0, 0, 0, 0,
-	0, 102, 0, 149, 139, 169, 0, 572, 148, 123,
-	161, 144, 168, 178, 179, 159, 176, 89, 158, 167,
-	99, 151, 91, 165, 156, 129, 115, 116, 90, 0,
-	147, 105, 109, 104, 137, 162, 163, 103, 186, 95,
-	174, 175, 93, 96, 173, 136, 160, 166, 130, 127,
-	92, 164, 128, 126, 118, 107, 112, 141, 125, 142,
-	113, 133, 132, 134, 0, 0, 0, 155, 171, 187,
-	0, 0, 180, 181, 182, 183, 0, 0, 0, 135,
-	97, 114, 152, 117, 124, 146, 185, 138, 150, 100,
-	170, 153, 0, 0, 0, 0, 106, 0, 0, 0,
-	119, 0, 122, 0, 0, 154, 131, 0, 0, 88,
-	94, 121, 184, 145, 108, 172, 0, 0, 0, 0,
-	0, 50, 0, 0, 86, 0, 0, 0, 0, 0,
-	0, 0, 0, 98, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
-	0, 0, 0, 143, 0, 101, 157, 111, 110, 120,
-	0, 0, 0, 0, 0, 102, 0, 149, 139, 169,
-	0, 140, 148, 123, 161, 144, 168, 178, 179, 159,
-	176, 89, 158, 167, 99, 151, 91, 165, 156, 129,
-	115, 116, 90, 0, 147, 105, 109, 104, 137, 162,
-	163, 103, 186, 95, 174, 175, 93, 96, 173, 136,
-	160, 166, 130, 127, 92, 164, 128, 126, 118, 107,
-	112, 141, 125, 142, 113, 133, 132, 134, 0, 0,
-	0, 155, 171, 187, 0, 0, 180, 181, 182, 183,
-	0, 0, 0, 135, 97, 114, 152, 117, 124, 146,
-	185, 138, 150, 100, 170, 153, 0, 0, 0, 0,
-	106, 0, 0, 0, 119, 0, 122, 0, 0, 154,
-	131, 0, 0, 88, 94, 121, 184, 145, 108, 172,
-	0, 0, 0, 0, 0, 0, 0, 0, 86, 0,
-	576, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 177, 0, 0, 0, 143, 0, 101,
-	157, 111, 110, 120, 0, 0, 0, 0, 0, 102,
-	0, 149, 139, 169, 0, 140, 148, 123, 161, 144,
-	168, 178, 179, 159, 176, 89, 158, 167, 99, 151,
-	91, 165, 156, 129, 115, 116, 90, 0, 147, 105,
-	109, 104, 137, 162, 163, 103, 186, 95, 174, 175,
-	93, 96, 173, 136, 160, 166, 130, 127, 92, 164,
-	128, 126, 118, 107, 112, 141, 125, 142, 113, 133,
-	132, 134, 0, 0, 0, 155, 171, 187, 0, 0,
-	180, 181, 182, 183, 0, 0, 0, 135, 97, 114,
-	152, 117, 124, 146, 185, 138, 150, 100, 170, 153,
-	0, 0, 0, 0, 106, 0, 0, 0, 119, 0,
-	122, 0, 0, 154, 131, 0, 0, 88, 94, 121,
-	184, 145, 108, 172, 0, 0, 0, 0, 0, 0,
-	0, 0, 316, 0, 480, 0, 0, 0, 0, 0,
-	0, 98, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 177, 0, 0,
-	0, 143, 0, 101, 157, 111, 110, 120, 0, 0,
-	0, 0, 0, 102, 0, 149, 139, 169, 0, 140,
-	148, 123, 161, 144, 168, 178, 179, 159, 176, 89,
-	158, 167, 99, 151, 91, 165, 156, 129, 115, 116,
-	90, 0, 147, 105, 109, 104, 137, 162, 163, 103,
-	186, 95, 174, 175, 93, 96, 173, 136, 160, 166,
-	130, 127, 92, 164, 128, 126, 118, 107, 112, 141,
-	125, 142, 113, 133, 132, 134, 0, 0, 0, 155,
-	171, 187, 0, 0, 180, 181, 182, 183, 0, 0,
-	0, 135, 97, 114, 152, 117, 124, 146, 185, 138,
-	150, 100, 170, 153, 0, 0, 0, 0, 106, 0,
-	0, 0, 119, 0, 122, 0, 0, 154, 131, 0,
-	0, 88, 94, 121, 184, 145, 108, 172, 0, 0,
-	0, 0, 0, 0, 0, 0, 86, 0, 0, 0,
-	0, 0, 0, 0, 0, 98, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 177, 0, 0, 0, 143, 0, 101, 157, 111,
-	110, 120, 0, 0, 0, 0, 0, 102, 0, 149,
-	139, 169, 0, 140, 148, 123, 161, 144, 168, 178,
-	179, 159, 176, 89, 158, 167, 99, 151, 91, 165,
-	156, 129, 115, 116, 90, 0, 147, 105, 109, 104,
-	137, 162, 163, 103, 186, 95, 174, 175, 93, 96,
-	173, 136, 160, 166, 130, 127, 92, 164, 128, 126,
-	118, 107, 112, 141, 125, 142, 113, 133, 132, 134,
-	0, 0, 0, 155, 171, 187, 0, 0, 180, 181,
-	182, 183, 0, 0, 0, 135, 97, 114, 152, 117,
-	124, 146, 185, 660, 150, 100, 170, 153, 0, 0,
+	370, 1922, 971, 1188, 1927, 612, 1995, 2119, 1837, 1899,
+	1224, 1890, 1982, 1773, 1949, 1082, 1925, 1933, 1946, 766,
+	1718, 1802, 1801, 1774, 1948, 927, 1011, 1810, 1472, 304,
+	292, 1782, 1438, 319, 754, 899, 1473, 103, 965, 968,
+	945, 963, 1439, 103, 1336, 873, 818, 790, 1292, 266,
+	989, 1502, 660, 1051, 1571, 1435, 1033, 658, 294, 979,
+	977, 1316, 260, 1076, 1024, 288, 354, 103, 103, 475,
+	526, 103, 1247, 58, 928, 1062, 1025, 103, 978, 103,
+	103, 103, 103, 611, 3, 970, 1204, 870, 902, 1413,
+	1296, 103, 103, 1146, 103, 1381, 365, 697, 73, 1094,
+	103, 1295, 291, 1215, 676, 1046, 1193, 916, 846, 541,
+	547, 261, 262, 263, 264, 690, 477, 1860, 753, 359,
+	662, 924, 346, 553, 345, 350, 675, 1891, 290, 647,
+	561, 1126, 901, 528, 656, 275, 265, 226, 494, 356,
+	1687, 1686, 1514, 626, 347, 1516, 228, 1407, 229, 230,
+	231, 1018, 1149, 279, 1273, 1272, 604, 904, 1275, 57,
+	227, 1595, 2113, 2024, 2102, 1960, 2023, 1430, 1623, 483,
+	98, 94, 95, 96, 1461, 1462, 1212, 1460, 1959, 1211,
+	62, 536, 1213, 959, 960, 1741, 677, 521, 678, 1584,
+	958, 235, 1034, 809, 716, 1277, 1021, 1026, 1153, 1154,
+	810, 1532, 362, 1746, 1531, 1047, 1612, 64, 65, 66,
+	67, 68, 1610, 259, 1996, 696, 532, 533, 1834, 1505,
+	1327, 1851, 688, 765, 1850, 496, 497, 2100, 2082, 55,
+	1035, 1951, 991, 1014, 1754, 1236, 1658, 1228, 1481, 103,
+	1501, 1506, 730, 731, 732, 733, 734, 735, 736, 1572,
+	737, 738, 739, 730, 731, 732, 733, 734, 735, 736,
+	992, 737, 738, 739, 1109, 1077, 1078, 1079, 288, 288,
+	523, 1263, 525, 1262, 1233, 1928, 1929, 1573, 1109, 1314,
+	1937, 1271, 1019, 1387, 704, 288, 874, 1320, 233, 1811,
+	1812, 1591, 1064, 1065, 1067, 1717, 288, 288, 1063, 2081,
+	288, 288, 288, 288, 288, 97, 1064, 1065, 1067, 1917,
+	1480, 232, 522, 524, 529, 530, 531, 234, 534, 991,
+	1311, 288, 1678, 1481, 1481, 538, 1064, 1065, 1067, 2068,
+	288, 717, 1371, 1479, 2034, 2111, 1977, 495, 1504, 1503,
+	1905, 1073, 503, 549, 1049, 103, 1029, 992, 597, 1732,
+	1852, 764, 103, 103, 103, 550, 730, 731, 732, 733,
+	734, 735, 736, 1513, 737, 738, 739, 740, 741, 1034,
+	742, 743, 744, 718, 719, 720, 721, 701, 703, 1274,
+	699, 702, 705, 1156, 706, 707, 708, 709, 710, 711,
+	712, 713, 714, 715, 722, 723, 724, 725, 726, 727,
+	728, 729, 881, 1958, 520, 1938, 92, 1035, 1321, 1312,
+	350, 1368, 1590, 1066, 1863, 510, 1559, 281, 1479, 1479,
+	667, 1270, 1971, 511, 1480, 1313, 1414, 1066, 1080, 889,
+	236, 884, 885, 878, 1482, 1864, 1072, 776, 888, 877,
+	512, 883, 882, 887, 891, 892, 751, 1066, 880, 893,
+	700, 1203, 876, 551, 1202, 890, 1252, 1201, 1253, 481,
+	1254, 1255, 1256, 886, 91, 480, 479, 1560, 1505, 946,
+	948, 498, 1561, 1416, 491, 103, 628, 629, 630, 631,
+	632, 633, 634, 635, 238, 103, 93, 1026, 1379, 1106,
+	1506, 362, 599, 600, 1882, 1866, 1735, 673, 103, 103,
+	1105, 2079, 575, 103, 1587, 586, 103, 1347, 1632, 587,
+	103, 103, 288, 1418, 103, 1422, 1499, 1417, 1394, 1415,
+	1786, 879, 1138, 1369, 586, 1420, 1367, 1015, 587, 1494,
+	1013, 750, 1115, 816, 1419, 775, 565, 1491, 103, 984,
+	509, 1783, 787, 1023, 1526, 1151, 947, 1421, 1423, 90,
+	1490, 1370, 92, 964, 1785, 813, 2080, 103, 560, 288,
+	288, 1225, 1121, 1114, 797, 1377, 288, 1113, 288, 1376,
+	774, 288, 288, 288, 288, 288, 288, 288, 288, 288,
+	288, 288, 288, 288, 288, 288, 288, 1504, 1503, 798,
+	799, 800, 801, 802, 803, 804, 805, 1865, 1734, 821,
+	1324, 1022, 847, 806, 807, 288, 785, 759, 71, 1900,
+	897, 288, 1390, 1527, 1968, 288, 288, 288, 288, 288,
+	288, 288, 288, 1784, 760, 1317, 288, 848, 1318, 815,
+	762, 1892, 1464, 72, 1318, 1787, 1788, 288, 288, 288,
+	288, 783, 103, 558, 288, 103, 103, 103, 103, 103,
+	911, 912, 796, 1570, 1122, 1432, 918, 103, 1191, 560,
+	103, 853, 842, 679, 103, 1466, 1319, 1553, 70, 103,
+	103, 1552, 1319, 921, 929, 851, 852, 850, 814, 917,
+	288, 1318, 917, 1015, 1175, 544, 548, 823, 769, 757,
+	1721, 1332, 1556, 838, 559, 558, 1682, 840, 906, 841,
+	1007, 1389, 566, 350, 350, 350, 350, 350, 2064, 1685,
+	1333, 560, 555, 1555, 559, 558, 1786, 1225, 350, 1319,
+	1382, 1434, 1351, 1165, 1465, 1164, 953, 350, 895, 896,
+	1383, 560, 1348, 1241, 2028, 1683, 540, 1783, 613, 502,
+	1808, 559, 558, 906, 2094, 55, 914, 624, 907, 908,
+	1785, 103, 103, 2055, 913, 849, 103, 1677, 560, 559,
+	558, 1974, 1240, 103, 1036, 1037, 1038, 930, 1008, 920,
+	933, 922, 923, 318, 103, 1970, 560, 103, 1027, 1028,
+	1030, 1031, 1032, 1896, 950, 942, 1554, 1044, 951, 1166,
+	931, 932, 955, 934, 103, 1041, 1042, 1043, 1053, 1492,
+	1493, 956, 819, 820, 975, 540, 362, 1010, 1676, 1671,
+	1845, 1885, 1841, 1842, 1843, 288, 288, 288, 288, 1784,
+	972, 1696, 1350, 1349, 1342, 1341, 1340, 1347, 1695, 288,
+	1688, 1787, 1788, 1058, 1840, 504, 505, 506, 507, 1086,
+	1673, 1088, 364, 88, 1672, 474, 478, 1048, 1050, 559,
+	558, 1112, 288, 288, 288, 1849, 1550, 492, 493, 540,
+	559, 558, 834, 836, 837, 1346, 560, 835, 1135, 1136,
+	1137, 1101, 559, 558, 559, 558, 1071, 560, 579, 580,
+	581, 582, 583, 575, 847, 1100, 586, 1515, 2001, 560,
+	587, 560, 1847, 1838, 1302, 1930, 1300, 1103, 871, 2004,
+	540, 288, 1281, 559, 558, 288, 1261, 1096, 1106, 848,
+	559, 558, 1987, 842, 1909, 288, 2109, 872, 288, 1105,
+	560, 1986, 1989, 1990, 1901, 2037, 1988, 560, 1127, 559,
+	558, 1128, 1753, 1293, 1099, 577, 578, 579, 580, 581,
+	582, 583, 575, 1691, 1846, 586, 560, 1015, 1596, 587,
+	841, 1150, 1152, 103, 1330, 1206, 1264, 1208, 603, 1142,
+	1223, 1134, 584, 585, 577, 578, 579, 580, 581, 582,
+	583, 575, 1819, 1221, 586, 1818, 831, 832, 587, 1815,
+	1813, 1225, 1850, 1680, 1093, 1091, 1092, 1521, 1090, 1518,
+	1226, 1190, 1839, 1189, 103, 559, 558, 288, 559, 558,
+	904, 540, 1726, 2121, 1726, 2114, 1436, 103, 1185, 1189,
+	1207, 350, 560, 1726, 2104, 560, 364, 364, 364, 364,
+	904, 364, 613, 1174, 1726, 2090, 1248, 1662, 364, 1107,
+	1894, 540, 613, 1158, 59, 909, 910, 643, 1234, 1235,
+	1198, 1238, 559, 558, 1652, 2083, 644, 1172, 1726, 2075,
+	1652, 2073, 1652, 2059, 1973, 563, 103, 103, 103, 560,
+	1239, 25, 1209, 1652, 2042, 1652, 2040, 1913, 2036, 1726,
+	103, 78, 1218, 1098, 644, 1286, 1726, 2035, 1289, 1290,
+	1291, 1844, 2017, 540, 1282, 1283, 1159, 1285, 1756, 1294,
+	1652, 2012, 1652, 2011, 1848, 1097, 952, 962, 669, 1250,
+	1652, 2010, 513, 77, 972, 514, 1284, 1652, 2009, 2003,
+	2002, 1397, 103, 1627, 309, 308, 288, 311, 312, 313,
+	314, 55, 103, 103, 310, 315, 1652, 1998, 1322, 1323,
+	103, 364, 1726, 1978, 1102, 1726, 1944, 681, 1652, 1918,
+	288, 288, 288, 1911, 1299, 1310, 1104, 670, 288, 288,
+	1344, 1343, 1338, 86, 87, 1301, 76, 80, 1913, 1912,
+	288, 1315, 1726, 1906, 75, 74, 82, 1159, 288, 288,
+	288, 288, 1529, 1829, 1219, 1400, 288, 1652, 1827, 1309,
+	1652, 1826, 88, 1117, 288, 1652, 1824, 1726, 1809, 1339,
+	288, 288, 288, 1726, 1794, 288, 79, 83, 288, 1726,
+	540, 671, 81, 669, 84, 1726, 1761, 1315, 1378, 1437,
+	1384, 1459, 842, 1118, 929, 687, 1723, 1337, 1440, 644,
+	929, 103, 1652, 1651, 1190, 1469, 669, 1648, 1457, 540,
+	1410, 288, 1124, 1125, 1569, 548, 1117, 1403, 1631, 540,
+	1535, 1534, 1533, 1409, 1529, 1530, 1431, 1170, 1412, 1385,
+	288, 1529, 1528, 1424, 747, 748, 1425, 1520, 1519, 669,
+	1489, 1168, 1446, 1216, 1447, 1159, 540, 288, 644, 540,
+	1442, 25, 1399, 364, 1445, 687, 686, 957, 779, 1189,
+	1458, 1698, 1697, 2106, 25, 788, 791, 1468, 85, 1219,
+	791, 1159, 364, 364, 364, 364, 364, 364, 364, 364,
+	1540, 1539, 272, 1169, 103, 1467, 364, 364, 1183, 1363,
+	672, 1184, 1507, 103, 1511, 817, 767, 1167, 288, 1358,
+	55, 755, 1160, 756, 1522, 1523, 825, 1525, 2092, 1510,
+	2066, 55, 1500, 103, 1822, 1176, 563, 1685, 2038, 364,
+	2032, 1524, 2019, 1517, 55, 2015, 1997, 1993, 1549, 1952,
+	1921, 1919, 1910, 972, 1908, 1226, 972, 649, 652, 653,
+	654, 650, 55, 651, 655, 1857, 103, 1194, 1195, 649,
+	652, 653, 654, 650, 103, 651, 655, 1856, 1855, 1548,
+	1854, 1700, 1832, 898, 1831, 1825, 1551, 1733, 1716, 1664,
+	1663, 288, 1543, 788, 788, 1574, 1575, 1566, 103, 788,
+	1598, 1564, 1557, 1359, 1659, 288, 1657, 1562, 1577, 1361,
+	1354, 1355, 1362, 1357, 1356, 1579, 1026, 788, 1052, 1547,
+	1542, 1541, 1045, 1508, 1451, 1331, 756, 1303, 1304, 1582,
+	1364, 1360, 1047, 1266, 1231, 288, 1589, 1588, 1230, 1227,
+	1220, 1040, 288, 1194, 1195, 1232, 364, 1039, 993, 89,
+	1660, 1594, 1353, 1436, 1197, 1599, 350, 103, 1111, 1057,
+	364, 478, 1567, 1056, 537, 223, 1374, 829, 939, 23,
+	1221, 937, 1608, 940, 1200, 1199, 938, 288, 936, 941,
+	1232, 653, 654, 935, 1705, 1706, 2099, 1923, 2047, 1947,
+	2014, 1605, 1606, 1626, 1607, 1719, 1634, 1609, 2000, 1611,
+	1975, 1939, 1903, 1902, 1898, 1867, 1828, 1791, 1641, 1639,
+	1736, 288, 1708, 1694, 1693, 344, 1592, 1563, 1488, 1054,
+	1487, 1653, 1649, 1650, 1486, 1241, 1372, 1334, 1399, 1665,
+	270, 1329, 1070, 1661, 1288, 1268, 1666, 1667, 103, 1237,
+	1668, 1214, 364, 1085, 364, 539, 1081, 894, 782, 781,
+	770, 768, 518, 515, 364, 746, 1297, 1298, 2085, 1083,
+	288, 1926, 1914, 1545, 1950, 1593, 1689, 1375, 1701, 1373,
+	1703, 1704, 1216, 1728, 925, 276, 277, 2060, 2022, 1635,
+	1393, 1636, 1637, 1638, 1123, 1690, 224, 1692, 2057, 1217,
+	364, 554, 1133, 1433, 103, 1132, 1707, 1287, 684, 542,
+	1226, 972, 1715, 966, 552, 1656, 519, 1625, 1448, 1449,
+	244, 543, 1450, 967, 1727, 1452, 1954, 288, 288, 1861,
+	288, 288, 288, 972, 1737, 1512, 237, 1738, 1087, 254,
+	819, 820, 1069, 1674, 778, 1711, 1679, 1712, 1713, 1714,
+	1945, 1308, 1267, 1061, 657, 749, 288, 288, 1483, 1710,
+	554, 273, 1777, 274, 1725, 288, 1131, 758, 267, 1871,
+	288, 1440, 1781, 1745, 1130, 1463, 268, 59, 1870, 1744,
+	1755, 1190, 1983, 1471, 1470, 1259, 1260, 1836, 556, 516,
+	1765, 1879, 812, 61, 1509, 63, 1345, 668, 1779, 1789,
+	1792, 56, 1, 1352, 1084, 1335, 1932, 761, 239, 1328,
+	1684, 103, 1835, 1337, 972, 241, 1075, 1724, 763, 1883,
+	1766, 1642, 247, 243, 1757, 1095, 1780, 288, 1474, 981,
+	1814, 69, 1012, 1205, 1985, 980, 1820, 976, 875, 689,
+	1276, 1020, 1816, 695, 1817, 693, 694, 691, 698, 692,
+	246, 357, 680, 1833, 364, 1017, 1016, 1544, 745, 557,
+	1366, 1365, 1089, 1388, 245, 808, 1120, 1229, 535, 248,
+	595, 1859, 1129, 249, 1210, 363, 1443, 1790, 546, 288,
+	1257, 1869, 1743, 1173, 1886, 623, 915, 293, 833, 307,
+	306, 1868, 972, 305, 824, 1795, 1182, 1269, 567, 1880,
+	1440, 283, 349, 640, 648, 240, 1278, 1280, 646, 645,
+	1196, 1192, 348, 1396, 1622, 1876, 828, 27, 1597, 60,
+	278, 21, 1897, 20, 19, 22, 18, 17, 16, 1280,
+	31, 1116, 1325, 242, 1709, 250, 251, 252, 253, 257,
+	793, 225, 288, 15, 256, 255, 14, 13, 12, 11,
+	10, 1916, 1881, 9, 8, 7, 6, 5, 4, 269,
+	24, 2, 1624, 0, 1924, 0, 0, 364, 0, 613,
+	0, 1858, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 288, 288, 0, 0, 0, 0, 1956, 0, 0,
+	288, 0, 0, 1940, 1941, 1942, 1943, 0, 288, 364,
+	0, 1386, 1953, 1966, 1655, 288, 0, 0, 0, 0,
+	1967, 0, 0, 0, 0, 1961, 103, 0, 0, 1964,
+	929, 0, 364, 0, 0, 0, 0, 0, 1972, 0,
+	1991, 0, 0, 0, 1907, 1408, 0, 0, 1681, 0,
+	1999, 0, 0, 1980, 0, 1984, 0, 288, 288, 288,
+	0, 0, 0, 0, 0, 0, 0, 0, 1992, 0,
+	1994, 0, 0, 0, 0, 0, 2005, 788, 0, 0,
+	1444, 1205, 0, 788, 2013, 0, 1934, 2007, 2008, 0,
+	0, 0, 0, 2021, 0, 0, 0, 0, 103, 0,
+	0, 2020, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 364, 0, 0, 364, 0, 2039, 2043,
+	2046, 1475, 1478, 0, 0, 1484, 1485, 0, 0, 0,
+	0, 0, 0, 0, 2050, 0, 2052, 0, 0, 0,
+	0, 2048, 2041, 2053, 0, 0, 0, 2051, 0, 2054,
+	0, 288, 2056, 0, 0, 103, 1979, 0, 0, 288,
+	0, 2062, 2072, 2071, 2063, 0, 0, 2074, 2058, 2069,
+	0, 0, 0, 2076, 0, 0, 2078, 0, 2077, 0,
+	0, 0, 0, 0, 613, 0, 0, 0, 0, 103,
+	2084, 0, 0, 2086, 0, 2097, 2095, 1798, 1538, 2096,
+	2098, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 788, 0, 0, 0, 0, 822, 0, 0, 0,
+	2107, 1558, 288, 0, 2108, 478, 0, 2112, 1568, 0,
+	0, 288, 2116, 0, 0, 0, 1576, 0, 0, 0,
+	1578, 0, 0, 0, 288, 2045, 0, 1580, 2126, 2128,
+	2127, 0, 2129, 2132, 1830, 2130, 0, 1934, 0, 2133,
+	0, 0, 0, 0, 0, 1583, 0, 0, 0, 1586,
+	0, 0, 0, 0, 364, 0, 0, 903, 905, 0,
+	2065, 0, 0, 0, 0, 0, 0, 0, 364, 0,
+	0, 0, 0, 919, 573, 584, 585, 577, 578, 579,
+	580, 581, 582, 583, 575, 0, 613, 586, 0, 0,
+	0, 587, 0, 0, 2091, 0, 0, 0, 0, 25,
+	26, 53, 28, 29, 944, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 47,
+	2105, 0, 0, 30, 0, 1568, 0, 1568, 1568, 1568,
+	0, 1640, 0, 0, 2115, 0, 0, 1643, 0, 0,
+	0, 364, 0, 0, 0, 0, 44, 0, 0, 1931,
+	0, 1568, 0, 0, 0, 42, 0, 0, 0, 55,
+	0, 0, 0, 364, 972, 0, 0, 0, 0, 0,
+	0, 37, 0, 364, 0, 285, 0, 0, 0, 0,
+	0, 0, 1568, 0, 0, 0, 0, 0, 1955, 613,
+	0, 574, 576, 573, 584, 585, 577, 578, 579, 580,
+	581, 582, 583, 575, 0, 613, 586, 0, 0, 0,
+	587, 0, 0, 1475, 1702, 1475, 1475, 0, 0, 0,
+	32, 33, 35, 34, 40, 0, 0, 0, 791, 0,
+	0, 0, 0, 0, 0, 0, 0, 1722, 0, 0,
+	0, 0, 0, 364, 364, 1729, 38, 39, 1730, 1731,
+	0, 2123, 540, 0, 2006, 1147, 0, 41, 48, 49,
+	0, 1739, 50, 51, 36, 1740, 0, 0, 0, 0,
+	0, 0, 0, 1009, 0, 0, 0, 0, 0, 996,
+	0, 43, 0, 45, 46, 0, 0, 0, 574, 576,
+	573, 584, 585, 577, 578, 579, 580, 581, 582, 583,
+	575, 1015, 0, 586, 0, 1759, 1760, 587, 0, 0,
+	0, 0, 0, 0, 997, 0, 1767, 1769, 1772, 0,
+	0, 1778, 364, 0, 0, 0, 1475, 0, 1005, 0,
+	994, 1568, 1797, 0, 1799, 995, 0, 1800, 1803, 0,
+	1155, 0, 0, 0, 0, 1157, 0, 0, 0, 0,
+	0, 0, 1161, 1162, 1163, 0, 2070, 0, 0, 1171,
+	0, 1878, 0, 0, 1177, 0, 1178, 1179, 1180, 1181,
+	54, 1475, 1821, 574, 576, 573, 584, 585, 577, 578,
+	579, 580, 581, 582, 583, 575, 320, 52, 586, 0,
+	0, 1002, 587, 1013, 0, 0, 0, 0, 1006, 0,
+	1853, 0, 984, 0, 0, 1014, 0, 1568, 0, 1000,
+	1001, 0, 1004, 1003, 0, 0, 0, 1619, 540, 613,
+	1877, 574, 576, 573, 584, 585, 577, 578, 579, 580,
+	581, 582, 583, 575, 0, 0, 586, 0, 0, 52,
+	587, 613, 0, 0, 1889, 1568, 0, 271, 0, 0,
+	0, 0, 0, 351, 574, 576, 573, 584, 585, 577,
+	578, 579, 580, 581, 582, 583, 575, 0, 0, 586,
+	1568, 601, 602, 587, 0, 605, 606, 607, 608, 609,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 999,
+	0, 0, 0, 0, 998, 0, 1920, 0, 0, 1475,
+	0, 0, 1616, 540, 0, 0, 364, 0, 1935, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 138, 88, 94, 121, 184, 145,
-	108, 172, 552, 106, 0, 0, 0, 119, 0, 122,
-	0, 0, 154, 131, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 86, 0, 0, 0, 0, 0, 0, 0, 0,
-	98, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 177, 0, 0, 0,
-	143, 0, 101, 157, 111, 110, 120, 0, 0, 0,
-	0, 0, 102, 0, 149, 139, 169, 0, 140, 148,
-	123, 161, 144, 168, 178, 179, 159, 176, 89, 158,
-	167, 99, 151, 91, 165, 156, 129, 115, 116, 90,
-	0, 147, 105, 109, 104, 137, 162, 163, 103, 186,
-	95, 174, 175, 93, 96, 173, 136, 160, 166, 130,
-	127, 92, 164, 128, 126, 118, 107, 112, 141, 125,
-	142, 113, 133, 132, 134, 0, 0, 0, 155, 171,
-	187, 0, 0, 180, 181, 182, 183, 0, 0, 0,
-	135, 97, 114, 152, 117, 124, 146, 185, 0, 150,
-	100, 170, 153, 0, 0, 0, 0, 0, 0, 0,
-	0, 300, 0, 0, 0, 0, 0, 0, 138, 0,
-	88, 94, 121, 184, 145, 108, 172, 106, 0, 0,
-	0, 119, 0, 122, 0, 0, 154, 131, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 86, 0, 0, 0, 0,
-	0, 0, 0, 0, 98, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	177, 0, 0, 0, 143, 0, 101, 157, 111, 110,
-	120, 0, 0, 0, 0, 0, 102, 0, 149, 139,
-	169, 0, 140, 148, 123, 161, 144, 168, 178, 179,
-	159, 176, 89, 158, 167, 99, 151, 91, 165, 156,
-	129, 115, 116, 90, 0, 147, 105, 109, 104, 137,
-	162, 163, 103, 186, 95, 174, 175, 93, 96, 173,
-	136, 160, 166, 130, 127, 92, 164, 128, 126, 118,
-	107, 112, 141, 125, 142, 113, 133, 132, 134, 0,
-	0, 0, 155, 171, 187, 0, 0, 180, 181, 182,
-	183, 0, 0, 0, 135, 97, 114, 152, 117, 124,
-	146, 185, 138, 150, 100, 170, 153, 0, 0, 0,
-	0, 106, 0, 0, 0, 119, 0, 122, 0, 0,
-	154, 131, 0, 0, 88, 94, 121, 184, 145, 108,
-	172, 0, 0, 0, 0, 0, 0, 0, 0, 86,
-	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 83, 0, 177, 0, 0, 0, 143, 0,
-	101, 157, 111, 110, 120, 0, 0, 0, 0, 0,
-	102, 0, 149, 139, 169, 0, 140, 148, 123, 161,
-	144, 168, 178, 179, 159, 176, 89, 158, 167, 99,
-	151, 91, 165, 156, 129, 115, 116, 90, 0, 147,
-	105, 109, 104, 137, 162, 163, 103, 186, 95, 174,
-	175, 93, 96, 173, 136, 160, 166, 130, 127, 92,
-	164, 128, 126, 118, 107, 112, 141, 125, 142, 113,
-	133, 132, 134, 0, 0, 0, 155, 171, 187, 0,
-	0, 180, 181, 182, 183, 0, 0, 0, 135, 97,
-	114, 152, 117, 124, 146, 185, 138, 150, 100, 170,
-	153, 0, 0, 0, 0, 106, 0, 0, 0, 119,
-	0, 122, 0, 0, 154, 131, 0, 0, 88, 94,
-	121, 184, 145, 108, 172, 0, 0, 0, 0, 0,
-	0, 0, 0, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
-	0, 0, 143, 0, 101, 157, 111, 110, 120, 0,
-	0, 0, 0, 0, 102, 0, 149, 139, 169, 0,
-	140, 148, 123, 161, 144, 168, 178, 179, 159, 176,
-	89, 158, 167, 99, 151, 91, 165, 156, 129, 115,
-	116, 90, 0, 147, 105, 109, 104, 137, 162, 163,
-	103, 186, 95, 174, 175, 93, 96, 173, 136, 160,
-	166, 130, 127, 92, 164, 128, 126, 118, 107, 112,
-	141, 125, 142, 113, 133, 132, 134, 0, 0, 0,
-	155, 171, 187, 0, 0, 180, 181, 182, 183, 0,
-	0, 0, 135, 97, 114, 152, 117, 124, 146, 185,
-	138, 150, 100, 170, 153, 0, 0, 0, 0, 106,
-	0, 0, 0, 119, 0, 122, 0, 0, 154, 131,
-	0, 0, 88, 94, 121, 184, 145, 108, 172, 0,
-	0, 0, 0, 0, 0, 0, 0, 237, 0, 0,
-	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 177, 0, 0, 0, 143, 0, 101, 157,
-	111, 110, 120, 0, 0, 0, 0, 0, 102, 0,
-	149, 139, 169, 0, 140, 148, 123, 161, 144, 168,
-	178, 179, 159, 176, 89, 158, 167, 99, 151, 91,
-	165, 156, 129, 115, 116, 90, 0, 147, 105, 109,
-	104, 137, 162, 163, 103, 186, 95, 174, 175, 93,
-	96, 173, 136, 160, 166, 130, 127, 92, 164, 128,
-	126, 118, 107, 112, 141, 125, 142, 113, 133, 132,
-	134, 0, 0, 0, 155, 171, 187, 0, 0, 180,
-	181, 182, 183, 0, 0, 0, 135, 97, 114, 152,
-	117, 124, 146, 185, 138, 150, 100, 170, 153, 0,
-	0, 0, 0, 106, 0, 0, 0, 119, 0, 122,
-	0, 0, 154, 131, 0, 0, 88, 94, 121, 184,
-	145, 108, 172, 0, 0, 0, 0, 0, 0, 0,
-	0, 86, 0, 0, 0, 0, 0, 0, 0, 0,
-	98, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 177, 0, 0, 0,
-	143, 0, 101, 157, 111, 110, 120, 0, 0, 0,
-	0, 0, 102, 0, 149, 139, 169, 0, 140, 148,
-	123, 161, 144, 168, 178, 179, 159, 176, 89, 158,
-	167, 99, 151, 91, 165, 156, 129, 115, 116, 90,
-	0, 147, 105, 109, 104, 137, 162, 163, 103, 186,
-	95, 174, 175, 93, 96, 173, 136, 160, 166, 130,
-	127, 92, 164, 128, 126, 118, 107, 112, 141, 125,
-	142, 113, 133, 132, 134, 0, 0, 0, 155, 171,
-	187, 0, 0, 180, 181, 182, 183, 0, 0, 0,
-	135, 97, 114, 152, 117, 124, 146, 185, 0, 150,
-	100, 170, 153, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	88, 94, 121, 184, 145, 108, 172,
-}
-var yyPact = [...]int{
-
-	1444, -1000, -172, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 876, 899, -1000, -1000, -1000, -1000, -1000, -1000, 715,
-	49, 91, 105, -4, 10024, 104, 1322, 10636, -1000, -20,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 688, -1000, -1000,
-	-1000, -1000, -1000, 859, 873, 713, 863, 801, -1000, 5626,
-	88, 8759, 9820, 4927, -1000, 527, 100, 10636, -145, 10228,
-	80, 80, 80, -1000, 102, 10636, -1000, 10636, 78, 521,
-	78, 78, 78, 10636, -1000, 156, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 10636, 519,
-	829, 45, 3205, 3205, 3205, 3205, -12, 3205, -95, 752,
-	-1000, -1000, -1000, -1000, 3205, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 367, 836, 6328, 6328, 876,
-	-1000, 688, -1000, -1000, -1000, 826, -1000, -1000, 255, 887,
-	-1000, 7233, 154, -1000, 6328, 1974, 560, -1000, -1000, 560,
-	-1000, -1000, 131, -1000, -1000, 6776, 6776, 6776, 6776, 6776,
-	6776, 6776, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 560, -1000, 6095, 560,
-	560, 560, 560, 560, 560, 560, 560, 6328, 560, 560,
-	560, 560, 560, 560, 560, 560, 560, 560, 560, 560,
-	560, 9596, 571, 868, -1000, -1000, -1000, 851, 7914, 8555,
-	10636, 543, -1000, 651, 4681, -96, -1000, -1000, -1000, 205,
-	8322, -1000, -1000, -1000, 828, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 590, -1000, 1977, 10228, 3205, 95, 663, 496, 224,
-	493, 10636, 9371, 3205, 93, 10636, 844, 750, 10636, 479,
-	446, -1000, 4435, -1000, 3205, 3205, 3205, 3205, 3205, 3205,
-	3205, 3205, -1000, -1000, -1000, -1000, -1000, -1000, 3205, 3205,
-	-1000, -80, -1000, 10636, -1000, -1000, -1000, -1000, 894, 186,
-	466, 152, 657, -1000, 407, 859, 367, 801, 8118, 764,
-	-1000, -1000, 10636, -1000, 6328, 6328, 404, -1000, 9167, -1000,
-	-1000, 3451, 190, 6776, 293, 209, 6776, 6776, 6776, 6776,
-	6776, 6776, 6776, 6776, 6776, 6776, 6776, 6776, 6776, 6776,
-	6776, 338, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	413, -1000, 688, 467, 467, 167, 167, 167, 167, 167,
-	167, 7000, 5160, 367, 587, 281, 6095, 5626, 5626, 6328,
-	6328, 10432, 10432, 5626, 853, 214, 281, 10432, -1000, 367,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 5626, 5626, 5626,
-	5626, 16, 10636, -1000, 10432, 8759, 8759, 8759, 8759, 8759,
-	-1000, 779, 775, -1000, 783, 763, 790, 10636, -1000, 566,
-	7914, 159, 560, -1000, 8963, -1000, -1000, 16, 624, 8759,
-	10636, -1000, -1000, 4189, 651, -96, 647, -1000, -94, -102,
-	5859, 165, -1000, -1000, -1000, -1000, 2713, 106, 320, -1000,
-	-76, -1000, -1000, -1000, -1000, 690, -1000, -1000, -1000, 690,
-	83, 690, 690, 690, -49, -49, -49, -49, -1000, -1000,
-	-1000, -1000, -1000, 712, 711, -1000, 690, 690, 690, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 709, 709, 709, 691, 691,
-	746, -1000, 10636, -161, 410, 3205, 842, 3205, -1000, 115,
-	10636, -1000, 10636, -1000, -1000, 10636, 3205, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 239, -1000, -1000, -1000, -1000, 809, 6328, 6328,
-	3943, 6328, -1000, -1000, -1000, 836, -1000, 853, 875, -1000,
-	820, 819, 5626, -1000, -1000, 190, 218, -1000, -1000, 246,
-	-1000, -1000, -1000, -1000, 146, 560, -1000, 631, -1000, -1000,
-	-1000, -1000, 293, 6776, 6776, 6776, 308, 631, 2007, 658,
-	417, 167, 363, 363, 166, 166, 166, 166, 166, 676,
-	676, -1000, -1000, -1000, 367, -1000, -1000, -1000, 367, 5626,
-	650, -1000, -1000, 6328, -1000, 367, 545, 545, 341, 509,
-	662, -1000, 144, 649, 545, 5626, 232, -1000, 6328, 367,
-	-1000, 545, 367, 545, 545, 607, 560, -1000, 656, -1000,
-	204, 868, 696, 748, 770, -1000, -1000, -1000, -1000, 767,
-	-1000, 766, -1000, -1000, -1000, -1000, -1000, 99, 98, 97,
-	10228, -1000, 885, 8759, 640, -1000, -1000, 647, -96, -121,
-	-1000, -1000, -1000, 281, -1000, 398, 645, 2467, -1000, -1000,
-	-1000, -1000, -1000, -1000, 693, 67, 58, 107, 388, -1000,
-	-1000, -1000, 242, 38, 893, -1000, 46, -1000, 44, 361,
-	-81, -1000, -1000, 327, -49, -49, 690, -49, -1000, -1000,
-	165, 827, 165, 165, 165, 360, 360, -1000, -1000, -1000,
-	-1000, 323, -1000, -1000, -1000, 316, -1000, 10636, 10228, 3205,
-	-1000, 3697, -1000, -1000, -1000, -1000, -1000, -1000, 248, 559,
-	142, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 0, 120, -1000, 3205, -1000, 267, 10636, 10636,
-	800, 281, 281, 141, -1000, -1000, 10636, -1000, -1000, -1000,
-	-1000, 646, -1000, -1000, -1000, 2959, 5626, -1000, 308, 631,
-	1889, -1000, 6776, 6776, -1000, -1000, 545, 5626, 281, -1000,
-	-1000, -1000, 32, 338, 32, 6776, 6776, 3943, 6776, 6776,
-	-155, 636, 213, -1000, 6328, 277, -1000, -1000, -1000, -1000,
-	-1000, 719, 10432, 560, -1000, 7690, 10228, 876, 10432, 6328,
-	6328, -1000, -1000, 6328, 692, -1000, 6328, -1000, -1000, -1000,
-	560, 560, 560, 511, -1000, 876, 640, -1000, -1000, -1000,
-	-109, -115, -1000, -1000, 2713, -1000, 2713, 10228, -1000, 379,
-	375, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-126, -1000, -1000, -1000, -1000, -1000, 535, 165, 165, -49,
-	165, -1000, 211, -1000, -1000, -1000, 534, -1000, 532, 644,
-	516, 630, 698, -1000, 627, -1000, 201, -1000, 56, -1000,
-	10228, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 10228,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 10636, -1000, -1000, -1000, -1000, -1000, 10228, 47, -1000,
-	-1000, 357, 6328, -1000, -1000, -1000, 3697, -1000, 885, 8759,
-	-1000, -1000, 367, -1000, 6776, 631, 631, -1000, -1000, 367,
-	690, 690, -1000, 690, 691, -1000, 690, -30, 690, -31,
-	367, 367, 1657, 1924, -1000, 839, 1808, 560, -152, -1000,
-	281, 6328, -1000, 832, 612, 617, -1000, -1000, 5393, 367,
-	513, 139, 511, 859, -1000, 281, 281, 281, 10228, 281,
-	10228, 10228, 10228, 7466, 10228, 859, -1000, -1000, -1000, -1000,
-	2467, -1000, 508, -1000, 690, -1000, -1000, -1000, -1000, -1000,
-	-1000, 165, -1000, -1000, -1000, -49, 340, -49, 311, -1000,
-	285, 10228, 10228, 10636, 3697, 2713, 10228, -1000, -1000, -1000,
-	686, -1000, -1000, -1000, -1000, 837, 10228, -1000, 281, 877,
-	626, -1000, 631, -1000, -1000, 73, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 6776, 6776, -1000, 6776, 6776,
-	6776, 367, 321, 281, 39, -1000, 560, -1000, -1000, 622,
-	10228, 10228, -1000, -1000, 484, -1000, 451, 451, 451, 159,
-	-1000, -1000, 123, 10228, -1000, -1000, 165, -1000, 165, 452,
-	403, 443, 685, 668, -1000, -1000, 661, 10228, 560, 57,
-	881, 872, -1000, -1000, 1857, 1857, 1857, 1857, 55, -1000,
-	-1000, 892, -1000, 560, -1000, 688, 134, -1000, 10228, -1000,
-	-1000, -1000, -1000, -1000, 123, -1000, 373, 199, 309, -1000,
-	-1000, -1000, -1000, -1000, -1000, 10228, 10228, 10228, 441, -2,
-	28, -1000, 6328, 6328, -1000, -1000, -1000, -1000, 367, 41,
-	-165, 10432, 617, 367, 10228, -1000, -1000, -1000, 284, -1000,
-	-1000, 429, 427, 402, 663, 394, -1000, 10228, 659, 281,
-	593, -1000, 797, -159, -168, 592, -1000, -1000, -1000, -1000,
-	-1000, -1000, -161, -1000, -2, 816, 10228, -1000, 792, -1000,
-	-1000, -1000, -3, 366, -162, -9, -1000, -166, 560, -169,
-	6552, -1000, 1857, 367, -1000, -1000,
-}
-var yyPgo = [...]int{
-
-	0, 1113, 46, 446, 1112, 1111, 1110, 1109, 1108, 1107,
-	1106, 1105, 1104, 1103, 1100, 1096, 1094, 1092, 1091, 1082,
-	1081, 1079, 1078, 1077, 157, 1076, 1075, 1074, 58, 1073,
-	61, 1071, 1069, 26, 121, 34, 32, 928, 1068, 31,
-	59, 69, 1065, 40, 1062, 1061, 67, 1060, 55, 1059,
-	1056, 1273, 1055, 1054, 6, 33, 1053, 1051, 1050, 1049,
-	77, 294, 1048, 1047, 1045, 1044, 1043, 1042, 37, 3,
-	7, 18, 10, 1041, 45, 17, 1039, 42, 1038, 1037,
-	1036, 1034, 36, 1030, 44, 1029, 12, 43, 1028, 20,
-	57, 29, 23, 9, 66, 52, 1027, 30, 53, 41,
-	1025, 1017, 364, 1016, 1015, 1014, 1013, 1011, 1009, 306,
-	310, 1008, 1005, 1003, 25, 0, 648, 108, 60, 1001,
-	39, 999, 1310, 65, 56, 11, 998, 27, 1226, 21,
-	997, 996, 15, 995, 993, 992, 991, 990, 977, 975,
-	973, 642, 16, 24, 971, 969, 48, 14, 28, 49,
-	968, 964, 54, 961, 952, 949, 948, 22, 13, 947,
-	5, 944, 4, 942, 941, 1, 927, 19, 925, 8,
-	924, 2, 923, 922, 921, 50, 209, 919, 917, 913,
-	906, 75,
-}
-var yyR1 = [...]int{
-
-	0, 173, 174, 174, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 2, 2, 2, 6, 3, 4, 4, 5,
-	5, 7, 7, 27, 27, 8, 9, 9, 9, 177,
-	177, 46, 46, 90, 90, 10, 10, 10, 10, 95,
-	95, 99, 99, 99, 100, 100, 100, 100, 130, 130,
-	11, 11, 11, 11, 11, 11, 11, 11, 11, 120,
-	120, 171, 171, 170, 169, 169, 168, 168, 167, 16,
-	154, 155, 155, 155, 149, 133, 133, 133, 133, 133,
-	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
-	150, 150, 150, 150, 150, 150, 137, 137, 135, 135,
-	135, 135, 135, 135, 135, 136, 136, 136, 136, 136,
-	138, 138, 138, 138, 138, 134, 134, 139, 139, 139,
-	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
-	139, 139, 139, 139, 140, 140, 140, 140, 140, 140,
-	140, 140, 148, 148, 141, 141, 146, 146, 147, 147,
-	147, 144, 144, 145, 145, 142, 142, 142, 143, 143,
-	151, 151, 163, 163, 162, 162, 162, 153, 153, 159,
-	159, 159, 159, 159, 152, 152, 161, 161, 160, 156,
-	156, 156, 157, 157, 157, 158, 158, 158, 12, 12,
-	12, 12, 12, 12, 12, 12, 12, 12, 12, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	178, 178, 179, 179, 179, 179, 179, 179, 179, 166,
-	164, 164, 165, 165, 13, 14, 14, 14, 14, 14,
-	15, 15, 17, 18, 18, 18, 18, 18, 18, 18,
-	18, 18, 18, 18, 18, 18, 18, 18, 18, 18,
-	18, 18, 18, 18, 18, 18, 107, 107, 104, 104,
-	105, 105, 106, 106, 106, 108, 108, 108, 131, 131,
-	131, 19, 19, 21, 21, 22, 23, 20, 20, 20,
-	20, 20, 180, 24, 25, 25, 26, 26, 26, 30,
-	30, 30, 28, 28, 29, 29, 35, 35, 34, 34,
-	36, 36, 36, 36, 119, 119, 119, 118, 118, 38,
-	38, 39, 39, 40, 40, 41, 41, 41, 53, 53,
-	89, 89, 91, 91, 42, 42, 42, 42, 43, 43,
-	44, 44, 45, 45, 126, 126, 125, 125, 125, 124,
-	124, 47, 47, 47, 49, 48, 48, 48, 48, 50,
-	50, 52, 52, 51, 51, 54, 54, 54, 54, 55,
-	55, 37, 37, 37, 37, 37, 37, 37, 103, 103,
-	57, 57, 56, 56, 56, 56, 56, 56, 56, 56,
-	56, 56, 67, 67, 67, 67, 67, 67, 58, 58,
-	58, 58, 58, 58, 58, 33, 33, 68, 68, 68,
-	74, 69, 69, 61, 61, 61, 61, 61, 61, 61,
-	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
-	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
-	61, 61, 61, 61, 65, 65, 65, 63, 63, 63,
-	63, 63, 63, 63, 63, 63, 63, 63, 63, 63,
-	63, 63, 64, 64, 64, 64, 64, 64, 64, 64,
-	181, 181, 66, 66, 66, 66, 31, 31, 31, 31,
-	31, 129, 129, 132, 132, 132, 132, 132, 132, 132,
-	132, 132, 132, 132, 132, 132, 78, 78, 32, 32,
-	76, 76, 77, 79, 79, 75, 75, 75, 60, 60,
-	60, 60, 60, 60, 60, 60, 62, 62, 62, 80,
-	80, 81, 81, 82, 82, 83, 83, 84, 85, 85,
-	85, 86, 86, 86, 86, 87, 87, 87, 59, 59,
-	59, 59, 59, 59, 88, 88, 88, 88, 92, 92,
-	70, 70, 72, 72, 71, 73, 93, 93, 97, 94,
-	94, 98, 98, 98, 96, 96, 96, 121, 121, 121,
-	101, 101, 109, 109, 110, 110, 102, 102, 111, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 112, 112,
-	112, 113, 113, 116, 116, 117, 117, 122, 122, 123,
-	123, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 175, 176, 127, 128, 128, 128,
-}
-var yyR2 = [...]int{
-
-	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 4, 6, 7, 5, 10, 1, 3, 1,
-	3, 7, 8, 1, 1, 8, 8, 7, 6, 1,
-	1, 1, 3, 0, 4, 3, 4, 5, 4, 1,
-	3, 3, 2, 2, 2, 2, 2, 1, 1, 1,
-	2, 9, 11, 11, 4, 6, 5, 5, 5, 0,
-	1, 0, 2, 1, 0, 2, 1, 3, 3, 4,
-	4, 1, 3, 3, 2, 3, 1, 1, 1, 1,
-	1, 2, 3, 3, 3, 3, 3, 3, 3, 4,
-	2, 3, 2, 3, 2, 3, 2, 1, 1, 1,
-	1, 1, 1, 1, 1, 2, 2, 2, 2, 2,
-	1, 2, 2, 2, 1, 1, 1, 4, 4, 4,
-	5, 2, 2, 3, 3, 3, 3, 1, 1, 1,
-	1, 1, 6, 6, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 3, 0, 3, 0, 5, 0, 3,
-	5, 0, 1, 0, 1, 0, 3, 3, 0, 2,
-	5, 4, 1, 2, 2, 3, 2, 0, 1, 2,
-	3, 3, 2, 2, 1, 1, 1, 3, 2, 0,
-	1, 3, 1, 2, 3, 1, 1, 1, 6, 11,
-	13, 7, 7, 12, 7, 7, 7, 4, 5, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 7,
-	1, 3, 8, 8, 5, 4, 6, 5, 4, 4,
-	3, 2, 3, 4, 4, 4, 4, 4, 4, 4,
-	4, 3, 3, 3, 3, 4, 3, 6, 4, 2,
-	4, 2, 2, 2, 2, 3, 1, 1, 0, 1,
-	0, 1, 0, 2, 2, 0, 2, 2, 0, 1,
-	1, 2, 1, 1, 2, 1, 1, 2, 2, 2,
-	2, 2, 0, 2, 0, 2, 1, 2, 2, 0,
-	1, 1, 0, 1, 0, 1, 0, 1, 1, 3,
-	1, 2, 3, 5, 0, 1, 2, 1, 1, 0,
-	2, 1, 3, 1, 1, 1, 3, 3, 3, 7,
-	1, 3, 1, 3, 4, 4, 4, 3, 2, 4,
-	0, 1, 0, 2, 0, 1, 0, 1, 2, 1,
-	1, 1, 2, 2, 1, 2, 3, 2, 3, 2,
-	2, 2, 1, 1, 3, 0, 5, 5, 5, 0,
-	2, 1, 3, 3, 2, 3, 1, 2, 0, 3,
-	1, 1, 3, 3, 4, 4, 5, 3, 4, 5,
-	6, 2, 1, 2, 1, 2, 1, 2, 1, 1,
-	1, 1, 1, 1, 1, 0, 2, 1, 1, 1,
-	3, 1, 3, 1, 1, 1, 1, 1, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 2, 2, 2, 2, 2, 2, 3,
-	1, 1, 1, 1, 4, 5, 6, 4, 4, 6,
-	6, 6, 6, 8, 8, 6, 8, 8, 9, 7,
-	5, 4, 2, 2, 2, 2, 2, 2, 2, 2,
-	0, 2, 4, 4, 4, 4, 0, 3, 4, 7,
-	3, 1, 1, 2, 3, 3, 1, 2, 2, 1,
-	2, 1, 2, 2, 1, 2, 0, 1, 0, 2,
-	1, 2, 4, 0, 2, 1, 3, 5, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 2, 2, 0,
-	3, 0, 2, 0, 3, 1, 3, 2, 0, 1,
-	1, 0, 2, 4, 4, 0, 2, 4, 2, 1,
-	3, 5, 4, 6, 1, 3, 3, 5, 0, 5,
-	1, 3, 1, 2, 3, 1, 1, 3, 3, 1,
-	3, 3, 3, 3, 1, 2, 1, 1, 1, 1,
-	1, 1, 0, 2, 0, 3, 0, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 0, 0, 1, 1,
-}
-var yyChk = [...]int{
-
-	-1000, -173, -1, -2, -6, -7, -8, -9, -10, -11,
-	-12, -13, -14, -15, -17, -18, -19, -21, -22, -23,
-	-20, -3, -4, 6, 7, -27, 9, 10, 29, -16,
-	111, 112, 114, 113, 140, 115, 133, 48, 152, 153,
-	155, 156, 25, 134, 135, 138, 139, -175, 8, 236,
-	52, -174, 251, -82, 15, -26, 5, -24, -180, -24,
-	-24, -24, -24, -24, -154, 52, -120, 120, 69, 148,
-	228, 117, 118, 131, -102, 120, 122, 118, 118, 119,
-	120, 228, 117, 118, -51, -122, 55, -115, 244, 152,
-	163, 157, 185, 177, 245, 174, 178, 215, 64, 155,
-	224, 126, 136, 172, 168, 166, 27, 190, 249, 167,
-	129, 128, 191, 195, 216, 161, 162, 218, 189, 31,
-	130, 246, 33, 144, 219, 193, 188, 184, 187, 160,
-	183, 37, 197, 196, 198, 214, 180, 169, 18, 139,
-	142, 192, 194, 124, 146, 248, 220, 165, 143, 138,
-	223, 156, 217, 226, 36, 202, 159, 127, 153, 150,
-	181, 145, 170, 171, 186, 158, 182, 154, 147, 140,
-	225, 203, 250, 179, 175, 176, 151, 120, 148, 149,
-	207, 208, 209, 210, 247, 221, 173, 204, 118, 105,
-	178, 111, 205, 119, 31, 146, -131, 118, -104, 149,
-	207, 208, 209, 210, 55, 217, 216, 211, -122, 154,
-	-127, -127, -127, -127, -127, -2, -86, 17, 16, -5,
-	-3, -175, 6, 20, 21, -30, 38, 39, -25, -36,
-	96, -37, -122, -56, 71, -61, 28, 55, -115, 23,
-	-60, -57, -75, -73, -74, 105, 106, 94, 95, 102,
-	72, 107, -65, -63, -64, -66, 57, 56, 65, 58,
-	59, 60, 61, 66, 67, 68, -116, -71, -175, 42,
-	43, 237, 238, 239, 240, 243, 241, 74, 32, 227,
-	235, 234, 233, 231, 232, 229, 230, 123, 228, 100,
-	236, -102, -39, -40, -41, -42, -53, -74, -175, -51,
-	11, -46, -51, -94, -130, 154, -98, 217, 216, -117,
-	-96, -116, -114, 215, 178, 214, 55, -115, 116, 70,
-	22, 24, 200, 73, 105, 16, 74, 104, 237, 111,
-	46, 229, 230, 227, 239, 240, 228, 205, 28, 10,
-	25, 134, 21, 98, 113, 77, 78, 137, 23, 135,
-	68, 19, 49, 11, 13, 14, 123, 122, 89, 119,
-	44, 8, 107, 26, 86, 40, 132, 42, 87, 17,
-	231, 232, 30, 243, 141, 100, 47, 34, 71, 66,
-	50, 222, 69, 15, 45, 88, 114, 236, 43, 117,
-	6, 242, 29, 133, 41, 118, 206, 76, 121, 67,
-	5, 131, 9, 48, 51, 233, 234, 235, 32, 75,
-	12, -155, -149, 55, 119, -51, 236, -116, -110, 123,
-	-110, -110, 118, -51, -51, -109, 123, 55, -109, -109,
-	-109, -51, 108, -51, 55, 29, 228, 55, 146, 118,
-	147, 120, -128, -175, -117, -128, -128, -128, 150, 151,
-	-128, -105, 212, 50, -128, -176, 54, -87, 19, 30,
-	-37, -122, -83, -84, -37, -82, -2, -24, 34, -28,
-	21, 63, 11, -119, 70, 69, 86, -118, 22, -116,
-	57, 108, -37, -58, 89, 71, 87, 88, 73, 91,
-	90, 101, 94, 95, 96, 97, 98, 99, 100, 92,
-	93, 104, 79, 80, 81, 82, 83, 84, 85, -103,
-	-175, -74, -175, 109, 110, -61, -61, -61, -61, -61,
-	-61, -61, -175, -2, -69, -37, -175, -175, -175, -175,
-	-175, -175, -175, -175, -175, -78, -37, -175, -181, -175,
-	-181, -181, -181, -181, -181, -181, -181, -175, -175, -175,
-	-175, -52, 26, -51, 29, 53, -47, -49, -48, -50,
-	40, 44, 46, 41, 42, 43, 47, -126, 22, -39,
-	-175, -125, 142, -124, 22, -122, 57, -51, -46, -177,
-	53, 11, 51, 53, -94, 154, -95, -99, 218, 220,
-	79, -121, -116, 57, 28, 29, 54, 53, -150, -133,
-	-137, -134, -139, -138, -140, -135, -136, 177, 245, 174,
-	178, 175, 105, 179, 181, 182, 183, 184, 185, 186,
-	187, 188, 189, 190, 29, 136, 170, 171, 172, 173,
-	191, 192, 193, 194, 195, 196, 197, 198, 157, 158,
-	159, 160, 161, 162, 163, 165, 166, 167, 168, 169,
-	-116, -128, 120, -171, 51, 55, 71, 55, -51, -51,
-	222, -128, 121, -51, 23, 50, -51, 55, 55, -123,
-	-122, -114, -128, -128, -128, -128, -128, -128, -128, -128,
-	-128, -128, -107, 206, 213, -51, 9, 89, 53, 18,
-	108, 53, -85, 24, 25, -86, -176, -30, -62, -116,
-	58, 61, -29, 41, -51, -37, -37, -67, 66, 71,
-	67, 68, -118, 96, -123, -117, -114, -61, -68, -71,
-	-74, 62, 89, 87, 88, 73, -61, -61, -61, -61,
-	-61, -61, -61, -61, -61, -61, -61, -61, -61, -61,
-	-61, -129, 55, 57, 55, -60, -60, -116, -35, 21,
-	-34, -36, -176, 53, -176, -2, -34, -34, -37, -37,
-	-75, -116, -122, -75, -34, -28, -76, -77, 75, -75,
-	-176, -34, -35, -34, -34, -90, 142, -51, -93, -97,
-	-75, -40, -41, -41, -40, -41, 40, 40, 40, 45,
-	40, 45, 40, -48, -122, -176, -54, 48, 122, 49,
-	-175, -124, -90, 51, -39, -51, -98, -95, 53, 219,
-	221, 222, 50, -37, -143, 104, -156, -157, -158, -117,
-	57, 58, -149, -151, -159, 124, 127, 131, -152, 119,
-	132, 66, 71, 28, 50, 200, 124, 132, 131, 64,
-	-144, 203, -141, 52, -141, -141, 176, -141, -141, -141,
-	-142, 178, -142, -142, -142, 52, 52, -141, -141, -141,
-	-146, 52, -146, -146, -147, 52, -147, 50, 51, -51,
-	-169, 247, -170, 55, -128, 23, -128, -111, 116, 113,
-	114, -166, 112, 200, 178, 64, 28, 15, 237, 142,
-	250, 55, 143, -51, -51, -51, -128, -106, 11, 89,
-	36, -37, -37, -123, -84, -87, -101, 19, 11, 32,
-	32, -34, 66, 67, 68, 108, -175, -68, -61, -61,
-	-61, -33, 137, 70, -176, -176, -34, 53, -37, -176,
-	-176, -176, 53, 51, 22, 53, 11, 108, 53, 11,
-	-176, -34, -79, -77, 77, -37, -176, -176, -176, -176,
-	-176, -59, 29, 32, -2, -175, -175, -55, 53, 12,
-	79, -44, -43, 50, 51, -45, 50, -43, 40, 40,
-	119, 119, 119, -91, -116, -55, -39, -55, -99, -100,
-	223, 220, 226, 55, 53, -158, 79, 52, 132, -152,
-	-152, 55, 55, 66, 57, 58, 59, 66, 227, 65,
-	9, 132, 132, 57, -145, 204, 58, -142, -142, -141,
-	-142, -143, 29, -143, -143, -143, -148, 57, -148, 58,
-	58, -51, -116, -128, -168, -167, -117, -127, -120, -179,
-	148, 125, 129, 128, 55, 124, 127, 142, -172, 148,
-	125, 126, 129, 128, 55, 119, 132, 124, 127, 142,
-	131, -112, -113, 121, 22, 119, 132, 142, 116, -128,
-	-108, 87, 12, -122, -122, 37, 108, -51, -38, 11,
-	96, -117, -35, -33, 70, -61, -61, -176, -36, -132,
-	105, 174, 136, 172, 168, 189, 180, 202, 170, 203,
-	-129, -132, -61, -61, -117, -61, -61, 244, -82, 78,
-	-37, 76, -92, 50, -93, -70, -72, -71, -175, -2,
-	-88, -116, -91, -82, -97, -37, -37, -37, 52, -37,
-	-175, -175, -175, -176, 53, -82, -55, 220, 224, 225,
-	-157, -158, -161, -160, -116, 55, 55, 227, 54, -143,
-	-143, -142, -143, 55, 105, 54, 53, 54, 53, 54,
-	53, 52, 51, 50, 53, 79, -178, 119, 132, -127,
-	-116, -127, -116, -51, -127, -116, 126, 57, -37, -55,
-	-39, -176, -61, -176, -141, -141, -141, -147, -141, 162,
-	-141, 162, -176, -176, -176, 53, 19, -176, 53, 19,
-	-175, -32, 242, -37, 27, -92, 53, -176, -176, -176,
-	53, 108, -176, -86, -89, -116, -89, -89, -89, -125,
-	-116, -86, 54, 53, -141, -143, -142, 57, -142, 58,
-	58, -89, -116, -51, -167, -158, -116, 52, 26, -116,
-	-80, 13, -142, 55, -61, -61, -61, -61, -61, -176,
-	57, 132, -72, 32, -2, -175, -116, -116, 53, 54,
-	-176, -176, -176, -54, -163, -162, 51, 130, 64, -160,
-	-143, -143, 54, 54, 54, 52, 52, 52, -89, -175,
-	124, -81, 14, 16, -176, -176, -176, -176, -31, 89,
-	247, 9, -70, -2, 108, -116, -162, 55, -153, 79,
-	57, -89, -89, -89, 54, -164, -165, 142, 132, -37,
-	-69, -176, 245, 47, 248, -93, -176, -116, 58, 54,
-	54, 54, -171, -176, 53, -116, 52, 37, 246, 249,
-	-169, -165, 32, -89, 37, 144, 54, 247, 145, 248,
-	-175, 249, -61, 141, -176, -176,
-}
-var yyDef = [...]int{
-
-	0, -2, 2, -2, 5, 6, 7, 8, 9, 10,
-	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 523, 0, 292, 292, 292, 292, 292, 292, 0,
-	69, 576, 0, 0, 0, 0, -2, 282, 283, 0,
-	285, 286, 796, 796, 796, 796, 796, 0, 33, 34,
-	794, 1, 3, 531, 0, 0, 296, 299, 294, 0,
-	576, 0, 0, 0, 60, 0, 0, 0, 0, 0,
-	574, 574, 574, 70, 0, 0, 577, 0, 572, 0,
-	572, 572, 572, 0, 241, 363, 597, 598, 694, 695,
-	696, 697, 698, 699, 700, 701, 702, 703, 704, 705,
-	706, 707, 708, 709, 710, 711, 712, 713, 714, 715,
-	716, 717, 718, 719, 720, 721, 722, 723, 724, 725,
-	726, 727, 728, 729, 730, 731, 732, 733, 734, 735,
-	736, 737, 738, 739, 740, 741, 742, 743, 744, 745,
-	746, 747, 748, 749, 750, 751, 752, 753, 754, 755,
-	756, 757, 758, 759, 760, 761, 762, 763, 764, 765,
-	766, 767, 768, 769, 770, 771, 772, 773, 774, 775,
-	776, 777, 778, 779, 780, 781, 782, 783, 784, 785,
-	786, 787, 788, 789, 790, 791, 792, 793, 0, 0,
-	0, 0, 797, 797, 797, 797, 0, 797, 270, 259,
-	261, 262, 263, 264, 797, 279, 280, 269, 281, 284,
-	287, 288, 289, 290, 291, 27, 535, 0, 0, 523,
-	29, 0, 292, 297, 298, 302, 300, 301, 293, 0,
-	310, 314, 0, 371, 0, 376, 378, -2, -2, 0,
-	413, 414, 415, 416, 417, 0, 0, 0, 0, 0,
-	0, 0, 440, 441, 442, 443, 508, 509, 510, 511,
-	512, 513, 514, 515, 380, 381, 505, 555, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 496, 0, 470,
-	470, 470, 470, 470, 470, 470, 470, 0, 0, 0,
-	0, 0, 0, 321, 323, 324, 325, 344, 0, 346,
-	0, 0, 41, 45, 0, 773, 559, -2, -2, 0,
-	0, 595, 596, -2, 702, -2, 593, 594, 601, 602,
-	603, 604, 605, 606, 607, 608, 609, 610, 611, 612,
-	613, 614, 615, 616, 617, 618, 619, 620, 621, 622,
-	623, 624, 625, 626, 627, 628, 629, 630, 631, 632,
-	633, 634, 635, 636, 637, 638, 639, 640, 641, 642,
-	643, 644, 645, 646, 647, 648, 649, 650, 651, 652,
-	653, 654, 655, 656, 657, 658, 659, 660, 661, 662,
-	663, 664, 665, 666, 667, 668, 669, 670, 671, 672,
-	673, 674, 675, 676, 677, 678, 679, 680, 681, 682,
-	683, 684, 685, 686, 687, 688, 689, 690, 691, 692,
-	693, 0, 81, 0, 0, 797, 0, 71, 0, 0,
-	0, 0, 0, 797, 0, 0, 0, 0, 0, 0,
-	0, 240, 0, 242, 797, 797, 797, 797, 797, 797,
-	797, 797, 251, 798, 799, 252, 253, 254, 797, 797,
-	256, 0, 271, 0, 265, 28, 795, 22, 0, 0,
-	532, 0, 524, 525, 528, 531, 27, 299, 0, 304,
-	303, 295, 0, 311, 0, 0, 0, 315, 0, 317,
-	318, 0, 374, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 398, 399, 400, 401, 402, 403, 404, 377,
-	0, 391, 0, 0, 0, 433, 434, 435, 436, 437,
-	438, 0, 306, 27, 0, 411, 0, 0, 0, 0,
-	0, 0, 0, 0, 302, 0, 497, 0, 462, 0,
-	463, 464, 465, 466, 467, 468, 469, 0, 306, 0,
-	0, 43, 0, 362, 0, 0, 0, 0, 0, 0,
-	351, 0, 0, 354, 0, 0, 0, 0, 345, 0,
-	0, 365, 746, 347, 0, 349, 350, -2, 0, 0,
-	0, 39, 40, 0, 46, 773, 48, 49, 0, 0,
-	0, 168, 567, 568, 569, 565, 189, 0, 84, 90,
-	161, 86, 87, 88, 89, 154, 107, 125, 126, 154,
-	154, 154, 154, 154, 165, 165, 165, 165, 137, 138,
-	139, 140, 141, 0, 0, 120, 154, 154, 154, 124,
-	144, 145, 146, 147, 148, 149, 150, 151, 108, 109,
-	110, 111, 112, 113, 114, 156, 156, 156, 158, 158,
-	0, 64, 0, 74, 0, 797, 0, 797, 79, 0,
-	0, 207, 0, 235, 573, 0, 797, 238, 239, 364,
-	599, 600, 243, 244, 245, 246, 247, 248, 249, 250,
-	255, 258, 272, 266, 267, 260, 536, 0, 0, 0,
-	0, 0, 527, 529, 530, 535, 30, 302, 0, 516,
-	0, 0, 0, 305, 25, 372, 373, 375, 392, 0,
-	394, 396, 316, 312, 0, 506, -2, 382, 383, 407,
-	408, 409, 0, 0, 0, 0, 405, 387, 0, 418,
-	419, 420, 421, 422, 423, 424, 425, 426, 427, 428,
-	429, 432, 481, 482, 0, 430, 431, 439, 0, 0,
-	307, 308, 410, 0, 554, 27, 0, 0, 0, 0,
-	0, 505, 0, 0, 0, 0, 503, 500, 0, 0,
-	471, 0, 0, 0, 0, 0, 0, 361, 369, 556,
-	0, 322, 340, 342, 0, 337, 352, 353, 355, 0,
-	357, 0, 359, 360, 326, 327, 328, 0, 0, 0,
-	0, 348, 369, 0, 369, 42, 560, 47, 0, 0,
-	52, 53, 561, 562, 563, 0, 80, 190, 192, 195,
-	196, 197, 82, 83, 0, 0, 0, 0, 0, 184,
-	185, 91, 0, 0, 0, 100, 0, 102, 104, 0,
-	163, 162, 106, 0, 165, 165, 154, 165, 131, 132,
-	168, 0, 168, 168, 168, 0, 0, 121, 122, 123,
-	115, 0, 116, 117, 118, 0, 119, 0, 0, 797,
-	66, 0, 72, 73, 67, 575, 68, 796, 69, 0,
-	588, 208, 578, 579, 580, 581, 582, 583, 584, 585,
-	586, 587, 0, 0, 234, 797, 237, 275, 0, 0,
-	0, 533, 534, 0, 526, 23, 0, 570, 571, 517,
-	518, 319, 393, 395, 397, 0, 306, 384, 405, 388,
-	0, 385, 0, 0, 379, 444, 0, 0, 412, -2,
-	447, 448, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 523, 0, 501, 0, 0, 461, 472, 473, 474,
-	475, 548, 0, 0, -2, 0, 0, 523, 0, 0,
-	0, 334, 341, 0, 0, 335, 0, 336, 356, 358,
-	0, 0, 0, 0, 332, 523, 369, 38, 50, 51,
-	0, 0, 57, 169, 0, 193, 0, 0, 179, 0,
-	0, 182, 183, 92, 93, 94, 95, 96, 97, 98,
-	0, 101, 103, 105, 85, 164, 0, 168, 168, 165,
-	168, 133, 0, 134, 135, 136, 0, 152, 0, 0,
-	0, 0, 0, 65, 75, 76, 0, 198, 0, 796,
-	0, 222, 223, 224, 225, 226, 227, 228, 796, 0,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 0, 796, 589, 590, 591, 592, 0, 0, 236,
-	257, 0, 0, 273, 274, 537, 0, 24, 369, 0,
-	313, 507, 0, 386, 0, 406, 389, 445, 309, 0,
-	154, 154, 486, 154, 158, 489, 154, 491, 154, 494,
-	0, 0, 0, 0, 506, 0, 0, 0, 498, 460,
-	504, 0, 31, 0, 548, 538, 550, 552, 0, 27,
-	0, 544, 0, 531, 557, 370, 558, 338, 0, 343,
-	0, 0, 0, 346, 0, 531, 37, 54, 55, 56,
-	191, 194, 0, 186, 154, 180, 181, 99, 155, 127,
-	128, 168, 129, 166, 167, 165, 0, 165, 0, 159,
-	0, 0, 0, 0, 0, 0, 0, 220, 221, 201,
-	0, 202, 204, 205, 206, 0, 0, 276, 277, 519,
-	320, 446, 390, 449, 483, 165, 487, 488, 490, 492,
-	493, 495, 451, 450, 452, 0, 0, 455, 0, 0,
-	0, 0, 0, 502, 0, 32, 0, 553, -2, 0,
-	0, 0, 44, 35, 0, 330, 0, 0, 0, 365,
-	333, 36, 171, 0, 188, 130, 168, 153, 168, 0,
-	0, 0, 0, 0, 77, 78, 0, 0, 0, 0,
-	521, 0, 484, 485, 0, 0, 0, 0, 476, 459,
-	499, 0, 551, 0, -2, 0, 546, 545, 0, 339,
-	366, 367, 368, 329, 170, 172, 0, 177, 0, 187,
-	142, 143, 157, 160, 61, 0, 0, 0, 0, 0,
-	0, 26, 0, 0, 453, 454, 456, 457, 0, 0,
-	0, 0, 541, 27, 0, 331, 173, 174, 0, 178,
-	176, 0, 0, 0, 71, 0, 230, 0, 0, 522,
-	520, 458, 0, 0, 0, 549, -2, 547, 175, 63,
-	62, 199, 74, 229, 0, 0, 0, 477, 0, 480,
-	203, 231, 0, 0, 478, 0, 200, 0, 0, 0,
-	0, 479, 0, 0, 232, 233,
-}
-var yyTok1 = [...]int{
-
-	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 72, 3, 3, 3, 99, 91, 3,
-	52, 54, 96, 94, 53, 95, 108, 97, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 251,
-	80, 79, 81, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 101, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 90, 3, 102,
-}
-var yyTok2 = [...]int{
-
-	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
-	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
-	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
-	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
-	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	55, 56, 57, 58, 59, 60, 61, 62, 63, 64,
-	65, 66, 67, 68, 69, 70, 71, 73, 74, 75,
-	76, 77, 78, 82, 83, 84, 85, 86, 87, 88,
-	89, 92, 93, 98, 100, 103, 104, 105, 106, 107,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
-	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
-	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
-	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
-	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
-	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
-	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
-	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
-	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
-	249, 250,
-}
-var yyTok3 = [...]int{
-	0,
-}
-
-var yyErrorMessages = [...]struct {
-	state int
-	token int
-	msg   string
-}{}
-
-//line yaccpar:1
-
-/*	parser for yacc output	*/
-
-var (
-	yyDebug        = 0
-	yyErrorVerbose = false
-)
-
-type yyLexer interface {
-	Lex(lval *yySymType) int
-	Error(s string)
-}
-
-type yyParser interface {
-	Parse(yyLexer) int
-	Lookahead() int
-}
-
-type yyParserImpl struct {
-	lval  yySymType
-	stack [yyInitialStackSize]yySymType
-	char  int
-}
-
-func (p *yyParserImpl) Lookahead() int {
-	return p.char
-}
-
-func yyNewParser() yyParser {
-	return &yyParserImpl{}
-}
-
-const yyFlag = -1000
-
-func yyTokname(c int) string {
-	if c >= 1 && c-1 < len(yyToknames) {
-		if yyToknames[c-1] != "" {
-			return yyToknames[c-1]
-		}
-	}
-	return __yyfmt__.Sprintf("tok-%v", c)
-}
-
-func yyStatname(s int) string {
-	if s >= 0 && s < len(yyStatenames) {
-		if yyStatenames[s] != "" {
-			return yyStatenames[s]
-		}
-	}
-	return __yyfmt__.Sprintf("state-%v", s)
-}
-
-func yyErrorMessage(state, lookAhead int) string {
-	const TOKSTART = 4
-
-	if !yyErrorVerbose {
-		return "syntax error"
-	}
-
-	for _, e := range yyErrorMessages {
-		if e.state == state && e.token == lookAhead {
-			return "syntax error: " + e.msg
-		}
-	}
-
-	res := "syntax error: unexpected " + yyTokname(lookAhead)
-
-	// To match Bison, suggest at most four expected tokens.
-	expected := make([]int, 0, 4)
-
-	// Look for shiftable tokens.
-	base := yyPact[state]
-	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
-			if len(expected) == cap(expected) {
-				return res
-			}
-			expected = append(expected, tok)
-		}
-	}
-
-	if yyDef[state] == -2 {
-		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
-			i += 2
-		}
-
-		// Look for tokens that we accept or reduce.
-		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
-			if tok < TOKSTART || yyExca[i+1] == 0 {
-				continue
-			}
-			if len(expected) == cap(expected) {
-				return res
-			}
-			expected = append(expected, tok)
-		}
-
-		// If the default action is to accept or reduce, give up.
-		if yyExca[i+1] != 0 {
-			return res
-		}
-	}
-
-	for i, tok := range expected {
-		if i == 0 {
-			res += ", expecting "
-		} else {
-			res += " or "
-		}
-		res += yyTokname(tok)
-	}
-	return res
-}
-
-func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
-	token = 0
-	char = lex.Lex(lval)
-	if char <= 0 {
-		token = yyTok1[0]
-		goto out
-	}
-	if char < len(yyTok1) {
-		token = yyTok1[char]
-		goto out
-	}
-	if char >= yyPrivate {
-		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
-			goto out
-		}
+	0, 0, 0, 0, 0, 0, 0, 0, 1475, 1475,
+	1475, 1475, 0, 0, 0, 0, 0, 0, 0, 574,
+	576, 573, 584, 585, 577, 578, 579, 580, 581, 582,
+	583, 575, 0, 788, 586, 0, 1963, 0, 587, 0,
+	0, 0, 1568, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1568, 0, 1803, 1981, 0, 1803, 0, 0,
+	0, 0, 0, 1475, 0, 1475, 0, 0, 0, 0,
+	0, 0, 0, 0, 1411, 0, 0, 0, 0, 0,
+	0, 1257, 1257, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2018, 0, 1475, 0, 0, 527,
+	527, 527, 527, 0, 527, 0, 0, 0, 0, 0,
+	0, 527, 0, 540, 0, 0, 0, 2031, 0, 0,
+	1456, 0, 0, 0, 0, 0, 0, 0, 52, 0,
+	0, 0, 0, 0, 0, 0, 0, 1475, 0, 0,
+	2044, 1568, 0, 596, 0, 0, 598, 364, 0, 574,
+	576, 573, 584, 585, 577, 578, 579, 580, 581, 582,
+	583, 575, 0, 1475, 586, 0, 0, 0, 587, 0,
+	0, 0, 0, 1568, 0, 610, 1568, 614, 615, 616,
+	617, 618, 619, 620, 621, 622, 0, 625, 627, 627,
+	627, 627, 627, 627, 627, 627, 627, 636, 637, 638,
+	639, 0, 0, 0, 0, 1568, 0, 0, 659, 0,
+	1568, 0, 0, 843, 0, 0, 854, 855, 856, 857,
+	858, 859, 860, 861, 862, 863, 864, 865, 866, 867,
+	868, 869, 0, 0, 0, 0, 1568, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1568, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2125, 0, 0, 0, 0, 0, 0,
+	2125, 2125, 0, 2125, 364, 0, 569, 2125, 572, 0,
+	0, 0, 0, 0, 588, 589, 590, 591, 592, 593,
+	594, 0, 570, 571, 568, 574, 576, 573, 584, 585,
+	577, 578, 579, 580, 581, 582, 583, 575, 0, 1620,
+	586, 0, 0, 0, 587, 0, 1600, 1601, 1602, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1604,
+	1617, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1613, 1614, 1615, 0, 1618, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 527, 1628, 1629, 1630,
+	0, 1633, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 527, 527, 527, 527, 527,
+	527, 527, 527, 0, 0, 0, 0, 0, 0, 527,
+	527, 0, 0, 0, 574, 576, 573, 584, 585, 577,
+	578, 579, 580, 581, 582, 583, 575, 0, 0, 586,
+	0, 1669, 1670, 587, 0, 574, 576, 573, 584, 585,
+	577, 578, 579, 580, 581, 582, 583, 575, 0, 0,
+	586, 0, 0, 0, 587, 0, 0, 1404, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 52, 0, 574, 576, 573,
+	584, 585, 577, 578, 579, 580, 581, 582, 583, 575,
+	0, 614, 586, 0, 0, 0, 587, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1143, 1144, 1145,
+	0, 351, 351, 351, 351, 351, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 659, 0, 949, 0,
+	0, 0, 0, 0, 0, 351, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1148, 0, 0, 1752, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1762, 1763, 1764, 574, 576, 573, 584, 585,
+	577, 578, 579, 580, 581, 582, 583, 575, 0, 0,
+	586, 1793, 0, 0, 587, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1804, 1805, 1806,
+	0, 1807, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 52, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 527, 0, 527, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 527, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1872, 1873, 1874, 1875, 0, 0, 0,
+	0, 0, 352, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1893,
+	0, 0, 0, 1895, 0, 0, 0, 0, 1139, 0,
+	0, 1140, 1141, 0, 0, 0, 0, 0, 1904, 100,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1915, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	355, 0, 0, 473, 0, 0, 0, 0, 0, 482,
+	0, 485, 488, 489, 490, 0, 0, 0, 0, 0,
+	0, 0, 0, 499, 500, 0, 501, 0, 0, 0,
+	0, 0, 508, 0, 0, 0, 0, 0, 0, 0,
+	0, 1186, 1187, 0, 0, 0, 1401, 1402, 0, 0,
+	0, 0, 1957, 1405, 1406, 0, 0, 1962, 0, 0,
+	0, 0, 1965, 0, 0, 0, 1969, 0, 0, 351,
+	0, 0, 0, 1426, 1427, 1428, 1429, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1249, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2016, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2025, 0, 2026, 2027, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1495, 0, 0, 0, 0,
+	0, 0, 0, 0, 545, 0, 0, 0, 0, 0,
+	0, 517, 0, 0, 0, 0, 0, 0, 52, 0,
+	0, 0, 2049, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 101, 0, 0, 0, 0, 0, 258, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	0, 101, 101, 0, 0, 101, 0, 0, 2087, 2088,
+	2089, 101, 0, 101, 101, 101, 101, 0, 0, 0,
+	0, 0, 0, 0, 0, 101, 101, 0, 101, 0,
+	0, 0, 2103, 0, 101, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 642, 0, 0,
+	0, 0, 0, 0, 0, 0, 666, 0, 2120, 0,
+	0, 0, 0, 2122, 2124, 0, 0, 0, 0, 0,
+	0, 1441, 0, 52, 2131, 0, 0, 0, 0, 0,
+	1603, 0, 0, 0, 0, 0, 0, 0, 1453, 1454,
+	1455, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1476, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1496,
+	0, 0, 1497, 1498, 610, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 101, 0, 0, 0, 685, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 752, 0, 0,
+	0, 0, 0, 0, 0, 52, 0, 0, 0, 0,
+	771, 772, 0, 0, 0, 777, 0, 0, 780, 0,
+	0, 0, 0, 786, 0, 0, 792, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1720, 0, 0, 0, 0,
+	811, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 830,
+	0, 0, 0, 0, 0, 0, 0, 527, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	0, 0, 0, 0, 351, 0, 101, 664, 101, 0,
+	0, 0, 1747, 1748, 0, 1749, 1750, 1751, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1621, 0, 0,
+	0, 1775, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 926, 0, 0, 0, 0, 0,
+	0, 1645, 1646, 1647, 0, 0, 0, 0, 0, 0,
+	0, 0, 1654, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 954, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1675, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	0, 0, 0, 0, 0, 0, 1476, 0, 1476, 1476,
+	0, 0, 101, 101, 0, 0, 0, 101, 0, 0,
+	101, 0, 0, 0, 784, 101, 789, 0, 101, 0,
+	0, 0, 0, 1059, 1060, 0, 0, 0, 1068, 0,
+	0, 0, 0, 0, 0, 1074, 0, 0, 0, 0,
+	0, 0, 101, 0, 0, 0, 1108, 0, 0, 1110,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 101, 0, 0, 0, 0, 1119, 0, 0, 0,
+	784, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1441, 0, 0, 1758, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1768, 1771, 0, 0, 0, 0, 0, 0, 0, 1476,
+	0, 0, 0, 0, 0, 282, 0, 0, 0, 0,
+	282, 282, 0, 0, 789, 789, 282, 0, 0, 0,
+	789, 1139, 0, 0, 0, 0, 0, 0, 0, 0,
+	1775, 282, 282, 282, 282, 0, 101, 0, 789, 101,
+	101, 101, 101, 101, 1476, 0, 0, 0, 0, 0,
+	0, 943, 0, 0, 101, 0, 0, 0, 664, 0,
+	0, 0, 0, 101, 101, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1862, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1441, 0, 52, 0, 0, 0, 0,
+	0, 0, 0, 1884, 0, 0, 1887, 1888, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 101, 101, 0, 0, 0,
+	101, 0, 0, 0, 0, 0, 355, 101, 0, 0,
+	0, 0, 0, 0, 0, 0, 1775, 0, 101, 1265,
+	0, 101, 1476, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1936, 0, 0, 101, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1476, 1476, 1476, 1476, 0, 0, 0, 0, 0,
+	0, 784, 0, 0, 0, 0, 0, 0, 1305, 1306,
+	1307, 0, 0, 282, 0, 0, 0, 0, 0, 0,
+	0, 0, 1326, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2117, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1476, 0, 1476, 0,
+	0, 0, 0, 0, 1380, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1395, 0, 0, 282, 0, 0, 0, 1476,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	0, 0, 0, 0, 0, 0, 0, 2029, 2030, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1476, 0, 0, 0, 0, 0, 0, 101, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1476, 0, 0, 0,
+	0, 0, 0, 0, 0, 2061, 0, 0, 0, 0,
+	0, 0, 0, 355, 0, 0, 0, 0, 101, 0,
+	0, 1258, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 101, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2101,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2110, 0, 0,
+	101, 101, 101, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 101, 0, 1536, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1565, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 101, 0, 0, 0,
+	784, 0, 0, 0, 0, 0, 1391, 1392, 0, 0,
+	0, 0, 0, 0, 101, 0, 0, 0, 1581, 0,
+	0, 0, 0, 0, 282, 0, 1585, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 789, 0,
+	0, 0, 0, 0, 789, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 101, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 101, 0,
+	1699, 0, 0, 0, 0, 0, 0, 1546, 0, 0,
+	0, 0, 789, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 101, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1742, 0, 0, 0,
+	101, 0, 0, 0, 0, 0, 0, 0, 101, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 101, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 664, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1823, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 101, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 101, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	164, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	127, 0, 0, 0, 144, 0, 147, 0, 0, 182,
+	156, 0, 0, 166, 0, 0, 218, 219, 0, 0,
+	0, 0, 121, 369, 162, 188, 0, 0, 0, 282,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1976, 0,
+	118, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 101, 574, 576, 573, 584,
+	585, 577, 578, 579, 580, 581, 582, 583, 575, 0,
+	0, 586, 0, 0, 0, 587, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 209, 125,
+	0, 0, 0, 169, 0, 0, 186, 133, 132, 145,
+	2033, 0, 0, 104, 0, 0, 0, 134, 106, 212,
+	190, 213, 141, 107, 0, 0, 0, 0, 0, 122,
+	0, 175, 165, 201, 0, 174, 148, 193, 170, 200,
+	129, 0, 0, 138, 181, 191, 210, 211, 189, 208,
+	108, 199, 119, 177, 111, 197, 184, 154, 139, 140,
+	109, 0, 185, 178, 110, 173, 126, 2067, 131, 124,
+	163, 194, 195, 123, 221, 115, 206, 207, 113, 116,
+	205, 161, 192, 198, 155, 152, 112, 196, 153, 151,
+	143, 128, 135, 167, 150, 168, 136, 158, 157, 159,
+	0, 2093, 0, 183, 203, 222, 187, 0, 0, 214,
+	215, 216, 217, 0, 0, 0, 160, 117, 137, 179,
+	142, 149, 172, 220, 0, 176, 120, 202, 180, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 789, 0, 0, 105, 114, 146,
+	171, 130, 204, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	101, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1258, 1258, 0, 0, 0, 462, 452, 0,
+	421, 464, 398, 413, 472, 414, 415, 443, 380, 429,
+	164, 411, 0, 373, 401, 374, 408, 375, 399, 423,
+	127, 397, 454, 432, 144, 470, 147, 437, 0, 182,
+	156, 0, 101, 166, 0, 0, 218, 219, 0, 0,
+	0, 0, 121, 369, 162, 188, 425, 456, 427, 450,
+	420, 444, 388, 436, 465, 412, 440, 466, 0, 0,
+	0, 0, 973, 0, 974, 0, 0, 0, 0, 0,
+	118, 0, 439, 461, 410, 442, 372, 438, 0, 378,
+	382, 471, 459, 405, 406, 0, 0, 0, 0, 101,
+	0, 0, 424, 428, 446, 418, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 402, 0, 435, 0, 0,
+	0, 384, 379, 0, 422, 0, 0, 0, 0, 387,
+	0, 403, 447, 101, 371, 451, 457, 419, 209, 125,
+	460, 417, 416, 169, 0, 385, 186, 133, 132, 145,
+	445, 381, 449, 104, 383, 0, 0, 134, 106, 212,
+	190, 213, 141, 107, 463, 426, 455, 400, 409, 122,
+	407, 175, 165, 201, 434, 174, 148, 193, 170, 200,
+	129, 377, 404, 138, 181, 191, 210, 211, 189, 208,
+	108, 199, 119, 177, 111, 197, 184, 154, 139, 140,
+	109, 0, 185, 178, 110, 173, 126, 0, 131, 124,
+	163, 194, 195, 123, 221, 115, 206, 207, 113, 116,
+	205, 161, 192, 198, 155, 152, 112, 196, 153, 151,
+	143, 128, 135, 167, 150, 168, 136, 158, 157, 159,
+	0, 376, 0, 183, 203, 222, 187, 396, 458, 214,
+	215, 216, 217, 0, 0, 0, 160, 117, 137, 179,
+	142, 149, 172, 220, 441, 176, 120, 202, 180, 391,
+	395, 389, 392, 390, 430, 431, 467, 468, 469, 448,
+	386, 0, 393, 394, 0, 453, 433, 105, 114, 146,
+	171, 130, 204, 462, 452, 0, 421, 464, 398, 413,
+	472, 414, 415, 443, 380, 429, 164, 411, 0, 373,
+	401, 374, 408, 375, 399, 423, 127, 397, 454, 432,
+	144, 470, 147, 437, 0, 182, 156, 0, 0, 0,
+	0, 0, 218, 219, 0, 0, 0, 0, 121, 369,
+	162, 188, 425, 456, 427, 450, 420, 444, 388, 436,
+	465, 412, 440, 466, 0, 0, 0, 0, 973, 0,
+	974, 0, 0, 0, 0, 0, 118, 0, 439, 461,
+	410, 442, 372, 438, 0, 378, 382, 471, 459, 405,
+	406, 1222, 0, 0, 0, 0, 0, 0, 424, 428,
+	446, 418, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 402, 0, 435, 0, 0, 0, 384, 379, 0,
+	422, 0, 0, 0, 0, 387, 0, 403, 447, 0,
+	371, 451, 457, 419, 209, 125, 460, 417, 416, 169,
+	0, 385, 186, 133, 132, 145, 445, 381, 449, 104,
+	383, 0, 0, 134, 106, 212, 190, 213, 141, 107,
+	463, 426, 455, 400, 409, 122, 407, 175, 165, 201,
+	434, 174, 148, 193, 170, 200, 129, 377, 404, 138,
+	181, 191, 210, 211, 189, 208, 108, 199, 119, 177,
+	111, 197, 184, 154, 139, 140, 109, 0, 185, 178,
+	110, 173, 126, 0, 131, 124, 163, 194, 195, 123,
+	221, 115, 206, 207, 113, 116, 205, 161, 192, 198,
+	155, 152, 112, 196, 153, 151, 143, 128, 135, 167,
+	150, 168, 136, 158, 157, 159, 0, 376, 0, 183,
+	203, 222, 187, 396, 458, 214, 215, 216, 217, 0,
+	0, 0, 160, 117, 137, 179, 142, 149, 172, 220,
+	441, 176, 120, 202, 180, 391, 395, 389, 392, 390,
+	430, 431, 467, 468, 469, 448, 386, 0, 393, 394,
+	0, 453, 433, 105, 114, 146, 171, 130, 204, 462,
+	452, 0, 421, 464, 398, 413, 472, 414, 415, 443,
+	380, 429, 164, 411, 0, 373, 401, 374, 408, 375,
+	399, 423, 127, 397, 454, 432, 144, 470, 147, 437,
+	0, 182, 156, 0, 0, 166, 0, 0, 218, 219,
+	0, 0, 0, 0, 121, 369, 162, 188, 425, 456,
+	427, 450, 420, 444, 388, 436, 465, 412, 440, 466,
+	55, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 118, 0, 439, 461, 410, 442, 372, 438,
+	0, 378, 382, 471, 459, 405, 406, 0, 0, 0,
+	0, 0, 0, 0, 424, 428, 446, 418, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 402, 0, 435,
+	0, 0, 0, 384, 379, 0, 422, 0, 0, 0,
+	0, 387, 0, 403, 447, 0, 371, 451, 457, 419,
+	209, 125, 460, 417, 416, 169, 0, 385, 186, 133,
+	132, 145, 445, 381, 449, 104, 383, 0, 0, 134,
+	106, 212, 190, 213, 141, 107, 463, 426, 455, 400,
+	409, 122, 407, 175, 165, 201, 434, 174, 148, 193,
+	170, 200, 129, 377, 404, 138, 181, 191, 210, 211,
+	189, 208, 108, 199, 119, 177, 111, 197, 184, 154,
+	139, 140, 109, 0, 185, 178, 110, 173, 126, 0,
+	131, 124, 163, 194, 195, 123, 221, 115, 206, 207,
+	113, 116, 205, 161, 192, 198, 155, 152, 112, 196,
+	153, 151, 143, 128, 135, 167, 150, 168, 136, 158,
+	157, 159, 0, 376, 0, 183, 203, 222, 187, 396,
+	458, 214, 215, 216, 217, 0, 0, 0, 160, 117,
+	137, 179, 142, 149, 172, 220, 441, 176, 120, 202,
+	180, 391, 395, 389, 392, 390, 430, 431, 467, 468,
+	469, 448, 386, 0, 393, 394, 0, 453, 433, 105,
+	114, 146, 171, 130, 204, 462, 452, 0, 421, 464,
+	398, 413, 472, 414, 415, 443, 380, 429, 164, 411,
+	0, 373, 401, 374, 408, 375, 399, 423, 127, 397,
+	454, 432, 144, 470, 147, 437, 0, 182, 156, 0,
+	0, 166, 0, 0, 218, 219, 0, 0, 0, 0,
+	121, 369, 162, 188, 425, 456, 427, 450, 420, 444,
+	388, 436, 465, 412, 440, 466, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 118, 0,
+	439, 461, 410, 442, 372, 438, 0, 378, 382, 471,
+	459, 405, 406, 0, 0, 0, 0, 0, 0, 0,
+	424, 428, 446, 418, 0, 0, 0, 0, 0, 0,
+	0, 1398, 0, 402, 0, 435, 0, 0, 0, 384,
+	379, 0, 422, 0, 0, 0, 0, 387, 0, 403,
+	447, 0, 371, 451, 457, 419, 209, 125, 460, 417,
+	416, 169, 0, 385, 186, 133, 132, 145, 445, 381,
+	449, 104, 383, 0, 0, 134, 106, 212, 190, 213,
+	141, 107, 463, 426, 455, 400, 409, 122, 407, 175,
+	165, 201, 434, 174, 148, 193, 170, 200, 129, 377,
+	404, 138, 181, 191, 210, 211, 189, 208, 108, 199,
+	119, 177, 111, 197, 184, 154, 139, 140, 109, 0,
+	185, 178, 110, 173, 126, 0, 131, 124, 163, 194,
+	195, 123, 221, 115, 206, 207, 113, 116, 205, 161,
+	192, 198, 155, 152, 112, 196, 153, 151, 143, 128,
+	135, 167, 150, 168, 136, 158, 157, 159, 0, 376,
+	0, 183, 203, 222, 187, 396, 458, 214, 215, 216,
+	217, 0, 0, 0, 160, 117, 137, 179, 142, 149,
+	172, 220, 441, 176, 120, 202, 180, 391, 395, 389,
+	392, 390, 430, 431, 467, 468, 469, 448, 386, 0,
+	393, 394, 0, 453, 433, 105, 114, 146, 171, 130,
+	204, 462, 452, 0, 421, 464, 398, 413, 472, 414,
+	415, 443, 380, 429, 164, 411, 0, 373, 401, 374,
+	408, 375, 399, 423, 127, 397, 454, 432, 144, 470,
+	147, 437, 0, 182, 156, 0, 0, 0, 0, 0,
+	218, 219, 0, 0, 0, 0, 121, 369, 162, 188,
+	425, 456, 427, 450, 420, 444, 388, 436, 465, 412,
+	440, 466, 0, 0, 0, 0, 973, 0, 974, 0,
+	0, 0, 0, 0, 118, 0, 439, 461, 410, 442,
+	372, 438, 0, 378, 382, 471, 459, 405, 406, 0,
+	0, 0, 0, 0, 0, 0, 424, 428, 446, 418,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 402,
+	0, 435, 0, 0, 0, 384, 379, 0, 422, 0,
+	0, 0, 0, 387, 0, 403, 447, 0, 371, 451,
+	457, 419, 209, 125, 460, 417, 416, 169, 0, 385,
+	186, 133, 132, 145, 445, 381, 449, 104, 383, 0,
+	0, 134, 106, 212, 190, 213, 141, 107, 463, 426,
+	455, 400, 409, 122, 407, 175, 165, 201, 434, 174,
+	148, 193, 170, 200, 129, 377, 404, 969, 181, 191,
+	210, 211, 189, 208, 108, 199, 119, 177, 111, 197,
+	184, 154, 139, 140, 109, 0, 185, 178, 110, 173,
+	126, 0, 131, 124, 163, 194, 195, 123, 221, 115,
+	206, 207, 113, 116, 205, 161, 192, 198, 155, 152,
+	112, 196, 153, 151, 143, 128, 135, 167, 150, 168,
+	136, 158, 157, 159, 0, 376, 0, 183, 203, 222,
+	187, 396, 458, 214, 215, 216, 217, 0, 0, 0,
+	160, 117, 137, 179, 142, 149, 172, 220, 441, 176,
+	120, 202, 180, 391, 395, 389, 392, 390, 430, 431,
+	467, 468, 469, 448, 386, 0, 393, 394, 0, 453,
+	433, 105, 114, 146, 171, 130, 204, 462, 452, 0,
+	421, 464, 398, 413, 472, 414, 415, 443, 380, 429,
+	164, 411, 0, 373, 401, 374, 408, 375, 399, 423,
+	127, 397, 454, 432, 144, 470, 147, 437, 0, 182,
+	156, 0, 0, 166, 0, 0, 218, 219, 0, 0,
+	0, 0, 121, 287, 162, 188, 425, 456, 427, 450,
+	420, 444, 388, 436, 465, 412, 440, 466, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	118, 0, 439, 461, 410, 442, 372, 438, 0, 378,
+	382, 471, 459, 405, 406, 0, 0, 0, 0, 0,
+	0, 0, 424, 428, 446, 418, 0, 0, 0, 0,
+	0, 0, 0, 839, 0, 402, 0, 435, 0, 0,
+	0, 384, 379, 0, 422, 0, 0, 0, 0, 387,
+	0, 403, 447, 0, 371, 451, 457, 419, 209, 125,
+	460, 417, 416, 169, 0, 385, 186, 133, 132, 145,
+	445, 381, 449, 104, 383, 0, 0, 134, 106, 212,
+	190, 213, 141, 107, 463, 426, 455, 400, 409, 122,
+	407, 175, 165, 201, 434, 174, 148, 193, 170, 200,
+	129, 377, 404, 138, 181, 191, 210, 211, 189, 208,
+	108, 199, 119, 177, 111, 197, 184, 154, 139, 140,
+	109, 0, 185, 178, 110, 173, 126, 0, 131, 124,
+	163, 194, 195, 123, 221, 115, 206, 207, 113, 116,
+	205, 161, 192, 198, 155, 152, 112, 196, 153, 151,
+	143, 128, 135, 167, 150, 168, 136, 158, 157, 159,
+	0, 376, 0, 183, 203, 222, 187, 396, 458, 214,
+	215, 216, 217, 0, 0, 0, 160, 117, 137, 179,
+	142, 149, 172, 220, 441, 176, 120, 202, 180, 391,
+	395, 389, 392, 390, 430, 431, 467, 468, 469, 448,
+	386, 0, 393, 394, 0, 453, 433, 105, 114, 146,
+	171, 130, 204, 462, 452, 0, 421, 464, 398, 413,
+	472, 414, 415, 443, 380, 429, 164, 411, 0, 373,
+	401, 374, 408, 375, 399, 423, 127, 397, 454, 432,
+	144, 470, 147, 437, 0, 182, 156, 0, 0, 166,
+	0, 0, 218, 219, 0, 0, 0, 0, 121, 369,
+	162, 188, 425, 456, 427, 450, 420, 444, 388, 436,
+	465, 412, 440, 466, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 118, 0, 439, 461,
+	410, 442, 372, 438, 0, 378, 382, 471, 459, 405,
+	406, 0, 0, 0, 0, 0, 0, 0, 424, 428,
+	446, 418, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 402, 0, 435, 0, 0, 0, 384, 379, 0,
+	422, 0, 0, 0, 0, 387, 0, 403, 447, 0,
+	371, 451, 457, 419, 209, 125, 460, 417, 416, 169,
+	0, 385, 186, 133, 132, 145, 445, 381, 449, 104,
+	383, 0, 0, 134, 106, 212, 190, 213, 141, 107,
+	463, 426, 455, 400, 409, 122, 407, 175, 165, 201,
+	434, 174, 148, 193, 170, 200, 129, 377, 404, 138,
+	181, 191, 210, 211, 189, 208, 108, 199, 119, 177,
+	111, 197, 184, 154, 139, 140, 109, 0, 185, 178,
+	110, 173, 126, 0, 131, 124, 163, 194, 195, 123,
+	221, 115, 206, 207, 113, 116, 205, 161, 192, 198,
+	155, 152, 112, 196, 153, 151, 143, 128, 135, 167,
+	150, 168, 136, 158, 157, 159, 0, 376, 0, 183,
+	203, 222, 187, 396, 458, 214, 215, 216, 217, 0,
+	0, 0, 160, 117, 137, 179, 142, 149, 172, 220,
+	441, 176, 120, 202, 180, 391, 395, 389, 392, 390,
+	430, 431, 467, 468, 469, 448, 386, 0, 393, 394,
+	0, 453, 433, 105, 114, 146, 171, 130, 204, 462,
+	452, 0, 421, 464, 398, 413, 472, 414, 415, 443,
+	380, 429, 164, 411, 0, 373, 401, 374, 408, 375,
+	399, 423, 127, 397, 454, 432, 144, 470, 147, 437,
+	0, 182, 156, 0, 0, 166, 0, 0, 218, 219,
+	0, 0, 0, 0, 121, 287, 162, 188, 425, 456,
+	427, 450, 420, 444, 388, 436, 465, 412, 440, 466,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 118, 0, 439, 461, 410, 442, 372, 438,
+	0, 378, 382, 471, 459, 405, 406, 0, 0, 0,
+	0, 0, 0, 0, 424, 428, 446, 418, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 402, 0, 435,
+	0, 0, 0, 384, 379, 0, 422, 0, 0, 0,
+	0, 387, 0, 403, 447, 0, 371, 451, 457, 419,
+	209, 125, 460, 417, 416, 169, 0, 385, 186, 133,
+	132, 145, 445, 381, 449, 104, 383, 0, 0, 134,
+	106, 212, 190, 213, 141, 107, 463, 426, 455, 400,
+	409, 122, 407, 175, 165, 201, 434, 174, 148, 193,
+	170, 200, 129, 377, 404, 138, 181, 191, 210, 211,
+	189, 208, 108, 199, 119, 177, 111, 197, 184, 154,
+	139, 140, 109, 0, 185, 178, 110, 173, 126, 0,
+	131, 124, 163, 194, 195, 123, 221, 115, 206, 207,
+	113, 116, 205, 161, 192, 198, 155, 152, 112, 196,
+	153, 151, 143, 128, 135, 167, 150, 168, 136, 158,
+	157, 159, 0, 376, 0, 183, 203, 222, 187, 396,
+	458, 214, 215, 216, 217, 0, 0, 0, 160, 117,
+	137, 179, 142, 149, 172, 220, 441, 176, 120, 202,
+	180, 391, 395, 389, 392, 390, 430, 431, 467, 468,
+	469, 448, 386, 0, 393, 394, 0, 453, 433, 105,
+	114, 146, 171, 130, 204, 462, 452, 0, 421, 464,
+	398, 413, 472, 414, 415, 443, 380, 429, 164, 411,
+	0, 373, 401, 374, 408, 375, 399, 423, 127, 397,
+	454, 432, 144, 470, 147, 437, 0, 182, 156, 0,
+	0, 166, 0, 0, 218, 219, 0, 0, 0, 0,
+	121, 369, 162, 188, 425, 456, 427, 450, 420, 444,
+	388, 436, 465, 412, 440, 466, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 118, 0,
+	439, 461, 410, 442, 372, 438, 0, 378, 382, 471,
+	459, 405, 406, 0, 0, 0, 0, 0, 0, 0,
+	424, 428, 446, 418, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 402, 0, 435, 0, 0, 0, 384,
+	379, 0, 422, 0, 0, 0, 0, 387, 0, 403,
+	447, 0, 371, 451, 457, 419, 209, 125, 460, 417,
+	416, 169, 0, 385, 186, 133, 132, 145, 445, 381,
+	449, 104, 383, 0, 0, 134, 106, 212, 190, 213,
+	141, 107, 463, 426, 455, 400, 409, 122, 407, 175,
+	165, 201, 434, 174, 148, 193, 170, 200, 129, 377,
+	404, 138, 181, 191, 210, 211, 189, 208, 108, 199,
+	119, 177, 111, 197, 184, 154, 139, 140, 109, 0,
+	185, 178, 110, 173, 126, 0, 131, 124, 163, 194,
+	195, 123, 221, 115, 206, 207, 113, 367, 205, 161,
+	192, 198, 155, 152, 112, 196, 153, 151, 143, 128,
+	135, 167, 150, 168, 136, 158, 157, 159, 0, 376,
+	0, 183, 203, 222, 187, 396, 458, 214, 215, 216,
+	217, 0, 0, 0, 368, 366, 137, 179, 142, 149,
+	172, 220, 441, 176, 120, 202, 180, 391, 395, 389,
+	392, 390, 430, 431, 467, 468, 469, 448, 386, 0,
+	393, 394, 0, 453, 433, 105, 114, 146, 171, 130,
+	204, 462, 452, 0, 421, 464, 398, 413, 472, 414,
+	415, 443, 380, 429, 164, 411, 0, 373, 401, 374,
+	408, 375, 399, 423, 127, 397, 454, 432, 144, 470,
+	147, 437, 0, 182, 156, 0, 0, 166, 0, 0,
+	218, 219, 0, 0, 0, 0, 121, 102, 162, 188,
+	425, 456, 427, 450, 420, 444, 388, 436, 465, 412,
+	440, 466, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 118, 0, 439, 461, 410, 442,
+	372, 438, 0, 378, 382, 471, 459, 405, 406, 0,
+	0, 0, 0, 0, 0, 0, 424, 428, 446, 418,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 402,
+	0, 435, 0, 0, 0, 384, 379, 0, 422, 0,
+	0, 0, 0, 387, 0, 403, 447, 0, 371, 451,
+	457, 419, 209, 125, 460, 417, 416, 169, 0, 385,
+	186, 133, 132, 145, 445, 381, 449, 104, 383, 0,
+	0, 134, 106, 212, 190, 213, 141, 107, 463, 426,
+	455, 400, 409, 122, 407, 175, 165, 201, 434, 174,
+	148, 193, 170, 200, 129, 377, 404, 138, 181, 191,
+	210, 211, 189, 208, 108, 199, 119, 177, 111, 197,
+	184, 154, 139, 140, 109, 0, 185, 178, 110, 173,
+	126, 0, 131, 124, 163, 194, 195, 123, 221, 115,
+	206, 207, 113, 116, 205, 161, 192, 198, 155, 152,
+	112, 196, 153, 151, 143, 128, 135, 167, 150, 168,
+	136, 158, 157, 159, 0, 376, 0, 183, 203, 222,
+	187, 396, 458, 214, 215, 216, 217, 0, 0, 0,
+	160, 117, 137, 179, 142, 149, 172, 220, 441, 176,
+	120, 202, 180, 391, 395, 389, 392, 390, 430, 431,
+	467, 468, 469, 448, 386, 0, 393, 394, 0, 453,
+	433, 105, 114, 146, 171, 130, 204, 462, 452, 0,
+	421, 464, 398, 413, 472, 414, 415, 443, 380, 429,
+	164, 411, 0, 373, 401, 374, 408, 375, 399, 423,
+	127, 397, 454, 432, 144, 470, 147, 437, 0, 182,
+	156, 0, 0, 166, 0, 0, 218, 219, 0, 0,
+	0, 0, 121, 369, 162, 188, 425, 456, 427, 450,
+	420, 444, 388, 436, 465, 412, 440, 466, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	118, 0, 439, 461, 410, 442, 372, 438, 0, 378,
+	382, 471, 459, 405, 406, 0, 0, 0, 0, 0,
+	0, 0, 424, 428, 446, 418, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 402, 0, 435, 0, 0,
+	0, 384, 379, 0, 422, 0, 0, 0, 0, 387,
+	0, 403, 447, 0, 371, 451, 457, 419, 209, 125,
+	460, 417, 416, 169, 0, 385, 186, 133, 132, 145,
+	445, 381, 449, 104, 383, 0, 0, 134, 106, 212,
+	190, 213, 141, 107, 463, 426, 455, 400, 409, 122,
+	407, 175, 165, 201, 434, 174, 148, 193, 170, 200,
+	129, 377, 404, 138, 181, 191, 210, 211, 189, 208,
+	108, 674, 119, 177, 111, 197, 184, 154, 139, 140,
+	109, 0, 185, 178, 110, 173, 126, 0, 131, 124,
+	163, 194, 195, 123, 221, 115, 206, 207, 113, 367,
+	205, 161, 192, 198, 155, 152, 112, 196, 153, 151,
+	143, 128, 135, 167, 150, 168, 136, 158, 157, 159,
+	0, 376, 0, 183, 203, 222, 187, 396, 458, 214,
+	215, 216, 217, 0, 0, 0, 368, 366, 137, 179,
+	142, 149, 172, 220, 441, 176, 120, 202, 180, 391,
+	395, 389, 392, 390, 430, 431, 467, 468, 469, 448,
+	386, 0, 393, 394, 0, 453, 433, 105, 114, 146,
+	171, 130, 204, 462, 452, 0, 421, 464, 398, 413,
+	472, 414, 415, 443, 380, 429, 164, 411, 0, 373,
+	401, 374, 408, 375, 399, 423, 127, 397, 454, 432,
+	144, 470, 147, 437, 0, 182, 156, 0, 0, 166,
+	0, 0, 218, 219, 0, 0, 0, 0, 121, 369,
+	162, 188, 425, 456, 427, 450, 420, 444, 388, 436,
+	465, 412, 440, 466, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 118, 0, 439, 461,
+	410, 442, 372, 438, 0, 378, 382, 471, 459, 405,
+	406, 0, 0, 0, 0, 0, 0, 0, 424, 428,
+	446, 418, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 402, 0, 435, 0, 0, 0, 384, 379, 0,
+	422, 0, 0, 0, 0, 387, 0, 403, 447, 0,
+	371, 451, 457, 419, 209, 125, 460, 417, 416, 169,
+	0, 385, 186, 133, 132, 145, 445, 381, 449, 104,
+	383, 0, 0, 134, 106, 212, 190, 213, 141, 107,
+	463, 426, 455, 400, 409, 122, 407, 175, 165, 201,
+	434, 174, 148, 193, 170, 200, 129, 377, 404, 138,
+	181, 191, 210, 211, 189, 208, 108, 358, 119, 177,
+	111, 197, 184, 154, 139, 140, 109, 0, 185, 178,
+	110, 173, 126, 0, 131, 124, 163, 194, 195, 123,
+	221, 115, 206, 207, 113, 367, 205, 161, 192, 198,
+	155, 152, 112, 196, 153, 151, 143, 128, 135, 167,
+	150, 168, 136, 158, 157, 159, 0, 376, 0, 183,
+	203, 222, 187, 396, 458, 214, 215, 216, 217, 0,
+	0, 0, 368, 366, 361, 360, 142, 149, 172, 220,
+	441, 176, 120, 202, 180, 391, 395, 389, 392, 390,
+	430, 431, 467, 468, 469, 448, 386, 0, 393, 394,
+	0, 453, 433, 105, 114, 146, 171, 130, 204, 164,
+	0, 0, 0, 900, 0, 289, 0, 0, 0, 127,
+	286, 0, 0, 144, 330, 147, 0, 0, 182, 156,
+	0, 0, 166, 0, 0, 218, 219, 0, 0, 0,
+	0, 121, 287, 162, 188, 0, 0, 321, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 55, 0, 0,
+	309, 308, 297, 311, 312, 313, 314, 0, 0, 118,
+	310, 315, 316, 317, 0, 0, 284, 302, 0, 329,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	299, 300, 280, 0, 0, 0, 342, 0, 301, 0,
+	0, 295, 296, 303, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 209, 125, 0,
+	0, 340, 169, 0, 0, 186, 133, 132, 145, 0,
+	0, 0, 104, 0, 0, 0, 134, 106, 212, 190,
+	213, 141, 298, 0, 0, 0, 0, 0, 122, 0,
+	175, 165, 201, 0, 174, 148, 193, 170, 200, 129,
+	0, 0, 138, 181, 191, 210, 211, 189, 208, 108,
+	199, 119, 177, 111, 197, 184, 154, 139, 140, 109,
+	0, 185, 178, 110, 173, 126, 0, 131, 124, 163,
+	194, 195, 123, 221, 115, 206, 207, 113, 116, 205,
+	161, 192, 198, 155, 152, 112, 196, 153, 151, 143,
+	128, 135, 167, 150, 168, 136, 158, 157, 159, 0,
+	0, 0, 183, 203, 222, 187, 0, 0, 214, 215,
+	216, 217, 0, 0, 0, 160, 117, 137, 179, 142,
+	149, 172, 220, 0, 176, 120, 202, 180, 331, 341,
+	337, 338, 339, 335, 336, 334, 333, 332, 343, 323,
+	324, 325, 326, 328, 0, 327, 105, 114, 146, 171,
+	130, 204, 164, 0, 0, 0, 0, 0, 289, 0,
+	0, 0, 127, 286, 0, 0, 144, 330, 147, 0,
+	0, 182, 156, 0, 0, 166, 0, 0, 218, 219,
+	0, 0, 0, 0, 121, 287, 162, 188, 0, 0,
+	321, 322, 0, 0, 0, 0, 0, 0, 0, 0,
+	55, 0, 0, 309, 308, 297, 311, 312, 313, 314,
+	0, 0, 118, 310, 315, 316, 317, 0, 0, 284,
+	302, 0, 329, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 299, 300, 280, 0, 0, 0, 342,
+	0, 301, 0, 0, 295, 296, 303, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	209, 125, 0, 0, 340, 169, 0, 0, 186, 133,
+	132, 145, 0, 0, 0, 104, 0, 0, 0, 134,
+	106, 212, 190, 213, 141, 298, 0, 0, 0, 0,
+	0, 122, 0, 175, 165, 201, 0, 174, 148, 193,
+	170, 200, 129, 0, 0, 138, 181, 191, 210, 211,
+	189, 208, 108, 199, 119, 177, 111, 197, 184, 154,
+	139, 140, 109, 0, 185, 178, 110, 173, 126, 0,
+	131, 124, 163, 194, 195, 123, 221, 115, 206, 207,
+	113, 116, 205, 161, 192, 198, 155, 152, 112, 196,
+	153, 151, 143, 128, 135, 167, 150, 168, 136, 158,
+	157, 159, 0, 0, 0, 183, 203, 222, 187, 0,
+	0, 214, 215, 216, 217, 0, 0, 0, 160, 117,
+	137, 179, 142, 149, 172, 220, 0, 176, 120, 202,
+	180, 331, 341, 337, 338, 339, 335, 336, 334, 333,
+	332, 343, 323, 324, 325, 326, 328, 0, 327, 105,
+	114, 146, 171, 130, 204, 164, 0, 0, 0, 0,
+	0, 289, 0, 0, 0, 127, 286, 0, 0, 144,
+	330, 147, 0, 0, 182, 156, 0, 0, 166, 0,
+	0, 218, 219, 0, 0, 0, 0, 121, 287, 162,
+	188, 0, 0, 321, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 55, 0, 540, 309, 308, 297, 311,
+	312, 313, 314, 0, 0, 118, 310, 315, 316, 317,
+	0, 0, 284, 302, 0, 329, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 299, 300, 0, 0,
+	0, 0, 342, 0, 301, 0, 0, 295, 296, 303,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 209, 125, 0, 0, 340, 169, 0,
+	0, 186, 133, 132, 145, 0, 0, 0, 104, 0,
+	0, 0, 134, 106, 212, 190, 213, 141, 298, 0,
+	0, 0, 0, 0, 122, 0, 175, 165, 201, 0,
+	174, 148, 193, 170, 200, 129, 0, 0, 138, 181,
+	191, 210, 211, 189, 208, 108, 199, 119, 177, 111,
+	197, 184, 154, 139, 140, 109, 0, 185, 178, 110,
+	173, 126, 0, 131, 124, 163, 194, 195, 123, 221,
+	115, 206, 207, 113, 116, 205, 161, 192, 198, 155,
+	152, 112, 196, 153, 151, 143, 128, 135, 167, 150,
+	168, 136, 158, 157, 159, 0, 0, 0, 183, 203,
+	222, 187, 0, 0, 214, 215, 216, 217, 0, 0,
+	0, 160, 117, 137, 179, 142, 149, 172, 220, 0,
+	176, 120, 202, 180, 331, 341, 337, 338, 339, 335,
+	336, 334, 333, 332, 343, 323, 324, 325, 326, 328,
+	0, 327, 105, 114, 146, 171, 130, 204, 164, 0,
+	0, 0, 0, 0, 289, 0, 0, 0, 127, 286,
+	0, 0, 144, 330, 147, 0, 0, 182, 156, 0,
+	0, 166, 0, 0, 218, 219, 0, 0, 0, 0,
+	121, 287, 162, 188, 0, 0, 321, 322, 0, 0,
+	0, 0, 0, 0, 961, 0, 55, 0, 0, 309,
+	308, 297, 311, 312, 313, 314, 0, 0, 118, 310,
+	315, 316, 317, 0, 0, 284, 302, 0, 329, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 299,
+	300, 0, 0, 0, 0, 342, 0, 301, 0, 0,
+	295, 296, 303, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 209, 125, 0, 0,
+	340, 169, 0, 0, 186, 133, 132, 145, 0, 0,
+	0, 104, 0, 0, 0, 134, 106, 212, 190, 213,
+	141, 298, 0, 0, 0, 0, 0, 122, 0, 175,
+	165, 201, 0, 174, 148, 193, 170, 200, 129, 0,
+	0, 138, 181, 191, 210, 211, 189, 208, 108, 199,
+	119, 177, 111, 197, 184, 154, 139, 140, 109, 0,
+	185, 178, 110, 173, 126, 0, 131, 124, 163, 194,
+	195, 123, 221, 115, 206, 207, 113, 116, 205, 161,
+	192, 198, 155, 152, 112, 196, 153, 151, 143, 128,
+	135, 167, 150, 168, 136, 158, 157, 159, 0, 0,
+	0, 183, 203, 222, 187, 0, 0, 214, 215, 216,
+	217, 0, 0, 0, 160, 117, 137, 179, 142, 149,
+	172, 220, 0, 176, 120, 202, 180, 331, 341, 337,
+	338, 339, 335, 336, 334, 333, 332, 343, 323, 324,
+	325, 326, 328, 25, 327, 105, 114, 146, 171, 130,
+	204, 0, 0, 0, 0, 164, 0, 0, 0, 0,
+	0, 289, 0, 0, 0, 127, 286, 0, 0, 144,
+	330, 147, 0, 0, 182, 156, 0, 0, 166, 0,
+	0, 218, 219, 0, 0, 0, 0, 121, 287, 162,
+	188, 0, 0, 321, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 55, 0, 0, 309, 308, 297, 311,
+	312, 313, 314, 0, 0, 118, 310, 315, 316, 317,
+	0, 0, 284, 302, 0, 329, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 299, 300, 0, 0,
+	0, 0, 342, 0, 301, 0, 0, 295, 296, 303,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 209, 125, 0, 0, 340, 169, 0,
+	0, 186, 133, 132, 145, 0, 0, 0, 104, 0,
+	0, 0, 134, 106, 212, 190, 213, 141, 298, 0,
+	0, 0, 0, 0, 122, 0, 175, 165, 201, 0,
+	174, 148, 193, 170, 200, 129, 0, 0, 138, 181,
+	191, 210, 211, 189, 208, 108, 199, 119, 177, 111,
+	197, 184, 154, 139, 140, 109, 0, 185, 178, 110,
+	173, 126, 0, 131, 124, 163, 194, 195, 123, 221,
+	115, 206, 207, 113, 116, 205, 161, 192, 198, 155,
+	152, 112, 196, 153, 151, 143, 128, 135, 167, 150,
+	168, 136, 158, 157, 159, 0, 0, 0, 183, 203,
+	222, 187, 0, 0, 214, 215, 216, 217, 0, 0,
+	0, 160, 117, 137, 179, 142, 149, 172, 220, 0,
+	176, 120, 202, 180, 331, 341, 337, 338, 339, 335,
+	336, 334, 333, 332, 343, 323, 324, 325, 326, 328,
+	0, 327, 105, 114, 146, 171, 130, 204, 164, 0,
+	0, 0, 0, 0, 289, 0, 0, 0, 127, 286,
+	0, 0, 144, 330, 147, 0, 0, 182, 156, 0,
+	0, 166, 0, 0, 218, 219, 0, 0, 0, 0,
+	121, 287, 162, 188, 0, 0, 321, 322, 0, 0,
+	0, 0, 0, 0, 0, 0, 55, 0, 0, 309,
+	308, 297, 311, 312, 313, 314, 0, 0, 118, 310,
+	315, 316, 317, 0, 0, 284, 302, 0, 329, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 299,
+	300, 0, 0, 0, 0, 342, 0, 301, 0, 0,
+	295, 296, 303, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 209, 125, 0, 0,
+	340, 169, 0, 0, 186, 133, 132, 145, 0, 0,
+	0, 104, 0, 0, 0, 134, 106, 212, 190, 213,
+	141, 298, 0, 0, 0, 0, 0, 122, 0, 175,
+	165, 201, 0, 174, 148, 193, 170, 200, 129, 0,
+	0, 138, 181, 191, 210, 211, 189, 208, 108, 199,
+	119, 177, 111, 197, 184, 154, 139, 140, 109, 0,
+	185, 178, 110, 173, 126, 0, 131, 124, 163, 194,
+	195, 123, 221, 115, 206, 207, 113, 116, 205, 161,
+	192, 198, 155, 152, 112, 196, 153, 151, 143, 128,
+	135, 167, 150, 168, 136, 158, 157, 159, 0, 0,
+	0, 183, 203, 222, 187, 0, 0, 214, 215, 216,
+	217, 0, 0, 0, 160, 117, 137, 179, 142, 149,
+	172, 220, 0, 176, 120, 202, 180, 331, 341, 337,
+	338, 339, 335, 336, 334, 333, 332, 343, 323, 324,
+	325, 326, 328, 0, 327, 105, 114, 146, 171, 130,
+	204, 164, 0, 845, 844, 0, 0, 0, 0, 0,
+	0, 127, 0, 0, 0, 144, 330, 147, 0, 0,
+	182, 156, 0, 0, 166, 0, 0, 218, 219, 0,
+	0, 0, 0, 121, 287, 162, 188, 0, 0, 321,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 55,
+	0, 0, 309, 308, 297, 311, 312, 313, 314, 0,
+	0, 118, 310, 315, 316, 317, 0, 0, 0, 302,
+	0, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 299, 300, 0, 0, 0, 0, 342, 0,
+	301, 0, 0, 295, 296, 303, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 209,
+	125, 0, 0, 340, 169, 0, 0, 186, 133, 132,
+	145, 0, 0, 0, 104, 0, 0, 0, 134, 106,
+	212, 190, 213, 141, 298, 0, 0, 0, 0, 0,
+	122, 0, 175, 165, 201, 0, 174, 148, 193, 170,
+	200, 129, 0, 0, 138, 181, 191, 210, 211, 189,
+	208, 108, 199, 119, 177, 111, 197, 184, 154, 139,
+	140, 109, 0, 185, 178, 110, 173, 126, 0, 131,
+	124, 163, 194, 195, 123, 221, 115, 206, 207, 113,
+	116, 205, 161, 192, 198, 155, 152, 112, 196, 153,
+	151, 143, 128, 135, 167, 150, 168, 136, 158, 157,
+	159, 0, 0, 0, 183, 203, 222, 187, 0, 0,
+	214, 215, 216, 217, 0, 0, 0, 160, 117, 137,
+	179, 142, 149, 172, 220, 0, 176, 120, 202, 180,
+	331, 341, 337, 338, 339, 335, 336, 334, 333, 332,
+	343, 323, 324, 325, 326, 328, 164, 327, 105, 114,
+	146, 171, 130, 204, 0, 0, 127, 0, 0, 0,
+	144, 330, 147, 0, 0, 182, 156, 0, 0, 166,
+	0, 0, 218, 219, 0, 0, 0, 0, 121, 287,
+	162, 188, 0, 0, 321, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 55, 0, 0, 309, 308, 297,
+	311, 312, 313, 314, 0, 0, 118, 310, 315, 316,
+	317, 0, 0, 0, 302, 0, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 299, 300, 0,
+	0, 0, 0, 342, 0, 301, 0, 0, 295, 296,
+	303, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 209, 125, 0, 0, 340, 169,
+	0, 0, 186, 133, 132, 145, 0, 0, 0, 104,
+	0, 0, 0, 134, 106, 212, 190, 213, 141, 298,
+	0, 0, 0, 0, 0, 122, 0, 175, 165, 201,
+	2118, 174, 148, 193, 170, 200, 129, 0, 0, 138,
+	181, 191, 210, 211, 189, 208, 108, 199, 119, 177,
+	111, 197, 184, 154, 139, 140, 109, 0, 185, 178,
+	110, 173, 126, 0, 131, 124, 163, 194, 195, 123,
+	221, 115, 206, 207, 113, 116, 205, 161, 192, 198,
+	155, 152, 112, 196, 153, 151, 143, 128, 135, 167,
+	150, 168, 136, 158, 157, 159, 0, 0, 0, 183,
+	203, 222, 187, 0, 0, 214, 215, 216, 217, 0,
+	0, 0, 160, 117, 137, 179, 142, 149, 172, 220,
+	0, 176, 120, 202, 180, 331, 341, 337, 338, 339,
+	335, 336, 334, 333, 332, 343, 323, 324, 325, 326,
+	328, 164, 327, 105, 114, 146, 171, 130, 204, 0,
+	0, 127, 0, 0, 0, 144, 330, 147, 0, 0,
+	182, 156, 0, 0, 166, 0, 0, 218, 219, 0,
+	0, 0, 0, 121, 287, 162, 188, 0, 0, 321,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 55,
+	0, 0, 309, 308, 297, 311, 312, 313, 314, 0,
+	0, 118, 310, 315, 316, 317, 0, 0, 0, 302,
+	0, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 299, 300, 0, 0, 0, 0, 342, 0,
+	301, 0, 0, 295, 296, 303, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 209,
+	125, 0, 0, 340, 169, 0, 0, 186, 133, 132,
+	145, 0, 0, 0, 104, 0, 0, 0, 134, 106,
+	212, 190, 213, 141, 298, 0, 0, 0, 0, 0,
+	122, 0, 175, 165, 201, 1776, 174, 148, 193, 170,
+	200, 129, 0, 0, 138, 181, 191, 210, 211, 189,
+	208, 108, 199, 119, 177, 111, 197, 184, 154, 139,
+	140, 109, 0, 185, 178, 110, 173, 126, 0, 131,
+	124, 163, 194, 195, 123, 221, 115, 206, 207, 113,
+	116, 205, 161, 192, 198, 155, 152, 112, 196, 153,
+	151, 143, 128, 135, 167, 150, 168, 136, 158, 157,
+	159, 0, 0, 0, 183, 203, 222, 187, 0, 0,
+	214, 215, 216, 217, 0, 0, 0, 160, 117, 137,
+	179, 142, 149, 172, 220, 0, 176, 120, 202, 180,
+	331, 341, 337, 338, 339, 335, 336, 334, 333, 332,
+	343, 323, 324, 325, 326, 328, 164, 327, 105, 114,
+	146, 171, 130, 204, 0, 0, 127, 0, 0, 0,
+	144, 330, 147, 0, 0, 182, 156, 0, 0, 166,
+	0, 0, 218, 219, 0, 0, 0, 0, 121, 287,
+	162, 188, 0, 0, 321, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 55, 0, 0, 309, 308, 297,
+	311, 312, 313, 314, 0, 0, 118, 310, 315, 316,
+	317, 0, 0, 0, 302, 0, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 299, 300, 0,
+	0, 0, 0, 342, 0, 301, 0, 0, 295, 296,
+	303, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 209, 125, 0, 0, 340, 169,
+	0, 0, 186, 133, 132, 145, 0, 0, 0, 104,
+	0, 0, 0, 134, 106, 212, 190, 213, 141, 298,
+	0, 0, 0, 0, 0, 122, 0, 175, 165, 201,
+	0, 174, 148, 193, 170, 200, 129, 0, 0, 138,
+	181, 191, 210, 211, 189, 208, 108, 199, 119, 177,
+	111, 197, 184, 154, 139, 140, 109, 0, 185, 178,
+	110, 173, 126, 0, 131, 124, 163, 194, 195, 123,
+	221, 115, 206, 207, 113, 116, 205, 161, 192, 198,
+	155, 152, 112, 196, 153, 151, 143, 128, 135, 167,
+	150, 168, 136, 158, 157, 159, 0, 0, 0, 183,
+	203, 222, 187, 0, 0, 214, 215, 216, 217, 0,
+	0, 0, 160, 117, 137, 179, 142, 149, 172, 220,
+	0, 176, 120, 202, 180, 331, 341, 337, 338, 339,
+	335, 336, 334, 333, 332, 343, 323, 324, 325, 326,
+	328, 164, 327, 105, 114, 146, 171, 130, 204, 0,
+	0, 127, 0, 0, 0, 144, 0, 147, 0, 0,
+	182, 156, 0, 0, 166, 0, 0, 218, 219, 0,
+	0, 0, 0, 121, 287, 162, 188, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 55,
+	0, 0, 0, 1242, 0, 1243, 1245, 0, 0, 0,
+	0, 118, 1251, 1246, 316, 317, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1244, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 209,
+	125, 0, 0, 0, 169, 0, 0, 186, 133, 132,
+	145, 0, 0, 0, 104, 0, 0, 0, 134, 106,
+	212, 190, 213, 141, 107, 0, 0, 0, 0, 0,
+	122, 0, 175, 165, 201, 0, 174, 148, 193, 170,
+	200, 129, 0, 0, 138, 181, 191, 210, 211, 189,
+	208, 108, 199, 119, 177, 111, 197, 184, 154, 139,
+	140, 109, 0, 185, 178, 110, 173, 126, 0, 131,
+	124, 163, 194, 195, 123, 221, 115, 206, 207, 113,
+	116, 205, 161, 192, 198, 155, 152, 112, 196, 153,
+	151, 143, 128, 135, 167, 150, 168, 136, 158, 157,
+	159, 0, 0, 0, 183, 203, 222, 187, 0, 0,
+	214, 215, 216, 217, 0, 0, 0, 160, 117, 137,
+	179, 142, 149, 172, 220, 0, 176, 120, 202, 180,
+	1252, 0, 1253, 0, 1254, 1255, 1256, 0, 0, 0,
+	0, 0, 0, 0, 164, 0, 0, 0, 105, 114,
+	146, 171, 130, 204, 127, 0, 0, 0, 144, 0,
+	147, 0, 0, 182, 156, 0, 0, 166, 0, 0,
+	218, 219, 0, 0, 0, 0, 121, 985, 162, 188,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 118, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 991, 209, 125, 0, 0, 0, 986, 0, 983,
+	987, 990, 982, 145, 0, 0, 0, 104, 984, 0,
+	0, 134, 106, 212, 190, 213, 141, 107, 988, 992,
+	0, 0, 0, 122, 0, 175, 165, 201, 0, 174,
+	148, 193, 170, 200, 129, 0, 0, 138, 181, 191,
+	210, 211, 189, 208, 108, 199, 119, 177, 111, 197,
+	184, 154, 139, 140, 109, 0, 185, 178, 110, 173,
+	126, 0, 131, 124, 163, 194, 195, 123, 221, 115,
+	206, 207, 113, 116, 205, 161, 192, 198, 155, 152,
+	112, 196, 153, 151, 143, 128, 135, 167, 150, 168,
+	136, 158, 157, 159, 0, 0, 0, 183, 203, 222,
+	187, 0, 0, 214, 215, 216, 217, 0, 0, 0,
+	160, 117, 137, 179, 142, 149, 172, 220, 0, 176,
+	120, 202, 180, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 105, 114, 146, 171, 130, 204, 164, 0, 0,
+	0, 0, 562, 0, 0, 0, 0, 127, 0, 0,
+	0, 144, 0, 147, 0, 0, 182, 156, 0, 0,
+	166, 0, 0, 0, 219, 0, 0, 0, 0, 121,
+	369, 162, 188, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 564,
+	0, 0, 0, 0, 0, 0, 0, 118, 0, 0,
+	0, 0, 559, 558, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 560,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 209, 125, 0, 0, 0,
+	169, 0, 0, 186, 133, 132, 145, 0, 0, 0,
+	104, 0, 0, 0, 134, 106, 212, 190, 213, 141,
+	107, 0, 0, 0, 0, 0, 122, 0, 175, 165,
+	201, 0, 174, 148, 193, 170, 200, 129, 0, 0,
+	138, 181, 191, 210, 211, 189, 208, 108, 199, 119,
+	177, 111, 197, 184, 154, 139, 140, 109, 0, 185,
+	178, 110, 173, 126, 0, 131, 124, 163, 194, 195,
+	123, 221, 115, 206, 207, 113, 116, 205, 161, 192,
+	198, 155, 152, 112, 196, 153, 151, 143, 128, 135,
+	167, 150, 168, 136, 158, 157, 159, 0, 0, 0,
+	183, 203, 222, 187, 0, 0, 214, 215, 216, 217,
+	0, 0, 0, 160, 117, 137, 179, 142, 149, 172,
+	220, 0, 176, 120, 202, 180, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	164, 0, 0, 0, 105, 114, 146, 171, 130, 204,
+	127, 0, 0, 0, 144, 0, 147, 0, 0, 182,
+	156, 0, 0, 166, 0, 0, 218, 219, 0, 0,
+	0, 0, 121, 369, 162, 188, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 55, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	118, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 209, 125,
+	0, 0, 0, 169, 0, 0, 186, 133, 132, 145,
+	0, 0, 0, 104, 0, 0, 0, 134, 106, 212,
+	190, 213, 141, 107, 0, 1770, 0, 0, 0, 122,
+	0, 175, 165, 201, 0, 174, 148, 193, 170, 200,
+	129, 0, 0, 138, 181, 191, 210, 211, 189, 208,
+	108, 199, 119, 177, 111, 197, 184, 154, 139, 140,
+	109, 0, 185, 178, 110, 173, 126, 0, 131, 124,
+	163, 194, 195, 123, 221, 115, 206, 207, 113, 116,
+	205, 161, 192, 198, 155, 152, 112, 196, 153, 151,
+	143, 128, 135, 167, 150, 168, 136, 158, 157, 159,
+	0, 0, 0, 183, 203, 222, 187, 0, 0, 214,
+	215, 216, 217, 0, 0, 0, 160, 117, 137, 179,
+	142, 149, 172, 220, 0, 176, 120, 202, 180, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 164, 0, 0, 0, 105, 114, 146,
+	171, 130, 204, 127, 0, 0, 0, 144, 0, 147,
+	0, 0, 182, 156, 0, 0, 166, 0, 0, 218,
+	219, 0, 0, 0, 0, 121, 287, 162, 188, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1318, 0, 0,
+	0, 0, 0, 118, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1319, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 209, 125, 0, 0, 0, 169, 0, 0, 186,
+	133, 132, 145, 0, 0, 0, 104, 0, 0, 0,
+	134, 106, 212, 190, 213, 141, 107, 0, 0, 0,
+	0, 0, 122, 0, 175, 165, 201, 0, 174, 148,
+	193, 170, 200, 129, 0, 0, 138, 181, 191, 210,
+	211, 189, 208, 108, 199, 119, 177, 111, 197, 184,
+	154, 139, 140, 109, 0, 185, 178, 110, 173, 126,
+	0, 131, 124, 163, 194, 195, 123, 221, 115, 206,
+	207, 113, 116, 205, 161, 192, 198, 155, 152, 112,
+	196, 153, 151, 143, 128, 135, 167, 150, 168, 136,
+	158, 157, 159, 0, 0, 0, 183, 203, 222, 187,
+	0, 0, 214, 215, 216, 217, 0, 0, 0, 160,
+	117, 137, 179, 142, 149, 172, 220, 0, 176, 120,
+	202, 180, 0, 0, 25, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 164, 0, 0, 0,
+	105, 114, 146, 171, 130, 204, 127, 0, 0, 0,
+	144, 0, 147, 0, 0, 182, 156, 0, 0, 166,
+	0, 0, 218, 219, 0, 0, 0, 0, 121, 369,
+	162, 188, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 55, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 118, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 209, 125, 0, 0, 0, 169,
+	0, 0, 186, 133, 132, 145, 0, 0, 0, 104,
+	0, 0, 0, 134, 106, 212, 190, 213, 141, 107,
+	0, 0, 0, 0, 0, 122, 0, 175, 165, 201,
+	0, 174, 148, 193, 170, 200, 129, 0, 0, 138,
+	181, 191, 210, 211, 189, 208, 108, 199, 119, 177,
+	111, 197, 184, 154, 139, 140, 109, 0, 185, 178,
+	110, 173, 126, 0, 131, 124, 163, 194, 195, 123,
+	221, 115, 206, 207, 113, 116, 205, 161, 192, 198,
+	155, 152, 112, 196, 153, 151, 143, 128, 135, 167,
+	150, 168, 136, 158, 157, 159, 0, 0, 0, 183,
+	203, 222, 187, 0, 0, 214, 215, 216, 217, 0,
+	0, 0, 160, 117, 137, 179, 142, 149, 172, 220,
+	0, 176, 120, 202, 180, 0, 0, 25, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 164,
+	0, 0, 0, 105, 114, 146, 171, 130, 204, 127,
+	0, 0, 0, 144, 0, 147, 0, 0, 182, 156,
+	0, 0, 166, 0, 0, 218, 219, 0, 0, 0,
+	0, 121, 102, 162, 188, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 55, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 118,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 209, 125, 0,
+	0, 0, 169, 0, 0, 186, 133, 132, 145, 0,
+	0, 0, 104, 0, 0, 0, 134, 106, 212, 190,
+	213, 141, 107, 0, 0, 0, 0, 0, 122, 0,
+	175, 165, 201, 0, 174, 148, 193, 170, 200, 129,
+	0, 0, 138, 181, 191, 210, 211, 189, 208, 108,
+	199, 119, 177, 111, 197, 184, 154, 139, 140, 109,
+	0, 185, 178, 110, 173, 126, 0, 131, 124, 163,
+	194, 195, 123, 221, 115, 206, 207, 113, 116, 205,
+	161, 192, 198, 155, 152, 112, 196, 153, 151, 143,
+	128, 135, 167, 150, 168, 136, 158, 157, 159, 0,
+	0, 0, 183, 203, 222, 187, 0, 0, 214, 215,
+	216, 217, 0, 0, 0, 160, 117, 137, 179, 142,
+	149, 172, 220, 0, 176, 120, 202, 180, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 164, 0, 0, 0, 105, 114, 146, 171,
+	130, 204, 127, 0, 0, 0, 144, 0, 147, 0,
+	0, 182, 156, 0, 0, 166, 0, 0, 218, 219,
+	0, 0, 0, 0, 121, 369, 162, 188, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 826, 0, 0, 827,
+	0, 0, 118, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	209, 125, 0, 0, 0, 169, 0, 0, 186, 133,
+	132, 145, 0, 0, 0, 104, 0, 0, 0, 134,
+	106, 212, 190, 213, 141, 107, 0, 0, 0, 0,
+	0, 122, 0, 175, 165, 201, 0, 174, 148, 193,
+	170, 200, 129, 0, 0, 138, 181, 191, 210, 211,
+	189, 208, 108, 199, 119, 177, 111, 197, 184, 154,
+	139, 140, 109, 0, 185, 178, 110, 173, 126, 0,
+	131, 124, 163, 194, 195, 123, 221, 115, 206, 207,
+	113, 116, 205, 161, 192, 198, 155, 152, 112, 196,
+	153, 151, 143, 128, 135, 167, 150, 168, 136, 158,
+	157, 159, 0, 0, 0, 183, 203, 222, 187, 0,
+	0, 214, 215, 216, 217, 0, 0, 0, 160, 117,
+	137, 179, 142, 149, 172, 220, 0, 176, 120, 202,
+	180, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 164, 0, 0, 0, 105,
+	114, 146, 171, 130, 204, 127, 683, 0, 0, 144,
+	0, 147, 0, 0, 182, 156, 0, 0, 166, 0,
+	0, 218, 219, 0, 0, 0, 0, 121, 369, 162,
+	188, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 682, 0, 0,
+	0, 0, 0, 0, 0, 118, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 209, 125, 0, 0, 0, 169, 0,
+	0, 186, 133, 132, 145, 0, 0, 0, 104, 0,
+	0, 0, 134, 106, 212, 190, 213, 141, 107, 0,
+	0, 0, 0, 0, 122, 0, 175, 165, 201, 0,
+	174, 148, 193, 170, 200, 129, 0, 0, 138, 181,
+	191, 210, 211, 189, 208, 108, 199, 119, 177, 111,
+	197, 184, 154, 139, 140, 109, 0, 185, 178, 110,
+	173, 126, 0, 131, 124, 163, 194, 195, 123, 221,
+	115, 206, 207, 113, 116, 205, 161, 192, 198, 155,
+	152, 112, 196, 153, 151, 143, 128, 135, 167, 150,
+	168, 136, 158, 157, 159, 0, 0, 0, 183, 203,
+	222, 187, 0, 0, 214, 215, 216, 217, 0, 0,
+	0, 160, 117, 137, 179, 142, 149, 172, 220, 0,
+	176, 120, 202, 180, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 164, 0,
+	0, 0, 105, 114, 146, 171, 130, 204, 127, 0,
+	0, 0, 144, 0, 147, 0, 0, 182, 156, 0,
+	0, 166, 0, 0, 218, 219, 0, 0, 0, 0,
+	121, 369, 162, 188, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 55, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 118, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 209, 125, 0, 0,
+	0, 169, 0, 0, 186, 133, 132, 145, 0, 0,
+	0, 104, 0, 0, 0, 134, 106, 212, 190, 213,
+	141, 107, 0, 0, 0, 0, 0, 122, 0, 175,
+	165, 201, 0, 174, 148, 193, 170, 200, 129, 0,
+	0, 138, 181, 191, 210, 211, 189, 208, 108, 199,
+	119, 177, 111, 197, 184, 154, 139, 140, 109, 0,
+	185, 178, 110, 173, 126, 0, 131, 124, 163, 194,
+	195, 123, 221, 115, 206, 207, 113, 116, 205, 161,
+	192, 198, 155, 152, 112, 196, 153, 151, 143, 128,
+	135, 167, 150, 168, 136, 158, 157, 159, 0, 0,
+	0, 183, 203, 222, 187, 0, 0, 214, 215, 216,
+	217, 0, 0, 0, 160, 117, 137, 179, 142, 149,
+	172, 220, 0, 176, 120, 202, 180, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 164, 0, 0, 0, 105, 114, 146, 171, 130,
+	204, 127, 0, 0, 0, 144, 0, 147, 0, 0,
+	182, 156, 0, 0, 166, 0, 0, 218, 219, 0,
+	0, 0, 0, 121, 369, 162, 188, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1796,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 118, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 209,
+	125, 0, 0, 0, 169, 0, 0, 186, 133, 132,
+	145, 0, 0, 0, 104, 0, 0, 0, 134, 106,
+	212, 190, 213, 141, 107, 0, 0, 0, 0, 0,
+	122, 0, 175, 165, 201, 0, 174, 148, 193, 170,
+	200, 129, 0, 0, 138, 181, 191, 210, 211, 189,
+	208, 108, 199, 119, 177, 111, 197, 184, 154, 139,
+	140, 109, 0, 185, 178, 110, 173, 126, 0, 131,
+	124, 163, 194, 195, 123, 221, 115, 206, 207, 113,
+	116, 205, 161, 192, 198, 155, 152, 112, 196, 153,
+	151, 143, 128, 135, 167, 150, 168, 136, 158, 157,
+	159, 0, 0, 0, 183, 203, 222, 187, 0, 0,
+	214, 215, 216, 217, 0, 0, 0, 160, 117, 137,
+	179, 142, 149, 172, 220, 0, 176, 120, 202, 180,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 164, 0, 0, 0, 105, 114,
+	146, 171, 130, 204, 127, 0, 0, 0, 144, 0,
+	147, 0, 0, 182, 156, 0, 0, 166, 0, 0,
+	218, 219, 0, 0, 0, 0, 121, 369, 162, 188,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 118, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 209, 125, 0, 0, 0, 169, 0, 0,
+	186, 133, 132, 145, 0, 0, 0, 104, 0, 0,
+	0, 134, 106, 212, 190, 213, 141, 107, 0, 1644,
+	0, 0, 0, 122, 0, 175, 165, 201, 0, 174,
+	148, 193, 170, 200, 129, 0, 0, 138, 181, 191,
+	210, 211, 189, 208, 108, 199, 119, 177, 111, 197,
+	184, 154, 139, 140, 109, 0, 185, 178, 110, 173,
+	126, 0, 131, 124, 163, 194, 195, 123, 221, 115,
+	206, 207, 113, 116, 205, 161, 192, 198, 155, 152,
+	112, 196, 153, 151, 143, 128, 135, 167, 150, 168,
+	136, 158, 157, 159, 0, 0, 0, 183, 203, 222,
+	187, 0, 0, 214, 215, 216, 217, 0, 0, 0,
+	160, 117, 137, 179, 142, 149, 172, 220, 0, 176,
+	120, 202, 180, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 105, 114, 146, 171, 130, 204, 164, 0, 0,
+	0, 0, 663, 0, 0, 0, 0, 127, 0, 0,
+	0, 144, 0, 147, 0, 0, 182, 156, 0, 0,
+	166, 0, 0, 0, 219, 0, 0, 0, 0, 121,
+	102, 162, 188, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 665,
+	0, 0, 0, 0, 0, 0, 0, 118, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 209, 125, 0, 0, 0,
+	169, 0, 0, 186, 133, 132, 145, 0, 0, 0,
+	104, 0, 0, 0, 134, 106, 212, 190, 213, 141,
+	107, 0, 0, 0, 0, 0, 122, 0, 175, 165,
+	201, 0, 174, 148, 193, 170, 200, 129, 0, 0,
+	138, 181, 191, 210, 211, 189, 208, 108, 199, 119,
+	177, 111, 197, 184, 154, 139, 140, 109, 0, 185,
+	178, 110, 173, 126, 0, 131, 124, 163, 194, 195,
+	123, 221, 115, 206, 207, 113, 116, 205, 161, 192,
+	198, 155, 152, 112, 196, 153, 151, 143, 128, 135,
+	167, 150, 168, 136, 158, 157, 159, 0, 0, 0,
+	183, 203, 222, 187, 0, 0, 214, 215, 216, 217,
+	0, 0, 0, 160, 117, 137, 179, 142, 149, 172,
+	220, 0, 176, 120, 202, 180, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	164, 0, 0, 0, 105, 114, 146, 171, 130, 204,
+	127, 0, 0, 0, 144, 0, 147, 0, 0, 182,
+	156, 0, 0, 166, 0, 0, 218, 219, 0, 0,
+	0, 0, 121, 102, 162, 188, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 55, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	118, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 209, 125,
+	0, 0, 0, 169, 0, 0, 186, 133, 132, 145,
+	0, 0, 0, 104, 0, 0, 0, 134, 106, 212,
+	190, 213, 141, 107, 0, 0, 0, 0, 0, 122,
+	0, 175, 165, 201, 0, 174, 148, 193, 170, 200,
+	129, 0, 0, 138, 181, 191, 210, 211, 189, 208,
+	108, 199, 119, 177, 111, 197, 184, 154, 139, 140,
+	109, 0, 185, 178, 110, 173, 126, 0, 131, 124,
+	163, 194, 195, 123, 221, 115, 206, 207, 113, 116,
+	205, 161, 192, 198, 155, 152, 112, 196, 153, 151,
+	143, 128, 135, 167, 150, 168, 136, 158, 157, 159,
+	0, 0, 0, 183, 203, 222, 187, 0, 0, 214,
+	215, 216, 217, 0, 0, 0, 160, 117, 137, 179,
+	142, 149, 172, 220, 0, 176, 120, 202, 180, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 164, 0, 0, 0, 105, 114, 146,
+	171, 130, 204, 127, 0, 0, 0, 144, 0, 147,
+	0, 0, 182, 156, 0, 0, 166, 0, 0, 218,
+	219, 0, 0, 0, 0, 121, 102, 162, 188, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 118, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 209, 125, 0, 0, 0, 169, 0, 0, 186,
+	133, 132, 145, 0, 0, 0, 104, 0, 0, 0,
+	134, 106, 212, 190, 213, 141, 107, 0, 0, 0,
+	0, 0, 122, 0, 175, 165, 201, 0, 174, 148,
+	193, 170, 200, 129, 0, 0, 138, 181, 191, 210,
+	211, 189, 208, 108, 199, 119, 177, 111, 197, 184,
+	154, 139, 140, 109, 0, 185, 178, 110, 173, 126,
+	0, 131, 124, 163, 194, 195, 123, 221, 115, 206,
+	207, 113, 116, 205, 161, 192, 198, 155, 152, 112,
+	196, 153, 151, 143, 128, 135, 167, 150, 168, 136,
+	158, 157, 159, 0, 0, 0, 183, 203, 222, 187,
+	0, 0, 214, 215, 216, 217, 0, 0, 0, 160,
+	117, 137, 179, 142, 149, 172, 220, 1537, 176, 120,
+	202, 180, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 164, 0, 0, 0,
+	105, 114, 146, 171, 130, 204, 127, 0, 0, 0,
+	144, 0, 147, 0, 0, 182, 156, 0, 0, 166,
+	0, 0, 218, 219, 0, 0, 0, 0, 121, 369,
+	162, 188, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1477, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 118, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 209, 125, 0, 0, 0, 169,
+	0, 0, 186, 133, 132, 145, 0, 0, 0, 104,
+	0, 0, 0, 134, 106, 212, 190, 213, 141, 107,
+	0, 0, 0, 0, 0, 122, 0, 175, 165, 201,
+	0, 174, 148, 193, 170, 200, 129, 0, 0, 138,
+	181, 191, 210, 211, 189, 208, 108, 199, 119, 177,
+	111, 197, 184, 154, 139, 140, 109, 0, 185, 178,
+	110, 173, 126, 0, 131, 124, 163, 194, 195, 123,
+	221, 115, 206, 207, 113, 116, 205, 161, 192, 198,
+	155, 152, 112, 196, 153, 151, 143, 128, 135, 167,
+	150, 168, 136, 158, 157, 159, 0, 0, 0, 183,
+	203, 222, 187, 0, 0, 214, 215, 216, 217, 0,
+	0, 0, 160, 117, 137, 179, 142, 149, 172, 220,
+	0, 176, 120, 202, 180, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 164,
+	0, 0, 0, 105, 114, 146, 171, 130, 204, 127,
+	0, 0, 0, 144, 0, 147, 0, 0, 182, 156,
+	0, 0, 166, 0, 0, 218, 219, 0, 0, 0,
+	0, 121, 369, 162, 188, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1279, 0, 0, 0, 0, 0, 118,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 209, 125, 0,
+	0, 0, 169, 0, 0, 186, 133, 132, 145, 0,
+	0, 0, 104, 0, 0, 0, 134, 106, 212, 190,
+	213, 141, 107, 0, 0, 0, 0, 0, 122, 0,
+	175, 165, 201, 0, 174, 148, 193, 170, 200, 129,
+	0, 0, 138, 181, 191, 210, 211, 189, 208, 108,
+	199, 119, 177, 111, 197, 184, 154, 139, 140, 109,
+	0, 185, 178, 110, 173, 126, 0, 131, 124, 163,
+	194, 195, 123, 221, 115, 206, 207, 113, 116, 205,
+	161, 192, 198, 155, 152, 112, 196, 153, 151, 143,
+	128, 135, 167, 150, 168, 136, 158, 157, 159, 0,
+	0, 0, 183, 203, 222, 187, 0, 0, 214, 215,
+	216, 217, 0, 0, 0, 160, 117, 137, 179, 142,
+	149, 172, 220, 0, 176, 120, 202, 180, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 164, 0, 0, 0, 105, 114, 146, 171,
+	130, 204, 127, 0, 0, 0, 144, 0, 147, 0,
+	0, 182, 156, 0, 0, 166, 0, 0, 218, 219,
+	0, 0, 0, 0, 121, 369, 162, 188, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1055, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 118, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	209, 125, 0, 0, 0, 169, 0, 0, 186, 133,
+	132, 145, 0, 0, 0, 104, 0, 0, 0, 134,
+	106, 212, 190, 213, 141, 107, 0, 0, 0, 0,
+	0, 122, 0, 175, 165, 201, 0, 174, 148, 193,
+	170, 200, 129, 0, 0, 138, 181, 191, 210, 211,
+	189, 208, 108, 199, 119, 177, 111, 197, 184, 154,
+	139, 140, 109, 0, 185, 178, 110, 173, 126, 0,
+	131, 124, 163, 194, 195, 123, 221, 115, 206, 207,
+	113, 116, 205, 161, 192, 198, 155, 152, 112, 196,
+	153, 151, 143, 128, 135, 167, 150, 168, 136, 158,
+	157, 159, 0, 0, 0, 183, 203, 222, 187, 0,
+	0, 214, 215, 216, 217, 0, 0, 0, 160, 117,
+	137, 179, 142, 149, 172, 220, 0, 176, 120, 202,
+	180, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 164, 0, 0, 0, 105,
+	114, 146, 171, 130, 204, 127, 0, 0, 0, 144,
+	0, 147, 0, 0, 182, 156, 0, 0, 166, 0,
+	0, 218, 219, 0, 0, 0, 0, 121, 102, 162,
+	188, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 665, 0, 0,
+	0, 0, 0, 0, 0, 118, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 209, 125, 0, 0, 0, 169, 0,
+	0, 186, 133, 132, 145, 0, 0, 0, 104, 0,
+	0, 0, 134, 106, 212, 190, 213, 141, 107, 0,
+	0, 0, 0, 0, 122, 0, 175, 165, 201, 0,
+	174, 148, 193, 170, 200, 129, 0, 0, 138, 181,
+	191, 210, 211, 189, 208, 108, 199, 119, 177, 111,
+	197, 184, 154, 139, 140, 109, 0, 185, 178, 110,
+	173, 126, 0, 131, 124, 163, 194, 195, 123, 221,
+	115, 206, 207, 113, 116, 205, 161, 192, 198, 155,
+	152, 112, 196, 153, 151, 143, 128, 135, 167, 150,
+	168, 136, 158, 157, 159, 0, 0, 0, 183, 203,
+	222, 187, 0, 0, 214, 215, 216, 217, 0, 0,
+	0, 160, 117, 137, 179, 142, 149, 172, 220, 0,
+	176, 120, 202, 180, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 164, 0,
+	0, 0, 105, 114, 146, 171, 130, 204, 127, 0,
+	0, 0, 144, 0, 147, 0, 0, 182, 156, 0,
+	0, 166, 0, 0, 218, 219, 0, 0, 0, 0,
+	121, 369, 162, 188, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	564, 0, 0, 0, 0, 0, 0, 0, 118, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 209, 125, 0, 0,
+	0, 169, 0, 0, 186, 133, 132, 145, 0, 0,
+	0, 104, 0, 0, 0, 134, 106, 212, 190, 213,
+	141, 107, 0, 0, 0, 0, 0, 122, 0, 175,
+	165, 201, 0, 174, 148, 193, 170, 200, 129, 0,
+	0, 138, 181, 191, 210, 211, 189, 208, 108, 199,
+	119, 177, 111, 197, 184, 154, 139, 140, 109, 0,
+	185, 178, 110, 173, 126, 0, 131, 124, 163, 194,
+	195, 123, 221, 115, 206, 207, 113, 116, 205, 161,
+	192, 198, 155, 152, 112, 196, 153, 151, 143, 128,
+	135, 167, 150, 168, 136, 158, 157, 159, 0, 0,
+	0, 183, 203, 222, 187, 0, 0, 214, 215, 216,
+	217, 0, 0, 0, 160, 117, 137, 179, 142, 149,
+	172, 220, 0, 176, 120, 202, 180, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 164, 0, 0, 0, 105, 114, 146, 171, 130,
+	204, 127, 0, 0, 0, 144, 0, 147, 0, 0,
+	182, 156, 0, 0, 166, 0, 0, 218, 219, 0,
+	0, 0, 0, 121, 795, 162, 188, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 118, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 794, 0, 209,
+	125, 0, 0, 0, 169, 0, 0, 186, 133, 132,
+	145, 0, 0, 0, 104, 0, 0, 0, 134, 106,
+	212, 190, 213, 141, 107, 0, 0, 0, 0, 0,
+	122, 0, 175, 165, 201, 0, 174, 148, 193, 170,
+	200, 129, 0, 0, 138, 181, 191, 210, 211, 189,
+	208, 108, 199, 119, 177, 111, 197, 184, 154, 139,
+	140, 109, 0, 185, 178, 110, 173, 126, 0, 131,
+	124, 163, 194, 195, 123, 221, 115, 206, 207, 113,
+	116, 205, 161, 192, 198, 155, 152, 112, 196, 153,
+	151, 143, 128, 135, 167, 150, 168, 136, 158, 157,
+	159, 0, 0, 0, 183, 203, 222, 187, 0, 0,
+	214, 215, 216, 217, 0, 0, 0, 160, 117, 137,
+	179, 142, 149, 172, 220, 0, 176, 120, 202, 180,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 164, 0, 0, 0, 105, 114,
+	146, 171, 130, 204, 127, 0, 0, 0, 144, 0,
+	147, 0, 0, 182, 156, 0, 0, 166, 0, 0,
+	218, 219, 0, 0, 0, 0, 121, 102, 162, 188,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 118, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 209, 125, 0, 0, 0, 169, 0, 0,
+	186, 133, 132, 145, 0, 0, 0, 104, 0, 0,
+	0, 134, 106, 212, 190, 213, 141, 107, 0, 0,
+	0, 0, 0, 122, 0, 175, 165, 201, 0, 174,
+	148, 193, 170, 200, 129, 0, 0, 138, 181, 191,
+	210, 211, 189, 208, 108, 199, 119, 177, 111, 197,
+	184, 154, 139, 140, 109, 0, 185, 178, 110, 173,
+	126, 0, 131, 124, 163, 194, 195, 123, 221, 115,
+	206, 207, 113, 116, 205, 161, 192, 198, 155, 152,
+	112, 196, 153, 151, 143, 128, 135, 167, 150, 168,
+	136, 158, 157, 159, 0, 0, 0, 183, 203, 222,
+	187, 0, 0, 214, 215, 216, 217, 0, 0, 0,
+	160, 117, 137, 179, 142, 149, 172, 220, 773, 176,
+	120, 202, 180, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 105, 114, 146, 171, 130, 204, 164, 0, 0,
+	0, 0, 663, 0, 0, 0, 0, 127, 0, 0,
+	0, 144, 0, 147, 0, 0, 182, 156, 0, 0,
+	661, 0, 0, 0, 219, 0, 0, 0, 0, 121,
+	102, 162, 188, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 665,
+	0, 0, 0, 0, 0, 0, 0, 118, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 209, 125, 0, 0, 0,
+	169, 0, 0, 186, 133, 132, 145, 0, 0, 0,
+	104, 0, 0, 0, 134, 106, 212, 190, 213, 141,
+	107, 0, 0, 0, 0, 0, 122, 0, 175, 165,
+	201, 0, 174, 148, 193, 170, 200, 129, 0, 0,
+	138, 181, 191, 210, 211, 189, 208, 108, 199, 119,
+	177, 111, 197, 184, 154, 139, 140, 109, 0, 185,
+	178, 110, 173, 126, 0, 131, 124, 163, 194, 195,
+	123, 221, 115, 206, 207, 113, 116, 205, 161, 192,
+	198, 155, 152, 112, 196, 153, 151, 143, 128, 135,
+	167, 150, 168, 136, 158, 157, 159, 0, 0, 0,
+	183, 203, 222, 187, 0, 0, 214, 215, 216, 217,
+	0, 0, 0, 160, 117, 137, 179, 142, 149, 172,
+	220, 0, 176, 120, 202, 180, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 164, 0, 0, 105, 114, 146, 171, 130, 204,
+	641, 127, 0, 0, 0, 144, 0, 147, 0, 0,
+	182, 156, 0, 0, 166, 0, 0, 218, 219, 0,
+	0, 0, 0, 121, 102, 162, 188, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 118, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 209,
+	125, 0, 0, 0, 169, 0, 0, 186, 133, 132,
+	145, 0, 0, 0, 104, 0, 0, 0, 134, 106,
+	212, 190, 213, 141, 107, 0, 0, 0, 0, 0,
+	122, 0, 175, 165, 201, 0, 174, 148, 193, 170,
+	200, 129, 0, 0, 138, 181, 191, 210, 211, 189,
+	208, 108, 199, 119, 177, 111, 197, 184, 154, 139,
+	140, 109, 0, 185, 178, 110, 173, 126, 0, 131,
+	124, 163, 194, 195, 123, 221, 115, 206, 207, 113,
+	116, 205, 161, 192, 198, 155, 152, 112, 196, 153,
+	151, 143, 128, 135, 167, 150, 168, 136, 158, 157,
+	159, 0, 0, 0, 183, 203, 222, 187, 0, 0,
+	214, 215, 216, 217, 0, 0, 0, 160, 117, 137,
+	179, 142, 149, 172, 220, 0, 176, 120, 202, 180,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 164, 0, 0, 0, 105, 114,
+	146, 171, 130, 204, 127, 0, 0, 0, 144, 0,
+	147, 0, 0, 182, 156, 0, 0, 166, 0, 0,
+	218, 219, 0, 0, 0, 0, 121, 487, 162, 188,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 118, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 484, 125, 0, 0, 486, 169, 0, 0,
+	186, 133, 132, 145, 0, 0, 0, 104, 0, 0,
+	0, 134, 106, 212, 190, 213, 141, 107, 0, 0,
+	0, 0, 0, 122, 0, 175, 165, 201, 0, 174,
+	148, 193, 170, 200, 129, 0, 0, 138, 181, 191,
+	210, 211, 189, 208, 108, 199, 119, 177, 111, 197,
+	184, 154, 139, 140, 109, 0, 185, 178, 110, 173,
+	126, 0, 131, 124, 163, 194, 195, 123, 221, 115,
+	206, 207, 113, 116, 205, 161, 192, 198, 155, 152,
+	112, 196, 153, 151, 143, 128, 135, 167, 150, 168,
+	136, 158, 157, 159, 0, 0, 0, 183, 203, 222,
+	187, 0, 0, 214, 215, 216, 217, 0, 0, 0,
+	160, 117, 137, 179, 142, 149, 172, 220, 0, 176,
+	120, 202, 180, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 164, 0, 0,
+	0, 105, 114, 146, 171, 130, 204, 127, 0, 0,
+	0, 144, 0, 147, 0, 0, 182, 156, 0, 0,
+	166, 0, 0, 218, 219, 0, 0, 0, 0, 121,
+	369, 162, 188, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 476, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 118, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 209, 125, 0, 0, 0,
+	169, 0, 0, 186, 133, 132, 145, 0, 0, 0,
+	104, 0, 0, 0, 134, 106, 212, 190, 213, 141,
+	107, 0, 0, 0, 0, 0, 122, 0, 175, 165,
+	201, 0, 174, 148, 193, 170, 200, 129, 0, 0,
+	138, 181, 191, 210, 211, 189, 208, 108, 199, 119,
+	177, 111, 197, 184, 154, 139, 140, 109, 0, 185,
+	178, 110, 173, 126, 0, 131, 124, 163, 194, 195,
+	123, 221, 115, 206, 207, 113, 116, 205, 161, 192,
+	198, 155, 152, 112, 196, 153, 151, 143, 128, 135,
+	167, 150, 168, 136, 158, 157, 159, 0, 0, 0,
+	183, 203, 222, 187, 0, 0, 214, 215, 216, 217,
+	0, 0, 0, 160, 117, 137, 179, 142, 149, 172,
+	220, 0, 176, 120, 202, 180, 0, 0, 0, 0,
+	0, 0, 0, 353, 0, 0, 0, 0, 0, 0,
+	164, 0, 0, 0, 105, 114, 146, 171, 130, 204,
+	127, 0, 0, 0, 144, 0, 147, 0, 0, 182,
+	156, 0, 0, 166, 0, 0, 218, 219, 0, 0,
+	0, 0, 121, 102, 162, 188, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	118, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 209, 125,
+	0, 0, 0, 169, 0, 0, 186, 133, 132, 145,
+	0, 0, 0, 104, 0, 0, 0, 134, 106, 212,
+	190, 213, 141, 107, 0, 0, 0, 0, 0, 122,
+	0, 175, 165, 201, 0, 174, 148, 193, 170, 200,
+	129, 0, 0, 138, 181, 191, 210, 211, 189, 208,
+	108, 199, 119, 177, 111, 197, 184, 154, 139, 140,
+	109, 0, 185, 178, 110, 173, 126, 0, 131, 124,
+	163, 194, 195, 123, 221, 115, 206, 207, 113, 116,
+	205, 161, 192, 198, 155, 152, 112, 196, 153, 151,
+	143, 128, 135, 167, 150, 168, 136, 158, 157, 159,
+	0, 0, 0, 183, 203, 222, 187, 0, 0, 214,
+	215, 216, 217, 0, 0, 0, 160, 117, 137, 179,
+	142, 149, 172, 220, 0, 176, 120, 202, 180, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 164, 0, 0, 0, 105, 114, 146,
+	171, 130, 204, 127, 0, 0, 0, 144, 0, 147,
+	0, 0, 182, 156, 0, 0, 166, 0, 0, 218,
+	219, 0, 0, 0, 0, 121, 102, 162, 188, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 118, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 99,
+	0, 209, 125, 0, 0, 0, 169, 0, 0, 186,
+	133, 132, 145, 0, 0, 0, 104, 0, 0, 0,
+	134, 106, 212, 190, 213, 141, 107, 0, 0, 0,
+	0, 0, 122, 0, 175, 165, 201, 0, 174, 148,
+	193, 170, 200, 129, 0, 0, 138, 181, 191, 210,
+	211, 189, 208, 108, 199, 119, 177, 111, 197, 184,
+	154, 139, 140, 109, 0, 185, 178, 110, 173, 126,
+	0, 131, 124, 163, 194, 195, 123, 221, 115, 206,
+	207, 113, 116, 205, 161, 192, 198, 155, 152, 112,
+	196, 153, 151, 143, 128, 135, 167, 150, 168, 136,
+	158, 157, 159, 0, 0, 0, 183, 203, 222, 187,
+	0, 0, 214, 215, 216, 217, 0, 0, 0, 160,
+	117, 137, 179, 142, 149, 172, 220, 0, 176, 120,
+	202, 180, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 164, 0, 0, 0,
+	105, 114, 146, 171, 130, 204, 127, 0, 0, 0,
+	144, 0, 147, 0, 0, 182, 156, 0, 0, 166,
+	0, 0, 218, 219, 0, 0, 0, 0, 121, 369,
+	162, 188, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 118, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 209, 125, 0, 0, 0, 169,
+	0, 0, 186, 133, 132, 145, 0, 0, 0, 104,
+	0, 0, 0, 134, 106, 212, 190, 213, 141, 107,
+	0, 0, 0, 0, 0, 122, 0, 175, 165, 201,
+	0, 174, 148, 193, 170, 200, 129, 0, 0, 138,
+	181, 191, 210, 211, 189, 208, 108, 199, 119, 177,
+	111, 197, 184, 154, 139, 140, 109, 0, 185, 178,
+	110, 173, 126, 0, 131, 124, 163, 194, 195, 123,
+	221, 115, 206, 207, 113, 116, 205, 161, 192, 198,
+	155, 152, 112, 196, 153, 151, 143, 128, 135, 167,
+	150, 168, 136, 158, 157, 159, 0, 0, 0, 183,
+	203, 222, 187, 0, 0, 214, 215, 216, 217, 0,
+	0, 0, 160, 117, 137, 179, 142, 149, 172, 220,
+	0, 176, 120, 202, 180, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 164,
+	0, 0, 0, 105, 114, 146, 171, 130, 204, 127,
+	0, 0, 0, 144, 0, 147, 0, 0, 182, 156,
+	0, 0, 166, 0, 0, 218, 219, 0, 0, 0,
+	0, 121, 102, 162, 188, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 118,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 209, 125, 0,
+	0, 0, 169, 0, 0, 186, 133, 132, 145, 0,
+	0, 0, 104, 0, 0, 0, 134, 106, 212, 190,
+	213, 141, 107, 0, 0, 0, 0, 0, 122, 0,
+	175, 165, 201, 0, 174, 148, 193, 170, 200, 129,
+	0, 0, 138, 181, 191, 210, 211, 189, 208, 108,
+	199, 119, 177, 111, 197, 184, 154, 139, 140, 109,
+	0, 185, 178, 110, 173, 126, 0, 131, 124, 163,
+	194, 195, 123, 221, 115, 206, 207, 113, 116, 205,
+	161, 192, 198, 155, 152, 112, 196, 153, 151, 143,
+	128, 135, 167, 150, 168, 136, 158, 157, 159, 0,
+	0, 0, 183, 203, 222, 187, 0, 0, 214, 215,
+	216, 217, 0, 0, 0, 160, 117, 137, 179, 142,
+	149, 172, 220, 0, 176, 120, 202, 180, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 164, 0, 0, 0, 105, 114, 146, 171,
+	130, 204, 127, 0, 0, 0, 144, 0, 147, 0,
+	0, 182, 156, 0, 0, 166, 0, 0, 218, 219,
+	0, 0, 0, 0, 121, 287, 162, 188, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 118, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	209, 125, 0, 0, 0, 169, 0, 0, 186, 133,
+	132, 145, 0, 0, 0, 104, 0, 0, 0, 134,
+	106, 212, 190, 213, 141, 107, 0, 0, 0, 0,
+	0, 122, 0, 175, 165, 201, 0, 174, 148, 193,
+	170, 200, 129, 0, 0, 138, 181, 191, 210, 211,
+	189, 208, 108, 199, 119, 177, 111, 197, 184, 154,
+	139, 140, 109, 0, 185, 178, 110, 173, 126, 0,
+	131, 124, 163, 194, 195, 123, 221, 115, 206, 207,
+	113, 116, 205, 161, 192, 198, 155, 152, 112, 196,
+	153, 151, 143, 128, 135, 167, 150, 168, 136, 158,
+	157, 159, 0, 0, 0, 183, 203, 222, 187, 0,
+	0, 214, 215, 216, 217, 0, 0, 0, 160, 117,
+	137, 179, 142, 149, 172, 220, 0, 176, 120, 202,
+	180, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 164, 0, 0, 0, 105,
+	114, 146, 171, 130, 204, 127, 0, 0, 0, 144,
+	0, 147, 0, 0, 182, 156, 0, 0, 166, 0,
+	0, 0, 219, 0, 0, 0, 0, 121, 102, 162,
+	188, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 118, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 209, 125, 0, 0, 0, 169, 0,
+	0, 186, 133, 132, 145, 0, 0, 0, 104, 0,
+	0, 0, 134, 106, 212, 190, 213, 141, 107, 0,
+	0, 0, 0, 0, 122, 0, 175, 165, 201, 0,
+	174, 148, 193, 170, 200, 129, 0, 0, 138, 181,
+	191, 210, 211, 189, 208, 108, 199, 119, 177, 111,
+	197, 184, 154, 139, 140, 109, 0, 185, 178, 110,
+	173, 126, 0, 131, 124, 163, 194, 195, 123, 221,
+	115, 206, 207, 113, 116, 205, 161, 192, 198, 155,
+	152, 112, 196, 153, 151, 143, 128, 135, 167, 150,
+	168, 136, 158, 157, 159, 0, 0, 0, 183, 203,
+	222, 187, 0, 0, 214, 215, 216, 217, 0, 0,
+	0, 160, 117, 137, 179, 142, 149, 172, 220, 0,
+	176, 120, 202, 180, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 105, 114, 146, 171, 130, 204,
+}
+
+var yyPact = [...]int{
+	2193, -1000, -132, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1652, 1678, -1000, -1000, -1000, -1000, -1000,
+	-1000, 567, 1020, 413, 352, 37, 18725, 1401, 140, 140,
+	350, 1578, 19251, -1000, 24, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1265, -1000, -1000, -1000, -1000, -1000, 1641, 1650,
+	1296, 1631, 1536, -1000, 9164, 267, 15032, 18462, 8618, -1000,
+	19251, 18988, 18199, 331, 330, 324, 19251, -108, 17936, 19251,
+	19251, 19251, 340, 18988, 18988, 197, 197, 197, -1000, 337,
+	19251, 19251, -1000, 19251, 202, 202, 202, 202, 202, 19251,
+	-1000, 416, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 281, 302, 1038, -1000, 1502, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1668, 19251, 1501,
+	1576, 136, 6044, 6044, 6044, 6044, 30, 6044, -71, 1400,
+	-1000, -1000, -1000, -1000, 6044, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 832, 1580, 10260, 10260, 1652,
+	-1000, 1265, -1000, -1000, -1000, 1569, -1000, -1000, 635, 1667,
+	-1000, 12129, 412, -1000, 10260, 2821, 1254, -1000, -1000, 1254,
+	-1000, -1000, 367, -1000, -1000, 11328, 11328, 888, -136, 11328,
+	11328, 11328, 11328, 11328, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1254, -1000,
+	9987, 1254, 1254, 1254, 1254, 1254, 1254, 1254, 1254, 10260,
+	1254, 1254, 1254, 1254, 1254, 1254, 1254, 1254, 1254, 1254,
+	1254, 1254, 1254, 1254, 17673, 1007, 1315, -1000, -1000, -1000,
+	1621, 13181, 17409, 19251, 1136, -1000, 1243, 8332, -72, -1000,
+	-1000, -1000, 570, 13707, -1000, -1000, -1000, 1568, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 19251, 1208, 41, -1000, 164, 1505,
+	18988, 18988, 1622, 395, 19777, 1257, 604, 1638, 1360, 1621,
+	-1000, 197, 172, 1251, 1500, 603, 1499, 19251, 17136, 6044,
+	-1000, 299, 19251, 1610, 18988, 19251, 1498, 1497, -1000, 8046,
+	19251, 19514, 18988, 16873, 140, -1000, 18988, -1000, 6044, 6044,
+	6044, 6044, 6044, 6044, 6044, 6044, -1000, -1000, -1000, -1000,
+	-1000, -1000, 6044, 6044, -1000, -53, -1000, 19251, -1000, -1000,
+	-1000, -1000, 1673, 452, 611, 409, 1248, -1000, 777, 1641,
+	832, 1536, 13444, 1412, -1000, -1000, 19251, -1000, 10260, 10260,
+	782, -1000, 16610, -1000, -1000, 6902, 458, 10533, 679, 574,
+	11328, 11328, 11328, 11328, 11328, 11328, 11328, 11328, 11328, 11328,
+	11328, 11328, 11328, 11328, 11328, 11328, 847, 235, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1496, -1000, 1265, 1045,
+	1045, 405, 405, -1000, 10260, 405, 405, 405, 405, 5132,
+	8891, 832, 933, 789, 9987, 9164, 9164, 10260, 10260, 19514,
+	19514, 9164, 1628, 590, 789, 19514, -1000, 832, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 9164, 9164, 9164, 9164,
+	1533, 19251, -1000, 19514, 15032, 15032, 15032, 15032, 15032, -1000,
+	1429, 1424, -1000, 1417, 1414, 1425, 19251, -1000, 1201, 13181,
+	407, 1254, -1000, 16347, -1000, -1000, 1533, 1031, 15032, 19251,
+	-1000, -1000, 7760, 1243, -72, 1210, -1000, -69, -78, 9710,
+	434, -1000, -1000, -1000, -1000, 1584, 6616, 11856, 1382, 2350,
+	-10, -46, -1000, -1000, -1000, -1000, 477, 1350, -1000, -1000,
+	-1000, 1350, 131, 1350, 1350, 1350, -25, -25, -25, -25,
+	-1000, -1000, -1000, -1000, -1000, 1381, 1375, -1000, 1350, 1350,
+	1350, -1000, 1356, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1366, 139, 1366, 1352, 1352, 16084, -1000, 1399, 1395, 1265,
+	19251, 19251, 1620, -1000, 275, 19251, -1000, 1608, 18988, -1000,
+	164, 290, 19251, 257, -1000, 1495, 1515, 1492, 6044, 1604,
+	6044, -1000, 856, 19251, -1000, 255, 19251, -1000, -1000, 1394,
+	6044, -1000, -1000, -1000, -1000, -1000, 467, 463, -1000, 408,
+	1169, -1000, -1000, 19251, -1000, -1000, -1000, 1116, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 551, -1000,
+	-1000, -1000, -1000, 1547, 10260, 10260, 7474, 10260, -1000, -1000,
+	-1000, 1580, -1000, 1628, 1645, -1000, 1562, 1559, 9164, -1000,
+	-1000, 458, 559, -1000, -1000, 788, -1000, -1000, -1000, -1000,
+	398, 1254, -1000, 2369, 1254, 1254, -1000, -1000, -1000, -1000,
+	679, 11328, 11328, 11328, 2187, 2369, 3081, 855, 2068, 405,
+	2068, 767, 767, 386, 386, 386, 386, 386, 826, 826,
+	-1000, -1000, -1000, -140, 421, 1350, -17, -6, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 832, -1000, -1000, 90, -1000, 832,
+	9164, 1224, -1000, -1000, 10260, -1000, 832, 1198, 1198, 658,
+	766, 1250, 1236, 1198, 9164, 593, -1000, 10260, 832, -1000,
+	1198, 832, 1198, 1198, 1278, 1254, -1000, 1212, -1000, 565,
+	1315, 1379, 1390, 1303, -1000, -1000, -1000, -1000, 1421, -1000,
+	1420, -1000, -1000, -1000, -1000, -1000, 322, 319, 316, 18988,
+	-1000, 1659, 15032, 979, -1000, -1000, 1210, -72, -84, -1000,
+	-1000, -1000, 789, -1000, 1490, 1531, 1556, -1000, 1222, 1374,
+	5758, -1000, -1000, -1000, -1000, -1000, -1000, 896, -1000, 632,
+	-1000, 1373, 74, 18988, 1372, 1415, 111, 97, 184, 1488,
+	97, -1000, -1000, 19251, -1000, 682, 11593, 1666, 834, -1000,
+	-1000, -1000, 110, -1000, 108, 886, 19251, -1000, -1000, 1367,
+	1619, -1000, 1484, 18988, 265, -1000, -1000, -137, -138, 86,
+	-48, -1000, 18988, 15821, -1000, -1000, 830, -25, -25, 1350,
+	-25, -1000, -1000, 434, 1567, 1483, 434, 434, 434, 863,
+	863, 1512, 1512, -1000, -1000, 18988, -1000, 824, -1000, 1366,
+	-1000, -1000, 822, -1000, 1363, 19251, 19251, 19251, -1000, 1618,
+	1360, 1265, 276, 50, 609, 236, 562, 556, -1000, 19251,
+	38, -1000, 1480, 884, 1359, 627, -1000, -1000, 1476, -1000,
+	-1000, -1000, -1000, 7188, -1000, -1000, -1000, -1000, -1000, -1000,
+	681, 1268, 388, 190, 1475, -1000, 1528, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1403, 1526, 437, 61,
+	-1000, 19251, -1000, 650, 650, 7474, -1000, 18988, 130, -1000,
+	600, 19251, 19251, 1542, 789, 789, 394, -1000, -1000, 19251,
+	-1000, -1000, -1000, -1000, 1100, -1000, -1000, -1000, 6330, 9164,
+	11328, 11328, -1000, 2187, 2369, 2983, -1000, 11328, 11328, -146,
+	-1000, 18988, 1512, 1350, -1000, -1000, -1000, -1000, 1198, 9164,
+	789, -1000, -1000, -1000, 306, 847, 306, 11328, 11328, 11328,
+	11328, -118, 1019, 563, -1000, 10260, 631, -1000, -1000, -1000,
+	-1000, -1000, 1389, 19514, 1254, -1000, 12918, 18988, 1652, 19514,
+	10260, 10260, -1000, -1000, 10260, 1358, -1000, 10260, -1000, -1000,
+	-1000, 1254, 1254, 1254, 1161, -1000, 1652, 979, -1000, -1000,
+	-1000, -83, -90, -1000, -1000, -1000, 1649, 621, -1000, 5472,
+	19251, -1000, 5472, 1664, -1000, 1474, -1000, 13970, 15558, 272,
+	10260, 18988, 18988, -1000, 1473, 1469, -1000, -1000, 1467, 1192,
+	-1000, -1000, 427, 414, 727, 406, -1000, -1000, -1000, 11328,
+	-1000, -1000, 1254, -1000, -1000, 1254, 1254, 1254, 392, 189,
+	438, -1000, -1000, -1000, -1000, 1357, 10260, 1263, -1000, 158,
+	-1000, 1596, 70, 815, -1000, -148, -1000, -1000, 1356, 921,
+	1190, 919, 434, 434, -25, 434, -1000, 493, -1000, -1000,
+	-1000, -1000, 1184, -1000, 1177, -1000, -1000, -5, -8, -1000,
+	1175, -1000, 1173, 15295, 18988, 1235, 1355, 1354, 1265, -1000,
+	1519, -1000, 19251, -1000, 1353, -1000, -1000, 12655, -1000, 784,
+	-1000, -1000, -1000, -1000, 562, 648, -1000, 18988, 387, 1466,
+	-1000, 18988, 19251, 257, 18988, 1167, -1000, 560, -1000, 114,
+	114, 114, 18988, 896, 632, -1000, 18988, 74, 1380, -1000,
+	-1000, -1000, -1000, 18988, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 19251, -1000, -1000, -1000, -1000,
+	-1000, 18988, -70, 19251, -1000, 18988, 361, 149, 1465, 1524,
+	6044, -1000, -1000, -1000, -1000, -1000, -1000, -129, -1000, 878,
+	10260, -1000, -1000, -1000, 7188, -1000, 1659, 15032, -1000, -1000,
+	832, 2675, 2675, -1000, 11328, 2369, 2369, -1000, -1000, -1000,
+	-1000, -1000, -1000, 832, 1350, 1350, -1000, 1350, 1352, -1000,
+	1350, 15, 1350, 9, 832, 832, 2535, 2941, 2450, 2920,
+	1254, -115, -1000, 789, 10260, -1000, 1579, 942, 1046, -1000,
+	-1000, 9437, 832, 1171, 384, 1161, 1641, -1000, 789, 789,
+	789, 18988, 789, 18988, 18988, 18988, 14769, 18988, 1641, -1000,
+	-1000, -1000, -1000, 14496, 1254, 1254, 1254, 5758, 1159, -1000,
+	438, 438, 1155, -1000, 1605, 1254, 10260, 18988, 1340, 73,
+	1338, 1385, 97, 959, 1324, 1323, -1000, -1000, -1000, 5472,
+	235, 235, -1000, -1000, 235, 2675, 737, 772, 768, 7188,
+	-1000, 1254, -1000, -1000, -1000, 728, 171, -1000, 18988, 915,
+	10260, 643, -1000, -1000, -152, -153, -1000, -1000, -1000, -1000,
+	758, -1000, -1000, -1000, 434, -1000, -1000, -1000, -25, 873,
+	-25, 1463, 1462, 756, -1000, 749, 1216, 19251, 1317, 13970,
+	18988, 13970, 13970, -1000, -1000, 1433, -1000, 863, -1000, -1000,
+	-1000, -1000, 1461, 1629, 18988, 1322, 143, 276, 1444, 11328,
+	-1000, 610, -1000, 18988, 1148, 1635, -1000, 1002, -1000, 7188,
+	5472, 18988, -1000, -1000, 18988, 18988, 187, -1000, 1321, -1000,
+	-1000, -1000, -1000, 466, 1459, 1584, 1600, 18988, 896, 632,
+	1380, 18988, -74, 19251, -1000, -1000, -1000, 789, 1656, 1152,
+	-1000, -1000, -1000, 2369, -1000, -1000, 152, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 11328, 11328, -1000, 11328,
+	11328, 11328, 832, 862, 789, 71, -1000, 1254, -1000, -1000,
+	1055, 18988, 18988, -1000, -1000, 1138, 1132, 1132, 1132, 407,
+	-1000, -1000, 18988, 12392, 13970, 11063, 10260, 18988, 5472, -1000,
+	-1000, 476, 13970, 1456, 9164, 791, 1126, 18988, 14233, 10260,
+	18988, -1000, -1000, 18988, 18988, 1107, -140, -140, -140, -1000,
+	-1000, 832, 832, 832, 1254, 668, -1000, -1000, -1000, 1120,
+	132, 912, -1000, -1000, -1000, -1000, -1000, -1000, 911, -1000,
+	434, -1000, 434, -1000, -1000, 907, 904, 13970, 18988, 1269,
+	19251, 1118, 1319, 1113, 1110, -1000, 1455, 1105, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1116, 10260, 1318, -1000, 1316,
+	2369, -1000, 1444, 36, 804, 170, 18988, -1000, -1000, 1314,
+	1312, 1311, 1299, 18988, 78, 1590, -1000, -1000, 1254, 273,
+	465, 1454, 1584, 1654, 1643, -1000, -1000, 2675, 2675, 2675,
+	2675, 2417, -1000, -1000, 1672, -1000, 1254, -1000, 1265, 370,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1254, 739, 10260, 1254,
+	13970, 18988, 538, 963, -1000, 2369, -1000, 933, 711, 1107,
+	672, -1000, -1000, 1453, 516, 854, 1452, -1000, -1000, -1000,
+	-1000, 1451, 832, -1000, 192, 1095, 18988, 1288, 846, 1286,
+	1076, 1091, -1000, 1518, -1000, -1000, -1000, -1000, 832, -1000,
+	-1000, -1000, -1000, 132, 241, -1000, -1000, -1000, -1000, -1000,
+	1071, 1285, 18988, 1284, 1436, 13970, 1517, 116, -1000, -1000,
+	827, 10260, 7188, -1000, 18988, -1000, -1000, -1000, 1254, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	229, -1000, 1450, -1000, 13970, 13970, 13970, 13970, 1068, -1000,
+	1617, 1438, 1523, 68, 1283, 78, 1587, -1000, -1000, -1000,
+	10260, 10260, -1000, -1000, -1000, -1000, 832, 117, -123, 19514,
+	1046, 832, 18988, -1000, 1523, -1000, 933, 10260, 18988, 521,
+	832, 1002, 703, 284, 11063, -1000, 987, -1000, -1000, 689,
+	-1000, -1000, 1449, -1000, -1000, 19251, 188, 1065, 18988, -1000,
+	18988, 18988, 1660, 18988, 828, -1000, -1000, -1000, 1436, 13970,
+	1281, 13970, 31, 1280, 1059, 116, 1447, -1000, -1000, -1000,
+	-1000, 820, 1042, -1000, 829, 1444, 10260, 19514, 19514, -1000,
+	1040, 1033, 1025, 1023, 1251, 1439, -1000, 1279, 1015, -1000,
+	18988, 1276, 13970, -1000, 1438, 789, 953, -1000, 1540, -121,
+	-126, 926, -1000, -1000, 1015, -1000, 933, 832, 662, -1000,
+	1254, 1254, -1000, 18988, -1000, -1000, 1274, 19251, 186, 1009,
+	1000, 857, -1000, 1272, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 31, 998, 13970, 996, 1659, 18988, 18988, 1436, -1000,
+	1437, -1000, -1000, 7188, -1000, -1000, 791, -1000, -1000, 116,
+	1517, 116, 632, 1515, 1271, 683, -1000, 1523, 1555, 13970,
+	985, -1000, -1000, 1539, -1000, -1000, -1000, -1000, 1254, 18988,
+	11063, 636, 18988, 1264, 19251, 181, 1660, -1000, 10260, 1659,
+	1436, 983, 116, -1000, -1000, 981, 31, -1000, -1000, 46,
+	-1000, 116, -1000, -1000, -1000, 433, -1000, 125, 977, 632,
+	1514, 18988, 832, 963, 832, 957, 18988, 1262, 19251, -1000,
+	676, 116, 31, 1436, -1000, -1000, 1659, -1000, -1000, -1000,
+	1435, 52, 1254, -1000, -1000, -124, 832, -1000, -1000, -1000,
+	-1000, 946, 18988, 1217, -1000, -1000, 1659, 31, -1000, 848,
+	163, 10260, -127, -1000, -1000, 937, 18988, -1000, 1659, -1000,
+	10798, -1000, 933, -1000, -1000, 935, -1000, 2284, 832, 18988,
+	-1000, -1000, -1000, 10260, -1000, 516, 18988, 18988, 933, 18988,
+	5472, -1000, -1000, 18988,
+}
+
+var yyPgo = [...]int{
+	0, 1851, 83, 1469, 1850, 1849, 1848, 1847, 1846, 1845,
+	1844, 1843, 1840, 1839, 1838, 1837, 1836, 1833, 1586, 1831,
+	47, 137, 1830, 99, 1824, 1822, 1821, 1820, 1818, 1817,
+	1816, 1815, 1814, 1813, 1811, 180, 1810, 1809, 1807, 123,
+	1806, 135, 1805, 1804, 93, 132, 35, 88, 417, 1803,
+	57, 122, 144, 1802, 106, 1801, 1800, 66, 1799, 129,
+	1798, 1794, 3302, 1793, 1792, 40, 3, 1791, 102, 1788,
+	1786, 128, 2275, 1784, 1783, 1780, 29, 1779, 1778, 108,
+	5, 32, 33, 42, 1777, 58, 30, 1776, 107, 1775,
+	1773, 1772, 1771, 73, 1768, 110, 46, 1767, 1, 6,
+	49, 109, 1766, 127, 121, 86, 55, 25, 139, 126,
+	1765, 74, 119, 104, 1764, 1762, 1449, 1760, 27, 18,
+	1759, 1758, 1756, 1755, 1753, 739, 138, 1752, 1751, 1750,
+	96, 0, 773, 133, 130, 1749, 98, 1748, 1747, 4,
+	1746, 1745, 90, 101, 1742, 3534, 131, 120, 52, 134,
+	62, 70, 87, 1741, 1740, 89, 115, 1739, 97, 1738,
+	1737, 1736, 1735, 1733, 76, 95, 72, 56, 41, 1731,
+	1730, 105, 53, 48, 75, 116, 1729, 45, 64, 1728,
+	1727, 60, 78, 59, 1725, 21, 22, 1724, 16, 12,
+	10, 1722, 51, 50, 9, 1721, 69, 39, 85, 2,
+	1719, 1718, 36, 28, 31, 1716, 24, 14, 1715, 103,
+	1711, 11, 1710, 1709, 38, 13, 23, 7, 1708, 63,
+	1707, 1706, 1702, 8, 118, 34, 61, 117, 1700, 26,
+	1699, 1697, 17, 1696, 20, 44, 1695, 15, 1694, 19,
+	1693, 1692, 1691, 2486, 1545, 1687, 54, 1686, 1685, 143,
+	1677,
+}
+
+var yyR1 = [...]int{
+	0, 241, 242, 242, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 2, 2, 6, 3, 4,
+	4, 5, 5, 7, 7, 38, 38, 8, 9, 9,
+	9, 245, 245, 57, 57, 104, 104, 10, 10, 10,
+	10, 109, 109, 113, 113, 113, 114, 114, 114, 114,
+	153, 153, 11, 11, 11, 11, 11, 11, 11, 11,
+	11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
+	11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
+	11, 136, 136, 137, 137, 239, 239, 238, 237, 237,
+	236, 236, 235, 27, 195, 195, 195, 209, 209, 210,
+	210, 210, 210, 210, 210, 212, 212, 214, 214, 214,
+	214, 215, 215, 216, 216, 213, 213, 196, 196, 196,
+	196, 196, 196, 196, 175, 156, 156, 156, 156, 156,
+	156, 156, 156, 156, 156, 178, 178, 176, 176, 176,
+	176, 176, 176, 176, 176, 176, 176, 176, 176, 176,
+	176, 176, 176, 176, 176, 176, 176, 176, 176, 176,
+	176, 176, 176, 176, 176, 176, 176, 176, 176, 176,
+	176, 176, 176, 176, 176, 176, 230, 230, 230, 230,
+	230, 119, 119, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 227, 227, 229, 228, 228, 118, 118, 118,
+	160, 160, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 159, 159, 159, 159, 159, 159, 161, 161,
+	161, 161, 161, 143, 143, 142, 142, 157, 157, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 163, 163, 163, 163,
+	163, 163, 163, 163, 163, 173, 173, 177, 177, 177,
+	177, 177, 177, 177, 140, 140, 140, 140, 141, 141,
+	141, 141, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 164, 164,
+	171, 171, 172, 172, 172, 169, 169, 170, 170, 167,
+	167, 167, 167, 168, 168, 180, 180, 180, 181, 181,
+	181, 181, 181, 181, 181, 182, 182, 184, 183, 183,
+	183, 190, 191, 191, 191, 186, 186, 185, 189, 189,
+	187, 187, 187, 187, 187, 192, 192, 192, 192, 192,
+	205, 205, 204, 204, 204, 204, 204, 204, 139, 139,
+	139, 188, 188, 194, 194, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 193, 193,
+	203, 203, 202, 98, 98, 99, 99, 97, 97, 201,
+	201, 201, 197, 197, 197, 198, 198, 198, 199, 199,
+	199, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+	12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+	12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+	12, 240, 240, 240, 240, 240, 240, 240, 240, 240,
+	240, 240, 246, 246, 247, 247, 247, 247, 247, 247,
+	208, 206, 206, 207, 207, 207, 207, 207, 217, 217,
+	13, 14, 14, 14, 14, 14, 14, 15, 15, 17,
+	17, 18, 18, 22, 22, 19, 19, 21, 21, 21,
+	21, 21, 21, 21, 21, 21, 21, 21, 20, 20,
+	26, 26, 16, 16, 165, 165, 28, 29, 29, 29,
+	29, 29, 29, 29, 29, 29, 29, 29, 29, 29,
+	29, 29, 29, 29, 29, 29, 29, 29, 29, 29,
+	123, 123, 120, 120, 121, 121, 122, 122, 122, 124,
+	124, 124, 154, 154, 154, 30, 30, 32, 32, 33,
+	34, 31, 31, 31, 31, 31, 248, 35, 36, 36,
+	37, 37, 37, 41, 41, 41, 39, 39, 40, 40,
+	46, 46, 45, 45, 47, 47, 47, 47, 135, 135,
+	135, 134, 134, 49, 49, 50, 50, 51, 51, 52,
+	52, 52, 64, 64, 211, 211, 103, 103, 105, 105,
+	53, 53, 53, 53, 54, 54, 55, 55, 56, 56,
+	149, 149, 148, 148, 148, 147, 147, 58, 58, 58,
+	60, 59, 59, 59, 59, 61, 61, 63, 63, 62,
+	62, 65, 65, 65, 65, 66, 66, 48, 48, 48,
+	48, 48, 48, 48, 117, 117, 68, 68, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	78, 78, 78, 78, 78, 78, 69, 69, 69, 69,
+	69, 69, 69, 44, 44, 79, 79, 79, 85, 80,
+	80, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 76, 76, 76, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 75, 75, 75, 75, 75, 75,
+	75, 75, 75, 249, 249, 77, 77, 77, 77, 42,
+	42, 42, 42, 42, 152, 152, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 89,
+	89, 43, 43, 87, 87, 88, 90, 90, 86, 86,
+	86, 71, 71, 71, 71, 71, 71, 71, 71, 73,
+	73, 73, 91, 91, 92, 92, 93, 93, 94, 94,
+	95, 96, 96, 96, 100, 100, 100, 100, 101, 101,
+	101, 70, 70, 70, 70, 70, 70, 102, 102, 102,
+	102, 106, 106, 81, 81, 83, 83, 82, 84, 107,
+	107, 111, 108, 108, 112, 112, 112, 110, 110, 110,
+	144, 144, 144, 115, 115, 125, 125, 126, 126, 116,
+	116, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 128, 128, 128, 129, 129, 132, 132, 133, 133,
+	145, 145, 146, 146, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 131, 131, 131, 131,
+	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
+	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
+	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
+	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
+	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
+	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
+	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
+	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
+	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
+	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
+	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
+	131, 131, 131, 131, 131, 243, 244, 150, 138, 138,
+	138, 224, 23, 23, 23, 25, 25, 25, 25, 25,
+	25, 24, 24, 24, 24, 24, 174, 174, 174, 174,
+	231, 231, 234, 234, 233, 233, 232, 225, 225, 225,
+	225, 225, 225, 225, 225, 225, 225, 225, 226, 226,
+	218, 218, 218, 221, 221, 219, 219, 219, 219, 219,
+	220, 220, 220, 222, 222, 222, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 223, 223, 151,
+	151, 151,
+}
+
+var yyR2 = [...]int{
+	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 4, 6, 7, 5, 10, 1,
+	3, 1, 3, 7, 8, 1, 1, 8, 8, 7,
+	6, 1, 1, 1, 3, 0, 4, 3, 4, 5,
+	4, 1, 3, 3, 2, 2, 2, 2, 2, 1,
+	1, 1, 2, 3, 6, 14, 13, 15, 12, 14,
+	16, 11, 10, 5, 7, 7, 4, 6, 4, 5,
+	7, 9, 6, 6, 9, 8, 11, 9, 5, 5,
+	5, 0, 1, 0, 1, 0, 2, 1, 0, 2,
+	1, 3, 3, 4, 5, 8, 7, 0, 5, 4,
+	5, 4, 7, 5, 8, 0, 2, 10, 6, 10,
+	1, 1, 3, 1, 1, 0, 3, 1, 3, 3,
+	3, 3, 3, 3, 2, 3, 1, 1, 1, 1,
+	1, 3, 4, 2, 4, 3, 5, 2, 2, 3,
+	3, 5, 3, 5, 4, 3, 5, 4, 3, 3,
+	3, 5, 3, 3, 4, 6, 7, 3, 2, 2,
+	2, 3, 2, 3, 2, 3, 6, 4, 4, 2,
+	2, 6, 7, 2, 5, 5, 0, 3, 2, 3,
+	2, 4, 6, 1, 3, 4, 1, 1, 1, 4,
+	1, 4, 2, 3, 4, 0, 3, 0, 1, 1,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 2, 2, 3, 2, 2, 2, 1, 3,
+	3, 2, 1, 0, 1, 3, 3, 1, 1, 4,
+	4, 4, 5, 2, 2, 3, 3, 3, 3, 1,
+	1, 1, 1, 1, 6, 6, 1, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 3, 2, 2, 3,
+	3, 2, 3, 3, 0, 1, 1, 4, 2, 3,
+	3, 4, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 0, 3,
+	0, 5, 0, 3, 5, 0, 1, 0, 1, 0,
+	3, 3, 2, 0, 2, 5, 4, 5, 10, 11,
+	12, 13, 4, 4, 2, 4, 6, 8, 7, 9,
+	2, 1, 1, 2, 2, 1, 3, 3, 0, 4,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 2,
+	1, 2, 2, 3, 2, 3, 1, 1, 0, 1,
+	1, 0, 3, 0, 1, 2, 3, 2, 1, 3,
+	2, 2, 3, 2, 1, 1, 3, 4, 1, 1,
+	1, 3, 3, 0, 4, 0, 2, 0, 2, 1,
+	4, 3, 0, 1, 3, 1, 2, 3, 1, 1,
+	1, 6, 12, 13, 12, 13, 11, 12, 12, 13,
+	6, 7, 6, 7, 7, 7, 12, 7, 7, 7,
+	9, 10, 10, 11, 8, 9, 4, 4, 5, 8,
+	9, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	7, 1, 3, 9, 11, 9, 7, 8, 0, 4,
+	5, 4, 7, 4, 5, 4, 4, 3, 2, 5,
+	4, 3, 4, 1, 1, 1, 3, 1, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	0, 3, 6, 6, 1, 1, 3, 4, 4, 4,
+	4, 4, 4, 4, 4, 3, 3, 3, 3, 4,
+	3, 6, 4, 2, 4, 2, 2, 2, 2, 3,
+	1, 1, 0, 1, 0, 1, 0, 2, 2, 0,
+	2, 2, 0, 1, 1, 2, 1, 1, 2, 1,
+	1, 2, 2, 2, 2, 2, 0, 2, 0, 2,
+	1, 2, 2, 0, 1, 1, 0, 1, 0, 1,
+	0, 1, 1, 3, 1, 2, 3, 5, 0, 1,
+	2, 1, 1, 0, 2, 1, 3, 1, 1, 1,
+	3, 3, 3, 7, 0, 1, 1, 3, 1, 3,
+	4, 4, 4, 3, 2, 4, 0, 1, 0, 2,
+	0, 1, 0, 1, 2, 1, 1, 1, 2, 2,
+	1, 2, 3, 2, 3, 2, 2, 2, 1, 1,
+	3, 0, 5, 5, 5, 0, 2, 1, 3, 3,
+	2, 3, 1, 2, 0, 3, 1, 1, 3, 3,
+	4, 6, 6, 4, 5, 3, 4, 5, 6, 2,
+	1, 2, 1, 2, 1, 2, 1, 1, 1, 1,
+	1, 1, 1, 0, 2, 1, 1, 1, 3, 1,
+	3, 1, 1, 1, 1, 1, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 2, 2, 2, 4, 2, 2, 2,
+	2, 3, 1, 1, 1, 1, 4, 5, 6, 4,
+	4, 6, 6, 6, 6, 8, 8, 6, 8, 8,
+	9, 7, 5, 4, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 0, 2, 4, 4, 4, 4, 0,
+	3, 4, 7, 3, 1, 1, 2, 3, 3, 1,
+	2, 2, 1, 2, 1, 2, 2, 1, 2, 0,
+	1, 0, 2, 1, 2, 4, 0, 2, 1, 3,
+	5, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 2, 0, 3, 0, 2, 0, 3, 1, 3,
+	2, 0, 1, 1, 0, 2, 4, 4, 0, 2,
+	4, 2, 1, 3, 5, 4, 6, 1, 3, 3,
+	5, 0, 5, 1, 3, 1, 2, 3, 1, 1,
+	3, 3, 1, 3, 3, 3, 3, 1, 2, 1,
+	1, 1, 1, 1, 1, 0, 2, 0, 3, 0,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 0, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 0, 0, 2,
+	3, 1, 1, 1, 2, 0, 3, 3, 3, 5,
+	6, 1, 1, 1, 1, 1, 0, 2, 3, 2,
+	0, 3, 0, 4, 1, 3, 2, 0, 3, 3,
+	4, 4, 2, 3, 3, 3, 3, 4, 1, 2,
+	1, 1, 2, 1, 3, 1, 1, 3, 1, 1,
+	0, 2, 3, 1, 1, 5, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 3, 0,
+	1, 1,
+}
+
+var yyChk = [...]int{
+	-1000, -241, -1, -2, -6, -7, -8, -9, -10, -11,
+	-12, -13, -14, -15, -16, -17, -28, -29, -30, -32,
+	-33, -34, -31, -3, -4, 6, 7, -38, 9, 10,
+	30, -27, 127, 128, 130, 129, 171, 78, 153, 154,
+	131, 164, 62, 188, 53, 190, 191, 26, 165, 166,
+	169, 170, -243, 8, 277, 66, -242, 291, -93, 15,
+	-37, 5, -35, -248, -35, -35, -35, -35, -35, -195,
+	101, 41, 66, -136, 145, 144, 136, 83, 51, 176,
+	137, 182, 146, 177, 184, 268, 133, 134, 162, -116,
+	136, 51, 139, 134, 134, 135, 136, 268, 133, 134,
+	-62, -145, 51, -131, 151, 285, 156, 161, 188, 198,
+	202, 192, 224, 216, 286, 213, 217, 255, 78, 190,
+	264, 50, 167, 211, 207, 137, 204, 28, 229, 178,
+	289, 206, 146, 145, 155, 230, 234, 256, 181, 196,
+	197, 160, 258, 228, 32, 147, 287, 34, 174, 259,
+	232, 227, 223, 226, 195, 222, 38, 236, 235, 237,
+	254, 219, 52, 208, 18, 170, 41, 231, 233, 141,
+	176, 288, 260, 203, 173, 169, 263, 191, 201, 257,
+	266, 182, 37, 241, 194, 200, 144, 244, 53, 186,
+	158, 183, 220, 175, 209, 210, 225, 193, 221, 189,
+	177, 171, 265, 242, 290, 218, 214, 215, 187, 136,
+	184, 185, 157, 159, 247, 248, 249, 250, 44, 45,
+	261, 212, 243, 64, -18, -19, -21, 20, 6, 8,
+	9, 10, 171, 148, 177, 51, 290, -18, 134, 120,
+	217, 127, 245, 135, 32, 176, -154, 134, -120, 185,
+	247, 248, 249, 250, 51, 257, 256, 251, -145, 189,
+	-150, -150, -150, -150, -150, -2, -100, 17, 16, -5,
+	-3, -243, 6, 20, 22, -41, 39, 40, -36, -47,
+	111, -48, -145, -67, 85, -72, 29, 51, -131, 24,
+	-71, -68, -86, -84, -85, 120, 121, 71, 161, 109,
+	110, 117, 86, 122, -76, -74, -75, -77, 70, 69,
+	79, 72, 73, 74, 75, 80, 81, 82, -132, -82,
+	-243, 56, 57, 278, 279, 280, 281, 284, 282, 88,
+	33, 267, 276, 275, 274, 272, 273, 269, 270, 271,
+	140, 268, 115, 277, -116, -50, -51, -52, -53, -64,
+	-85, -243, -62, 11, -57, -62, -108, -153, 189, -112,
+	257, 256, -133, -110, -132, -130, 255, 217, 254, 51,
+	-131, 132, 84, 21, 23, 25, 239, 179, 87, 120,
+	16, 149, 88, 152, 119, 143, 278, 127, 60, 269,
+	271, 267, 270, 280, 281, 268, 245, 29, 10, 26,
+	165, 22, 113, 129, 180, 91, 92, 168, 24, 166,
+	82, 19, 63, 11, 13, 14, 140, 139, 103, 135,
+	58, 8, 122, 27, 100, 54, 163, 56, 101, 17,
+	272, 273, 31, 284, 172, 115, 61, 35, 85, 80,
+	64, 262, 83, 15, 59, 148, 102, 130, 277, 150,
+	57, 133, 6, 283, 30, 164, 55, 134, 246, 90,
+	138, 81, 5, 162, 9, 62, 65, 274, 275, 276,
+	33, 89, 12, -62, -132, -196, 68, -175, -132, 135,
+	135, 135, -62, 277, 136, -62, 140, 51, -62, -62,
+	-62, 134, -132, -132, -126, 140, -126, -126, 134, -62,
+	-62, -62, -125, 140, -125, -125, -125, -125, -62, 124,
+	134, 142, 138, 64, 67, 51, 11, -62, 51, 30,
+	268, 51, 176, 134, 177, 136, -151, -243, -133, -151,
+	-151, -151, 186, 187, -151, -121, 252, 64, -151, -244,
+	68, -101, 19, 31, -48, -145, -94, -95, -48, -93,
+	-2, -35, 35, -39, 22, 77, 11, -135, 84, 83,
+	100, -134, 23, -132, 70, 124, -48, -69, 103, 85,
+	101, 102, 87, 106, 104, 116, 105, 109, 110, 111,
+	112, 113, 114, 115, 107, 108, 119, 123, 93, 94,
+	95, 96, 97, 98, 99, -117, -243, -85, -243, 125,
+	126, -72, -72, 70, 292, -72, -72, -72, -72, -72,
+	-243, -2, -80, -48, -243, -243, -243, -243, -243, -243,
+	-243, -243, -243, -89, -48, -243, -249, -243, -249, -249,
+	-249, -249, -249, -249, -249, -249, -243, -243, -243, -243,
+	-63, 27, -62, 30, 67, -58, -60, -59, -61, 54,
+	58, 60, 55, 56, 57, 61, -149, 23, -50, -243,
+	-148, 41, -147, 23, -145, 70, -62, -57, -245, 67,
+	11, 65, 67, -108, 189, -109, -113, 258, 260, 93,
+	-144, -132, 70, 29, 30, -62, 68, 67, 181, -176,
+	-156, -160, -157, -162, -161, -163, 51, -158, -159, 216,
+	286, 213, 217, 214, 120, 218, 220, 221, 222, 223,
+	224, 225, 226, 227, 228, 229, 30, 167, 209, 210,
+	211, 212, 230, 231, 232, 233, 234, 235, 236, 237,
+	192, 193, 194, 195, 196, 197, 198, 200, 201, 202,
+	203, 204, 206, 207, 208, -137, 50, -132, -132, 23,
+	136, 51, -62, -224, -225, 64, 66, 85, 19, -224,
+	-149, -231, -126, -218, 179, 51, -239, 65, 51, 85,
+	51, -62, -62, 262, -151, -225, 138, -62, 24, -132,
+	-62, 51, 51, -146, -145, -130, -62, -86, -132, -145,
+	-20, -132, -62, -22, 134, 51, -21, -20, -151, -151,
+	-151, -151, -151, -151, -151, -151, -151, -151, -123, 246,
+	253, -62, 9, 103, 67, 18, 124, 67, -96, 25,
+	26, -100, -244, -41, -73, -132, 72, 75, -40, 55,
+	-62, -48, -48, -78, 80, 85, 81, 82, -134, 111,
+	-146, -133, -130, -72, 21, 20, -79, -82, -85, 76,
+	103, 101, 102, 87, -72, -72, -72, -72, -72, -72,
+	-72, -72, -72, -72, -72, -72, -72, -72, -72, -72,
+	-152, 51, 70, -177, 51, -179, 217, 204, 198, 286,
+	213, 167, 207, 206, 196, 197, 228, 208, 203, 194,
+	220, 209, 210, 214, 51, -71, -71, -80, -132, -46,
+	22, -45, -47, -244, 67, -244, -2, -45, -45, -48,
+	-48, -86, -86, -45, -39, -87, -88, 89, -86, -244,
+	-45, -46, -45, -45, -104, 41, -62, -107, -111, -86,
+	-51, -52, -52, -51, -52, 54, 54, 54, 59, 54,
+	59, 54, -59, -145, -244, -65, 62, 139, 63, -243,
+	-147, -104, 65, -50, -62, -112, -109, 67, 259, 261,
+	262, 64, -48, -168, 119, -214, 19, 29, -197, 181,
+	-198, -199, -133, 70, 72, -175, -180, -181, -182, -183,
+	-184, -200, 146, 143, 152, 51, 141, 144, 162, -193,
+	145, 135, 163, 66, 80, 85, 29, 64, 244, 239,
+	159, 160, 141, 163, 162, 78, 148, -190, -182, 23,
+	-227, -229, -191, 143, 155, 51, -140, -141, 161, 292,
+	-169, 242, 124, 66, -178, -164, 66, -164, -164, 215,
+	-164, -164, -164, -167, 217, 255, -167, -167, -167, 66,
+	66, -164, -164, -164, -178, 66, -171, 66, -171, 205,
+	-171, -172, 66, -172, -132, 64, 64, 64, -2, -62,
+	-62, 23, -174, 23, 51, 52, 172, 53, -62, 24,
+	-132, -156, 146, 51, -62, -221, -219, 8, 9, 10,
+	171, 51, -237, 44, -238, 51, -151, 24, -151, -127,
+	132, 129, 130, 128, -23, -208, 51, 239, 217, 78,
+	29, 15, 278, 41, 290, 63, 52, 173, -62, 23,
+	-62, 64, -151, 100, 100, 124, -26, 67, 44, -62,
+	-122, 11, 103, 37, -48, -48, -146, -95, -101, -115,
+	19, 11, 33, 33, -45, 80, 81, 82, 124, -243,
+	-243, -243, -79, -72, -72, -72, -44, 168, 84, 292,
+	-164, 124, -164, 215, 205, -244, 293, -244, -45, 67,
+	-48, -244, -244, -244, 67, 65, 23, 67, 11, 67,
+	11, -244, -45, -90, -88, 91, -48, -244, -244, -244,
+	-244, -244, -70, 30, 33, -2, -243, -243, -66, 67,
+	12, 93, -55, -54, 64, 65, -56, 64, -54, 54,
+	54, 135, 135, 135, -105, -132, -66, -50, -66, -113,
+	-114, 263, 260, 266, 51, -209, 41, 33, -209, 67,
+	66, -199, 93, 64, -190, 85, -190, 66, 163, -132,
+	66, 19, 65, 163, -193, -193, 51, 51, -193, -57,
+	80, 51, 70, 72, 110, 73, 80, -166, -76, -243,
+	-68, 79, 267, 269, 271, 272, 273, -132, -145, 9,
+	10, 72, 163, 163, 70, -62, 66, 23, 51, -132,
+	156, 16, 292, 292, 293, 72, -170, 243, -132, 72,
+	-132, 72, -167, -167, -164, -167, -168, 30, 51, -168,
+	-168, -168, -173, 70, -173, -143, -142, 44, 45, -143,
+	72, -171, 72, 64, 65, -62, -62, -62, 23, -224,
+	-2, 44, 133, 149, 229, -158, -226, 16, 72, 110,
+	51, 172, -226, -226, 44, -25, -62, 182, -230, 51,
+	70, 66, 64, 83, 51, -236, -235, -133, -150, -136,
+	145, 144, 143, -181, -183, -247, 184, 146, 51, 142,
+	141, 41, -240, 184, 142, 143, 146, 145, 51, 135,
+	163, 141, 144, 41, 162, -128, -129, 138, 23, 135,
+	163, 142, 51, 41, 63, 41, 132, 128, -23, 51,
+	-62, -165, 70, 80, -165, -133, -132, 153, -124, 101,
+	12, -145, -145, 38, 124, -62, -49, 11, 111, -133,
+	-46, -72, -72, -44, 84, -72, -72, 293, -132, -142,
+	-164, -244, -47, -155, 120, 213, 167, 211, 207, 228,
+	219, 241, 209, 242, -152, -155, -72, -72, -72, -72,
+	285, -93, 92, -48, 90, -106, 64, -107, -81, -83,
+	-82, -243, -2, -102, -132, -105, -93, -111, -48, -48,
+	-48, 66, -48, -243, -243, -243, -244, 67, -93, -66,
+	260, 264, 265, 16, 11, 103, 44, -198, -57, -199,
+	10, 9, -203, -202, -201, -132, -243, 66, -132, 146,
+	152, 51, 162, -48, -132, -132, 51, 51, 51, 68,
+	123, 123, 72, 73, 123, -72, -243, -243, -243, 124,
+	-166, 51, -192, 150, 149, 30, 52, -192, 66, -48,
+	66, 51, 29, 293, 72, 72, 293, -178, 68, 68,
+	67, 68, -168, -168, -167, -168, 51, 120, 68, 67,
+	68, 209, 209, 67, 68, 67, -62, 262, -132, 66,
+	65, 66, 66, -2, -138, 44, -145, 66, -226, -86,
+	72, -226, 23, 19, 138, 65, 44, -174, -132, 29,
+	80, 85, -182, 51, -196, -62, -219, -103, -132, 67,
+	93, -246, 135, 163, -246, -246, -132, -150, -132, -150,
+	-132, -62, -150, -132, 259, -62, -132, 143, -181, -183,
+	51, 142, 51, 41, -151, 290, 70, -48, -66, -50,
+	-244, -244, -244, -72, -244, -164, -164, -164, -172, -164,
+	197, -164, 197, -244, -244, -244, 67, 19, -244, 67,
+	19, -243, -43, 283, -48, 28, -106, 67, -244, -244,
+	-244, 67, 124, -244, -100, -103, -103, -103, -103, -148,
+	-132, -100, -210, -132, 163, -243, -243, -243, 68, -192,
+	-192, 68, 67, -96, -243, -48, -103, 66, 163, 66,
+	65, -193, 68, 66, 66, -197, -177, -177, -177, -244,
+	-244, 72, 72, 72, -133, -243, 80, 29, 151, -103,
+	68, -48, 53, 92, -228, 66, 293, 293, 72, -168,
+	-167, 70, -167, 51, 51, 72, 72, 66, 65, -62,
+	64, -203, -132, -203, -203, 51, 52, -173, 51, -24,
+	20, 6, 8, 9, 10, -20, 66, 152, -234, 51,
+	-72, 80, -132, 68, -220, 19, 67, -235, -199, -132,
+	-132, -132, 162, 66, 132, 30, 51, -214, 27, -132,
+	-132, 259, -62, -91, 13, -167, 51, -72, -72, -72,
+	-72, -72, -244, 70, 163, -83, 33, -2, -243, -132,
+	-132, 68, -244, -244, -244, -65, -212, -132, -243, -132,
+	163, -243, -132, -215, -216, -72, 172, -80, -132, -197,
+	-205, -190, -204, 65, 147, 78, 44, 159, 160, -202,
+	-97, 51, -46, -244, 68, -103, 66, -132, -48, -132,
+	-132, -186, -185, -132, -244, -244, -244, -244, 72, 68,
+	-118, 157, 158, 68, -225, 68, -168, -168, 68, 68,
+	-203, -132, 65, -62, 68, 66, 68, 68, 51, 68,
+	-48, 66, 66, -234, 182, -222, -250, -223, 89, 188,
+	30, 8, 9, 10, 277, 6, 140, 88, 290, 51,
+	178, 51, 180, -132, 66, 66, 66, 66, -103, -229,
+	-227, 29, -243, 141, 162, 132, 30, 51, -214, -92,
+	14, 16, -244, -244, -244, -244, -42, 103, 44, 9,
+	-81, -2, 124, -213, -243, 72, -80, -243, -243, -132,
+	-211, -103, 93, -244, 67, -244, 72, -204, 51, -194,
+	93, 70, 51, 51, -244, 148, 68, -103, 66, 68,
+	66, 67, 68, 67, 44, -244, -118, 68, 68, 66,
+	-132, 66, -98, 51, -203, -188, 44, -139, 159, 160,
+	68, -48, -233, -232, -133, -132, -243, 51, 176, 51,
+	-203, -203, -203, -203, 68, 23, -119, 51, -206, -207,
+	41, 163, 66, -229, 29, -48, -80, -244, 286, 61,
+	288, -107, -244, -132, -206, -244, -80, -211, 93, -244,
+	72, 138, -216, 67, 72, 51, -62, 148, 68, -103,
+	-186, -132, -189, 12, -185, -187, 93, 84, 98, 94,
+	95, -98, -203, 66, -203, -99, 183, 66, 68, -139,
+	51, 68, 68, 67, 70, -234, -48, -76, -76, 68,
+	68, 68, 68, -239, 51, 66, -244, 67, -132, 66,
+	-203, -119, 38, 287, 289, -244, -244, -244, 72, -243,
+	-243, -132, 66, -62, 148, 68, 68, 68, 66, -99,
+	68, -203, 68, -66, -132, -103, -98, 51, -232, -244,
+	-139, -188, -139, -190, -237, 70, -207, 33, -203, 68,
+	38, -243, -211, -215, 72, -103, 66, -62, 148, -189,
+	-48, -66, -98, 68, -139, 68, -99, -223, -139, 68,
+	123, 174, 103, 68, -190, 44, -211, -244, -244, -244,
+	68, -103, 66, -62, 68, -139, -99, -98, -66, 51,
+	175, -243, 288, -244, 68, -103, 66, -66, -99, 68,
+	-243, 172, -80, 289, 68, -103, -66, -72, 172, -217,
+	-244, 68, -244, 67, -244, -132, -217, -217, -80, -217,
+	-194, -244, -199, -217,
+}
+
+var yyDef = [...]int{
+	0, -2, 2, -2, 5, 6, 7, 8, 9, 10,
+	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
+	21, 22, 23, 786, 0, 546, 546, 546, 546, 546,
+	546, 0, 91, 839, 0, 0, 0, 0, 0, 0,
+	0, -2, 536, 537, 0, 539, 540, 1087, 1087, 1087,
+	1087, 1087, 0, 35, 36, 1085, 1, 3, 794, 0,
+	0, 550, 553, 548, 0, 839, 0, 0, 0, 62,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 837, 837, 837, 92, 0,
+	0, 0, 840, 0, 835, 835, 835, 835, 835, 0,
+	468, 619, 860, 861, 966, 967, 968, 969, 970, 971,
+	972, 973, 974, 975, 976, 977, 978, 979, 980, 981,
+	982, 983, 984, 985, 986, 987, 988, 989, 990, 991,
+	992, 993, 994, 995, 996, 997, 998, 999, 1000, 1001,
+	1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009, 1010, 1011,
+	1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019, 1020, 1021,
+	1022, 1023, 1024, 1025, 1026, 1027, 1028, 1029, 1030, 1031,
+	1032, 1033, 1034, 1035, 1036, 1037, 1038, 1039, 1040, 1041,
+	1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051,
+	1052, 1053, 1054, 1055, 1056, 1057, 1058, 1059, 1060, 1061,
+	1062, 1063, 1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071,
+	1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081,
+	1082, 1083, 1084, 0, 0, 0, 475, 477, 479, 480,
+	481, 482, 483, 484, 485, 486, 487, 0, 0, 0,
+	0, 0, 1159, 1159, 1159, 1159, 0, 1159, 524, 513,
+	515, 516, 517, 518, 1159, 533, 534, 523, 535, 538,
+	541, 542, 543, 544, 545, 29, 798, 0, 0, 786,
+	31, 0, 546, 551, 552, 556, 554, 555, 547, 0,
+	564, 568, 0, 627, 0, 632, 634, -2, -2, 0,
+	671, 672, 673, 674, 675, 0, 0, 0, 969, 0,
+	0, 0, 0, 0, 702, 703, 704, 705, 771, 772,
+	773, 774, 775, 776, 777, 778, 636, 637, 768, 818,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 759,
+	0, 733, 733, 733, 733, 733, 733, 733, 733, 733,
+	0, 0, 0, 0, 0, 0, 575, 577, 578, 579,
+	600, 0, 602, 0, 0, 43, 47, 0, 1061, 822,
+	-2, -2, 0, 0, 858, 859, -2, 978, -2, 856,
+	857, 864, 865, 866, 867, 868, 869, 870, 871, 872,
+	873, 874, 875, 876, 877, 878, 879, 880, 881, 882,
+	883, 884, 885, 886, 887, 888, 889, 890, 891, 892,
+	893, 894, 895, 896, 897, 898, 899, 900, 901, 902,
+	903, 904, 905, 906, 907, 908, 909, 910, 911, 912,
+	913, 914, 915, 916, 917, 918, 919, 920, 921, 922,
+	923, 924, 925, 926, 927, 928, 929, 930, 931, 932,
+	933, 934, 935, 936, 937, 938, 939, 940, 941, 942,
+	943, 944, 945, 946, 947, 948, 949, 950, 951, 952,
+	953, 954, 955, 956, 957, 958, 959, 960, 961, 962,
+	963, 964, 965, 63, 0, 0, 0, 127, 0, 93,
+	0, 0, 0, 0, 1071, 1117, 0, 860, 0, 600,
+	1110, 837, 0, 95, 0, 0, 0, 0, 0, 1159,
+	1117, 0, 0, 0, 0, 0, 0, 0, 467, 0,
+	0, 0, 0, 0, 0, 478, 0, 496, 1159, 1159,
+	1159, 1159, 1159, 1159, 1159, 1159, 505, 1160, 1161, 506,
+	507, 508, 1159, 1159, 510, 0, 525, 0, 519, 30,
+	1086, 24, 0, 0, 795, 0, 787, 788, 791, 794,
+	29, 553, 0, 558, 557, 549, 0, 565, 0, 0,
+	0, 569, 0, 571, 572, 0, 630, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 656, 657,
+	658, 659, 660, 661, 662, 633, 0, 649, 0, 0,
+	0, 693, 694, 695, 0, 697, 698, 699, 700, 0,
+	560, 29, 0, 669, 0, 0, 0, 0, 0, 0,
+	0, 0, 556, 0, 760, 0, 724, 0, 725, 726,
+	727, 728, 729, 730, 731, 732, 0, 560, 0, 0,
+	45, 0, 618, 0, 0, 0, 0, 0, 0, 607,
+	0, 0, 610, 0, 0, 0, 0, 601, 0, 0,
+	621, 1028, 603, 0, 605, 606, -2, 0, 0, 0,
+	41, 42, 0, 48, 1061, 50, 51, 0, 0, 0,
+	313, 830, 831, 832, 828, 0, 392, 0, 0, 134,
+	274, 305, 136, 137, 138, 139, 140, 298, 211, 237,
+	238, 298, 298, 298, 298, 298, 309, 309, 309, 309,
+	249, 250, 251, 252, 253, 0, 0, 228, 298, 298,
+	298, 232, 256, 258, 259, 260, 261, 262, 263, 264,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	300, 300, 300, 302, 302, 0, 94, 0, 0, 0,
+	0, 0, 0, 76, 1106, 0, 1091, 0, 0, 78,
+	0, 0, 0, 0, 1130, 1131, 98, 0, 1159, 0,
+	1159, 103, 0, 0, 426, 427, 0, 461, 836, 463,
+	1159, 465, 466, 620, 862, 863, 0, 0, 768, 0,
+	490, 488, 471, 0, 473, -2, 476, 470, 497, 498,
+	499, 500, 501, 502, 503, 504, 509, 512, 526, 520,
+	521, 514, 799, 0, 0, 0, 0, 0, 790, 792,
+	793, 798, 32, 556, 0, 779, 0, 0, 0, 559,
+	27, 628, 629, 631, 650, 0, 652, 654, 570, 566,
+	0, 769, -2, 638, 0, 0, 639, 665, 666, 667,
+	0, 0, 0, 0, 663, 645, 0, 676, 677, 678,
+	679, 680, 681, 682, 683, 684, 685, 686, 687, 688,
+	691, 744, 745, 692, 298, 298, 0, 0, 282, 283,
+	284, 285, 286, 287, 288, 289, 290, 291, 292, 293,
+	294, 295, 296, 297, 0, 689, 690, 0, 701, 0,
+	0, 561, 562, 668, 0, 817, 29, 0, 0, 0,
+	0, 0, 0, 0, 0, 766, 763, 0, 0, 734,
+	0, 0, 0, 0, 0, 0, 617, 625, 819, 0,
+	576, 596, 598, 0, 593, 608, 609, 611, 0, 613,
+	0, 615, 616, 580, 581, 582, 0, 0, 0, 0,
+	604, 625, 0, 625, 44, 823, 49, 0, 0, 54,
+	55, 824, 825, 826, 0, 107, 0, 120, 107, 1000,
+	393, 395, 398, 399, 400, 128, 129, 130, 131, 132,
+	133, 0, 994, 0, 0, 856, 1031, -2, 375, 0,
+	-2, 378, 379, 0, 148, 0, 0, 0, 0, 168,
+	169, 170, 0, 172, 174, 0, 0, 179, 180, 0,
+	0, 183, 331, 0, 0, 332, 147, 275, 276, 0,
+	307, 306, 0, 0, 143, 210, 0, 309, 309, 298,
+	309, 243, 244, 313, 0, 0, 313, 313, 313, 0,
+	0, 233, 233, 231, 257, 0, 222, 0, 223, 300,
+	225, 226, 0, 227, 0, 0, 0, 0, -2, 0,
+	0, 0, 79, 0, 1122, 0, 0, 0, 1095, 0,
+	0, 186, 0, 0, 0, 0, 1133, 1135, 1136, 1138,
+	1139, 1132, 88, 0, 96, 97, 89, 838, 90, 1087,
+	91, 0, 851, 841, 0, 428, -2, 842, 843, 844,
+	845, 846, 847, 848, 849, 1093, 0, 0, 0, 0,
+	460, 0, 464, 0, 0, 0, 469, 0, 0, 472,
+	529, 0, 0, 0, 796, 797, 0, 789, 25, 0,
+	833, 834, 780, 781, 573, 651, 653, 655, 0, 560,
+	0, 0, 640, 663, 646, 0, 643, 0, 0, 0,
+	267, 0, 268, 298, 271, 635, 696, 706, 0, 0,
+	670, -2, 709, 710, 0, 0, 0, 0, 0, 0,
+	0, 0, 786, 0, 764, 0, 0, 723, 735, 736,
+	737, 738, 811, 0, 0, -2, 0, 0, 786, 0,
+	0, 0, 590, 597, 0, 0, 591, 0, 592, 612,
+	614, 0, 0, 0, 0, 588, 786, 625, 40, 52,
+	53, 0, 0, 59, 314, 64, 0, 0, 104, 0,
+	0, 396, 0, 0, 324, 0, 330, 0, 0, 0,
+	0, 0, 0, 365, 367, 0, 370, 371, 373, 0,
+	149, 333, 150, 152, 0, 155, 158, 159, 160, 0,
+	162, 163, 193, 196, 197, 198, 200, 0, 0, 0,
+	0, 167, 171, 173, 175, 0, 0, 0, 334, 0,
+	202, 0, 0, 0, 278, 0, 135, 308, 141, 0,
+	0, 0, 313, 313, 309, 313, 245, 0, 312, 246,
+	247, 248, 0, 265, 0, 229, 234, 0, 0, 230,
+	0, 224, 0, 0, 0, 0, 0, 0, 0, 77,
+	-2, 1107, 0, 1109, 0, 1118, 1119, 0, 1128, 0,
+	1123, 1125, 1124, 1126, 0, 82, 1106, 0, 83, 0,
+	1111, 0, 0, 0, 0, 99, 100, 0, 401, 0,
+	445, 448, 0, 410, 412, 1087, 0, 0, 446, 444,
+	447, 449, 1087, 0, 431, 432, 433, 434, 435, 436,
+	437, 438, 439, 440, 441, 0, 1087, 852, 853, 854,
+	855, 0, 0, 0, 1094, 0, 0, 0, 0, 1092,
+	1159, 492, 494, 495, 493, 769, 489, 0, 511, 0,
+	0, 527, 528, 800, 0, 26, 625, 0, 567, 770,
+	0, 0, 0, 644, 0, 664, 647, 273, 272, 269,
+	270, 707, 563, 0, 298, 298, 749, 298, 302, 752,
+	298, 754, 298, 757, 0, 0, 0, 0, 0, 0,
+	0, 761, 722, 767, 0, 33, 0, 811, 801, 813,
+	815, 0, 29, 0, 807, 0, 794, 820, 626, 821,
+	594, 0, 599, 0, 0, 0, 602, 0, 794, 39,
+	56, 57, 58, 0, 0, 0, 0, 394, 0, 397,
+	0, 0, 0, 380, 791, 389, 0, 0, 0, 0,
+	0, 0, 376, 0, 0, 0, 366, 369, 372, 392,
+	0, 0, 154, 157, 0, 0, 0, 0, 0, 0,
+	164, 0, 178, 345, 346, 0, 0, 177, 0, 0,
+	0, 205, 203, 280, 0, 0, 279, 144, 142, 145,
+	0, 299, 239, 240, 313, 241, 310, 311, 309, 0,
+	309, 0, 0, 0, 303, 0, 0, 0, 0, 0,
+	0, 0, 0, -2, 75, 0, 1108, 0, 1120, 1121,
+	1129, 1127, 0, 0, 0, 0, 0, 80, 1112, 0,
+	188, 0, 190, 0, 0, 1140, 1134, 1137, 586, 0,
+	0, 0, 442, 443, 0, 0, 0, 414, 0, 415,
+	417, 418, 419, 0, 0, 0, 0, 0, 411, 413,
+	0, 0, 0, 0, 462, 491, 530, 531, 782, 574,
+	708, 641, 642, 648, 711, 746, 309, 750, 751, 753,
+	755, 756, 758, 713, 712, 714, 0, 0, 717, 0,
+	0, 0, 0, 0, 765, 0, 34, 0, 816, -2,
+	0, 0, 0, 46, 37, 0, 0, 0, 0, 621,
+	589, 38, 115, 0, 0, 0, 0, 0, 392, 322,
+	323, 316, 0, 387, 560, 0, 0, 0, 0, 0,
+	0, 377, 325, 0, 0, 106, 151, 153, 156, 161,
+	194, 0, 0, 0, 0, 0, 347, 348, 349, 0,
+	207, 0, 184, 185, 204, 1117, 281, 277, 0, 242,
+	313, 266, 313, 235, 236, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1089, 0, 0, 1096, 1097,
+	1101, 1102, 1103, 1104, 1105, 1098, 0, 0, 85, 0,
+	187, 189, 1112, 0, 0, 0, 0, 101, 102, 0,
+	0, 0, 0, 0, 0, 0, 424, 429, 0, 0,
+	0, 0, 0, 784, 0, 747, 748, 0, 0, 0,
+	0, 739, 721, 762, 0, 814, 0, -2, 0, 809,
+	808, 595, 622, 623, 624, 583, 125, 0, 0, 0,
+	0, 584, 0, 0, 121, 123, 124, 0, 0, 105,
+	315, 317, 350, 0, 363, 0, 0, 356, 357, 381,
+	382, 0, 0, 391, 0, 0, 0, 0, 0, 0,
+	0, 0, 335, 0, 195, 199, 201, 165, 0, 176,
+	181, 208, 209, 207, 0, 146, 254, 255, 301, 304,
+	0, 0, 0, 0, 383, 0, 361, 358, 1090, 81,
+	0, 0, 0, 84, 0, 87, 1143, 1144, 0, 1146,
+	1147, 1148, 1149, 1150, 1151, 1152, 1153, 1154, 1155, 1156,
+	0, 1141, 0, 587, 0, 0, 0, 0, 0, 420,
+	0, 0, 0, 0, 0, 0, 0, 425, 430, 28,
+	0, 0, 715, 716, 718, 719, 0, 0, 0, 0,
+	804, 29, 0, 108, 0, 116, 0, 0, 584, 0,
+	0, 585, 0, 0, 0, 118, 0, 351, 352, 0,
+	364, 354, 0, 388, 390, 0, 0, 0, 0, 326,
+	0, 0, 338, 0, 0, 166, 182, 206, 383, 0,
+	0, 0, 385, 0, 0, 358, 0, 72, 359, 360,
+	1099, 0, 0, 1114, 0, 1112, 0, 0, 0, 1142,
+	0, 0, 0, 0, 95, 0, 422, 0, 0, 451,
+	0, 0, 0, 421, 0, 785, 783, 720, 0, 0,
+	0, 812, -2, 810, 0, 109, 0, 0, 0, 111,
+	0, 0, 122, 0, 353, 355, 0, 0, 0, 0,
+	0, 0, 328, 0, 336, 337, 340, 341, 342, 343,
+	344, 385, 0, 0, 0, 625, 0, 0, 383, 71,
+	0, 1100, 1113, 0, 1116, 86, 0, 1157, 1158, 358,
+	361, 358, 406, 98, 205, 0, 450, 0, 0, 0,
+	0, 423, 740, 0, 743, 126, 110, 113, 0, 584,
+	0, 0, 0, 0, 0, 0, 338, 327, 0, 625,
+	383, 0, 358, 68, 386, 0, 385, 362, 1115, 0,
+	402, 358, 404, 407, 416, 0, 452, 0, 0, 408,
+	741, 584, 0, 0, 0, 0, 0, 0, 0, 329,
+	0, 358, 385, 383, 66, 384, 625, 1145, 403, 191,
+	0, 0, 0, 405, 409, 0, 0, 112, 117, 119,
+	318, 0, 0, 0, 339, 65, 625, 385, 69, 0,
+	0, 0, 0, 114, 319, 0, 0, 67, 625, 192,
+	0, 458, 0, 742, 320, 0, 70, 0, 0, 456,
+	458, 321, 458, 0, 458, 363, 457, 453, 0, 455,
+	0, 458, 459, 454,
+}
+
+var yyTok1 = [...]int{
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 86, 3, 3, 3, 114, 106, 3,
+	66, 68, 111, 109, 67, 110, 124, 112, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 291,
+	94, 93, 95, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 292, 3, 293, 116, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 104, 3, 117,
+}
+
+var yyTok2 = [...]int{
+	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
+	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
+	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
+	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 69, 70, 71, 72, 73, 74,
+	75, 76, 77, 78, 79, 80, 81, 82, 83, 84,
+	85, 87, 88, 89, 90, 91, 92, 96, 97, 98,
+	99, 100, 101, 102, 103, 105, 107, 108, 113, 115,
+	118, 119, 120, 121, 122, 123, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
+	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
+	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
+	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
+	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
+	249, 250, 251, 252, 253, 254, 255, 256, 257, 258,
+	259, 260, 261, 262, 263, 264, 265, 266, 267, 268,
+	269, 270, 271, 272, 273, 274,
+}
+
+var yyTok3 = [...]int{
+	57600, 275, 57601, 276, 57602, 277, 57603, 278, 57604, 279,
+	57605, 280, 57606, 281, 57607, 282, 57608, 283, 57609, 284,
+	57610, 285, 57611, 286, 57612, 287, 57613, 288, 57614, 289,
+	57615, 290, 0,
+}
+
+var yyErrorMessages = [...]struct {
+	state int
+	token int
+	msg   string
+}{}
+
+//line yaccpar:1
+
+/*	parser for yacc output	*/
+
+var (
+	yyDebug        = 0
+	yyErrorVerbose = false
+)
+
+type yyLexer interface {
+	Lex(lval *yySymType) int
+	Error(s string)
+}
+
+type yyParser interface {
+	Parse(yyLexer) int
+	Lookahead() int
+}
+
+type yyParserImpl struct {
+	lval  yySymType
+	stack [yyInitialStackSize]yySymType
+	char  int
+}
+
+func (p *yyParserImpl) Lookahead() int {
+	return p.char
+}
+
+func yyNewParser() yyParser {
+	return &yyParserImpl{}
+}
+
+const yyFlag = -1000
+
+func yyTokname(c int) string {
+	if c >= 1 && c-1 < len(yyToknames) {
+		if yyToknames[c-1] != "" {
+			return yyToknames[c-1]
+		}
+	}
+	return __yyfmt__.Sprintf("tok-%v", c)
+}
+
+func yyStatname(s int) string {
+	if s >= 0 && s < len(yyStatenames) {
+		if yyStatenames[s] != "" {
+			return yyStatenames[s]
+		}
+	}
+	return __yyfmt__.Sprintf("state-%v", s)
+}
+
+func yyErrorMessage(state, lookAhead int) string {
+	const TOKSTART = 4
+
+	if !yyErrorVerbose {
+		return "syntax error"
+	}
+
+	for _, e := range yyErrorMessages {
+		if e.state == state && e.token == lookAhead {
+			return "syntax error: " + e.msg
+		}
+	}
+
+	res := "syntax error: unexpected " + yyTokname(lookAhead)
+
+	// To match Bison, suggest at most four expected tokens.
+	expected := make([]int, 0, 4)
+
+	// Look for shiftable tokens.
+	base := yyPact[state]
+	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
+		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+	}
+
+	if yyDef[state] == -2 {
+		i := 0
+		for yyExca[i] != -1 || yyExca[i+1] != state {
+			i += 2
+		}
+
+		// Look for tokens that we accept or reduce.
+		for i += 2; yyExca[i] >= 0; i += 2 {
+			tok := yyExca[i]
+			if tok < TOKSTART || yyExca[i+1] == 0 {
+				continue
+			}
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+
+		// If the default action is to accept or reduce, give up.
+		if yyExca[i+1] != 0 {
+			return res
+		}
+	}
+
+	for i, tok := range expected {
+		if i == 0 {
+			res += ", expecting "
+		} else {
+			res += " or "
+		}
+		res += yyTokname(tok)
+	}
+	return res
+}
+
+func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
+	token = 0
+	char = lex.Lex(lval)
+	if char <= 0 {
+		token = yyTok1[0]
+		goto out
+	}
+	if char < len(yyTok1) {
+		token = yyTok1[char]
+		goto out
+	}
+	if char >= yyPrivate {
+		if char < yyPrivate+len(yyTok2) {
+			token = yyTok2[char-yyPrivate]
+			goto out
+		}
+	}
+	for i := 0; i < len(yyTok3); i += 2 {
+		token = yyTok3[i+0]
+		if token == char {
+			token = yyTok3[i+1]
+			goto out
+		}
+	}
+
+out:
+	if token == 0 {
+		token = yyTok2[1] /* unknown char */
+	}
+	if yyDebug >= 3 {
+		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
+	}
+	return char, token
+}
+
+func yyParse(yylex yyLexer) int {
+	return yyNewParser().Parse(yylex)
+}
+
+func (yyrcvr *yyParserImpl) Parse(yylex yyLexer) int {
+	var yyn int
+	var yyVAL yySymType
+	var yyDollar []yySymType
+	_ = yyDollar // silence set and not used
+	yyS := yyrcvr.stack[:]
+
+	Nerrs := 0   /* number of errors */
+	Errflag := 0 /* error recovery flag */
+	yystate := 0
+	yyrcvr.char = -1
+	yytoken := -1 // yyrcvr.char translated into internal numbering
+	defer func() {
+		// Make sure we report no lookahead when not parsing.
+		yystate = -1
+		yyrcvr.char = -1
+		yytoken = -1
+	}()
+	yyp := -1
+	goto yystack
+
+ret0:
+	return 0
+
+ret1:
+	return 1
+
+yystack:
+	/* put a state and value onto the stack */
+	if yyDebug >= 4 {
+		__yyfmt__.Printf("char %v in %v\n", yyTokname(yytoken), yyStatname(yystate))
+	}
+
+	yyp++
+	if yyp >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyS[yyp] = yyVAL
+	yyS[yyp].yys = yystate
+
+yynewstate:
+	yyn = yyPact[yystate]
+	if yyn <= yyFlag {
+		goto yydefault /* simple state */
+	}
+	if yyrcvr.char < 0 {
+		yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+	}
+	yyn += yytoken
+	if yyn < 0 || yyn >= yyLast {
+		goto yydefault
+	}
+	yyn = yyAct[yyn]
+	if yyChk[yyn] == yytoken { /* valid shift */
+		yyrcvr.char = -1
+		yytoken = -1
+		yyVAL = yyrcvr.lval
+		yystate = yyn
+		if Errflag > 0 {
+			Errflag--
+		}
+		goto yystack
+	}
+
+yydefault:
+	/* default state action */
+	yyn = yyDef[yystate]
+	if yyn == -2 {
+		if yyrcvr.char < 0 {
+			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+		}
+
+		/* look through exception table */
+		xi := 0
+		for {
+			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+				break
+			}
+			xi += 2
+		}
+		for xi += 2; ; xi += 2 {
+			yyn = yyExca[xi+0]
+			if yyn < 0 || yyn == yytoken {
+				break
+			}
+		}
+		yyn = yyExca[xi+1]
+		if yyn < 0 {
+			goto ret0
+		}
+	}
+	if yyn == 0 {
+		/* error ... attempt to resume parsing */
+		switch Errflag {
+		case 0: /* brand new error */
+			yylex.Error(yyErrorMessage(yystate, yytoken))
+			Nerrs++
+			if yyDebug >= 1 {
+				__yyfmt__.Printf("%s", yyStatname(yystate))
+				__yyfmt__.Printf(" saw %s\n", yyTokname(yytoken))
+			}
+			fallthrough
+
+		case 1, 2: /* incompletely recovered error ... try again */
+			Errflag = 3
+
+			/* find a state where "error" is a legal shift action */
+			for yyp >= 0 {
+				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				if yyn >= 0 && yyn < yyLast {
+					yystate = yyAct[yyn] /* simulate a shift of "error" */
+					if yyChk[yystate] == yyErrCode {
+						goto yystack
+					}
+				}
+
+				/* the current p has no shift on "error", pop stack */
+				if yyDebug >= 2 {
+					__yyfmt__.Printf("error recovery pops state %d\n", yyS[yyp].yys)
+				}
+				yyp--
+			}
+			/* there is no state on the stack with an error shift ... abort */
+			goto ret1
+
+		case 3: /* no shift yet; clobber input char */
+			if yyDebug >= 2 {
+				__yyfmt__.Printf("error recovery discards %s\n", yyTokname(yytoken))
+			}
+			if yytoken == yyEofCode {
+				goto ret1
+			}
+			yyrcvr.char = -1
+			yytoken = -1
+			goto yynewstate /* try again in the same state */
+		}
+	}
+
+	/* reduction by production yyn */
+	if yyDebug >= 2 {
+		__yyfmt__.Printf("reduce %v in:\n\t%v\n", yyn, yyStatname(yystate))
+	}
+
+	yynt := yyn
+	yypt := yyp
+	_ = yypt // guard against "declared and not used"
+
+	yyp -= yyR2[yyn]
+	// yyp is now the index of $0. Perform the default action. Iff the
+	// reduced production is ε, $1 is possibly out of range.
+	if yyp+1 >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyVAL = yyS[yyp+1]
+
+	/* consult goto table to find next state */
+	yyn = yyR1[yyn]
+	yyg := yyPgo[yyn]
+	yyj := yyg + yyS[yyp].yys + 1
+
+	if yyj >= yyLast {
+		yystate = yyAct[yyg]
+	} else {
+		yystate = yyAct[yyj]
+		if yyChk[yystate] != -yyn {
+			yystate = yyAct[yyg]
+		}
 	}
-	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
-		if token == char {
-			token = yyTok3[i+1]
-			goto out
+	// dummy call; replaced with literal code
+	switch yynt {
+
+	case 1:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:395
+		{
+			setParseTree(yylex, yyDollar[1].statement)
+		}
+	case 2:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:400
+		{
+		}
+	case 3:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:401
+		{
+		}
+	case 4:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:405
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 24:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:430
+		{
+			sel := yyDollar[1].selStmt.(*Select)
+			sel.OrderBy = yyDollar[2].orderBy
+			sel.Limit = yyDollar[3].limit
+			sel.Lock = yyDollar[4].str
+			yyVAL.selStmt = sel
+		}
+	case 25:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:438
+		{
+			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
+		}
+	case 26:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:442
+		{
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
+		}
+	case 27:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:448
+		{
+			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
+		}
+	case 28:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:455
+		{
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
+		}
+	case 29:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:461
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 30:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:465
+		{
+			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+		}
+	case 31:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:471
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 32:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:475
+		{
+			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+		}
+	case 33:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:482
+		{
+			// insert_data returns a *Insert pre-filled with Columns & Values
+			ins := yyDollar[6].ins
+			ins.Action = yyDollar[1].str
+			ins.Comments = yyDollar[2].bytes2
+			ins.Ignore = yyDollar[3].str
+			ins.Table = yyDollar[4].tableName
+			ins.Partitions = yyDollar[5].partitions
+			ins.OnDup = OnDup(yyDollar[7].updateExprs)
+			yyVAL.statement = ins
+		}
+	case 34:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:494
+		{
+			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
+			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
+			for _, updateList := range yyDollar[7].updateExprs {
+				cols = append(cols, updateList.Name.Name)
+				vals = append(vals, updateList.Expr)
+			}
+			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
+		}
+	case 35:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:506
+		{
+			yyVAL.str = InsertStr
+		}
+	case 36:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:510
+		{
+			yyVAL.str = ReplaceStr
+		}
+	case 37:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:516
+		{
+			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), TableExprs: yyDollar[3].tableExprs, Exprs: yyDollar[5].updateExprs, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
+		}
+	case 38:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:522
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
+		}
+	case 39:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:526
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
+		}
+	case 40:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:530
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
+		}
+	case 41:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:535
+		{
+		}
+	case 42:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:536
+		{
+		}
+	case 43:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:540
+		{
+			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
+		}
+	case 44:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:544
+		{
+			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
+		}
+	case 45:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:549
+		{
+			yyVAL.partitions = nil
+		}
+	case 46:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:553
+		{
+			yyVAL.partitions = yyDollar[3].partitions
+		}
+	case 47:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:559
+		{
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
+		}
+	case 48:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:563
+		{
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
+		}
+	case 49:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:567
+		{
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
+		}
+	case 50:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:571
+		{
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
+		}
+	case 51:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:577
+		{
+			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
+		}
+	case 52:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:581
+		{
+			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
+		}
+	case 53:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:587
+		{
+			yyVAL.setExpr = yyDollar[3].setExpr
+		}
+	case 54:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:591
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("0"))}
+		}
+	case 55:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:595
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("1"))}
+		}
+	case 56:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:601
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("repeatable read"))}
+		}
+	case 57:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:605
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read committed"))}
+		}
+	case 58:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:609
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read uncommitted"))}
+		}
+	case 59:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:613
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("serializable"))}
+		}
+	case 60:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:619
+		{
+			yyVAL.str = SessionStr
+		}
+	case 61:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:623
+		{
+			yyVAL.str = GlobalStr
+		}
+	case 62:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:629
+		{
+			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 63:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:635
+		{
+			yyDollar[1].ddl.TableSpec = &TableSpec{Like: yyDollar[3].tableName}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 64:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:641
+		{
+			if yyDollar[3].colIdent.Lowered() != "of" {
+				yylex.Error("expected OF after PARTITION, but got: " + yyDollar[3].colIdent.String())
+				return 1
+			}
+			yyDollar[1].ddl.TableSpec = &TableSpec{PartitionOf: &PartitionOf{Parent: yyDollar[4].tableName, Bound: yyDollar[5].partBound}, Partition: yyDollar[6].partOption}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 65:
+		yyDollar = yyS[yypt-14 : yypt+1]
+//line parser.y:650
+		{
+			yyVAL.statement = &DDL{
+				Action:  CreateIndexStr,
+				Table:   yyDollar[7].tableName,
+				NewName: yyDollar[7].tableName,
+				IndexSpec: &IndexSpec{
+					Name:       yyDollar[5].colIdent,
+					Type:       NewColIdent(""),
+					Unique:     bool(yyDollar[2].boolVal),
+					Include:    yyDollar[11].columns,
+					Tablespace: yyDollar[12].str,
+					Where:      yyDollar[13].expr,
+					Invisible:  bool(yyDollar[14].boolVal),
+				},
+				IndexCols: yyDollar[9].indexColumns,
+			}
+		}
+	case 66:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line parser.y:669
+		{
+			yyVAL.statement = &DDL{
+				Action:  CreateIndexStr,
+				Table:   yyDollar[9].tableName,
+				NewName: yyDollar[9].tableName,
+				IndexSpec: &IndexSpec{
+					Name:      yyDollar[5].colIdent,
+					Type:      yyDollar[7].colIdent,
+					Unique:    bool(yyDollar[2].boolVal),
+					Invisible: bool(yyDollar[13].boolVal),
+				},
+				IndexCols: yyDollar[11].indexColumns,
+			}
+		}
+	case 67:
+		yyDollar = yyS[yypt-15 : yypt+1]
+//line parser.y:685
+		{
+			yyVAL.statement = &DDL{
+				Action:  CreateIndexStr,
+				Table:   yyDollar[7].tableName,
+				NewName: yyDollar[7].tableName,
+				IndexSpec: &IndexSpec{
+					Name:       yyDollar[5].colIdent,
+					Type:       yyDollar[9].colIdent,
+					Unique:     bool(yyDollar[2].boolVal),
+					Include:    yyDollar[13].columns,
+					Tablespace: yyDollar[14].str,
+					Where:      yyDollar[15].expr,
+				},
+				IndexCols: yyDollar[11].indexColumns,
+			}
+		}
+	case 68:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line parser.y:703
+		{
+			yyVAL.statement = &DDL{
+				Action:  CreateIndexStr,
+				Table:   yyDollar[6].tableName,
+				NewName: yyDollar[6].tableName,
+				IndexSpec: &IndexSpec{
+					Type:       NewColIdent(""),
+					Unique:     bool(yyDollar[2].boolVal),
+					Include:    yyDollar[10].columns,
+					Tablespace: yyDollar[11].str,
+					Where:      yyDollar[12].expr,
+				},
+				IndexCols: yyDollar[8].indexColumns,
+			}
+		}
+	case 69:
+		yyDollar = yyS[yypt-14 : yypt+1]
+//line parser.y:719
+		{
+			yyVAL.statement = &DDL{
+				Action:  CreateIndexStr,
+				Table:   yyDollar[6].tableName,
+				NewName: yyDollar[6].tableName,
+				IndexSpec: &IndexSpec{
+					Type:       yyDollar[8].colIdent,
+					Unique:     bool(yyDollar[2].boolVal),
+					Include:    yyDollar[12].columns,
+					Tablespace: yyDollar[13].str,
+					Where:      yyDollar[14].expr,
+				},
+				IndexCols: yyDollar[10].indexColumns,
+			}
+		}
+	case 70:
+		yyDollar = yyS[yypt-16 : yypt+1]
+//line parser.y:736
+		{
+			yyVAL.statement = &DDL{
+				Action:  CreateIndexStr,
+				Table:   yyDollar[8].tableName,
+				NewName: yyDollar[8].tableName,
+				IndexSpec: &IndexSpec{
+					Name:       yyDollar[5].colIdent,
+					Type:       yyDollar[10].colIdent,
+					Unique:     bool(yyDollar[2].boolVal),
+					Include:    yyDollar[14].columns,
+					Tablespace: yyDollar[15].str,
+					Where:      yyDollar[16].expr,
+				},
+				IndexCols: yyDollar[12].indexColumns,
+			}
+		}
+	case 71:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.y:754
+		{
+			yyVAL.statement = &DDL{
+				Action:  CreateIndexStr,
+				Table:   yyDollar[6].tableName,
+				NewName: yyDollar[6].tableName,
+				IndexSpec: &IndexSpec{
+					Name:      yyDollar[4].colIdent,
+					Type:      NewColIdent(""),
+					Fulltext:  true,
+					Parser:    yyDollar[10].str,
+					Invisible: bool(yyDollar[11].boolVal),
+				},
+				IndexCols: yyDollar[8].indexColumns,
+			}
+		}
+	case 72:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:770
+		{
+			yyVAL.statement = &DDL{
+				Action:  CreateIndexStr,
+				Table:   yyDollar[6].tableName,
+				NewName: yyDollar[6].tableName,
+				IndexSpec: &IndexSpec{
+					Name:      yyDollar[4].colIdent,
+					Type:      NewColIdent(""),
+					Spatial:   true,
+					Invisible: bool(yyDollar[10].boolVal),
+				},
+				IndexCols: yyDollar[8].indexColumns,
+			}
+		}
+	case 73:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:785
+		{
+			yyVAL.statement = &DDL{Action: CreateViewStr, NewName: yyDollar[3].tableName.ToViewName(), ViewExpr: yyDollar[5].selStmt}
+		}
+	case 74:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:789
+		{
+			yyVAL.statement = &DDL{Action: CreateViewStr, NewName: yyDollar[5].tableName.ToViewName(), ViewExpr: yyDollar[7].selStmt, OrReplace: true}
+		}
+	case 75:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:794
+		{
+			if NewColIdent(string(yyDollar[2].bytes)).Lowered() != "materialized" {
+				yylex.Error("expected MATERIALIZED VIEW, but got: " + string(yyDollar[2].bytes))
+				return 1
+			}
+			yyVAL.statement = &DDL{Action: CreateViewStr, NewName: yyDollar[4].tableName.ToViewName(), ViewExpr: yyDollar[6].selStmt, Materialized: true, WithNoData: bool(yyDollar[7].boolVal)}
+		}
+	case 76:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:802
+		{
+			if NewColIdent(string(yyDollar[2].bytes)).Lowered() != "function" {
+				yylex.Error("expected FUNCTION, but got: " + string(yyDollar[2].bytes))
+				return 1
+			}
+			yyVAL.statement = &DDL{Action: CreateFunctionStr, Table: yyDollar[3].tableName, FunctionSpec: yyDollar[4].functionSpec}
+		}
+	case 77:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:810
+		{
+			if NewColIdent(string(yyDollar[4].bytes)).Lowered() != "function" {
+				yylex.Error("expected FUNCTION, but got: " + string(yyDollar[4].bytes))
+				return 1
+			}
+			yyVAL.statement = &DDL{Action: CreateFunctionStr, Table: yyDollar[5].tableName, FunctionSpec: yyDollar[6].functionSpec, OrReplace: true}
+		}
+	case 78:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:819
+		{
+			yyVAL.statement = &DDL{Action: CreateProcedureStr, Table: yyDollar[3].tableName, FunctionSpec: yyDollar[4].functionSpec}
+		}
+	case 79:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:824
+		{
+			switch NewColIdent(string(yyDollar[2].bytes)).Lowered() {
+			case "sequence":
+				if len(yyDollar[5].strs) > 0 {
+					yylex.Error("unexpected options of CREATE SEQUENCE: " + yyDollar[5].strs[0])
+					return 1
+				}
+				yyVAL.statement = &DDL{Action: CreateSequenceStr, Table: yyDollar[3].tableName, SequenceSpec: yyDollar[4].sequenceSpec}
+			case "extension":
+				if *yyDollar[4].sequenceSpec != (SequenceSpec{}) {
+					yylex.Error("unexpected options of CREATE EXTENSION")
+					return 1
+				}
+				yyVAL.statement = &DDL{Action: CreateExtensionStr, Table: yyDollar[3].tableName, ExtensionOptions: yyDollar[5].strs}
+			default:
+				yylex.Error("expected SEQUENCE or EXTENSION, but got: " + string(yyDollar[2].bytes))
+				return 1
+			}
+		}
+	case 80:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:844
+		{
+			if NewColIdent(string(yyDollar[2].bytes)).Lowered() != "extension" {
+				yylex.Error("expected EXTENSION, but got: " + string(yyDollar[2].bytes))
+				return 1
+			}
+			yyVAL.statement = &DDL{Action: CreateExtensionStr, Table: yyDollar[6].tableName, ExtensionOptions: yyDollar[7].strs}
+		}
+	case 81:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:853
+		{
+			if NewColIdent(string(yyDollar[2].bytes)).Lowered() != "type" || *yyDollar[4].sequenceSpec != (SequenceSpec{}) {
+				yylex.Error("expected CREATE TYPE ... AS ENUM, but got: " + string(yyDollar[2].bytes))
+				return 1
+			}
+			yyVAL.statement = &DDL{Action: CreateTypeStr, Table: yyDollar[3].tableName, EnumValues: yyDollar[8].strs}
+		}
+	case 82:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:862
+		{
+			if NewColIdent(string(yyDollar[2].bytes)).Lowered() != "policy" || !yyDollar[3].tableName.Qualifier.IsEmpty() {
+				yylex.Error("expected CREATE POLICY, but got: " + string(yyDollar[2].bytes))
+				return 1
+			}
+			yyDollar[6].policySpec.Name = NewColIdent(yyDollar[3].tableName.Name.String())
+			yyVAL.statement = &DDL{Action: CreatePolicyStr, Table: yyDollar[5].tableName, PolicySpec: yyDollar[6].policySpec}
+		}
+	case 83:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:871
+		{
+			yyDollar[6].domainSpec.Type = yyDollar[5].columnType
+			yyVAL.statement = &DDL{Action: CreateDomainStr, Table: yyDollar[3].tableName, DomainSpec: yyDollar[6].domainSpec}
+		}
+	case 84:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:877
+		{
+			if NewColIdent(string(yyDollar[6].bytes)).Lowered() != "data" || NewColIdent(string(yyDollar[7].bytes)).Lowered() != "wrapper" {
+				yylex.Error("expected FOREIGN DATA WRAPPER, but got: FOREIGN " + string(yyDollar[6].bytes) + " " + string(yyDollar[7].bytes))
+				return 1
+			}
+			yyDollar[4].foreignSpec.Wrapper = yyDollar[8].colIdent
+			yyDollar[4].foreignSpec.Options = yyDollar[9].foreignOptions
+			yyVAL.statement = &DDL{Action: CreateServerStr, Table: yyDollar[3].tableName, ForeignSpec: yyDollar[4].foreignSpec}
+		}
+	case 85:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:887
+		{
+			if NewColIdent(string(yyDollar[2].bytes)).Lowered() != "user" || NewColIdent(string(yyDollar[3].bytes)).Lowered() != "mapping" {
+				yylex.Error("expected USER MAPPING, but got: " + string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes))
+				return 1
+			}
+			yyVAL.statement = &DDL{Action: CreateUserMappingStr, ForeignSpec: &ForeignSpec{User: yyDollar[5].colIdent, Server: yyDollar[7].colIdent, Options: yyDollar[8].foreignOptions}}
+		}
+	case 86:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.y:895
+		{
+			yyVAL.statement = &DDL{Action: CreateForeignTableStr, NewName: yyDollar[5].tableName, TableSpec: yyDollar[7].TableSpec, ForeignSpec: &ForeignSpec{Server: yyDollar[10].colIdent, Options: yyDollar[11].foreignOptions}}
+		}
+	case 87:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:899
+		{
+			yyDollar[9].triggerSpec.Name = yyDollar[3].colIdent
+			yyDollar[9].triggerSpec.Time = yyDollar[4].str
+			yyDollar[9].triggerSpec.Events = yyDollar[5].strs
+			yyDollar[9].triggerSpec.ForEach = yyDollar[8].str
+			yyVAL.statement = &DDL{Action: CreateTriggerStr, Table: yyDollar[7].tableName, TriggerSpec: yyDollar[9].triggerSpec}
+		}
+	case 88:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:907
+		{
+			yyVAL.statement = &DDL{Action: CreateVindexStr, VindexSpec: &VindexSpec{
+				Name:   yyDollar[3].colIdent,
+				Type:   yyDollar[4].colIdent,
+				Params: yyDollar[5].vindexParams,
+			}}
+		}
+	case 89:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:915
+		{
+			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
+		}
+	case 90:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:920
+		{
+			if yylex.(*Tokenizer).mode == ParserModePostgres {
+				yyVAL.statement = &DDL{Action: CreateSchemaStr, Table: TableName{Name: NewTableIdent(string(yyDollar[4].bytes))}}
+			} else {
+				yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
+			}
+		}
+	case 91:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:929
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 92:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:933
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 93:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:940
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 94:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:944
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 95:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:949
+		{
+			yyVAL.colIdent = NewColIdent("")
+		}
+	case 96:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:953
+		{
+			yyVAL.colIdent = yyDollar[2].colIdent
+		}
+	case 97:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:959
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 98:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:964
+		{
+			var v []VindexParam
+			yyVAL.vindexParams = v
+		}
+	case 99:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:969
+		{
+			yyVAL.vindexParams = yyDollar[2].vindexParams
+		}
+	case 100:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:975
+		{
+			yyVAL.vindexParams = make([]VindexParam, 0, 4)
+			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
+		}
+	case 101:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:980
+		{
+			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
+		}
+	case 102:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:986
+		{
+			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
+		}
+	case 103:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:992
+		{
+			yyVAL.ddl = &DDL{Action: CreateStr, NewName: yyDollar[4].tableName}
+			setDDL(yylex, yyVAL.ddl)
+		}
+	case 104:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:999
+		{
+			yyVAL.TableSpec = yyDollar[2].TableSpec
+			yyVAL.TableSpec.Options = yyDollar[4].str
+			yyVAL.TableSpec.Partition = yyDollar[5].partOption
+		}
+	case 105:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:1006
+		{
+			yyVAL.TableSpec = yyDollar[2].TableSpec
+			yyVAL.TableSpec.Inherits = yyDollar[6].tableNames
+			yyVAL.TableSpec.Options = yyDollar[8].str
+		}
+	case 106:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:1012
+		{
+			yyVAL.TableSpec = &TableSpec{Inherits: yyDollar[5].tableNames, Options: yyDollar[7].str}
+		}
+	case 107:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1017
+		{
+			yyVAL.partOption = nil
+		}
+	case 108:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1021
+		{
+			yyVAL.partOption = yyDollar[3].partOption
+			yyVAL.partOption.Partitions = yyDollar[4].optVal
+			yyVAL.partOption.Definitions = yyDollar[5].partDefs
+		}
+	case 109:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1030
+		{
+			yyVAL.partOption = &PartitionOption{Type: yyDollar[1].colIdent.Lowered(), Exprs: yyDollar[3].exprs}
+			if !yyVAL.partOption.isValidType() {
+				yylex.Error("unknown partitioning type: " + yyDollar[1].colIdent.String())
+				return 1
+			}
+		}
+	case 110:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1038
+		{
+			switch {
+			case yyDollar[1].colIdent.Lowered() == "linear" && yyDollar[2].colIdent.Lowered() == "hash":
+				yyVAL.partOption = &PartitionOption{Type: yyDollar[2].colIdent.Lowered(), Linear: true, Exprs: yyDollar[4].exprs}
+			case yyDollar[2].colIdent.Lowered() == "columns":
+				yyVAL.partOption = &PartitionOption{Type: yyDollar[1].colIdent.Lowered(), Columns: true, Exprs: yyDollar[4].exprs}
+				if yyVAL.partOption.Type != PartitionRangeStr && yyVAL.partOption.Type != PartitionListStr {
+					yylex.Error("COLUMNS is specified for unexpected partitioning type: " + yyDollar[1].colIdent.String())
+					return 1
+				}
+			default:
+				yylex.Error("unknown partitioning type: " + yyDollar[1].colIdent.String() + " " + yyDollar[2].colIdent.String())
+				return 1
+			}
+		}
+	case 111:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1054
+		{
+			yyVAL.partOption = &PartitionOption{Type: PartitionKeyStr, KeyColumns: yyDollar[3].columns}
+		}
+	case 112:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:1058
+		{
+			if yyDollar[2].colIdent.Lowered() != "algorithm" {
+				yylex.Error("unexpected option for KEY partitioning: " + yyDollar[2].colIdent.String())
+				return 1
+			}
+			yyVAL.partOption = &PartitionOption{Type: PartitionKeyStr, Algorithm: NewIntVal(yyDollar[4].bytes), KeyColumns: yyDollar[6].columns}
+		}
+	case 113:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1066
+		{
+			if yyDollar[1].colIdent.Lowered() != "linear" {
+				yylex.Error("unknown partitioning type: " + yyDollar[1].colIdent.String() + " key")
+				return 1
+			}
+			yyVAL.partOption = &PartitionOption{Type: PartitionKeyStr, Linear: true, KeyColumns: yyDollar[4].columns}
+		}
+	case 114:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:1074
+		{
+			if yyDollar[1].colIdent.Lowered() != "linear" || yyDollar[3].colIdent.Lowered() != "algorithm" {
+				yylex.Error("unknown partitioning type: " + yyDollar[1].colIdent.String() + " key " + yyDollar[3].colIdent.String())
+				return 1
+			}
+			yyVAL.partOption = &PartitionOption{Type: PartitionKeyStr, Linear: true, Algorithm: NewIntVal(yyDollar[5].bytes), KeyColumns: yyDollar[7].columns}
+		}
+	case 115:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1083
+		{
+			yyVAL.optVal = nil
+		}
+	case 116:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1087
+		{
+			if yyDollar[1].colIdent.Lowered() != "partitions" {
+				yylex.Error("unexpected partition option: " + yyDollar[1].colIdent.String())
+				return 1
+			}
+			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 117:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:1097
+		{
+			yyVAL.partBound = &PartitionBound{From: yyDollar[5].exprs, To: yyDollar[9].exprs}
+		}
+	case 118:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1101
+		{
+			yyVAL.partBound = &PartitionBound{In: yyDollar[5].exprs}
+		}
+	case 119:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:1105
+		{
+			if yyDollar[5].colIdent.Lowered() != "modulus" || yyDollar[8].colIdent.Lowered() != "remainder" {
+				yylex.Error("expected MODULUS and REMAINDER, but got: " + yyDollar[5].colIdent.String() + " and " + yyDollar[8].colIdent.String())
+				return 1
+			}
+			yyVAL.partBound = &PartitionBound{Modulus: NewIntVal(yyDollar[6].bytes), Remainder: NewIntVal(yyDollar[9].bytes)}
+		}
+	case 120:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1113
+		{
+			yyVAL.partBound = &PartitionBound{Default: true}
+		}
+	case 121:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1119
+		{
+			yyVAL.exprs = Exprs{yyDollar[1].expr}
+		}
+	case 122:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1123
+		{
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+		}
+	case 123:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1130
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 124:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1134
+		{
+			yyVAL.expr = &MaxValueVal{}
+		}
+	case 125:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1139
+		{
+			yyVAL.partDefs = nil
+		}
+	case 126:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1143
+		{
+			yyVAL.partDefs = yyDollar[2].partDefs
+		}
+	case 127:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1149
+		{
+			yyVAL.TableSpec = &TableSpec{}
+			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
+		}
+	case 128:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1154
+		{
+			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+		}
+	case 129:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1158
+		{
+			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
+		}
+	case 130:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1162
+		{
+			yyVAL.TableSpec.AddForeignKey(yyDollar[3].foreignKeyDefinition)
+		}
+	case 131:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1166
+		{
+			yyVAL.TableSpec.AddCheck(yyDollar[3].checkDefinition)
+		}
+	case 132:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1170
+		{
+			yyVAL.TableSpec.AddExclusion(yyDollar[3].exclusionDefinition)
+		}
+	case 133:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1174
+		{
+			yyVAL.TableSpec.Period = yyDollar[3].periodDefinition
+		}
+	case 134:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1180
+		{
+			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
+		}
+	case 135:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1185
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
+			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
+		}
+	case 140:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1196
+		{
+			yyVAL.columnType = ColumnType{Type: NewColIdent(string(yyDollar[1].bytes)).Lowered()}
+		}
+	case 141:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1200
+		{
+			yyVAL.columnType = ColumnType{Type: NewColIdent(string(yyDollar[1].bytes)).Lowered() + "." + yyDollar[3].colIdent.Lowered()}
+		}
+	case 142:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1205
+		{
+			yyVAL.columnType = ColumnType{Type: NewColIdent(string(yyDollar[1].bytes)).Lowered(), Length: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 143:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1210
+		{
+			yyVAL.columnType = yyDollar[2].columnType
+			yyVAL.columnType.Type = NewColIdent(string(yyDollar[1].bytes)).Lowered()
+		}
+	case 144:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1215
+		{
+			yyVAL.columnType = yyDollar[4].columnType
+			yyVAL.columnType.Type = NewColIdent(string(yyDollar[1].bytes)).Lowered() + "." + yyDollar[3].colIdent.Lowered()
+		}
+	case 145:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1222
+		{
+			yyVAL.columnType = ColumnType{GeometryType: yyDollar[2].colIdent.Lowered()}
+		}
+	case 146:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1226
+		{
+			yyVAL.columnType = ColumnType{GeometryType: yyDollar[2].colIdent.Lowered(), Srid: NewIntVal(yyDollar[4].bytes)}
+		}
+	case 147:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1232
+		{
+			yyDollar[1].columnType.Array = yyDollar[2].boolVal
+			yyDollar[1].columnType.NotNull = BoolVal(false)
+			yyDollar[1].columnType.Default = nil
+			yyDollar[1].columnType.OnUpdate = nil
+			yyDollar[1].columnType.Autoincrement = BoolVal(false)
+			yyDollar[1].columnType.KeyOpt = colKeyNone
+			yyDollar[1].columnType.Comment = nil
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 148:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1243
+		{
+			yyDollar[1].columnType.NotNull = BoolVal(false)
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 149:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1248
+		{
+			yyDollar[1].columnType.NotNull = BoolVal(true)
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 150:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1253
+		{
+			yyDollar[1].columnType.Default = newScannedStrVal(yylex, yyDollar[3].bytes)
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 151:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1258
+		{
+			typ := yyDollar[5].columnType
+			yyDollar[1].columnType.DefaultExpr = &TypeCastExpr{Expr: newScannedStrVal(yylex, yyDollar[3].bytes), Type: &typ}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 152:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1264
+		{
+			yyDollar[1].columnType.Default = NewIntVal(yyDollar[3].bytes)
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 153:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1269
+		{
+			typ := yyDollar[5].columnType
+			yyDollar[1].columnType.DefaultExpr = &TypeCastExpr{Expr: NewIntVal(yyDollar[3].bytes), Type: &typ}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 154:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1275
+		{
+			yyDollar[1].columnType.Default = NewIntVal(append([]byte("-"), yyDollar[4].bytes...))
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 155:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1280
+		{
+			yyDollar[1].columnType.Default = NewFloatVal(yyDollar[3].bytes)
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 156:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1285
+		{
+			typ := yyDollar[5].columnType
+			yyDollar[1].columnType.DefaultExpr = &TypeCastExpr{Expr: NewFloatVal(yyDollar[3].bytes), Type: &typ}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 157:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1291
+		{
+			yyDollar[1].columnType.Default = NewFloatVal(append([]byte("-"), yyDollar[4].bytes...))
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 158:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1296
+		{
+			yyDollar[1].columnType.Default = NewValArg(yyDollar[3].bytes)
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 159:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1301
+		{
+			yyDollar[1].columnType.Default = yyDollar[3].optVal
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 160:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1306
+		{
+			if sequence, ok := nextvalSequence(yyDollar[3].expr); ok {
+				yyDollar[1].columnType.DefaultNextval = sequence
+			} else {
+				yyDollar[1].columnType.DefaultExpr = yyDollar[3].expr
+			}
+			yyVAL.columnType = yyDollar[1].columnType
 		}
-	}
-
-out:
-	if token == 0 {
-		token = yyTok2[1] /* unknown char */
-	}
-	if yyDebug >= 3 {
-		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
-	}
-	return char, token
-}
-
-func yyParse(yylex yyLexer) int {
-	return yyNewParser().Parse(yylex)
-}
-
-func (yyrcvr *yyParserImpl) Parse(yylex yyLexer) int {
-	var yyn int
-	var yyVAL yySymType
-	var yyDollar []yySymType
-	_ = yyDollar // silence set and not used
-	yyS := yyrcvr.stack[:]
-
-	Nerrs := 0   /* number of errors */
-	Errflag := 0 /* error recovery flag */
-	yystate := 0
-	yyrcvr.char = -1
-	yytoken := -1 // yyrcvr.char translated into internal numbering
-	defer func() {
-		// Make sure we report no lookahead when not parsing.
-		yystate = -1
-		yyrcvr.char = -1
-		yytoken = -1
-	}()
-	yyp := -1
-	goto yystack
-
-ret0:
-	return 0
-
-ret1:
-	return 1
-
-yystack:
-	/* put a state and value onto the stack */
-	if yyDebug >= 4 {
-		__yyfmt__.Printf("char %v in %v\n", yyTokname(yytoken), yyStatname(yystate))
-	}
-
-	yyp++
-	if yyp >= len(yyS) {
-		nyys := make([]yySymType, len(yyS)*2)
-		copy(nyys, yyS)
-		yyS = nyys
-	}
-	yyS[yyp] = yyVAL
-	yyS[yyp].yys = yystate
-
-yynewstate:
-	yyn = yyPact[yystate]
-	if yyn <= yyFlag {
-		goto yydefault /* simple state */
-	}
-	if yyrcvr.char < 0 {
-		yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
-	}
-	yyn += yytoken
-	if yyn < 0 || yyn >= yyLast {
-		goto yydefault
-	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
-		yyrcvr.char = -1
-		yytoken = -1
-		yyVAL = yyrcvr.lval
-		yystate = yyn
-		if Errflag > 0 {
-			Errflag--
+	case 161:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1315
+		{
+			yyDollar[1].columnType.DefaultExpr = &ParenExpr{Expr: yyDollar[4].expr}
+			yyVAL.columnType = yyDollar[1].columnType
 		}
-		goto yystack
-	}
-
-yydefault:
-	/* default state action */
-	yyn = yyDef[yystate]
-	if yyn == -2 {
-		if yyrcvr.char < 0 {
-			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+	case 162:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1320
+		{
+			yyDollar[1].columnType.DefaultExpr = yyDollar[3].boolVal
+			yyVAL.columnType = yyDollar[1].columnType
 		}
-
-		/* look through exception table */
-		xi := 0
-		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
-				break
+	case 163:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1325
+		{
+			yyDollar[1].columnType.Default = NewBitVal(yyDollar[3].bytes)
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 164:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1330
+		{
+			yyDollar[1].columnType.OnUpdate = yyDollar[4].optVal
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 165:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1335
+		{
+			if NewColIdent(string(yyDollar[4].bytes)).Lowered() != "now" {
+				yylex.Error("expected ON UPDATE CURRENT_TIMESTAMP, but got: " + string(yyDollar[4].bytes))
+				return 1
+			}
+			yyDollar[1].columnType.OnUpdate = NewValArg([]byte("now()"))
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 166:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:1344
+		{
+			if NewColIdent(string(yyDollar[4].bytes)).Lowered() != "now" {
+				yylex.Error("expected ON UPDATE CURRENT_TIMESTAMP, but got: " + string(yyDollar[4].bytes))
+				return 1
+			}
+			yyDollar[1].columnType.OnUpdate = NewValArg([]byte("now(" + string(yyDollar[6].bytes) + ")"))
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 167:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1353
+		{
+			yyDollar[1].columnType.Srid = NewIntVal(yyDollar[3].bytes)
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 168:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1358
+		{
+			yyDollar[1].columnType.Autoincrement = BoolVal(true)
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 169:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1363
+		{
+			yyDollar[1].columnType.Invisible = BoolVal(false)
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 170:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1368
+		{
+			yyDollar[1].columnType.Invisible = BoolVal(true)
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 171:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1373
+		{
+			yyDollar[1].columnType.KeyOpt = colKeyPrimary
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 172:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1378
+		{
+			yyDollar[1].columnType.KeyOpt = colKey
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 173:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1383
+		{
+			yyDollar[1].columnType.KeyOpt = colKeyUniqueKey
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 174:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1388
+		{
+			yyDollar[1].columnType.KeyOpt = colKeyUnique
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 175:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1393
+		{
+			yyDollar[1].columnType.Comment = newScannedStrVal(yylex, yyDollar[3].bytes)
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 176:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1398
+		{
+			yyDollar[1].columnType.References = &ForeignKeyDefinition{ReferenceName: yyDollar[3].tableName, ReferenceColumns: yyDollar[5].columns}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 177:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1403
+		{
+			if yyDollar[1].columnType.References == nil {
+				yylex.Error("ON DELETE is specified without REFERENCES")
+				return 1
+			}
+			yyDollar[1].columnType.References.OnDelete = yyDollar[4].colIdent
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 178:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1412
+		{
+			if yyDollar[1].columnType.References == nil {
+				yylex.Error("ON UPDATE is specified without REFERENCES")
+				return 1
+			}
+			yyDollar[1].columnType.References.OnUpdate = yyDollar[4].colIdent
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 179:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1421
+		{
+			if yyDollar[1].columnType.References == nil {
+				yylex.Error("DEFERRABLE is specified without REFERENCES")
+				return 1
+			}
+			yyDollar[1].columnType.References.Deferrable = yyDollar[2].str
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 180:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1430
+		{
+			yyDollar[1].columnType.Checks = append(yyDollar[1].columnType.Checks, yyDollar[2].checkDefinition)
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 181:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1435
+		{
+			yyDollar[1].columnType.Generated = &GeneratedColumn{Expr: yyDollar[4].expr, Type: yyDollar[6].str}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 182:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:1440
+		{
+			if yyDollar[2].str != "always" {
+				yylex.Error("expected GENERATED ALWAYS AS (expression), but got: GENERATED BY DEFAULT AS (expression)")
+				return 1
+			}
+			yyDollar[1].columnType.Generated = &GeneratedColumn{Expr: yyDollar[5].expr, Type: yyDollar[7].str}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 183:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1449
+		{
+			yyDollar[1].columnType.Identity = yyDollar[2].identitySpec
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 184:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1455
+		{
+			if yyDollar[2].str != "always" || NewColIdent(string(yyDollar[4].bytes)).Lowered() != "row" {
+				yylex.Error("expected GENERATED ALWAYS AS ROW START, but got: " + string(yyDollar[4].bytes))
+				return 1
+			}
+			yyDollar[1].columnType.SystemVersioning = RowStartStr
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 185:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1464
+		{
+			if yyDollar[2].str != "always" || NewColIdent(string(yyDollar[4].bytes)).Lowered() != "row" {
+				yylex.Error("expected GENERATED ALWAYS AS ROW END, but got: " + string(yyDollar[4].bytes))
+				return 1
+			}
+			yyDollar[1].columnType.SystemVersioning = RowEndStr
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 186:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1475
+		{
+			yyVAL.domainSpec = &DomainSpec{}
+		}
+	case 187:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1479
+		{
+			yyDollar[1].domainSpec.Default = yyDollar[3].expr
+			yyVAL.domainSpec = yyDollar[1].domainSpec
+		}
+	case 188:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1484
+		{
+			yyDollar[1].domainSpec.NotNull = false
+			yyVAL.domainSpec = yyDollar[1].domainSpec
+		}
+	case 189:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1489
+		{
+			yyDollar[1].domainSpec.NotNull = true
+			yyVAL.domainSpec = yyDollar[1].domainSpec
+		}
+	case 190:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1494
+		{
+			yyDollar[1].domainSpec.Checks = append(yyDollar[1].domainSpec.Checks, yyDollar[2].checkDefinition)
+			yyVAL.domainSpec = yyDollar[1].domainSpec
+		}
+	case 191:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1502
+		{
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "nextval" {
+				yylex.Error("expected nextval('sequence'), but got: " + string(yyDollar[1].bytes))
+				return 1
 			}
-			xi += 2
+			yyVAL.str = string(yyDollar[3].bytes)
 		}
-		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
-			if yyn < 0 || yyn == yytoken {
-				break
+	case 192:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1510
+		{
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "nextval" || NewColIdent(string(yyDollar[5].bytes)).Lowered() != "regclass" {
+				yylex.Error("expected nextval('sequence'::regclass), but got: " + string(yyDollar[1].bytes))
+				return 1
 			}
+			yyVAL.str = string(yyDollar[3].bytes)
 		}
-		yyn = yyExca[xi+1]
-		if yyn < 0 {
-			goto ret0
+	case 193:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1521
+		{
+			yyVAL.optVal = NewValArg(yyDollar[1].bytes)
 		}
-	}
-	if yyn == 0 {
-		/* error ... attempt to resume parsing */
-		switch Errflag {
-		case 0: /* brand new error */
-			yylex.Error(yyErrorMessage(yystate, yytoken))
-			Nerrs++
-			if yyDebug >= 1 {
-				__yyfmt__.Printf("%s", yyStatname(yystate))
-				__yyfmt__.Printf(" saw %s\n", yyTokname(yytoken))
-			}
-			fallthrough
-
-		case 1, 2: /* incompletely recovered error ... try again */
-			Errflag = 3
-
-			/* find a state where "error" is a legal shift action */
-			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
-				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
-						goto yystack
-					}
-				}
-
-				/* the current p has no shift on "error", pop stack */
-				if yyDebug >= 2 {
-					__yyfmt__.Printf("error recovery pops state %d\n", yyS[yyp].yys)
-				}
-				yyp--
-			}
-			/* there is no state on the stack with an error shift ... abort */
-			goto ret1
-
-		case 3: /* no shift yet; clobber input char */
-			if yyDebug >= 2 {
-				__yyfmt__.Printf("error recovery discards %s\n", yyTokname(yytoken))
-			}
-			if yytoken == yyEofCode {
-				goto ret1
+	case 194:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1525
+		{
+			yyVAL.optVal = NewValArg(yyDollar[1].bytes)
+		}
+	case 195:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1529
+		{
+			yyVAL.optVal = NewValArg([]byte(string(yyDollar[1].bytes) + "(" + string(yyDollar[3].bytes) + ")"))
+		}
+	case 196:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1533
+		{
+			yyVAL.optVal = NewValArg(yyDollar[1].bytes)
+		}
+	case 197:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1537
+		{
+			yyVAL.optVal = NewValArg(yyDollar[1].bytes)
+		}
+	case 198:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1541
+		{
+			yyVAL.optVal = NewValArg(yyDollar[1].bytes)
+		}
+	case 199:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1545
+		{
+			yyVAL.optVal = NewValArg([]byte(string(yyDollar[1].bytes) + "(" + string(yyDollar[3].bytes) + ")"))
+		}
+	case 200:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1549
+		{
+			yyVAL.optVal = NewValArg(yyDollar[1].bytes)
+		}
+	case 201:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1553
+		{
+			yyVAL.optVal = NewValArg([]byte(string(yyDollar[1].bytes) + "(" + string(yyDollar[3].bytes) + ")"))
+		}
+	case 202:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1559
+		{
+			yyVAL.str = "always"
+		}
+	case 203:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1563
+		{
+			yyVAL.str = "by default"
+		}
+	case 204:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1570
+		{
+			if NewColIdent(string(yyDollar[3].bytes)).Lowered() != "identity" {
+				yylex.Error("expected AS IDENTITY, but got: AS " + string(yyDollar[3].bytes))
+				return 1
 			}
-			yyrcvr.char = -1
-			yytoken = -1
-			goto yynewstate /* try again in the same state */
+			yyVAL.identitySpec = &IdentitySpec{Behavior: yyDollar[1].str, Sequence: yyDollar[4].sequenceSpec}
 		}
-	}
-
-	/* reduction by production yyn */
-	if yyDebug >= 2 {
-		__yyfmt__.Printf("reduce %v in:\n\t%v\n", yyn, yyStatname(yystate))
-	}
-
-	yynt := yyn
-	yypt := yyp
-	_ = yypt // guard against "declared and not used"
-
-	yyp -= yyR2[yyn]
-	// yyp is now the index of $0. Perform the default action. Iff the
-	// reduced production is ε, $1 is possibly out of range.
-	if yyp+1 >= len(yyS) {
-		nyys := make([]yySymType, len(yyS)*2)
-		copy(nyys, yyS)
-		yyS = nyys
-	}
-	yyVAL = yyS[yyp+1]
-
-	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
-	yyj := yyg + yyS[yyp].yys + 1
-
-	if yyj >= yyLast {
-		yystate = yyAct[yyg]
-	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+	case 205:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1579
+		{
+			yyVAL.sequenceSpec = nil
+		}
+	case 206:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1583
+		{
+			yyVAL.sequenceSpec = yyDollar[2].sequenceSpec
+		}
+	case 207:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1588
+		{
+			yyVAL.str = ""
+		}
+	case 208:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1592
+		{
+			yyVAL.str = VirtualStr
+		}
+	case 209:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1596
+		{
+			yyVAL.str = StoredStr
+		}
+	case 210:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1602
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.columnType.Length = yyDollar[2].optVal
+		}
+	case 211:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1607
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 212:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1613
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 213:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1617
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 214:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1621
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 215:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1625
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 216:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1629
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 217:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1633
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 218:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1637
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 219:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1641
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 220:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1645
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 221:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1649
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 222:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1655
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 223:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1661
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 224:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1667
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
+			yyVAL.columnType.Length = yyDollar[3].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[3].LengthScaleOption.Scale
+		}
+	case 225:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1673
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 226:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1679
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 227:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1685
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 228:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1693
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 229:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1697
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Timezone: yyDollar[3].boolVal}
+		}
+	case 230:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1701
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Timezone: yyDollar[3].boolVal}
 		}
-	}
-	// dummy call; replaced with literal code
-	switch yynt {
-
-	case 1:
+	case 231:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:303
+//line parser.y:1705
 		{
-			setParseTree(yylex, yyDollar[1].statement)
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 2:
+	case 232:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1709
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 233:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:308
+//line parser.y:1715
 		{
+			yyVAL.boolVal = BoolVal(false)
 		}
-	case 3:
+	case 234:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:309
+//line parser.y:1719
 		{
+			yyVAL.boolVal = yyDollar[1].boolVal
 		}
-	case 4:
+	case 235:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1725
+		{
+			if NewColIdent(string(yyDollar[3].bytes)).Lowered() != "zone" {
+				yylex.Error("expected WITH TIME ZONE, but got: WITH TIME " + string(yyDollar[3].bytes))
+				return 1
+			}
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 236:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1733
+		{
+			if NewColIdent(string(yyDollar[3].bytes)).Lowered() != "zone" {
+				yylex.Error("expected WITHOUT TIME ZONE, but got: WITHOUT TIME " + string(yyDollar[3].bytes))
+				return 1
+			}
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:313
+//line parser.y:1743
 		{
-			yyVAL.statement = yyDollar[1].selStmt
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 238:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1747
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 22:
+	case 239:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:336
+//line parser.y:1753
 		{
-			sel := yyDollar[1].selStmt.(*Select)
-			sel.OrderBy = yyDollar[2].orderBy
-			sel.Limit = yyDollar[3].limit
-			sel.Lock = yyDollar[4].str
-			yyVAL.selStmt = sel
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 23:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:344
+	case 240:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1757
 		{
-			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 24:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:348
+	case 241:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1761
 		{
-			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 25:
+	case 242:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:354
+//line parser.y:1765
 		{
-			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].optVal, Charset: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
-	case 26:
-		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:361
+	case 243:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1769
 		{
-			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 27:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:367
+	case 244:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1773
 		{
-			yyVAL.selStmt = yyDollar[1].selStmt
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 28:
+	case 245:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:371
+//line parser.y:1777
 		{
-			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 29:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:377
+	case 246:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1781
 		{
-			yyVAL.selStmt = yyDollar[1].selStmt
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 30:
+	case 247:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:381
+//line parser.y:1785
 		{
-			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 31:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:388
+	case 248:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1789
 		{
-			// insert_data returns a *Insert pre-filled with Columns & Values
-			ins := yyDollar[6].ins
-			ins.Action = yyDollar[1].str
-			ins.Comments = yyDollar[2].bytes2
-			ins.Ignore = yyDollar[3].str
-			ins.Table = yyDollar[4].tableName
-			ins.Partitions = yyDollar[5].partitions
-			ins.OnDup = OnDup(yyDollar[7].updateExprs)
-			yyVAL.statement = ins
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 32:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:400
+	case 249:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1793
 		{
-			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
-			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
-			for _, updateList := range yyDollar[7].updateExprs {
-				cols = append(cols, updateList.Name.Name)
-				vals = append(vals, updateList.Expr)
-			}
-			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 33:
+	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:412
+//line parser.y:1797
 		{
-			yyVAL.str = InsertStr
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 34:
+	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:416
+//line parser.y:1801
 		{
-			yyVAL.str = ReplaceStr
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 35:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:422
+	case 252:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1805
 		{
-			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), TableExprs: yyDollar[3].tableExprs, Exprs: yyDollar[5].updateExprs, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 36:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:428
+	case 253:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1809
 		{
-			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 37:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:432
+	case 254:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1813
 		{
-			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 38:
+	case 255:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:436
+//line parser.y:1818
 		{
-			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 39:
+	case 256:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:441
+//line parser.y:1824
 		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 40:
+	case 257:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1828
+		{
+			yyVAL.columnType = yyDollar[2].columnType
+			yyVAL.columnType.Type = string(yyDollar[1].bytes)
+		}
+	case 258:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:442
+//line parser.y:1833
 		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 41:
+	case 259:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1837
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 260:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1841
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 261:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1845
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 262:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1849
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 263:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1853
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 264:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1857
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 265:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1863
+		{
+			yyVAL.strs = make([]string, 0, 4)
+			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
+		}
+	case 266:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1868
+		{
+			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
+		}
+	case 267:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1875
+		{
+			yyVAL.columnType = ColumnType{Type: NewColIdent(string(yyDollar[1].bytes)).Lowered(), Length: yyDollar[2].optVal}
+		}
+	case 268:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1879
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+		}
+	case 269:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1883
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Timezone: yyDollar[3].boolVal}
+		}
+	case 270:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1887
+		{
+			yyVAL.columnType = ColumnType{Type: "character varying", Length: yyDollar[3].optVal}
+		}
+	case 271:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1891
+		{
+			yyVAL.columnType = ColumnType{Type: "double precision"}
+		}
+	case 272:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1895
+		{
+			yyVAL.columnType = ColumnType{Type: NewColIdent(string(yyDollar[1].bytes)).Lowered() + "." + yyDollar[3].colIdent.Lowered()}
+		}
+	case 273:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1899
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.columnType.Array = BoolVal(true)
+		}
+	case 274:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1906
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 275:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1910
+		{
+			yyVAL.boolVal = yyDollar[1].boolVal
+		}
+	case 276:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:446
+//line parser.y:1914
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 277:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1918
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 278:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1924
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 279:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1928
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 298:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1953
 		{
-			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
+			yyVAL.optVal = nil
 		}
-	case 42:
+	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:450
+//line parser.y:1957
 		{
-			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
+			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 43:
+	case 300:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:455
+//line parser.y:1962
 		{
-			yyVAL.partitions = nil
+			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 44:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:459
+	case 301:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1966
 		{
-			yyVAL.partitions = yyDollar[3].partitions
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+				Scale:  NewIntVal(yyDollar[4].bytes),
+			}
 		}
-	case 45:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:465
+	case 302:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1974
 		{
-			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
+			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 46:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:469
+	case 303:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1978
 		{
-			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+			}
 		}
-	case 47:
+	case 304:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:473
+//line parser.y:1984
 		{
-			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+				Scale:  NewIntVal(yyDollar[4].bytes),
+			}
 		}
-	case 48:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:477
+	case 305:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1992
 		{
-			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
+			yyVAL.boolVal = BoolVal(false)
 		}
-	case 49:
+	case 306:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:483
+//line parser.y:1996
 		{
-			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
+			yyVAL.boolVal = BoolVal(true)
 		}
-	case 50:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:487
+	case 307:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2001
 		{
-			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
+			yyVAL.boolVal = BoolVal(false)
 		}
-	case 51:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:493
+	case 308:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2005
 		{
-			yyVAL.setExpr = yyDollar[3].setExpr
+			yyVAL.boolVal = BoolVal(true)
 		}
-	case 52:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:497
+	case 309:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2010
 		{
-			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("0"))}
+			yyVAL.str = ""
 		}
-	case 53:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:501
+	case 310:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2014
 		{
-			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("1"))}
+			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 54:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:507
+	case 311:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2018
 		{
-			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("repeatable read"))}
+			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 55:
+	case 312:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:511
+//line parser.y:2022
 		{
-			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read committed"))}
+			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 56:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:515
+	case 313:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2027
 		{
-			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read uncommitted"))}
+			yyVAL.str = ""
 		}
-	case 57:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:519
+	case 314:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2031
 		{
-			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("serializable"))}
+			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 58:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:525
+	case 315:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:2037
 		{
-			yyVAL.str = SessionStr
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 59:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:529
+	case 316:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2041
 		{
-			yyVAL.str = GlobalStr
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
 		}
-	case 60:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:535
+	case 317:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:2045
 		{
-			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
-			yyVAL.statement = yyDollar[1].ddl
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Deferrable: yyDollar[5].str}
 		}
-	case 61:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:540
+	case 318:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:2051
 		{
-			yyVAL.statement = &DDL{
-				Action:  CreateIndexStr,
-				Table:   yyDollar[6].tableName,
-				NewName: yyDollar[6].tableName,
-				IndexSpec: &IndexSpec{
-					Name:   yyDollar[4].colIdent,
-					Type:   NewColIdent(""),
-					Unique: bool(yyDollar[2].boolVal),
-				},
-				IndexCols: yyDollar[8].columns,
-			}
+			yyVAL.foreignKeyDefinition = &ForeignKeyDefinition{IndexColumns: yyDollar[4].columns, ReferenceName: yyDollar[7].tableName, ReferenceColumns: yyDollar[9].columns}
 		}
-	case 62:
+	case 319:
 		yyDollar = yyS[yypt-11 : yypt+1]
-		//line parser.y:555
+//line parser.y:2055
 		{
-			yyVAL.statement = &DDL{
-				Action:  CreateIndexStr,
-				Table:   yyDollar[8].tableName,
-				NewName: yyDollar[8].tableName,
-				IndexSpec: &IndexSpec{
-					Name:   yyDollar[4].colIdent,
-					Type:   yyDollar[6].colIdent,
-					Unique: bool(yyDollar[2].boolVal),
-				},
-				IndexCols: yyDollar[10].columns,
-			}
+			yyVAL.foreignKeyDefinition = &ForeignKeyDefinition{IndexName: yyDollar[3].colIdent, IndexColumns: yyDollar[5].columns, ReferenceName: yyDollar[8].tableName, ReferenceColumns: yyDollar[10].columns}
 		}
-	case 63:
-		yyDollar = yyS[yypt-11 : yypt+1]
-		//line parser.y:570
+	case 320:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line parser.y:2059
 		{
-			yyVAL.statement = &DDL{
-				Action:  CreateIndexStr,
-				Table:   yyDollar[6].tableName,
-				NewName: yyDollar[6].tableName,
-				IndexSpec: &IndexSpec{
-					Name:   yyDollar[4].colIdent,
-					Type:   yyDollar[8].colIdent,
-					Unique: bool(yyDollar[2].boolVal),
-				},
-				IndexCols: yyDollar[10].columns,
-			}
+			yyVAL.foreignKeyDefinition = &ForeignKeyDefinition{ConstraintName: yyDollar[2].colIdent, IndexColumns: yyDollar[6].columns, ReferenceName: yyDollar[9].tableName, ReferenceColumns: yyDollar[11].columns}
 		}
-	case 64:
+	case 321:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line parser.y:2063
+		{
+			yyVAL.foreignKeyDefinition = &ForeignKeyDefinition{ConstraintName: yyDollar[2].colIdent, IndexName: yyDollar[5].colIdent, IndexColumns: yyDollar[7].columns, ReferenceName: yyDollar[10].tableName, ReferenceColumns: yyDollar[12].columns}
+		}
+	case 322:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:584
+//line parser.y:2067
 		{
-			yyVAL.statement = &DDL{Action: CreateStr, NewName: yyDollar[3].tableName.ToViewName()}
+			yyDollar[1].foreignKeyDefinition.OnDelete = yyDollar[4].colIdent
+			yyVAL.foreignKeyDefinition = yyDollar[1].foreignKeyDefinition
 		}
-	case 65:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:588
+	case 323:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2072
 		{
-			yyVAL.statement = &DDL{Action: CreateStr, NewName: yyDollar[5].tableName.ToViewName()}
+			yyDollar[1].foreignKeyDefinition.OnUpdate = yyDollar[4].colIdent
+			yyVAL.foreignKeyDefinition = yyDollar[1].foreignKeyDefinition
 		}
-	case 66:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:592
+	case 324:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2077
 		{
-			yyVAL.statement = &DDL{Action: CreateVindexStr, VindexSpec: &VindexSpec{
-				Name:   yyDollar[3].colIdent,
-				Type:   yyDollar[4].colIdent,
-				Params: yyDollar[5].vindexParams,
-			}}
+			yyDollar[1].foreignKeyDefinition.Deferrable = yyDollar[2].str
+			yyVAL.foreignKeyDefinition = yyDollar[1].foreignKeyDefinition
 		}
-	case 67:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:600
+	case 325:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2084
 		{
-			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
+			yyVAL.checkDefinition = &CheckDefinition{Expr: yyDollar[3].expr}
 		}
-	case 68:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:604
+	case 326:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2088
 		{
-			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
+			yyVAL.checkDefinition = &CheckDefinition{ConstraintName: yyDollar[2].colIdent, Expr: yyDollar[5].expr}
 		}
-	case 69:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:609
+	case 327:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:2095
 		{
-			yyVAL.boolVal = BoolVal(false)
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "period" {
+				yylex.Error("expected PERIOD FOR, but got: " + string(yyDollar[1].bytes))
+				return 1
+			}
+			yyVAL.periodDefinition = &PeriodDefinition{Name: yyDollar[3].colIdent, Start: yyDollar[5].colIdent, End: yyDollar[7].colIdent}
 		}
-	case 70:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:613
+	case 328:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2106
 		{
-			yyVAL.boolVal = BoolVal(true)
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "exclude" {
+				yylex.Error("expected EXCLUDE, but got: " + string(yyDollar[1].bytes))
+				return 1
+			}
+			yyVAL.exclusionDefinition = &ExclusionDefinition{IndexType: yyDollar[3].colIdent, Elements: yyDollar[5].exclusionElements, Where: yyDollar[7].expr}
 		}
-	case 71:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:618
+	case 329:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:2114
 		{
-			yyVAL.colIdent = NewColIdent("")
+			if NewColIdent(string(yyDollar[3].bytes)).Lowered() != "exclude" {
+				yylex.Error("expected EXCLUDE, but got: " + string(yyDollar[3].bytes))
+				return 1
+			}
+			yyVAL.exclusionDefinition = &ExclusionDefinition{ConstraintName: yyDollar[2].colIdent, IndexType: yyDollar[5].colIdent, Elements: yyDollar[7].exclusionElements, Where: yyDollar[9].expr}
 		}
-	case 72:
+	case 330:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:622
+//line parser.y:2122
 		{
-			yyVAL.colIdent = yyDollar[2].colIdent
+			yyDollar[1].exclusionDefinition.Deferrable = yyDollar[2].str
+			yyVAL.exclusionDefinition = yyDollar[1].exclusionDefinition
 		}
-	case 73:
+	case 331:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:628
+//line parser.y:2130
 		{
-			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+			deferrable, err := normalizeDeferrability(yyDollar[1].strs)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.str = deferrable
 		}
-	case 74:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:633
+	case 332:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2141
 		{
-			var v []VindexParam
-			yyVAL.vindexParams = v
+			yyVAL.strs = []string{NewColIdent(string(yyDollar[1].bytes)).Lowered()}
 		}
-	case 75:
+	case 333:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:638
+//line parser.y:2145
 		{
-			yyVAL.vindexParams = yyDollar[2].vindexParams
+			yyVAL.strs = []string{"not", NewColIdent(string(yyDollar[2].bytes)).Lowered()}
 		}
-	case 76:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:644
+	case 334:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2149
 		{
-			yyVAL.vindexParams = make([]VindexParam, 0, 4)
-			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
+			yyVAL.strs = append(yyDollar[1].strs, NewColIdent(string(yyDollar[2].bytes)).Lowered())
 		}
-	case 77:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:649
+	case 335:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2155
 		{
-			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
+			yyVAL.exclusionElements = []ExclusionElement{yyDollar[1].exclusionElement}
 		}
-	case 78:
+	case 336:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:655
+//line parser.y:2159
 		{
-			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
-		}
-	case 79:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:661
-		{
-			yyVAL.ddl = &DDL{Action: CreateStr, NewName: yyDollar[4].tableName}
-			setDDL(yylex, yyVAL.ddl)
-		}
-	case 80:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:668
-		{
-			yyVAL.TableSpec = yyDollar[2].TableSpec
-			yyVAL.TableSpec.Options = yyDollar[4].str
-		}
-	case 81:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:675
-		{
-			yyVAL.TableSpec = &TableSpec{}
-			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
+			yyVAL.exclusionElements = append(yyDollar[1].exclusionElements, yyDollar[3].exclusionElement)
 		}
-	case 82:
+	case 337:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:680
+//line parser.y:2165
 		{
-			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+			yyVAL.exclusionElement = ExclusionElement{Column: yyDollar[1].colIdent, Operator: yyDollar[3].str}
 		}
-	case 83:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:684
+	case 338:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2170
 		{
-			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
+			yyVAL.expr = nil
 		}
-	case 84:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:690
+	case 339:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2174
 		{
-			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+			yyVAL.expr = yyDollar[3].expr
 		}
-	case 85:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:695
+	case 340:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2180
 		{
-			yyVAL.columnType = yyDollar[1].columnType
-			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
-			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
+			yyVAL.str = "="
 		}
-	case 90:
+	case 341:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:706
+//line parser.y:2184
 		{
-			yyDollar[1].columnType.NotNull = BoolVal(false)
-			yyDollar[1].columnType.Default = nil
-			yyDollar[1].columnType.OnUpdate = nil
-			yyDollar[1].columnType.Autoincrement = BoolVal(false)
-			yyDollar[1].columnType.KeyOpt = colKeyNone
-			yyDollar[1].columnType.Comment = nil
-			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.str = "&&"
 		}
-	case 91:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:716
+	case 342:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2188
 		{
-			yyDollar[1].columnType.NotNull = BoolVal(false)
-			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.str = "<>"
 		}
-	case 92:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:721
+	case 343:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2192
 		{
-			yyDollar[1].columnType.NotNull = BoolVal(true)
-			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.str = "<"
 		}
-	case 93:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:726
+	case 344:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2196
 		{
-			yyDollar[1].columnType.Default = NewStrVal(yyDollar[3].bytes)
-			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.str = ">"
 		}
-	case 94:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:731
+	case 345:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2202
 		{
-			yyDollar[1].columnType.Default = NewIntVal(yyDollar[3].bytes)
-			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 95:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:736
+	case 346:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2206
 		{
-			yyDollar[1].columnType.Default = NewFloatVal(yyDollar[3].bytes)
-			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 96:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:741
+	case 347:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2210
 		{
-			yyDollar[1].columnType.Default = NewValArg(yyDollar[3].bytes)
-			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes))
 		}
-	case 97:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:746
+	case 348:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2214
 		{
-			yyDollar[1].columnType.Default = NewValArg(yyDollar[3].bytes)
-			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes))
 		}
-	case 98:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:751
+	case 349:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2218
 		{
-			yyDollar[1].columnType.Default = NewBitVal(yyDollar[3].bytes)
-			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes))
 		}
-	case 99:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:756
+	case 350:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2224
 		{
-			yyDollar[1].columnType.OnUpdate = NewValArg(yyDollar[4].bytes)
-			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 100:
+	case 351:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:761
+//line parser.y:2228
 		{
-			yyDollar[1].columnType.Autoincrement = BoolVal(true)
-			yyVAL.columnType = yyDollar[1].columnType
-		}
-	case 101:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:766
-		{
-			yyDollar[1].columnType.KeyOpt = colKeyPrimary
-			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 102:
+	case 352:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:771
+//line parser.y:2234
 		{
-			yyDollar[1].columnType.KeyOpt = colKey
-			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
 		}
-	case 103:
+	case 353:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:776
+//line parser.y:2238
 		{
-			yyDollar[1].columnType.KeyOpt = colKeyUniqueKey
-			yyVAL.columnType = yyDollar[1].columnType
+			// should not be string
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
-	case 104:
+	case 354:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:781
+//line parser.y:2243
 		{
-			yyDollar[1].columnType.KeyOpt = colKeyUnique
-			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: newScannedStrVal(yylex, yyDollar[2].bytes)}
 		}
-	case 105:
+	case 355:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:786
+//line parser.y:2247
 		{
-			yyDollar[1].columnType.Comment = NewStrVal(yyDollar[3].bytes)
-			yyVAL.columnType = yyDollar[1].columnType
+			if NewColIdent(string(yyDollar[2].bytes)).Lowered() != "parser" {
+				yylex.Error("expected WITH PARSER, but got: " + string(yyDollar[2].bytes))
+				return 1
+			}
+			yyVAL.indexOption = &IndexOption{Name: "with parser", Using: string(yyDollar[3].bytes)}
 		}
-	case 106:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:793
+	case 356:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2255
 		{
-			yyVAL.columnType = yyDollar[1].columnType
-			yyVAL.columnType.Length = yyDollar[2].optVal
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes)}
 		}
-	case 107:
+	case 357:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:798
+//line parser.y:2259
 		{
-			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes)}
 		}
-	case 108:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:804
+	case 358:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2265
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.boolVal = BoolVal(false)
 		}
-	case 109:
+	case 359:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:808
+//line parser.y:2269
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.boolVal = BoolVal(false)
 		}
-	case 110:
+	case 360:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:812
+//line parser.y:2273
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.boolVal = BoolVal(true)
 		}
-	case 111:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:816
+	case 361:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2278
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = ""
 		}
-	case 112:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:820
+	case 362:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2282
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			if NewColIdent(string(yyDollar[2].bytes)).Lowered() != "parser" {
+				yylex.Error("expected WITH PARSER, but got: " + string(yyDollar[2].bytes))
+				return 1
+			}
+			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 113:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:824
+	case 363:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2292
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = ""
 		}
-	case 114:
+	case 364:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:828
+//line parser.y:2296
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 115:
+	case 365:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:834
+//line parser.y:2302
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
-			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 116:
+	case 366:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2306
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Spatial: true, Unique: false}
+		}
+	case 367:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:840
+//line parser.y:2310
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
-			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Spatial: true}
 		}
-	case 117:
+	case 368:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2314
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Spatial: true}
+		}
+	case 369:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2318
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Unique: true}
+		}
+	case 370:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:846
+//line parser.y:2322
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
-			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: true}
 		}
-	case 118:
+	case 371:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:852
+//line parser.y:2326
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
-			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: false}
 		}
-	case 119:
+	case 372:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2330
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Fulltext: true}
+		}
+	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:858
+//line parser.y:2334
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
-			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Fulltext: true}
 		}
-	case 120:
+	case 374:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:866
+//line parser.y:2338
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Fulltext: true}
 		}
-	case 121:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:870
+	case 375:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2342
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Unique: true}
 		}
-	case 122:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:874
+	case 376:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2346
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[3].bytes), Name: yyDollar[2].colIdent, Unique: true, Constraint: true}
 		}
-	case 123:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:878
+	case 377:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2350
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[3].bytes) + " " + string(yyDollar[4].str), Name: yyDollar[2].colIdent, Unique: true, Constraint: true}
 		}
-	case 124:
+	case 378:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:882
+//line parser.y:2356
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 125:
+	case 379:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:888
+//line parser.y:2360
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 126:
+	case 380:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:892
+//line parser.y:2366
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 127:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:898
+	case 381:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2370
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 128:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:902
+	case 382:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2376
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+			yyDollar[1].indexColumn.Direction = yyDollar[2].str
+			yyDollar[1].indexColumn.NullsOrder = yyDollar[3].str
+			yyVAL.indexColumn = yyDollar[1].indexColumn
 		}
-	case 129:
+	case 383:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2384
+		{
+			yyVAL.columns = nil
+		}
+	case 384:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:906
+//line parser.y:2388
+		{
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "include" {
+				yylex.Error("expected INCLUDE, but got: " + string(yyDollar[1].bytes))
+				return 1
+			}
+			yyVAL.columns = yyDollar[3].columns
+		}
+	case 385:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2398
+		{
+			yyVAL.str = ""
+		}
+	case 386:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2402
+		{
+			yyVAL.str = yyDollar[2].colIdent.String()
+		}
+	case 387:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2408
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+			yyVAL.str = ""
 		}
-	case 130:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:910
+	case 388:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2412
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].optVal, Charset: yyDollar[4].str, Collate: yyDollar[5].str}
+			nulls := NewColIdent(string(yyDollar[1].bytes)).Lowered()
+			order := NewColIdent(string(yyDollar[2].bytes)).Lowered()
+			if nulls != "nulls" || (order != "first" && order != "last") {
+				yylex.Error("expected NULLS FIRST or NULLS LAST, but got: " + string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes))
+				return 1
+			}
+			yyVAL.str = nulls + " " + order
 		}
-	case 131:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:914
+	case 389:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2424
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent}
 		}
-	case 132:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:918
+	case 390:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2429
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+			column := &IndexColumn{Expr: &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}}
+			if yylex.(*Tokenizer).mode == ParserModeMysql && len(yyDollar[3].selectExprs) == 1 {
+				if expr, ok := yyDollar[3].selectExprs[0].(*AliasedExpr); ok {
+					if val, ok := expr.Expr.(*SQLVal); ok && val.Type == IntVal {
+						column = &IndexColumn{Column: yyDollar[1].colIdent, Length: val}
+					}
+				}
+			}
+			yyVAL.indexColumn = column
 		}
-	case 133:
+	case 391:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:922
+//line parser.y:2441
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+			yyVAL.indexColumn = &IndexColumn{Expr: yyDollar[2].expr}
 		}
-	case 134:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:926
+	case 392:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2447
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+			yyVAL.str = ""
 		}
-	case 135:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:930
+	case 393:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2451
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+			yyVAL.str = " " + string(yyDollar[1].str)
 		}
-	case 136:
+	case 394:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:934
+//line parser.y:2455
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
 		}
-	case 137:
+	case 395:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:938
+//line parser.y:2463
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = yyDollar[1].str
 		}
-	case 138:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:942
+	case 396:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2467
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 139:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:946
+	case 397:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2471
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
 		}
-	case 140:
+	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:950
+//line parser.y:2477
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 141:
+	case 399:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:954
+//line parser.y:2481
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.str = String(newScannedStrVal(yylex, yyDollar[1].bytes))
 		}
-	case 142:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:958
+	case 400:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2485
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 143:
+	case 401:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:963
+//line parser.y:2491
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
 		}
-	case 144:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:969
+	case 402:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line parser.y:2495
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.statement = &DDL{
+				Action:  AddIndexStr,
+				Table:   yyDollar[4].tableName,
+				NewName: yyDollar[4].tableName,
+				IndexSpec: &IndexSpec{
+					Name:      yyDollar[8].colIdent,
+					Unique:    bool(yyDollar[6].boolVal),
+					Primary:   false,
+					Invisible: bool(yyDollar[12].boolVal),
+				},
+				IndexCols: yyDollar[10].indexColumns,
+			}
 		}
-	case 145:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:973
+	case 403:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line parser.y:2510
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.statement = &DDL{
+				Action:  AddIndexStr,
+				Table:   yyDollar[4].tableName,
+				NewName: yyDollar[4].tableName,
+				IndexSpec: &IndexSpec{
+					Name:      yyDollar[8].colIdent,
+					Fulltext:  true,
+					Parser:    yyDollar[12].str,
+					Invisible: bool(yyDollar[13].boolVal),
+				},
+				IndexCols: yyDollar[10].indexColumns,
+			}
 		}
-	case 146:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:977
+	case 404:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line parser.y:2525
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.statement = &DDL{
+				Action:  AddIndexStr,
+				Table:   yyDollar[4].tableName,
+				NewName: yyDollar[4].tableName,
+				IndexSpec: &IndexSpec{
+					Name:      yyDollar[8].colIdent,
+					Spatial:   true,
+					Invisible: bool(yyDollar[12].boolVal),
+				},
+				IndexCols: yyDollar[10].indexColumns,
+			}
 		}
-	case 147:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:981
+	case 405:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line parser.y:2539
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.statement = &DDL{
+				Action:  AddPrimaryKeyStr,
+				Table:   yyDollar[5].tableName,
+				NewName: yyDollar[5].tableName,
+				IndexSpec: &IndexSpec{
+					Name:    yyDollar[8].colIdent,
+					Unique:  false,
+					Primary: true,
+				},
+				IndexCols: yyDollar[12].indexColumns,
+			}
 		}
-	case 148:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:985
+	case 406:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.y:2553
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.statement = &DDL{
+				Action:  AddIndexStr,
+				Table:   yyDollar[4].tableName,
+				NewName: yyDollar[4].tableName,
+				IndexSpec: &IndexSpec{
+					Name:       yyDollar[7].colIdent,
+					Unique:     true,
+					Constraint: true,
+				},
+				IndexCols: yyDollar[10].indexColumns,
+			}
 		}
-	case 149:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:989
+	case 407:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line parser.y:2567
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.statement = &DDL{
+				Action:  AddIndexStr,
+				Table:   yyDollar[4].tableName,
+				NewName: yyDollar[4].tableName,
+				IndexSpec: &IndexSpec{
+					Name:       yyDollar[7].colIdent,
+					Unique:     true,
+					Constraint: true,
+					Deferrable: yyDollar[12].str,
+				},
+				IndexCols: yyDollar[10].indexColumns,
+			}
 		}
-	case 150:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:993
+	case 408:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line parser.y:2582
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.statement = &DDL{
+				Action:  AddIndexStr,
+				Table:   yyDollar[5].tableName,
+				NewName: yyDollar[5].tableName,
+				IndexSpec: &IndexSpec{
+					Name:       yyDollar[8].colIdent,
+					Unique:     true,
+					Constraint: true,
+				},
+				IndexCols: yyDollar[11].indexColumns,
+			}
 		}
-	case 151:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:997
+	case 409:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line parser.y:2596
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.statement = &DDL{
+				Action:  AddIndexStr,
+				Table:   yyDollar[5].tableName,
+				NewName: yyDollar[5].tableName,
+				IndexSpec: &IndexSpec{
+					Name:       yyDollar[8].colIdent,
+					Unique:     true,
+					Constraint: true,
+					Deferrable: yyDollar[13].str,
+				},
+				IndexCols: yyDollar[11].indexColumns,
+			}
 		}
-	case 152:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1003
+	case 410:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2611
 		{
-			yyVAL.strs = make([]string, 0, 4)
-			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
+			yyVAL.statement = &DDL{Action: AddForeignKeyStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName, ForeignKey: yyDollar[6].foreignKeyDefinition}
 		}
-	case 153:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1008
+	case 411:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2615
 		{
-			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
+			yyVAL.statement = &DDL{Action: AddForeignKeyStr, Table: yyDollar[5].tableName, NewName: yyDollar[5].tableName, ForeignKey: yyDollar[7].foreignKeyDefinition}
 		}
-	case 154:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1013
+	case 412:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2619
 		{
-			yyVAL.optVal = nil
+			yyVAL.statement = &DDL{Action: AddExclusionStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName, Exclusion: yyDollar[6].exclusionDefinition}
 		}
-	case 155:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1017
+	case 413:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2623
 		{
-			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
+			yyVAL.statement = &DDL{Action: AddExclusionStr, Table: yyDollar[5].tableName, NewName: yyDollar[5].tableName, Exclusion: yyDollar[7].exclusionDefinition}
 		}
-	case 156:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1022
+	case 414:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2627
 		{
-			yyVAL.LengthScaleOption = LengthScaleOption{}
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
 		}
-	case 157:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1026
+	case 415:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2631
 		{
-			yyVAL.LengthScaleOption = LengthScaleOption{
-				Length: NewIntVal(yyDollar[2].bytes),
-				Scale:  NewIntVal(yyDollar[4].bytes),
-			}
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
 		}
-	case 158:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1034
+	case 416:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line parser.y:2635
 		{
-			yyVAL.LengthScaleOption = LengthScaleOption{}
+			yyVAL.statement = &DDL{
+				Action: AddColVindexStr,
+				Table:  yyDollar[4].tableName,
+				VindexSpec: &VindexSpec{
+					Name:   yyDollar[7].colIdent,
+					Type:   yyDollar[11].colIdent,
+					Params: yyDollar[12].vindexParams,
+				},
+				VindexCols: yyDollar[9].columns,
+			}
 		}
-	case 159:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1038
+	case 417:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2648
 		{
-			yyVAL.LengthScaleOption = LengthScaleOption{
-				Length: NewIntVal(yyDollar[2].bytes),
+			yyVAL.statement = &DDL{
+				Action: DropColVindexStr,
+				Table:  yyDollar[4].tableName,
+				VindexSpec: &VindexSpec{
+					Name: yyDollar[7].colIdent,
+				},
 			}
 		}
-	case 160:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1044
+	case 418:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2658
 		{
-			yyVAL.LengthScaleOption = LengthScaleOption{
-				Length: NewIntVal(yyDollar[2].bytes),
-				Scale:  NewIntVal(yyDollar[4].bytes),
-			}
+			// Change this to a rename statement
+			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[4].tableName, NewName: yyDollar[7].tableName}
 		}
-	case 161:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1052
+	case 419:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2663
 		{
-			yyVAL.boolVal = BoolVal(false)
+			// Rename an index can just be an alter
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
 		}
-	case 162:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1056
+	case 420:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:2668
 		{
-			yyVAL.boolVal = BoolVal(true)
+			yyVAL.statement = &DDL{Action: AlterColumnStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName, AlterColumn: &AlterColumnSpec{Column: yyDollar[7].colIdent, Identity: yyDollar[9].identitySpec}}
 		}
-	case 163:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1061
+	case 421:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:2672
 		{
-			yyVAL.boolVal = BoolVal(false)
+			yyVAL.statement = &DDL{Action: AlterColumnStr, Table: yyDollar[5].tableName, NewName: yyDollar[5].tableName, AlterColumn: &AlterColumnSpec{Column: yyDollar[8].colIdent, Identity: yyDollar[10].identitySpec}}
 		}
-	case 164:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1065
+	case 422:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:2676
 		{
-			yyVAL.boolVal = BoolVal(true)
+			yyVAL.statement = &DDL{Action: AlterColumnStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName, AlterColumn: &AlterColumnSpec{Column: yyDollar[7].colIdent, DefaultNextval: yyDollar[10].str}}
 		}
-	case 165:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1070
+	case 423:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.y:2680
 		{
-			yyVAL.str = ""
+			yyVAL.statement = &DDL{Action: AlterColumnStr, Table: yyDollar[5].tableName, NewName: yyDollar[5].tableName, AlterColumn: &AlterColumnSpec{Column: yyDollar[8].colIdent, DefaultNextval: yyDollar[11].str}}
 		}
-	case 166:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1074
+	case 424:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:2684
 		{
-			yyVAL.str = string(yyDollar[3].bytes)
+			if NewColIdent(string(yyDollar[6].bytes)).Lowered() != "row" || NewColIdent(string(yyDollar[8].bytes)).Lowered() != "security" {
+				yylex.Error("expected ROW LEVEL SECURITY, but got: " + string(yyDollar[6].bytes))
+				return 1
+			}
+			yyVAL.statement = &DDL{Action: RowLevelSecurityStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName, RowLevelSecurity: yyDollar[5].str}
 		}
-	case 167:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1078
+	case 425:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:2692
 		{
-			yyVAL.str = string(yyDollar[3].bytes)
+			if NewColIdent(string(yyDollar[7].bytes)).Lowered() != "row" || NewColIdent(string(yyDollar[9].bytes)).Lowered() != "security" {
+				yylex.Error("expected ROW LEVEL SECURITY, but got: " + string(yyDollar[7].bytes))
+				return 1
+			}
+			yyVAL.statement = &DDL{Action: RowLevelSecurityStr, Table: yyDollar[5].tableName, NewName: yyDollar[5].tableName, RowLevelSecurity: yyDollar[6].str}
 		}
-	case 168:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1083
+	case 426:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2700
 		{
-			yyVAL.str = ""
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName(), NewName: yyDollar[3].tableName.ToViewName()}
 		}
-	case 169:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1087
+	case 427:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2704
 		{
-			yyVAL.str = string(yyDollar[2].bytes)
+			if NewColIdent(string(yyDollar[2].bytes)).Lowered() != "sequence" {
+				yylex.Error("expected SEQUENCE, but got: " + string(yyDollar[2].bytes))
+				return 1
+			}
+			yyVAL.statement = &DDL{Action: AlterSequenceStr, Table: yyDollar[3].tableName, SequenceSpec: yyDollar[4].sequenceSpec}
 		}
-	case 170:
+	case 428:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1093
+//line parser.y:2712
 		{
-			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
 		}
-	case 171:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1097
+	case 429:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:2717
 		{
-			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
+			if NewColIdent(string(yyDollar[5].bytes)).Lowered() != "attach" {
+				yylex.Error("expected ATTACH PARTITION, but got: " + string(yyDollar[5].bytes))
+				return 1
+			}
+			yyVAL.statement = &DDL{
+				Action:        AttachPartitionStr,
+				Table:         yyDollar[4].tableName,
+				NewName:       yyDollar[4].tableName,
+				PartitionSpec: &PartitionSpec{Action: AttachPartitionStr, Table: yyDollar[7].tableName, Bound: yyDollar[8].partBound},
+			}
 		}
-	case 172:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1103
+	case 430:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:2730
 		{
-			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
+			if NewColIdent(string(yyDollar[6].bytes)).Lowered() != "attach" {
+				yylex.Error("expected ATTACH PARTITION, but got: " + string(yyDollar[6].bytes))
+				return 1
+			}
+			yyVAL.statement = &DDL{
+				Action:        AttachPartitionStr,
+				Table:         yyDollar[5].tableName,
+				NewName:       yyDollar[5].tableName,
+				PartitionSpec: &PartitionSpec{Action: AttachPartitionStr, Table: yyDollar[8].tableName, Bound: yyDollar[9].partBound},
+			}
 		}
-	case 173:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1107
+	case 450:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2770
 		{
-			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
+			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
 		}
-	case 174:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1113
+	case 451:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2776
 		{
-			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
+			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 175:
+	case 452:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1117
+//line parser.y:2780
 		{
-			// should not be string
-			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
+			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 176:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1122
+	case 453:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:2786
 		{
-			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr, Options: yyDollar[9].str}
 		}
-	case 177:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1128
+	case 454:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.y:2790
 		{
-			yyVAL.str = ""
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: append(ValTuple{yyDollar[7].expr}, yyDollar[9].exprs...), Options: yyDollar[11].str}
 		}
-	case 178:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1132
+	case 455:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:2794
 		{
-			yyVAL.str = string(yyDollar[1].bytes)
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true, Options: yyDollar[9].str}
 		}
-	case 179:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1138
+	case 456:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2798
 		{
-			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true, Options: yyDollar[7].str}
 		}
-	case 180:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1142
+	case 457:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:2802
 		{
-			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Spatial: true, Unique: false}
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, In: yyDollar[6].exprs, Options: yyDollar[8].str}
 		}
-	case 181:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1146
+	case 458:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2808
 		{
-			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Unique: true}
+			yyVAL.str = ""
 		}
-	case 182:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1150
+	case 459:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2812
 		{
-			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: true}
+			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].colIdent.String() + " = " + yyDollar[4].str
 		}
-	case 183:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1154
+	case 460:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:2818
 		{
-			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: false}
+			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[3].tableName, NewName: yyDollar[5].tableName}
 		}
-	case 184:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1160
+	case 461:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2824
 		{
-			yyVAL.str = string(yyDollar[1].bytes)
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName, IfExists: exists}
 		}
-	case 185:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1164
+	case 462:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2832
 		{
-			yyVAL.str = string(yyDollar[1].bytes)
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropIndexStr, Table: yyDollar[6].tableName, IfExists: exists, IndexSpec: &IndexSpec{Name: yyDollar[4].colIdent}}
 		}
-	case 186:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1170
+	case 463:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2841
 		{
-			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropIndexStr, IfExists: exists, IndexSpec: &IndexSpec{Name: yyDollar[4].colIdent}}
 		}
-	case 187:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1174
+	case 464:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:2849
 		{
-			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName.ToViewName(), IfExists: exists}
 		}
-	case 188:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1180
+	case 465:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2857
 		{
-			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].optVal}
+			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 189:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1185
+	case 466:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2861
 		{
-			yyVAL.str = ""
+			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 190:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1189
+	case 467:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2867
 		{
-			yyVAL.str = " " + string(yyDollar[1].str)
+			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
 		}
-	case 191:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1193
+	case 468:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2871
 		{
-			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
+			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
 		}
-	case 192:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1201
+	case 469:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:2877
 		{
-			yyVAL.str = yyDollar[1].str
+			yyDollar[2].ddl.Action = GrantStr
+			yyDollar[2].ddl.GrantSpec.Grantees = yyDollar[4].strs
+			yyDollar[2].ddl.GrantSpec.WithGrantOption = bool(yyDollar[5].boolVal)
+			yyVAL.statement = yyDollar[2].ddl
 		}
-	case 193:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1205
+	case 470:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2884
 		{
-			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
+			yyDollar[2].ddl.Action = RevokeStr
+			yyDollar[2].ddl.GrantSpec.Grantees = yyDollar[4].strs
+			yyVAL.statement = yyDollar[2].ddl
 		}
-	case 194:
+	case 471:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1209
+//line parser.y:2892
 		{
-			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
+			yyVAL.ddl = &DDL{Table: yyDollar[3].tableName, GrantSpec: &GrantSpec{Privileges: yyDollar[1].strs, ObjectType: "table"}}
 		}
-	case 195:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1215
+	case 472:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2896
 		{
-			yyVAL.str = yyDollar[1].colIdent.String()
+			yyVAL.ddl = &DDL{Table: yyDollar[4].tableName, GrantSpec: &GrantSpec{Privileges: yyDollar[1].strs, ObjectType: yyDollar[3].str}}
 		}
-	case 196:
+	case 473:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1219
+//line parser.y:2902
 		{
-			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
+			yyVAL.str = "table"
 		}
-	case 197:
+	case 474:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1223
-		{
-			yyVAL.str = string(yyDollar[1].bytes)
-		}
-	case 198:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1229
-		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
-		}
-	case 199:
-		yyDollar = yyS[yypt-11 : yypt+1]
-		//line parser.y:1233
-		{
-			yyVAL.statement = &DDL{
-				Action:  AddIndexStr,
-				Table:   yyDollar[4].tableName,
-				NewName: yyDollar[4].tableName,
-				IndexSpec: &IndexSpec{
-					Name:    yyDollar[8].colIdent,
-					Unique:  bool(yyDollar[6].boolVal),
-					Primary: false,
-				},
-				IndexCols: yyDollar[10].columns,
-			}
-		}
-	case 200:
-		yyDollar = yyS[yypt-13 : yypt+1]
-		//line parser.y:1247
+//line parser.y:2906
 		{
-			yyVAL.statement = &DDL{
-				Action:  AddPrimaryKeyStr,
-				Table:   yyDollar[5].tableName,
-				NewName: yyDollar[5].tableName,
-				IndexSpec: &IndexSpec{
-					Name:    yyDollar[8].colIdent,
-					Unique:  false,
-					Primary: true,
-				},
-				IndexCols: yyDollar[12].columns,
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "sequence" {
+				yylex.Error("expected TABLE or SEQUENCE, but got: " + string(yyDollar[1].bytes))
+				return 1
 			}
+			yyVAL.str = "sequence"
 		}
-	case 201:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:1261
+	case 475:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2916
 		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
+			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 202:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:1265
+	case 476:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2920
 		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
+			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
-	case 203:
-		yyDollar = yyS[yypt-12 : yypt+1]
-		//line parser.y:1269
-		{
-			yyVAL.statement = &DDL{
-				Action: AddColVindexStr,
-				Table:  yyDollar[4].tableName,
-				VindexSpec: &VindexSpec{
-					Name:   yyDollar[7].colIdent,
-					Type:   yyDollar[11].colIdent,
-					Params: yyDollar[12].vindexParams,
-				},
-				VindexCols: yyDollar[9].columns,
-			}
+	case 477:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2926
+		{
+			yyVAL.str = "all"
 		}
-	case 204:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:1282
+	case 478:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2930
 		{
-			yyVAL.statement = &DDL{
-				Action: DropColVindexStr,
-				Table:  yyDollar[4].tableName,
-				VindexSpec: &VindexSpec{
-					Name: yyDollar[7].colIdent,
-				},
+			if NewColIdent(string(yyDollar[2].bytes)).Lowered() != "privileges" {
+				yylex.Error("expected ALL PRIVILEGES, but got: " + string(yyDollar[2].bytes))
+				return 1
 			}
+			yyVAL.str = "all"
 		}
-	case 205:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:1292
+	case 479:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2938
 		{
-			// Change this to a rename statement
-			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[4].tableName, NewName: yyDollar[7].tableName}
+			yyVAL.str = "select"
 		}
-	case 206:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:1297
+	case 480:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2942
 		{
-			// Rename an index can just be an alter
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
+			yyVAL.str = "insert"
 		}
-	case 207:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1302
+	case 481:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2946
 		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName(), NewName: yyDollar[3].tableName.ToViewName()}
+			yyVAL.str = "update"
 		}
-	case 208:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1306
+	case 482:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2950
 		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
+			yyVAL.str = "delete"
 		}
-	case 229:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:1338
+	case 483:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2954
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
+			yyVAL.str = "truncate"
 		}
-	case 230:
+	case 484:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1344
+//line parser.y:2958
 		{
-			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
+			yyVAL.str = "references"
 		}
-	case 231:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1348
+	case 485:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2962
 		{
-			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
+			yyVAL.str = "trigger"
 		}
-	case 232:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1354
+	case 486:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2966
 		{
-			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
+			yyVAL.str = NewColIdent(string(yyDollar[1].bytes)).Lowered()
 		}
-	case 233:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1358
+	case 487:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2971
 		{
-			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "usage" {
+				yylex.Error("unexpected privilege: " + string(yyDollar[1].bytes))
+				return 1
+			}
+			yyVAL.str = "usage"
 		}
-	case 234:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1364
+	case 488:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2981
 		{
-			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[3].tableName, NewName: yyDollar[5].tableName}
+			yyVAL.strs = []string{yyDollar[1].colIdent.Lowered()}
 		}
-	case 235:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1370
+	case 489:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2985
 		{
-			var exists bool
-			if yyDollar[3].byt != 0 {
-				exists = true
-			}
-			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName, IfExists: exists}
+			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].colIdent.Lowered())
 		}
-	case 236:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1378
+	case 490:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2990
 		{
-			// Change this to an alter statement
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName, NewName: yyDollar[5].tableName}
+			yyVAL.boolVal = BoolVal(false)
 		}
-	case 237:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1383
+	case 491:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2995
 		{
-			var exists bool
-			if yyDollar[3].byt != 0 {
-				exists = true
+			if NewColIdent(string(yyDollar[3].bytes)).Lowered() != "option" {
+				yylex.Error("expected WITH GRANT OPTION, but got: " + string(yyDollar[3].bytes))
+				return 1
 			}
-			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName.ToViewName(), IfExists: exists}
+			yyVAL.boolVal = BoolVal(true)
 		}
-	case 238:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1391
+	case 492:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:3005
 		{
-			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
+			yyVAL.statement = &DDL{Action: CommentStr, Table: yyDollar[4].tableName, CommentSpec: &CommentSpec{Comment: yyDollar[6].optVal}}
 		}
-	case 239:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1395
+	case 493:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:3009
 		{
-			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
+			yyVAL.statement = &DDL{Action: CommentStr, Table: yyDollar[4].colName.Qualifier, CommentSpec: &CommentSpec{Column: yyDollar[4].colName.Name, Comment: yyDollar[6].optVal}}
 		}
-	case 240:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1401
+	case 494:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3015
 		{
-			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
+			yyVAL.optVal = newScannedStrVal(yylex, yyDollar[1].bytes)
 		}
-	case 241:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1405
+	case 495:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3019
 		{
-			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
+			yyVAL.optVal = nil
 		}
-	case 242:
+	case 496:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1410
+//line parser.y:3025
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName, NewName: yyDollar[3].tableName}
 		}
-	case 243:
+	case 497:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1416
+//line parser.y:3031
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 244:
+	case 498:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1420
+//line parser.y:3035
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 245:
+	case 499:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1424
+//line parser.y:3039
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 246:
+	case 500:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1429
+//line parser.y:3044
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 247:
+	case 501:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1433
+//line parser.y:3048
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 248:
+	case 502:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1437
+//line parser.y:3052
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 249:
+	case 503:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1441
+//line parser.y:3056
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 250:
+	case 504:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1445
+//line parser.y:3060
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 251:
+	case 505:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1449
+//line parser.y:3064
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 252:
+	case 506:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1453
+//line parser.y:3068
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 253:
+	case 507:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1457
+//line parser.y:3072
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 254:
+	case 508:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1461
+//line parser.y:3076
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 255:
+	case 509:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1465
+//line parser.y:3080
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 256:
+	case 510:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1469
+//line parser.y:3084
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 257:
+	case 511:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1473
+//line parser.y:3088
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[4].str == "processlist" {
@@ -4170,598 +7401,604 @@ yydefault:
 				yyVAL.statement = &Show{Type: yyDollar[4].str, ShowTablesOpt: showTablesOpt}
 			}
 		}
-	case 258:
+	case 512:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1483
+//line parser.y:3098
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 259:
+	case 513:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1487
+//line parser.y:3102
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 260:
+	case 514:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1491
+//line parser.y:3106
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), OnTable: yyDollar[4].tableName}
 		}
-	case 261:
+	case 515:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1495
+//line parser.y:3110
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 262:
+	case 516:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1499
+//line parser.y:3114
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 263:
+	case 517:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1503
+//line parser.y:3118
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 264:
+	case 518:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1507
+//line parser.y:3122
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 265:
+	case 519:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1517
+//line parser.y:3132
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 266:
+	case 520:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1523
+//line parser.y:3138
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 267:
+	case 521:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1527
+//line parser.y:3142
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 268:
+	case 522:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1533
+//line parser.y:3148
 		{
 			yyVAL.str = ""
 		}
-	case 269:
+	case 523:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1537
+//line parser.y:3152
 		{
 			yyVAL.str = "extended "
 		}
-	case 270:
+	case 524:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1543
+//line parser.y:3158
 		{
 			yyVAL.str = ""
 		}
-	case 271:
+	case 525:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1547
+//line parser.y:3162
 		{
 			yyVAL.str = "full "
 		}
-	case 272:
+	case 526:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1553
+//line parser.y:3168
 		{
 			yyVAL.str = ""
 		}
-	case 273:
+	case 527:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1557
+//line parser.y:3172
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 274:
+	case 528:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1561
+//line parser.y:3176
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 275:
+	case 529:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1567
+//line parser.y:3182
 		{
 			yyVAL.showFilter = nil
 		}
-	case 276:
+	case 530:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1571
+//line parser.y:3186
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 277:
+	case 531:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1575
+//line parser.y:3190
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 278:
+	case 532:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1581
+//line parser.y:3196
 		{
 			yyVAL.str = ""
 		}
-	case 279:
+	case 533:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1585
+//line parser.y:3200
 		{
 			yyVAL.str = SessionStr
 		}
-	case 280:
+	case 534:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1589
+//line parser.y:3204
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 281:
+	case 535:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1595
+//line parser.y:3210
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 282:
+	case 536:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1599
+//line parser.y:3214
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 283:
+	case 537:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1605
+//line parser.y:3220
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 284:
+	case 538:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1609
+//line parser.y:3224
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 285:
+	case 539:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1615
+//line parser.y:3230
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 286:
+	case 540:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1621
+//line parser.y:3236
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 287:
+	case 541:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1627
+//line parser.y:3242
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 288:
+	case 542:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1631
+//line parser.y:3246
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 289:
+	case 543:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1635
+//line parser.y:3250
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 290:
+	case 544:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1639
+//line parser.y:3254
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 291:
+	case 545:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1643
+//line parser.y:3258
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 292:
+	case 546:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1648
+//line parser.y:3263
 		{
 			setAllowComments(yylex, true)
 		}
-	case 293:
+	case 547:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1652
+//line parser.y:3267
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 294:
+	case 548:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1658
+//line parser.y:3273
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 295:
+	case 549:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1662
+//line parser.y:3277
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 296:
+	case 550:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1668
+//line parser.y:3283
 		{
 			yyVAL.str = UnionStr
 		}
-	case 297:
+	case 551:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1672
+//line parser.y:3287
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 298:
+	case 552:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1676
+//line parser.y:3291
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 299:
+	case 553:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1681
+//line parser.y:3296
 		{
 			yyVAL.str = ""
 		}
-	case 300:
+	case 554:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1685
+//line parser.y:3300
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 301:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1689
+//line parser.y:3304
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 302:
+	case 556:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1694
+//line parser.y:3309
 		{
 			yyVAL.str = ""
 		}
-	case 303:
+	case 557:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1698
+//line parser.y:3313
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 304:
+	case 558:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1703
+//line parser.y:3318
 		{
 			yyVAL.str = ""
 		}
-	case 305:
+	case 559:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1707
+//line parser.y:3322
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 306:
+	case 560:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1712
+//line parser.y:3327
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 307:
+	case 561:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1716
+//line parser.y:3331
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 308:
+	case 562:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1722
+//line parser.y:3337
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 309:
+	case 563:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1726
+//line parser.y:3341
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 310:
+	case 564:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1732
+//line parser.y:3347
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 311:
+	case 565:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1736
+//line parser.y:3351
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 312:
+	case 566:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1740
+//line parser.y:3355
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 313:
+	case 567:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1744
+//line parser.y:3359
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 314:
+	case 568:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1749
+//line parser.y:3365
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 315:
+	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1753
+//line parser.y:3369
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 316:
+	case 570:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1757
+//line parser.y:3373
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 318:
+	case 572:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1764
+//line parser.y:3380
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 319:
+	case 573:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1769
+//line parser.y:3385
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 320:
+	case 574:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1773
+//line parser.y:3389
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 321:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1779
+//line parser.y:3395
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 322:
+	case 576:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1783
+//line parser.y:3399
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 325:
+	case 579:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1793
+//line parser.y:3409
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 326:
+	case 580:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1797
+//line parser.y:3413
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 327:
+	case 581:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1801
+//line parser.y:3417
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 328:
+	case 582:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1807
+//line parser.y:3423
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 329:
+	case 583:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:1811
+//line parser.y:3427
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 330:
+	case 584:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:3432
+		{
+			yyVAL.columns = nil
+		}
+	case 586:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1817
+//line parser.y:3439
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 331:
+	case 587:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1821
+//line parser.y:3443
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 332:
+	case 588:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1827
+//line parser.y:3449
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 333:
+	case 589:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1831
+//line parser.y:3453
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 334:
+	case 590:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1844
+//line parser.y:3466
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 335:
+	case 591:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1848
+//line parser.y:3470
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 336:
+	case 592:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1852
+//line parser.y:3474
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 337:
+	case 593:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1856
+//line parser.y:3478
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 338:
+	case 594:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1862
+//line parser.y:3484
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 339:
+	case 595:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1864
+//line parser.y:3486
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 340:
+	case 596:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1868
+//line parser.y:3490
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 341:
+	case 597:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1870
+//line parser.y:3492
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 342:
+	case 598:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1874
+//line parser.y:3496
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 343:
+	case 599:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1876
+//line parser.y:3498
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 344:
+	case 600:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1879
+//line parser.y:3501
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 345:
+	case 601:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1881
+//line parser.y:3503
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 346:
+	case 602:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1884
+//line parser.y:3507
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 347:
+	case 603:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1888
+//line parser.y:3511
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 348:
+	case 604:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1892
+//line parser.y:3515
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 350:
+	case 606:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1899
+//line parser.y:3522
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 351:
+	case 607:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1905
+//line parser.y:3528
 		{
 			yyVAL.str = JoinStr
 		}
-	case 352:
+	case 608:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1909
+//line parser.y:3532
 		{
 			yyVAL.str = JoinStr
 		}
-	case 353:
+	case 609:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1913
+//line parser.y:3536
 		{
 			yyVAL.str = JoinStr
 		}
-	case 354:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1919
+//line parser.y:3542
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 355:
+	case 611:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1925
+//line parser.y:3548
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 356:
+	case 612:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1929
+//line parser.y:3552
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 357:
+	case 613:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1933
+//line parser.y:3556
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 358:
+	case 614:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1937
+//line parser.y:3560
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 359:
+	case 615:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1943
+//line parser.y:3566
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 360:
+	case 616:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1947
+//line parser.y:3570
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -4769,453 +8006,490 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 361:
+	case 617:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1957
+//line parser.y:3580
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 362:
+	case 618:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1961
+//line parser.y:3584
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 363:
+	case 619:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1967
+//line parser.y:3590
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 364:
+	case 620:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1971
+//line parser.y:3594
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 365:
+	case 621:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1976
+//line parser.y:3599
 		{
 			yyVAL.indexHints = nil
 		}
-	case 366:
+	case 622:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1980
+//line parser.y:3603
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 367:
+	case 623:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1984
+//line parser.y:3607
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 368:
+	case 624:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1988
+//line parser.y:3611
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 369:
+	case 625:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1993
+//line parser.y:3616
 		{
 			yyVAL.expr = nil
 		}
-	case 370:
+	case 626:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1997
+//line parser.y:3620
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 371:
+	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2003
+//line parser.y:3626
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 372:
+	case 628:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2007
+//line parser.y:3630
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 373:
+	case 629:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2011
+//line parser.y:3634
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 374:
+	case 630:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2015
+//line parser.y:3638
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 375:
+	case 631:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2019
+//line parser.y:3642
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 376:
+	case 632:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2023
+//line parser.y:3646
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 377:
+	case 633:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2027
+//line parser.y:3650
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 378:
+	case 634:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2033
+//line parser.y:3656
 		{
 			yyVAL.str = ""
 		}
-	case 379:
+	case 635:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2037
+//line parser.y:3660
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 380:
+	case 636:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2043
+//line parser.y:3666
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 381:
+	case 637:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2047
+//line parser.y:3670
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 382:
+	case 638:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2053
+//line parser.y:3676
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 383:
+	case 639:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2057
+//line parser.y:3680
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 384:
+	case 640:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2061
+//line parser.y:3684
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 385:
+	case 641:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:3688
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: &QuantifiedExpr{Quantifier: AnyStr, Expr: yyDollar[5].expr}}
+		}
+	case 642:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:3692
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: &QuantifiedExpr{Quantifier: AllStr, Expr: yyDollar[5].expr}}
+		}
+	case 643:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2065
+//line parser.y:3696
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 386:
+	case 644:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2069
+//line parser.y:3700
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 387:
+	case 645:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2073
+//line parser.y:3704
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 388:
+	case 646:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2077
+//line parser.y:3708
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 389:
+	case 647:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2081
+//line parser.y:3712
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 390:
+	case 648:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2085
+//line parser.y:3716
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 391:
+	case 649:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2089
+//line parser.y:3720
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 392:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2095
+//line parser.y:3726
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 393:
+	case 651:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2099
+//line parser.y:3730
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 394:
+	case 652:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2103
+//line parser.y:3734
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 395:
+	case 653:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2107
+//line parser.y:3738
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 396:
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2111
+//line parser.y:3742
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 397:
+	case 655:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2115
+//line parser.y:3746
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 398:
+	case 656:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2121
+//line parser.y:3752
 		{
 			yyVAL.str = EqualStr
 		}
-	case 399:
+	case 657:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2125
+//line parser.y:3756
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 400:
+	case 658:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2129
+//line parser.y:3760
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 401:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2133
+//line parser.y:3764
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 402:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2137
+//line parser.y:3768
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 403:
+	case 661:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2141
+//line parser.y:3772
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 404:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2145
+//line parser.y:3776
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 405:
+	case 663:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2150
+//line parser.y:3781
 		{
 			yyVAL.expr = nil
 		}
-	case 406:
+	case 664:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2154
+//line parser.y:3785
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 407:
+	case 665:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2160
+//line parser.y:3791
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 408:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2164
+//line parser.y:3795
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 409:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2168
+//line parser.y:3799
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 410:
+	case 668:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2174
+//line parser.y:3805
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 411:
+	case 669:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2180
+//line parser.y:3811
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 412:
+	case 670:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2184
+//line parser.y:3815
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 413:
+	case 671:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2190
+//line parser.y:3821
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 414:
+	case 672:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2194
+//line parser.y:3825
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 415:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2198
+//line parser.y:3829
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 416:
+	case 674:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2202
+//line parser.y:3833
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 417:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2206
+//line parser.y:3837
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 418:
+	case 676:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2210
+//line parser.y:3841
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 419:
+	case 677:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2214
+//line parser.y:3845
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 420:
+	case 678:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2218
+//line parser.y:3849
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 421:
+	case 679:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2222
+//line parser.y:3853
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ConcatStr, Right: yyDollar[3].expr}
+		}
+	case 680:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:3857
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 422:
+	case 681:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2226
+//line parser.y:3861
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 423:
+	case 682:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2230
+//line parser.y:3865
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 424:
+	case 683:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2234
+//line parser.y:3869
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 425:
+	case 684:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2238
+//line parser.y:3873
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 426:
+	case 685:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2242
+//line parser.y:3877
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 427:
+	case 686:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2246
+//line parser.y:3881
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 428:
+	case 687:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2250
+//line parser.y:3885
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 429:
+	case 688:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2254
+//line parser.y:3889
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 430:
+	case 689:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2258
+//line parser.y:3893
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 431:
+	case 690:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2262
+//line parser.y:3897
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 432:
+	case 691:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2266
+//line parser.y:3901
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 433:
+	case 692:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:3905
+		{
+			typ := yyDollar[3].columnType
+			yyVAL.expr = &TypeCastExpr{Expr: yyDollar[1].expr, Type: &typ}
+		}
+	case 693:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2270
+//line parser.y:3910
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 434:
+	case 694:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2274
+//line parser.y:3914
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 435:
+	case 695:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:3918
+		{
+			yyVAL.expr = &IntroducerExpr{CharacterSet: string(yyDollar[1].bytes), Expr: newScannedStrVal(yylex, yyDollar[2].bytes)}
+		}
+	case 696:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:3922
+		{
+			yyVAL.expr = &ArrayConstructor{Elements: yyDollar[3].exprs}
+		}
+	case 697:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2278
+//line parser.y:3926
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -5223,9 +8497,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 436:
+	case 698:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2286
+//line parser.y:3934
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -5239,21 +8513,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 437:
+	case 699:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2300
+//line parser.y:3948
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 438:
+	case 700:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2304
+//line parser.y:3952
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 439:
+	case 701:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2308
+//line parser.y:3956
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -5261,431 +8535,437 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 444:
+	case 706:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2326
+//line parser.y:3974
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
-	case 445:
+	case 707:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2330
+//line parser.y:3978
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 446:
+	case 708:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2334
+//line parser.y:3982
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 447:
+	case 709:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2344
+//line parser.y:3992
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 448:
+	case 710:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2348
+//line parser.y:3996
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 449:
+	case 711:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2352
+//line parser.y:4000
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 450:
+	case 712:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2356
+//line parser.y:4004
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 451:
+	case 713:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2360
+//line parser.y:4008
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 452:
+	case 714:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2364
+//line parser.y:4012
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: nil}
 		}
-	case 453:
+	case 715:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:2368
+//line parser.y:4016
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 454:
+	case 716:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:2372
+//line parser.y:4020
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 455:
+	case 717:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2376
+//line parser.y:4024
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: nil}
 		}
-	case 456:
+	case 718:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:2380
+//line parser.y:4028
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 457:
+	case 719:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:2384
+//line parser.y:4032
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 458:
+	case 720:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:2388
+//line parser.y:4036
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 459:
+	case 721:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:2392
+//line parser.y:4040
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
 		}
-	case 460:
+	case 722:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2396
+//line parser.y:4044
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 461:
+	case 723:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2400
+//line parser.y:4048
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 462:
+	case 724:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2410
+//line parser.y:4058
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 463:
+	case 725:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2414
+//line parser.y:4062
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 464:
+	case 726:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2418
+//line parser.y:4066
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 465:
+	case 727:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2422
+//line parser.y:4070
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 466:
+	case 728:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2427
+//line parser.y:4075
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 467:
+	case 729:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2432
+//line parser.y:4080
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 468:
+	case 730:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2437
+//line parser.y:4085
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 469:
+	case 731:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:4089
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_user")}
+		}
+	case 732:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2442
+//line parser.y:4094
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 472:
+	case 735:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2456
+//line parser.y:4108
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 473:
+	case 736:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2460
+//line parser.y:4112
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 474:
+	case 737:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2464
+//line parser.y:4116
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 475:
+	case 738:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2468
+//line parser.y:4120
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 476:
+	case 739:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2474
+//line parser.y:4126
 		{
 			yyVAL.str = ""
 		}
-	case 477:
+	case 740:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2478
+//line parser.y:4130
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 478:
+	case 741:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2482
+//line parser.y:4134
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 479:
+	case 742:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:2486
+//line parser.y:4138
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 480:
+	case 743:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2490
+//line parser.y:4142
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 481:
+	case 744:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2496
+//line parser.y:4148
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 482:
+	case 745:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2500
+//line parser.y:4152
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 483:
+	case 746:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2506
+//line parser.y:4158
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 484:
+	case 747:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2510
+//line parser.y:4162
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
 		}
-	case 485:
+	case 748:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2514
+//line parser.y:4166
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: string(yyDollar[3].bytes)}
 		}
-	case 486:
+	case 749:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2518
+//line parser.y:4170
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 487:
+	case 750:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2522
+//line parser.y:4174
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 488:
+	case 751:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2526
+//line parser.y:4178
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 489:
+	case 752:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2532
+//line parser.y:4184
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 490:
+	case 753:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2536
+//line parser.y:4188
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 491:
+	case 754:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2540
+//line parser.y:4192
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 492:
+	case 755:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2544
+//line parser.y:4196
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 493:
+	case 756:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2548
+//line parser.y:4200
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 494:
+	case 757:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2552
+//line parser.y:4204
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 495:
+	case 758:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2556
+//line parser.y:4208
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 496:
+	case 759:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2561
+//line parser.y:4213
 		{
 			yyVAL.expr = nil
 		}
-	case 497:
+	case 760:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2565
+//line parser.y:4217
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 498:
+	case 761:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2570
+//line parser.y:4222
 		{
 			yyVAL.str = string("")
 		}
-	case 499:
+	case 762:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2574
+//line parser.y:4226
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 500:
+	case 763:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2580
+//line parser.y:4232
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 501:
+	case 764:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2584
+//line parser.y:4236
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 502:
+	case 765:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2590
+//line parser.y:4242
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 503:
+	case 766:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2595
+//line parser.y:4247
 		{
 			yyVAL.expr = nil
 		}
-	case 504:
+	case 767:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2599
+//line parser.y:4251
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 505:
+	case 768:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2605
+//line parser.y:4257
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 506:
+	case 769:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2609
+//line parser.y:4261
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 507:
+	case 770:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2613
+//line parser.y:4265
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 508:
+	case 771:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2619
+//line parser.y:4271
 		{
-			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
+			yyVAL.expr = newScannedStrVal(yylex, yyDollar[1].bytes)
 		}
-	case 509:
+	case 772:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2623
+//line parser.y:4275
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 510:
+	case 773:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2627
+//line parser.y:4279
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 511:
+	case 774:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2631
+//line parser.y:4283
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 512:
+	case 775:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2635
+//line parser.y:4287
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 513:
+	case 776:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2639
+//line parser.y:4291
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 514:
+	case 777:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2643
+//line parser.y:4295
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 515:
+	case 778:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2647
+//line parser.y:4299
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 516:
+	case 779:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2653
+//line parser.y:4305
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -5694,239 +8974,239 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 517:
+	case 780:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2662
+//line parser.y:4314
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 518:
+	case 781:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2666
+//line parser.y:4318
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 519:
+	case 782:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2671
+//line parser.y:4323
 		{
 			yyVAL.exprs = nil
 		}
-	case 520:
+	case 783:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2675
+//line parser.y:4327
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 521:
+	case 784:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2680
+//line parser.y:4332
 		{
 			yyVAL.expr = nil
 		}
-	case 522:
+	case 785:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2684
+//line parser.y:4336
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 523:
+	case 786:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2689
+//line parser.y:4341
 		{
 			yyVAL.orderBy = nil
 		}
-	case 524:
+	case 787:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2693
+//line parser.y:4345
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 525:
+	case 788:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2699
+//line parser.y:4351
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 526:
+	case 789:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2703
+//line parser.y:4355
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 527:
+	case 790:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2709
+//line parser.y:4361
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 528:
+	case 791:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2714
+//line parser.y:4366
 		{
 			yyVAL.str = AscScr
 		}
-	case 529:
+	case 792:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2718
+//line parser.y:4370
 		{
 			yyVAL.str = AscScr
 		}
-	case 530:
+	case 793:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2722
+//line parser.y:4374
 		{
 			yyVAL.str = DescScr
 		}
-	case 531:
+	case 794:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2727
+//line parser.y:4379
 		{
 			yyVAL.limit = nil
 		}
-	case 532:
+	case 795:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2731
+//line parser.y:4383
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 533:
+	case 796:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2735
+//line parser.y:4387
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 534:
+	case 797:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2739
+//line parser.y:4391
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 535:
+	case 798:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2744
+//line parser.y:4396
 		{
 			yyVAL.str = ""
 		}
-	case 536:
+	case 799:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2748
+//line parser.y:4400
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 537:
+	case 800:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2752
+//line parser.y:4404
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 538:
+	case 801:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2765
+//line parser.y:4417
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 539:
+	case 802:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2769
+//line parser.y:4421
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 540:
+	case 803:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2773
+//line parser.y:4425
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 541:
+	case 804:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2778
+//line parser.y:4430
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 542:
+	case 805:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2782
+//line parser.y:4434
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 543:
+	case 806:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2786
+//line parser.y:4438
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 544:
+	case 807:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2793
+//line parser.y:4445
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 545:
+	case 808:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2797
+//line parser.y:4449
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 546:
+	case 809:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2801
+//line parser.y:4453
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 547:
+	case 810:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2805
+//line parser.y:4457
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 548:
+	case 811:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2810
+//line parser.y:4462
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 549:
+	case 812:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2814
+//line parser.y:4466
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 550:
+	case 813:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2820
+//line parser.y:4472
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 551:
+	case 814:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2824
+//line parser.y:4476
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 552:
+	case 815:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2830
+//line parser.y:4482
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 553:
+	case 816:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2834
+//line parser.y:4486
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 554:
+	case 817:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2840
+//line parser.y:4492
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 555:
+	case 818:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2846
+//line parser.y:4498
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -5934,276 +9214,749 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 556:
+	case 819:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2856
+//line parser.y:4508
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 557:
+	case 820:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2860
+//line parser.y:4512
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 558:
+	case 821:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2866
+//line parser.y:4518
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 559:
+	case 822:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2872
+//line parser.y:4524
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 560:
+	case 823:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2876
+//line parser.y:4528
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 561:
+	case 824:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2882
+//line parser.y:4534
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
 		}
-	case 562:
+	case 825:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2886
+//line parser.y:4538
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 563:
+	case 826:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2890
+//line parser.y:4542
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 565:
+	case 828:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2897
+//line parser.y:4549
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 567:
+	case 830:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2904
+//line parser.y:4556
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 568:
+	case 831:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2908
+//line parser.y:4560
 		{
-			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
+			yyVAL.expr = newScannedStrVal(yylex, yyDollar[1].bytes)
 		}
-	case 569:
+	case 832:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2912
+//line parser.y:4564
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 572:
+	case 835:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2921
+//line parser.y:4573
 		{
 			yyVAL.byt = 0
 		}
-	case 573:
+	case 836:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2923
+//line parser.y:4575
 		{
 			yyVAL.byt = 1
 		}
-	case 574:
+	case 837:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2926
+//line parser.y:4578
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 575:
+	case 838:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2928
+//line parser.y:4580
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 576:
+	case 839:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2931
+//line parser.y:4583
 		{
 			yyVAL.str = ""
 		}
-	case 577:
+	case 840:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2933
+//line parser.y:4585
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 578:
+	case 841:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2937
+//line parser.y:4589
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 579:
+	case 842:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2939
+//line parser.y:4591
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 580:
+	case 843:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2941
+//line parser.y:4593
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 581:
+	case 844:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2943
+//line parser.y:4595
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 582:
+	case 845:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2945
+//line parser.y:4597
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 583:
+	case 846:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2947
+//line parser.y:4599
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 584:
+	case 847:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2949
+//line parser.y:4601
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 585:
+	case 848:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2951
+//line parser.y:4603
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 586:
+	case 849:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2953
+//line parser.y:4605
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 587:
+	case 850:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2955
+//line parser.y:4607
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 588:
+	case 851:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2958
+//line parser.y:4610
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 589:
+	case 852:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2960
+//line parser.y:4612
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 590:
+	case 853:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2962
+//line parser.y:4614
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 591:
+	case 854:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2966
+//line parser.y:4618
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 592:
+	case 855:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2968
+//line parser.y:4620
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 593:
+	case 856:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2972
+//line parser.y:4624
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 594:
+	case 857:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2976
+//line parser.y:4628
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 596:
+	case 859:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2983
+//line parser.y:4635
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 597:
+	case 860:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2989
+//line parser.y:4641
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 598:
+	case 861:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2993
+//line parser.y:4645
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 600:
+	case 863:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:3000
+//line parser.y:4652
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 794:
+	case 1085:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:3219
+//line parser.y:4899
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 795:
+	case 1086:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:3228
+//line parser.y:4908
 		{
 			decNesting(yylex)
 		}
-	case 796:
+	case 1087:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:3233
+//line parser.y:4913
 		{
 			forceEOF(yylex)
 		}
-	case 797:
+	case 1088:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:4919
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 1089:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:4923
+		{
+			if NewColIdent(string(yyDollar[2].bytes)).Lowered() != "data" {
+				yylex.Error("expected WITH DATA, but got: WITH " + string(yyDollar[2].bytes))
+				return 1
+			}
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 1090:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:4931
+		{
+			if NewColIdent(string(yyDollar[3].bytes)).Lowered() != "data" {
+				yylex.Error("expected WITH NO DATA, but got: WITH NO " + string(yyDollar[3].bytes))
+				return 1
+			}
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 1091:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:4942
+		{
+			spec, err := parseFunctionDefinition(skipToEnd(yylex), yylex.(*Tokenizer).mode)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.functionSpec = spec
+		}
+	case 1092:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:4954
+		{
+			switch action := NewColIdent(string(yyDollar[1].bytes)).Lowered(); action {
+			case "enable", "disable":
+				yyVAL.str = action
+			default:
+				yylex.Error("expected ENABLE or DISABLE, but got: " + string(yyDollar[1].bytes))
+				return 1
+			}
+		}
+	case 1093:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:4964
+		{
+			yyVAL.str = "force"
+		}
+	case 1094:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:4968
+		{
+			yyVAL.str = "no force"
+		}
+	case 1095:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:4973
+		{
+			yyVAL.policySpec = &PolicySpec{Permissive: "permissive", Command: "all", Roles: []string{}}
+		}
+	case 1096:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:4977
+		{
+			switch permissive := NewColIdent(string(yyDollar[3].bytes)).Lowered(); permissive {
+			case "permissive", "restrictive":
+				yyDollar[1].policySpec.Permissive = permissive
+			default:
+				yylex.Error("expected PERMISSIVE or RESTRICTIVE, but got: " + string(yyDollar[3].bytes))
+				return 1
+			}
+			yyVAL.policySpec = yyDollar[1].policySpec
+		}
+	case 1097:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:4988
+		{
+			yyDollar[1].policySpec.Command = yyDollar[3].str
+			yyVAL.policySpec = yyDollar[1].policySpec
+		}
+	case 1098:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:4993
+		{
+			yyDollar[1].policySpec.Roles = yyDollar[3].strs
+			yyVAL.policySpec = yyDollar[1].policySpec
+		}
+	case 1099:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:4998
+		{
+			yyDollar[1].policySpec.Using = yyDollar[4].expr
+			yyVAL.policySpec = yyDollar[1].policySpec
+		}
+	case 1100:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:5003
+		{
+			yyDollar[1].policySpec.WithCheck = yyDollar[5].expr
+			yyVAL.policySpec = yyDollar[1].policySpec
+		}
+	case 1101:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:5010
+		{
+			yyVAL.str = "all"
+		}
+	case 1102:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:5014
+		{
+			yyVAL.str = "select"
+		}
+	case 1103:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:5018
+		{
+			yyVAL.str = "insert"
+		}
+	case 1104:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:5022
+		{
+			yyVAL.str = "update"
+		}
+	case 1105:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:5026
+		{
+			yyVAL.str = "delete"
+		}
+	case 1106:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:5032
+		{
+			yyVAL.strs = []string{}
+		}
+	case 1107:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:5036
+		{
+			yyVAL.strs = yyDollar[1].strs
+		}
+	case 1108:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:5040
+		{
+			yyVAL.strs = append(yyDollar[1].strs, "schema "+yyDollar[3].tableIdent.String())
+		}
+	case 1109:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:5044
+		{
+			yyVAL.strs = append(yyDollar[1].strs, "cascade")
+		}
+	case 1110:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:5050
+		{
+			yyVAL.foreignSpec = &ForeignSpec{}
+		}
+	case 1111:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:5054
+		{
+			switch NewColIdent(string(yyDollar[2].bytes)).Lowered() {
+			case "type":
+				yyDollar[1].foreignSpec.Type = newScannedStrVal(yylex, yyDollar[3].bytes)
+			case "version":
+				yyDollar[1].foreignSpec.Version = newScannedStrVal(yylex, yyDollar[3].bytes)
+			default:
+				yylex.Error("unexpected option of SERVER: " + string(yyDollar[2].bytes))
+				return 1
+			}
+			yyVAL.foreignSpec = yyDollar[1].foreignSpec
+		}
+	case 1112:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:5069
+		{
+			yyVAL.foreignOptions = nil
+		}
+	case 1113:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:5073
+		{
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "options" {
+				yylex.Error("expected OPTIONS, but got: " + string(yyDollar[1].bytes))
+				return 1
+			}
+			yyVAL.foreignOptions = yyDollar[3].foreignOptions
+		}
+	case 1114:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:5083
+		{
+			yyVAL.foreignOptions = ForeignOptions{yyDollar[1].foreignOption}
+		}
+	case 1115:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:5087
+		{
+			yyVAL.foreignOptions = append(yyDollar[1].foreignOptions, yyDollar[3].foreignOption)
+		}
+	case 1116:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:5093
+		{
+			yyVAL.foreignOption = &ForeignOption{Name: yyDollar[1].colIdent, Value: newScannedStrVal(yylex, yyDollar[2].bytes)}
+		}
+	case 1117:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:5098
+		{
+			yyVAL.sequenceSpec = &SequenceSpec{}
+		}
+	case 1118:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:5102
+		{
+			yyDollar[1].sequenceSpec.Type = NewColIdent(yyDollar[3].columnType.Type).Lowered()
+			yyVAL.sequenceSpec = yyDollar[1].sequenceSpec
+		}
+	case 1119:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:5107
+		{
+			switch NewColIdent(string(yyDollar[2].bytes)).Lowered() {
+			case "increment":
+				yyDollar[1].sequenceSpec.IncrementBy = yyDollar[3].str
+			case "minvalue":
+				yyDollar[1].sequenceSpec.MinValue = yyDollar[3].str
+			case "cache":
+				yyDollar[1].sequenceSpec.Cache = yyDollar[3].str
+			default:
+				yylex.Error("unexpected option of SEQUENCE: " + string(yyDollar[2].bytes))
+				return 1
+			}
+			yyVAL.sequenceSpec = yyDollar[1].sequenceSpec
+		}
+	case 1120:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:5122
+		{
+			if NewColIdent(string(yyDollar[2].bytes)).Lowered() != "increment" {
+				yylex.Error("expected INCREMENT BY, but got: " + string(yyDollar[2].bytes) + " BY")
+				return 1
+			}
+			yyDollar[1].sequenceSpec.IncrementBy = yyDollar[4].str
+			yyVAL.sequenceSpec = yyDollar[1].sequenceSpec
+		}
+	case 1121:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:5131
+		{
+			if NewColIdent(string(yyDollar[2].bytes)).Lowered() != "owned" {
+				yylex.Error("expected OWNED BY, but got: " + string(yyDollar[2].bytes) + " BY")
+				return 1
+			}
+			yyDollar[1].sequenceSpec.OwnedBy = yyDollar[4].colName
+			yyVAL.sequenceSpec = yyDollar[1].sequenceSpec
+		}
+	case 1122:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:5140
+		{
+			if NewColIdent(string(yyDollar[2].bytes)).Lowered() != "cycle" {
+				yylex.Error("unexpected option of SEQUENCE: " + string(yyDollar[2].bytes))
+				return 1
+			}
+			yyDollar[1].sequenceSpec.Cycle = "cycle"
+			yyVAL.sequenceSpec = yyDollar[1].sequenceSpec
+		}
+	case 1123:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:5149
+		{
+			switch NewColIdent(string(yyDollar[3].bytes)).Lowered() {
+			case "minvalue":
+				yyDollar[1].sequenceSpec.NoMinValue = true
+			case "cycle":
+				yyDollar[1].sequenceSpec.Cycle = "no cycle"
+			default:
+				yylex.Error("unexpected option of SEQUENCE: NO " + string(yyDollar[3].bytes))
+				return 1
+			}
+			yyVAL.sequenceSpec = yyDollar[1].sequenceSpec
+		}
+	case 1124:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:5162
+		{
+			yyDollar[1].sequenceSpec.MaxValue = yyDollar[3].str
+			yyVAL.sequenceSpec = yyDollar[1].sequenceSpec
+		}
+	case 1125:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:5167
+		{
+			yyDollar[1].sequenceSpec.NoMaxValue = true
+			yyVAL.sequenceSpec = yyDollar[1].sequenceSpec
+		}
+	case 1126:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:5172
+		{
+			yyDollar[1].sequenceSpec.StartWith = yyDollar[3].str
+			yyVAL.sequenceSpec = yyDollar[1].sequenceSpec
+		}
+	case 1127:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:5177
+		{
+			yyDollar[1].sequenceSpec.StartWith = yyDollar[4].str
+			yyVAL.sequenceSpec = yyDollar[1].sequenceSpec
+		}
+	case 1128:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:5184
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 1129:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:5188
+		{
+			yyVAL.str = "-" + string(yyDollar[2].bytes)
+		}
+	case 1130:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:5195
+		{
+			yyVAL.str = "before"
+		}
+	case 1131:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:5199
+		{
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "after" {
+				yylex.Error("expected BEFORE or AFTER, but got: " + string(yyDollar[1].bytes))
+				return 1
+			}
+			yyVAL.str = "after"
+		}
+	case 1132:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:5207
+		{
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "instead" || NewColIdent(string(yyDollar[2].bytes)).Lowered() != "of" {
+				yylex.Error("expected INSTEAD OF, but got: " + string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes))
+				return 1
+			}
+			yyVAL.str = "instead of"
+		}
+	case 1133:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:5218
+		{
+			yyVAL.strs = []string{yyDollar[1].str}
+		}
+	case 1134:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:5222
+		{
+			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
+		}
+	case 1135:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:5228
+		{
+			yyVAL.str = "insert"
+		}
+	case 1136:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:5232
+		{
+			yyVAL.str = "update"
+		}
+	case 1137:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:5236
+		{
+			if NewColIdent(string(yyDollar[2].bytes)).Lowered() != "of" {
+				yylex.Error("expected UPDATE OF, but got: UPDATE " + string(yyDollar[2].bytes))
+				return 1
+			}
+			yyVAL.str = "update of "
+			for i, column := range yyDollar[3].columns {
+				if i > 0 {
+					yyVAL.str += ", "
+				}
+				yyVAL.str += String(column)
+			}
+		}
+	case 1138:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:5250
+		{
+			yyVAL.str = "delete"
+		}
+	case 1139:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:5254
+		{
+			yyVAL.str = "truncate"
+		}
+	case 1140:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:5260
+		{
+			yyVAL.str = ""
+		}
+	case 1141:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:5264
+		{
+			yyVAL.str = NewColIdent(string(yyDollar[2].bytes)).Lowered()
+			if yyVAL.str != "row" && yyVAL.str != "statement" {
+				yylex.Error("expected FOR ROW or FOR STATEMENT, but got: FOR " + string(yyDollar[2].bytes))
+				return 1
+			}
+		}
+	case 1142:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:5272
+		{
+			yyVAL.str = NewColIdent(string(yyDollar[3].bytes)).Lowered()
+			if yyVAL.str != "row" && yyVAL.str != "statement" {
+				yylex.Error("expected FOR EACH ROW or FOR EACH STATEMENT, but got: FOR EACH " + string(yyDollar[3].bytes))
+				return 1
+			}
+		}
+	case 1143:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:5283
+		{
+			yyVAL.triggerSpec = &TriggerSpec{Body: skipToEnd(yylex)}
+		}
+	case 1144:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:5288
+		{
+			yyVAL.triggerSpec = &TriggerSpec{Execute: yyDollar[1].funcExpr}
+		}
+	case 1145:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:5292
+		{
+			yyVAL.triggerSpec = &TriggerSpec{When: yyDollar[3].expr, Execute: yyDollar[5].funcExpr}
+		}
+	case 1157:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:5313
+		{
+			if NewColIdent(string(yyDollar[2].bytes)).Lowered() != "function" {
+				yylex.Error("expected EXECUTE FUNCTION or EXECUTE PROCEDURE, but got: EXECUTE " + string(yyDollar[2].bytes))
+				return 1
+			}
+			yyVAL.funcExpr = yyDollar[3].expr.(*FuncExpr)
+		}
+	case 1158:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:5321
+		{
+			yyVAL.funcExpr = yyDollar[3].expr.(*FuncExpr)
+		}
+	case 1159:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:3238
+//line parser.y:5326
 		{
 			forceEOF(yylex)
 		}
-	case 798:
+	case 1160:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:3242
+//line parser.y:5330
 		{
 			forceEOF(yylex)
 		}
-	case 799:
+	case 1161:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:3246
+//line parser.y:5334
 		{
 			forceEOF(yylex)
 		}
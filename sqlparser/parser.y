@@ -29,6 +29,13 @@ func setDDL(yylex interface{}, ddl *DDL) {
   yylex.(*Tokenizer).partialDDL = ddl
 }
 
+// A string of PostgreSQL is formatted as it's scanned, keeping backslashes.
+func newScannedStrVal(yylex interface{}, in []byte) *SQLVal {
+  val := NewStrVal(in)
+  val.Postgres = yylex.(*Tokenizer).mode == ParserModePostgres
+  return val
+}
+
 func incNesting(yylex interface{}) bool {
   yylex.(*Tokenizer).nesting++
   if yylex.(*Tokenizer).nesting == 200 {
@@ -48,6 +55,12 @@ func forceEOF(yylex interface{}) {
   yylex.(*Tokenizer).ForceEOF = true
 }
 
+// skipToEnd returns the rest of the statement from the token which has been read as a lookahead,
+// and forces the lexer to end. This is used for a statement which can't be parsed, like MySQL's trigger body.
+func skipToEnd(yylex interface{}) string {
+  return yylex.(*Tokenizer).skipToEnd()
+}
+
 %}
 
 %union {
@@ -104,9 +117,27 @@ func forceEOF(yylex interface{}) {
   indexOptions  []*IndexOption
   indexColumn   *IndexColumn
   indexColumns  []*IndexColumn
+  foreignKeyDefinition *ForeignKeyDefinition
+  checkDefinition *CheckDefinition
+  exclusionDefinition *ExclusionDefinition
+  periodDefinition *PeriodDefinition
+  exclusionElement ExclusionElement
+  exclusionElements []ExclusionElement
   partDefs      []*PartitionDefinition
   partDef       *PartitionDefinition
   partSpec      *PartitionSpec
+  partOption    *PartitionOption
+  partBound     *PartitionBound
+  triggerSpec   *TriggerSpec
+  funcExpr      *FuncExpr
+  functionSpec  *FunctionSpec
+  sequenceSpec  *SequenceSpec
+  identitySpec  *IdentitySpec
+  domainSpec    *DomainSpec
+  policySpec    *PolicySpec
+  foreignSpec   *ForeignSpec
+  foreignOption *ForeignOption
+  foreignOptions ForeignOptions
   vindexParam   VindexParam
   vindexParams  []VindexParam
   showFilter    *ShowFilter
@@ -115,14 +146,30 @@ func forceEOF(yylex interface{}) {
 %token LEX_ERROR
 %left <bytes> UNION
 %token <bytes> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR
-%token <bytes> ALL DISTINCT AS EXISTS ASC DESC INTO DUPLICATE DEFAULT SET LOCK KEYS
+%token <bytes> ALL ANY DISTINCT AS EXISTS ASC DESC INTO DUPLICATE DEFAULT SET LOCK KEYS
 %token <bytes> VALUES LAST_INSERT_ID
 %token <bytes> NEXT VALUE SHARE MODE
 %token <bytes> SQL_NO_CACHE SQL_CACHE
+// Table options end before PARTITION BY, although PARTITION can be a table option value.
+%nonassoc <bytes> PARTITION
+%nonassoc <bytes> END_OF_TABLE_OPTIONS
+// A type of a cast continues with WITH TIME ZONE, although WITH can follow an expression.
+%nonassoc <bytes> END_OF_TYPECAST_TYPE
+// A select statement ends before WITH [NO] DATA, although WITH can be an alias.
+%nonassoc <bytes> WITH WITHOUT
+%nonassoc <bytes> NO_ALIAS
+// CREATE MATERIALIZED VIEW continues with its name, although VIEW can be the name of a sequence.
+%nonassoc <bytes> VIEW_AS_NAME
+// Words of a deferrability like DEFERRABLE INITIALLY DEFERRED continue, although they're not keywords.
+%nonassoc <bytes> END_OF_DEFERRABILITY
+// CREATE INDEX CONCURRENTLY continues with the index name, although CONCURRENTLY can be the name.
+%nonassoc <bytes> NO_CONCURRENTLY
+%nonassoc <bytes> CONCURRENTLY
+%nonassoc <bytes> ID NO START
 %left <bytes> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE
 %left <bytes> ON USING
 %token <empty> '(' ',' ')'
-%token <bytes> ID HEX STRING INTEGRAL FLOAT HEXNUM VALUE_ARG LIST_ARG COMMENT COMMENT_KEYWORD BIT_LITERAL
+%token <bytes> HEX STRING UNDERSCORE_CHARSET INTEGRAL FLOAT HEXNUM VALUE_ARG LIST_ARG COMMENT COMMENT_KEYWORD BIT_LITERAL
 %token <bytes> NULL TRUE FALSE
 
 // Precedence dictated by mysql. But the vitess grammar is simplified.
@@ -134,7 +181,7 @@ func forceEOF(yylex interface{}) {
 %right <bytes> NOT '!'
 %left <bytes> BETWEEN CASE WHEN THEN ELSE END
 %left <bytes> '=' '<' '>' LE GE NE NULL_SAFE_EQUAL IS LIKE REGEXP IN
-%left <bytes> '|'
+%left <bytes> '|' CONCAT
 %left <bytes> '&'
 %left <bytes> SHIFT_LEFT SHIFT_RIGHT
 %left <bytes> '+' '-'
@@ -144,6 +191,7 @@ func forceEOF(yylex interface{}) {
 %left <bytes> COLLATE
 %right <bytes> BINARY UNDERSCORE_BINARY
 %right <bytes> INTERVAL
+%left <bytes> TYPECAST
 %nonassoc <bytes> '.'
 
 // There is no need to define precedence for the JSON
@@ -153,19 +201,22 @@ func forceEOF(yylex interface{}) {
 
 // DDL Tokens
 %token <bytes> CREATE ALTER DROP RENAME ANALYZE ADD
-%token <bytes> SCHEMA TABLE INDEX VIEW TO IGNORE IF PRIMARY COLUMN CONSTRAINT SPATIAL FULLTEXT FOREIGN KEY_BLOCK_SIZE
+%token <bytes> SCHEMA TABLE INDEX VIEW DOMAIN TO IGNORE IF PRIMARY COLUMN CONSTRAINT SPATIAL FULLTEXT FOREIGN KEY_BLOCK_SIZE
+%token <bytes> REFERENCES CASCADE RESTRICT ACTION CHECK
+%token <bytes> GRANT REVOKE
+%token <bytes> GENERATED ALWAYS VIRTUAL STORED VISIBLE INVISIBLE ARRAY
 %right <bytes> UNIQUE KEY
 %token <bytes> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
-%token <bytes> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
+%token <bytes> MAXVALUE REORGANIZE LESS THAN PROCEDURE TRIGGER EXECUTE BEFORE EACH INHERITS SERVER TABLESPACE
 %token <bytes> VINDEX VINDEXES
 %token <bytes> STATUS VARIABLES
 
 // Transaction Tokens
-%token <bytes> BEGIN START TRANSACTION COMMIT ROLLBACK
+%token <bytes> BEGIN TRANSACTION COMMIT ROLLBACK
 
 // Type Tokens
-%token <bytes> BIT TINYINT SMALLINT MEDIUMINT INT INTEGER BIGINT INTNUM
-%token <bytes> REAL DOUBLE FLOAT_TYPE DECIMAL NUMERIC
+%token <bytes> BIT TINYINT SMALLINT MEDIUMINT INT INTEGER BIGINT INTNUM SMALLSERIAL SERIAL BIGSERIAL
+%token <bytes> REAL DOUBLE PRECISION FLOAT_TYPE DECIMAL NUMERIC
 %token <bytes> TIME TIMESTAMP DATETIME YEAR
 %token <bytes> CHAR VARCHAR VARYING BOOL CHARACTER VARBINARY NCHAR
 %token <bytes> TEXT TINYTEXT MEDIUMTEXT LONGTEXT
@@ -173,7 +224,7 @@ func forceEOF(yylex interface{}) {
 %token <bytes> GEOMETRY POINT LINESTRING POLYGON GEOMETRYCOLLECTION MULTIPOINT MULTILINESTRING MULTIPOLYGON
 
 // Type Modifiers
-%token <bytes> NULLX AUTO_INCREMENT APPROXNUM SIGNED UNSIGNED ZEROFILL
+%token <bytes> NULLX AUTO_INCREMENT APPROXNUM SIGNED UNSIGNED ZEROFILL SRID
 
 // Supported SHOW tokens
 %token <bytes> DATABASES TABLES VITESS_KEYSPACES VITESS_SHARDS VITESS_TABLETS VSCHEMA_TABLES EXTENDED FULL PROCESSLIST
@@ -182,7 +233,7 @@ func forceEOF(yylex interface{}) {
 %token <bytes> NAMES CHARSET GLOBAL SESSION ISOLATION LEVEL READ WRITE ONLY REPEATABLE COMMITTED UNCOMMITTED SERIALIZABLE
 
 // Functions
-%token <bytes> CURRENT_TIMESTAMP DATABASE CURRENT_DATE
+%token <bytes> CURRENT_TIMESTAMP DATABASE CURRENT_DATE CURRENT_USER
 %token <bytes> CURRENT_TIME LOCALTIME LOCALTIMESTAMP
 %token <bytes> UTC_DATE UTC_TIME UTC_TIMESTAMP
 %token <bytes> REPLACE
@@ -191,7 +242,7 @@ func forceEOF(yylex interface{}) {
 %token <bytes> GROUP_CONCAT SEPARATOR
 
 // Match
-%token <bytes> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION
+%token <bytes> MATCH AGAINST BOOLEAN LANGUAGE QUERY EXPANSION
 
 // MySQL reserved words that are unused by this grammar will map to this token.
 %token <bytes> UNUSED
@@ -200,6 +251,12 @@ func forceEOF(yylex interface{}) {
 %type <selStmt> select_statement base_select union_lhs union_rhs
 %type <statement> stream_statement insert_statement update_statement delete_statement set_statement
 %type <statement> create_statement alter_statement rename_statement drop_statement truncate_statement
+%type <statement> comment_statement grant_statement
+%type <ddl> grant_spec
+%type <strs> privilege_list grantee_list
+%type <str> privilege grant_object_type row_level_security_action policy_command
+%type <policySpec> policy_option_list
+%type <boolVal> grant_option_opt
 %type <ddl> create_table_prefix
 %type <statement> analyze_statement show_statement use_statement other_statement
 %type <statement> begin_statement commit_statement rollback_statement
@@ -240,7 +297,9 @@ func forceEOF(yylex interface{}) {
 %type <expr> having_opt
 %type <orderBy> order_by_opt order_list
 %type <order> order
-%type <str> asc_desc_opt
+%type <str> asc_desc_opt nulls_order_opt
+%type <columns> index_include_opt
+%type <str> tablespace_opt
 %type <limit> limit_opt
 %type <str> lock_opt
 %type <columns> ins_column_list column_list
@@ -252,14 +311,14 @@ func forceEOF(yylex interface{}) {
 %type <updateExpr> update_expression
 %type <setExpr> set_expression transaction_char isolation_level
 %type <bytes> for_from
-%type <str> ignore_opt default_opt
+%type <str> ignore_opt default_opt generated_column_type_opt sequence_nextval
 %type <str> extended_opt full_opt from_database_opt tables_or_processlist
 %type <showFilter> like_or_where_opt
 %type <byt> exists_opt
 %type <empty> not_exists_opt non_add_drop_or_rename_operation to_opt index_opt
 %type <bytes> reserved_keyword non_reserved_keyword
 %type <colIdent> sql_id reserved_sql_id col_alias as_ci_opt
-%type <boolVal> unique_opt
+%type <boolVal> unique_opt concurrently_opt with_data_opt invisible_opt array_opt array_bounds time_zone time_zone_opt
 %type <expr> charset_value
 %type <tableIdent> table_id reserved_table_id table_alias as_opt_id
 %type <empty> as_opt
@@ -269,26 +328,59 @@ func forceEOF(yylex interface{}) {
 %type <convertType> convert_type
 %type <columnType> column_type
 %type <columnType> bool_type int_type decimal_type numeric_type time_type char_type spatial_type
-%type <optVal> length_opt
+%type <optVal> length_opt comment_value current_timestamp_value
 %type <str> charset_opt collate_opt
 %type <boolVal> unsigned_opt zero_fill_opt
 %type <LengthScaleOption> float_length_opt decimal_length_opt
-%type <strs> enum_values
+%type <strs> enum_values extension_option_list
 %type <columnDefinition> column_definition
-%type <columnType> column_definition_type
+%type <columnType> column_definition_type typecast_type geometry_type_modifier
+%type <bytes> typecast_type_keyword
 %type <indexDefinition> index_definition
+%type <foreignKeyDefinition> foreign_key_definition
+%type <checkDefinition> check_definition
+%type <exclusionDefinition> exclusion_definition
+%type <periodDefinition> period_definition
+%type <exclusionElement> exclusion_element
+%type <exclusionElements> exclusion_element_list
+%type <str> exclusion_operator fulltext_parser_opt
+%type <expr> exclusion_where_opt
+%type <str> deferrability
+%type <strs> deferrability_words
+%type <colIdent> reference_option
 %type <str> index_or_key
 %type <str> equal_opt
 %type <TableSpec> table_spec table_column_list
 %type <str> table_option_list table_option table_opt_value
 %type <indexInfo> index_info
-%type <indexColumn> index_column
+%type <indexColumn> index_column index_column_with_order
 %type <indexColumns> index_column_list
 %type <indexOption> index_option
 %type <indexOptions> index_option_list
 %type <partDefs> partition_definitions
 %type <partDef> partition_definition
 %type <partSpec> partition_operation
+%type <partOption> partition_option_opt partition_method
+%type <columns> column_list_opt
+%type <optVal> partition_count_opt
+%type <partDefs> partition_definitions_opt
+%type <partBound> partition_bound
+%type <exprs> partition_bound_values
+%type <expr> partition_bound_value
+%type <str> partition_definition_options
+%type <str> trigger_time trigger_event trigger_for_each_opt
+%type <strs> trigger_event_list
+%type <triggerSpec> trigger_body
+%type <funcExpr> trigger_execute
+%type <functionSpec> function_definition
+%type <sequenceSpec> sequence_option_list
+%type <str> sequence_value generated_when
+%type <sequenceSpec> identity_sequence_opt
+%type <identitySpec> identity_definition
+%type <domainSpec> domain_option_list
+%type <foreignSpec> server_option_list
+%type <foreignOption> foreign_option
+%type <foreignOptions> foreign_option_list foreign_options_opt
 %type <vindexParam> vindex_param
 %type <vindexParams> vindex_param_list vindex_params_opt
 %type <colIdent> vindex_type vindex_type_opt
@@ -323,6 +415,8 @@ command:
 | rename_statement
 | drop_statement
 | truncate_statement
+| comment_statement
+| grant_statement
 | analyze_statement
 | show_statement
 | use_statement
@@ -536,37 +630,127 @@ create_statement:
     $1.TableSpec = $2
     $$ = $1
   }
-| CREATE unique_opt INDEX sql_id ON table_name '(' column_list ')'
+/* For MySQL */
+| create_table_prefix LIKE table_name
+  {
+    $1.TableSpec = &TableSpec{Like: $3}
+    $$ = $1
+  }
+/* For PostgreSQL */
+| create_table_prefix PARTITION sql_id table_name partition_bound partition_option_opt
+  {
+    if $3.Lowered() != "of" {
+      yylex.Error("expected OF after PARTITION, but got: " + $3.String())
+      return 1
+    }
+    $1.TableSpec = &TableSpec{PartitionOf: &PartitionOf{Parent: $4, Bound: $5}, Partition: $6}
+    $$ = $1
+  }
+| CREATE unique_opt INDEX concurrently_opt sql_id ON table_name '(' index_column_list ')' index_include_opt tablespace_opt where_expression_opt invisible_opt
+  {
+    $$ = &DDL{
+        Action: CreateIndexStr,
+        Table: $7,
+        NewName: $7,
+        IndexSpec: &IndexSpec{
+          Name: $5,
+          Type: NewColIdent(""),
+          Unique: bool($2),
+          Include: $11,
+          Tablespace: $12,
+          Where: $13,
+          Invisible: bool($14),
+        },
+        IndexCols: $9,
+      }
+  }
+/* For MySQL */
+| CREATE unique_opt INDEX concurrently_opt sql_id USING sql_id ON table_name '(' index_column_list ')' invisible_opt
+  {
+    $$ = &DDL{
+        Action: CreateIndexStr,
+        Table: $9,
+        NewName: $9,
+        IndexSpec: &IndexSpec{
+          Name: $5,
+          Type: $7,
+          Unique: bool($2),
+          Invisible: bool($13),
+        },
+        IndexCols: $11,
+      }
+  }
+/* For PostgreSQL */
+| CREATE unique_opt INDEX concurrently_opt sql_id ON table_name USING sql_id '(' index_column_list ')' index_include_opt tablespace_opt where_expression_opt
+  {
+    $$ = &DDL{
+        Action: CreateIndexStr,
+        Table: $7,
+        NewName: $7,
+        IndexSpec: &IndexSpec{
+          Name: $5,
+          Type: $9,
+          Unique: bool($2),
+          Include: $13,
+          Tablespace: $14,
+          Where: $15,
+        },
+        IndexCols: $11,
+      }
+  }
+/* For PostgreSQL's unnamed index, which is named by the database */
+| CREATE unique_opt INDEX concurrently_opt ON table_name '(' index_column_list ')' index_include_opt tablespace_opt where_expression_opt
   {
     $$ = &DDL{
         Action: CreateIndexStr,
         Table: $6,
         NewName: $6,
         IndexSpec: &IndexSpec{
-          Name: $4,
           Type: NewColIdent(""),
           Unique: bool($2),
+          Include: $10,
+          Tablespace: $11,
+          Where: $12,
         },
         IndexCols: $8,
       }
   }
-/* For MySQL */
-| CREATE unique_opt INDEX sql_id USING sql_id ON table_name '(' column_list ')'
+| CREATE unique_opt INDEX concurrently_opt ON table_name USING sql_id '(' index_column_list ')' index_include_opt tablespace_opt where_expression_opt
+  {
+    $$ = &DDL{
+        Action: CreateIndexStr,
+        Table: $6,
+        NewName: $6,
+        IndexSpec: &IndexSpec{
+          Type: $8,
+          Unique: bool($2),
+          Include: $12,
+          Tablespace: $13,
+          Where: $14,
+        },
+        IndexCols: $10,
+      }
+  }
+/* For PostgreSQL's partitioned table */
+| CREATE unique_opt INDEX concurrently_opt sql_id ON ONLY table_name USING sql_id '(' index_column_list ')' index_include_opt tablespace_opt where_expression_opt
   {
     $$ = &DDL{
         Action: CreateIndexStr,
         Table: $8,
         NewName: $8,
         IndexSpec: &IndexSpec{
-          Name: $4,
-          Type: $6,
+          Name: $5,
+          Type: $10,
           Unique: bool($2),
+          Include: $14,
+          Tablespace: $15,
+          Where: $16,
         },
-        IndexCols: $10,
+        IndexCols: $12,
       }
   }
-/* For PostgreSQL */
-| CREATE unique_opt INDEX sql_id ON table_name USING sql_id '(' column_list ')'
+/* For MySQL */
+| CREATE FULLTEXT INDEX sql_id ON table_name '(' index_column_list ')' fulltext_parser_opt invisible_opt
   {
     $$ = &DDL{
         Action: CreateIndexStr,
@@ -574,19 +758,150 @@ create_statement:
         NewName: $6,
         IndexSpec: &IndexSpec{
           Name: $4,
-          Type: $8,
-          Unique: bool($2),
+          Type: NewColIdent(""),
+          Fulltext: true,
+          Parser: $10,
+          Invisible: bool($11),
         },
-        IndexCols: $10,
+        IndexCols: $8,
+      }
+  }
+| CREATE SPATIAL INDEX sql_id ON table_name '(' index_column_list ')' invisible_opt
+  {
+    $$ = &DDL{
+        Action: CreateIndexStr,
+        Table: $6,
+        NewName: $6,
+        IndexSpec: &IndexSpec{
+          Name: $4,
+          Type: NewColIdent(""),
+          Spatial: true,
+          Invisible: bool($10),
+        },
+        IndexCols: $8,
+      }
+  }
+| CREATE VIEW table_name AS select_statement
+  {
+    $$ = &DDL{Action: CreateViewStr, NewName: $3.ToViewName(), ViewExpr: $5}
+  }
+| CREATE OR REPLACE VIEW table_name AS select_statement
+  {
+    $$ = &DDL{Action: CreateViewStr, NewName: $5.ToViewName(), ViewExpr: $7, OrReplace: true}
+  }
+/* For PostgreSQL */
+| CREATE ID VIEW table_name AS select_statement with_data_opt
+  {
+    if NewColIdent(string($2)).Lowered() != "materialized" {
+      yylex.Error("expected MATERIALIZED VIEW, but got: " + string($2))
+      return 1
+    }
+    $$ = &DDL{Action: CreateViewStr, NewName: $4.ToViewName(), ViewExpr: $6, Materialized: true, WithNoData: bool($7)}
+  }
+| CREATE ID table_name function_definition
+  {
+    if NewColIdent(string($2)).Lowered() != "function" {
+      yylex.Error("expected FUNCTION, but got: " + string($2))
+      return 1
+    }
+    $$ = &DDL{Action: CreateFunctionStr, Table: $3, FunctionSpec: $4}
+  }
+| CREATE OR REPLACE ID table_name function_definition
+  {
+    if NewColIdent(string($4)).Lowered() != "function" {
+      yylex.Error("expected FUNCTION, but got: " + string($4))
+      return 1
+    }
+    $$ = &DDL{Action: CreateFunctionStr, Table: $5, FunctionSpec: $6, OrReplace: true}
+  }
+/* For MySQL */
+| CREATE PROCEDURE table_name function_definition
+  {
+    $$ = &DDL{Action: CreateProcedureStr, Table: $3, FunctionSpec: $4}
+  }
+/* For PostgreSQL */
+| CREATE ID table_name sequence_option_list extension_option_list
+  {
+    switch NewColIdent(string($2)).Lowered() {
+    case "sequence":
+      if len($5) > 0 {
+        yylex.Error("unexpected options of CREATE SEQUENCE: " + $5[0])
+        return 1
+      }
+      $$ = &DDL{Action: CreateSequenceStr, Table: $3, SequenceSpec: $4}
+    case "extension":
+      if *$4 != (SequenceSpec{}) {
+        yylex.Error("unexpected options of CREATE EXTENSION")
+        return 1
       }
+      $$ = &DDL{Action: CreateExtensionStr, Table: $3, ExtensionOptions: $5}
+    default:
+      yylex.Error("expected SEQUENCE or EXTENSION, but got: " + string($2))
+      return 1
+    }
+  }
+| CREATE ID IF NOT EXISTS table_name extension_option_list
+  {
+    if NewColIdent(string($2)).Lowered() != "extension" {
+      yylex.Error("expected EXTENSION, but got: " + string($2))
+      return 1
+    }
+    $$ = &DDL{Action: CreateExtensionStr, Table: $6, ExtensionOptions: $7}
+  }
+/* The empty sequence_option_list avoids a conflict with `CREATE SEQUENCE name AS type` */
+| CREATE ID table_name sequence_option_list AS ENUM '(' enum_values ')'
+  {
+    if NewColIdent(string($2)).Lowered() != "type" || *$4 != (SequenceSpec{}) {
+      yylex.Error("expected CREATE TYPE ... AS ENUM, but got: " + string($2))
+      return 1
+    }
+    $$ = &DDL{Action: CreateTypeStr, Table: $3, EnumValues: $8}
   }
-| CREATE VIEW table_name ddl_force_eof
+/* The policy name is given as table_name to avoid a conflict with other `CREATE ID table_name` */
+| CREATE ID table_name ON table_name policy_option_list
   {
-    $$ = &DDL{Action: CreateStr, NewName: $3.ToViewName()}
+    if NewColIdent(string($2)).Lowered() != "policy" || !$3.Qualifier.IsEmpty() {
+      yylex.Error("expected CREATE POLICY, but got: " + string($2))
+      return 1
+    }
+    $6.Name = NewColIdent($3.Name.String())
+    $$ = &DDL{Action: CreatePolicyStr, Table: $5, PolicySpec: $6}
+  }
+| CREATE DOMAIN table_name as_opt column_type domain_option_list
+  {
+    $6.Type = $5
+    $$ = &DDL{Action: CreateDomainStr, Table: $3, DomainSpec: $6}
+  }
+/* PostgreSQL's foreign data */
+| CREATE SERVER table_name server_option_list FOREIGN ID ID sql_id foreign_options_opt
+  {
+    if NewColIdent(string($6)).Lowered() != "data" || NewColIdent(string($7)).Lowered() != "wrapper" {
+      yylex.Error("expected FOREIGN DATA WRAPPER, but got: FOREIGN " + string($6) + " " + string($7))
+      return 1
+    }
+    $4.Wrapper = $8
+    $4.Options = $9
+    $$ = &DDL{Action: CreateServerStr, Table: $3, ForeignSpec: $4}
   }
-| CREATE OR REPLACE VIEW table_name ddl_force_eof
+| CREATE ID ID FOR sql_id SERVER sql_id foreign_options_opt
   {
-    $$ = &DDL{Action: CreateStr, NewName: $5.ToViewName()}
+    if NewColIdent(string($2)).Lowered() != "user" || NewColIdent(string($3)).Lowered() != "mapping" {
+      yylex.Error("expected USER MAPPING, but got: " + string($2) + " " + string($3))
+      return 1
+    }
+    $$ = &DDL{Action: CreateUserMappingStr, ForeignSpec: &ForeignSpec{User: $5, Server: $7, Options: $8}}
+  }
+| CREATE FOREIGN TABLE not_exists_opt table_name '(' table_column_list ')' SERVER sql_id foreign_options_opt
+  {
+    $$ = &DDL{Action: CreateForeignTableStr, NewName: $5, TableSpec: $7, ForeignSpec: &ForeignSpec{Server: $10, Options: $11}}
+  }
+| CREATE TRIGGER sql_id trigger_time trigger_event_list ON table_name trigger_for_each_opt trigger_body
+  {
+    $9.Name = $3
+    $9.Time = $4
+    $9.Events = $5
+    $9.ForEach = $8
+    $$ = &DDL{Action: CreateTriggerStr, Table: $7, TriggerSpec: $9}
   }
 | CREATE VINDEX sql_id vindex_type_opt vindex_params_opt
   {
@@ -600,9 +915,14 @@ create_statement:
   {
     $$ = &DBDDL{Action: CreateStr, DBName: string($4)}
   }
+/* MySQL's schema is a database, while PostgreSQL's schema is a namespace in the database. */
 | CREATE SCHEMA not_exists_opt ID ddl_force_eof
   {
-    $$ = &DBDDL{Action: CreateStr, DBName: string($4)}
+    if yylex.(*Tokenizer).mode == ParserModePostgres {
+      $$ = &DDL{Action: CreateSchemaStr, Table: TableName{Name: NewTableIdent(string($4))}}
+    } else {
+      $$ = &DBDDL{Action: CreateStr, DBName: string($4)}
+    }
   }
 
 unique_opt:
@@ -614,6 +934,17 @@ unique_opt:
     $$ = BoolVal(true)
   }
 
+/* PostgreSQL's CREATE INDEX CONCURRENTLY, which can't be run in a transaction */
+concurrently_opt:
+  %prec NO_CONCURRENTLY
+  {
+    $$ = BoolVal(false)
+  }
+| CONCURRENTLY
+  {
+    $$ = BoolVal(true)
+  }
+
 vindex_type_opt:
   {
     $$ = NewColIdent("")
@@ -664,10 +995,153 @@ create_table_prefix:
   }
 
 table_spec:
-  '(' table_column_list ')' table_option_list
+  '(' table_column_list ')' table_option_list partition_option_opt
   {
     $$ = $2
     $$.Options = $4
+    $$.Partition = $5
+  }
+/* PostgreSQL's table inheritance. A child table may have no columns of its own. */
+| '(' table_column_list ')' INHERITS '(' table_name_list ')' table_option_list
+  {
+    $$ = $2
+    $$.Inherits = $6
+    $$.Options = $8
+  }
+| '(' ')' INHERITS '(' table_name_list ')' table_option_list
+  {
+    $$ = &TableSpec{Inherits: $5, Options: $7}
+  }
+
+partition_option_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY partition_method partition_count_opt partition_definitions_opt
+  {
+    $$ = $3
+    $$.Partitions = $4
+    $$.Definitions = $5
+  }
+
+// Partitioning types are not keywords, not to prevent using them as column names.
+partition_method:
+  sql_id openb expression_list closeb
+  {
+    $$ = &PartitionOption{Type: $1.Lowered(), Exprs: $3}
+    if !$$.isValidType() {
+      yylex.Error("unknown partitioning type: " + $1.String())
+      return 1
+    }
+  }
+| sql_id sql_id openb expression_list closeb
+  {
+    switch {
+    case $1.Lowered() == "linear" && $2.Lowered() == "hash":
+      $$ = &PartitionOption{Type: $2.Lowered(), Linear: true, Exprs: $4}
+    case $2.Lowered() == "columns":
+      $$ = &PartitionOption{Type: $1.Lowered(), Columns: true, Exprs: $4}
+      if $$.Type != PartitionRangeStr && $$.Type != PartitionListStr {
+        yylex.Error("COLUMNS is specified for unexpected partitioning type: " + $1.String())
+        return 1
+      }
+    default:
+      yylex.Error("unknown partitioning type: " + $1.String() + " " + $2.String())
+      return 1
+    }
+  }
+| KEY openb column_list_opt closeb
+  {
+    $$ = &PartitionOption{Type: PartitionKeyStr, KeyColumns: $3}
+  }
+| KEY sql_id '=' INTEGRAL openb column_list_opt closeb
+  {
+    if $2.Lowered() != "algorithm" {
+      yylex.Error("unexpected option for KEY partitioning: " + $2.String())
+      return 1
+    }
+    $$ = &PartitionOption{Type: PartitionKeyStr, Algorithm: NewIntVal($4), KeyColumns: $6}
+  }
+| sql_id KEY openb column_list_opt closeb
+  {
+    if $1.Lowered() != "linear" {
+      yylex.Error("unknown partitioning type: " + $1.String() + " key")
+      return 1
+    }
+    $$ = &PartitionOption{Type: PartitionKeyStr, Linear: true, KeyColumns: $4}
+  }
+| sql_id KEY sql_id '=' INTEGRAL openb column_list_opt closeb
+  {
+    if $1.Lowered() != "linear" || $3.Lowered() != "algorithm" {
+      yylex.Error("unknown partitioning type: " + $1.String() + " key " + $3.String())
+      return 1
+    }
+    $$ = &PartitionOption{Type: PartitionKeyStr, Linear: true, Algorithm: NewIntVal($5), KeyColumns: $7}
+  }
+
+partition_count_opt:
+  {
+    $$ = nil
+  }
+| sql_id INTEGRAL
+  {
+    if $1.Lowered() != "partitions" {
+      yylex.Error("unexpected partition option: " + $1.String())
+      return 1
+    }
+    $$ = NewIntVal($2)
+  }
+
+partition_bound:
+  FOR VALUES FROM openb partition_bound_values closeb TO openb partition_bound_values closeb
+  {
+    $$ = &PartitionBound{From: $5, To: $9}
+  }
+| FOR VALUES IN openb expression_list closeb
+  {
+    $$ = &PartitionBound{In: $5}
+  }
+| FOR VALUES WITH openb sql_id INTEGRAL ',' sql_id INTEGRAL closeb
+  {
+    if $5.Lowered() != "modulus" || $8.Lowered() != "remainder" {
+      yylex.Error("expected MODULUS and REMAINDER, but got: " + $5.String() + " and " + $8.String())
+      return 1
+    }
+    $$ = &PartitionBound{Modulus: NewIntVal($6), Remainder: NewIntVal($9)}
+  }
+| DEFAULT
+  {
+    $$ = &PartitionBound{Default: true}
+  }
+
+partition_bound_values:
+  partition_bound_value
+  {
+    $$ = Exprs{$1}
+  }
+| partition_bound_values ',' partition_bound_value
+  {
+    $$ = append($1, $3)
+  }
+
+// MINVALUE is not a keyword, and it's parsed as a column name.
+partition_bound_value:
+  value_expression
+  {
+    $$ = $1
+  }
+| MAXVALUE
+  {
+    $$ = &MaxValueVal{}
+  }
+
+partition_definitions_opt:
+  {
+    $$ = nil
+  }
+| openb partition_definitions closeb
+  {
+    $$ = $2
   }
 
 table_column_list:
@@ -684,11 +1158,27 @@ table_column_list:
   {
     $$.AddIndex($3)
   }
+| table_column_list ',' foreign_key_definition
+  {
+    $$.AddForeignKey($3)
+  }
+| table_column_list ',' check_definition
+  {
+    $$.AddCheck($3)
+  }
+| table_column_list ',' exclusion_definition
+  {
+    $$.AddExclusion($3)
+  }
+| table_column_list ',' period_definition
+  {
+    $$.Period = $3
+  }
 
 column_definition:
-  ID column_definition_type
+  sql_id column_definition_type
   {
-    $$ = &ColumnDefinition{Name: NewColIdent(string($1)), Type: $2}
+    $$ = &ColumnDefinition{Name: $1, Type: $2}
   }
 column_type:
   numeric_type unsigned_opt zero_fill_opt
@@ -701,9 +1191,46 @@ column_type:
 | char_type
 | time_type
 | spatial_type
+/* PostgreSQL's user-defined type like an enum type */
+| ID
+  {
+    $$ = ColumnType{Type: NewColIdent(string($1)).Lowered()}
+  }
+| ID '.' sql_id
+  {
+    $$ = ColumnType{Type: NewColIdent(string($1)).Lowered() + "." + $3.Lowered()}
+  }
+/* PostgreSQL's type with a length like timestamptz(3) */
+| ID '(' INTEGRAL ')'
+  {
+    $$ = ColumnType{Type: NewColIdent(string($1)).Lowered(), Length: NewIntVal($3)}
+  }
+/* PostGIS's type like geography(Point,4326), which may be qualified by the schema of the extension */
+| ID geometry_type_modifier
+  {
+    $$ = $2
+    $$.Type = NewColIdent(string($1)).Lowered()
+  }
+| ID '.' sql_id geometry_type_modifier
+  {
+    $$ = $4
+    $$.Type = NewColIdent(string($1)).Lowered() + "." + $3.Lowered()
+  }
+
+geometry_type_modifier:
+  '(' sql_id ')'
+  {
+    $$ = ColumnType{GeometryType: $2.Lowered()}
+  }
+| '(' sql_id ',' INTEGRAL ')'
+  {
+    $$ = ColumnType{GeometryType: $2.Lowered(), Srid: NewIntVal($4)}
+  }
+
 column_definition_type:
-  column_type
+  column_type array_opt
   {
+    $1.Array = $2
     $1.NotNull = BoolVal(false)
     $1.Default = nil
     $1.OnUpdate = nil
@@ -724,7 +1251,13 @@ column_definition_type:
   }
 | column_definition_type DEFAULT STRING
   {
-    $1.Default = NewStrVal($3)
+    $1.Default = newScannedStrVal(yylex, $3)
+    $$ = $1
+  }
+| column_definition_type DEFAULT STRING TYPECAST typecast_type
+  {
+    typ := $5
+    $1.DefaultExpr = &TypeCastExpr{Expr: newScannedStrVal(yylex, $3), Type: &typ}
     $$ = $1
   }
 | column_definition_type DEFAULT INTEGRAL
@@ -732,34 +1265,108 @@ column_definition_type:
     $1.Default = NewIntVal($3)
     $$ = $1
   }
-| column_definition_type DEFAULT FLOAT
+| column_definition_type DEFAULT INTEGRAL TYPECAST typecast_type
   {
-    $1.Default = NewFloatVal($3)
+    typ := $5
+    $1.DefaultExpr = &TypeCastExpr{Expr: NewIntVal($3), Type: &typ}
     $$ = $1
   }
-| column_definition_type DEFAULT NULL
+| column_definition_type DEFAULT '-' INTEGRAL
   {
-    $1.Default = NewValArg($3)
+    $1.Default = NewIntVal(append([]byte("-"), $4...))
     $$ = $1
   }
-| column_definition_type DEFAULT CURRENT_TIMESTAMP
+| column_definition_type DEFAULT FLOAT
   {
-    $1.Default = NewValArg($3)
+    $1.Default = NewFloatVal($3)
     $$ = $1
   }
-| column_definition_type DEFAULT BIT_LITERAL
+| column_definition_type DEFAULT FLOAT TYPECAST typecast_type
   {
-    $1.Default = NewBitVal($3)
+    typ := $5
+    $1.DefaultExpr = &TypeCastExpr{Expr: NewFloatVal($3), Type: &typ}
     $$ = $1
   }
-| column_definition_type ON UPDATE CURRENT_TIMESTAMP
+| column_definition_type DEFAULT '-' FLOAT
   {
-    $1.OnUpdate = NewValArg($4)
+    $1.Default = NewFloatVal(append([]byte("-"), $4...))
     $$ = $1
   }
-| column_definition_type AUTO_INCREMENT
+| column_definition_type DEFAULT NULL
   {
-    $1.Autoincrement = BoolVal(true)
+    $1.Default = NewValArg($3)
+    $$ = $1
+  }
+| column_definition_type DEFAULT current_timestamp_value
+  {
+    $1.Default = $3
+    $$ = $1
+  }
+| column_definition_type DEFAULT function_call_generic
+  {
+    if sequence, ok := nextvalSequence($3); ok {
+      $1.DefaultNextval = sequence
+    } else {
+      $1.DefaultExpr = $3
+    }
+    $$ = $1
+  }
+| column_definition_type DEFAULT openb value_expression closeb
+  {
+    $1.DefaultExpr = &ParenExpr{Expr: $4}
+    $$ = $1
+  }
+| column_definition_type DEFAULT boolean_value
+  {
+    $1.DefaultExpr = $3
+    $$ = $1
+  }
+| column_definition_type DEFAULT BIT_LITERAL
+  {
+    $1.Default = NewBitVal($3)
+    $$ = $1
+  }
+| column_definition_type ON UPDATE current_timestamp_value
+  {
+    $1.OnUpdate = $4
+    $$ = $1
+  }
+| column_definition_type ON UPDATE ID openb closeb
+  {
+    if NewColIdent(string($4)).Lowered() != "now" {
+      yylex.Error("expected ON UPDATE CURRENT_TIMESTAMP, but got: " + string($4))
+      return 1
+    }
+    $1.OnUpdate = NewValArg([]byte("now()"))
+    $$ = $1
+  }
+| column_definition_type ON UPDATE ID openb INTEGRAL closeb
+  {
+    if NewColIdent(string($4)).Lowered() != "now" {
+      yylex.Error("expected ON UPDATE CURRENT_TIMESTAMP, but got: " + string($4))
+      return 1
+    }
+    $1.OnUpdate = NewValArg([]byte("now(" + string($6) + ")"))
+    $$ = $1
+  }
+| column_definition_type SRID INTEGRAL
+  {
+    $1.Srid = NewIntVal($3)
+    $$ = $1
+  }
+| column_definition_type AUTO_INCREMENT
+  {
+    $1.Autoincrement = BoolVal(true)
+    $$ = $1
+  }
+| column_definition_type VISIBLE
+  {
+    $1.Invisible = BoolVal(false)
+    $$ = $1
+  }
+| column_definition_type INVISIBLE
+  {
+    $1.Invisible = BoolVal(true)
     $$ = $1
   }
 | column_definition_type PRIMARY KEY
@@ -784,9 +1391,211 @@ column_definition_type:
   }
 | column_definition_type COMMENT_KEYWORD STRING
   {
-    $1.Comment = NewStrVal($3)
+    $1.Comment = newScannedStrVal(yylex, $3)
+    $$ = $1
+  }
+| column_definition_type REFERENCES table_name '(' column_list ')'
+  {
+    $1.References = &ForeignKeyDefinition{ReferenceName: $3, ReferenceColumns: $5}
+    $$ = $1
+  }
+| column_definition_type ON DELETE reference_option
+  {
+    if $1.References == nil {
+      yylex.Error("ON DELETE is specified without REFERENCES")
+      return 1
+    }
+    $1.References.OnDelete = $4
+    $$ = $1
+  }
+| column_definition_type ON UPDATE reference_option
+  {
+    if $1.References == nil {
+      yylex.Error("ON UPDATE is specified without REFERENCES")
+      return 1
+    }
+    $1.References.OnUpdate = $4
+    $$ = $1
+  }
+| column_definition_type deferrability
+  {
+    if $1.References == nil {
+      yylex.Error("DEFERRABLE is specified without REFERENCES")
+      return 1
+    }
+    $1.References.Deferrable = $2
+    $$ = $1
+  }
+| column_definition_type check_definition
+  {
+    $1.Checks = append($1.Checks, $2)
+    $$ = $1
+  }
+| column_definition_type AS '(' expression ')' generated_column_type_opt
+  {
+    $1.Generated = &GeneratedColumn{Expr: $4, Type: $6}
+    $$ = $1
+  }
+| column_definition_type generated_when AS '(' expression ')' generated_column_type_opt
+  {
+    if $2 != "always" {
+      yylex.Error("expected GENERATED ALWAYS AS (expression), but got: GENERATED BY DEFAULT AS (expression)")
+      return 1
+    }
+    $1.Generated = &GeneratedColumn{Expr: $5, Type: $7}
+    $$ = $1
+  }
+| column_definition_type identity_definition
+  {
+    $1.Identity = $2
+    $$ = $1
+  }
+/* MariaDB's row start or row end column of a system-versioned table */
+| column_definition_type generated_when AS ID START
+  {
+    if $2 != "always" || NewColIdent(string($4)).Lowered() != "row" {
+      yylex.Error("expected GENERATED ALWAYS AS ROW START, but got: " + string($4))
+      return 1
+    }
+    $1.SystemVersioning = RowStartStr
+    $$ = $1
+  }
+| column_definition_type generated_when AS ID END
+  {
+    if $2 != "always" || NewColIdent(string($4)).Lowered() != "row" {
+      yylex.Error("expected GENERATED ALWAYS AS ROW END, but got: " + string($4))
+      return 1
+    }
+    $1.SystemVersioning = RowEndStr
+    $$ = $1
+  }
+
+// Constraints of PostgreSQL's `CREATE DOMAIN` following its base type
+domain_option_list:
+  {
+    $$ = &DomainSpec{}
+  }
+| domain_option_list DEFAULT value_expression
+  {
+    $1.Default = $3
+    $$ = $1
+  }
+| domain_option_list NULL
+  {
+    $1.NotNull = false
+    $$ = $1
+  }
+| domain_option_list NOT NULL
+  {
+    $1.NotNull = true
     $$ = $1
   }
+| domain_option_list check_definition
+  {
+    $1.Checks = append($1.Checks, $2)
+    $$ = $1
+  }
+
+// PostgreSQL's `nextval('sequence'::regclass)`, which is given for a serial column
+sequence_nextval:
+  ID '(' STRING ')'
+  {
+    if NewColIdent(string($1)).Lowered() != "nextval" {
+      yylex.Error("expected nextval('sequence'), but got: " + string($1))
+      return 1
+    }
+    $$ = string($3)
+  }
+| ID '(' STRING TYPECAST ID ')'
+  {
+    if NewColIdent(string($1)).Lowered() != "nextval" || NewColIdent(string($5)).Lowered() != "regclass" {
+      yylex.Error("expected nextval('sequence'::regclass), but got: " + string($1))
+      return 1
+    }
+    $$ = string($3)
+  }
+
+// Datetime functions called without parentheses, which can be a default value without an expression
+current_timestamp_value:
+  CURRENT_TIMESTAMP
+  {
+    $$ = NewValArg($1)
+  }
+| CURRENT_TIMESTAMP openb closeb
+  {
+    $$ = NewValArg($1)
+  }
+| CURRENT_TIMESTAMP openb INTEGRAL closeb
+  {
+    $$ = NewValArg([]byte(string($1) + "(" + string($3) + ")"))
+  }
+| CURRENT_DATE
+  {
+    $$ = NewValArg($1)
+  }
+| CURRENT_TIME
+  {
+    $$ = NewValArg($1)
+  }
+| LOCALTIME
+  {
+    $$ = NewValArg($1)
+  }
+| LOCALTIME openb INTEGRAL closeb
+  {
+    $$ = NewValArg([]byte(string($1) + "(" + string($3) + ")"))
+  }
+| LOCALTIMESTAMP
+  {
+    $$ = NewValArg($1)
+  }
+| LOCALTIMESTAMP openb INTEGRAL closeb
+  {
+    $$ = NewValArg([]byte(string($1) + "(" + string($3) + ")"))
+  }
+
+generated_when:
+  GENERATED ALWAYS
+  {
+    $$ = "always"
+  }
+| GENERATED BY DEFAULT
+  {
+    $$ = "by default"
+  }
+
+// PostgreSQL's identity column
+identity_definition:
+  generated_when AS ID identity_sequence_opt
+  {
+    if NewColIdent(string($3)).Lowered() != "identity" {
+      yylex.Error("expected AS IDENTITY, but got: AS " + string($3))
+      return 1
+    }
+    $$ = &IdentitySpec{Behavior: $1, Sequence: $4}
+  }
+
+identity_sequence_opt:
+  {
+    $$ = nil
+  }
+| '(' sequence_option_list ')'
+  {
+    $$ = $2
+  }
+
+generated_column_type_opt:
+  {
+    $$ = ""
+  }
+| VIRTUAL
+  {
+    $$ = VirtualStr
+  }
+| STORED
+  {
+    $$ = StoredStr
+  }
 
 numeric_type:
   int_type length_opt
@@ -828,6 +1637,18 @@ int_type:
   {
     $$ = ColumnType{Type: string($1)}
   }
+| SMALLSERIAL
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| SERIAL
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
+| BIGSERIAL
+  {
+    $$ = ColumnType{Type: string($1)}
+  }
 
 decimal_type:
 REAL float_length_opt
@@ -842,6 +1663,12 @@ REAL float_length_opt
     $$.Length = $2.Length
     $$.Scale = $2.Scale
   }
+| DOUBLE PRECISION float_length_opt
+  {
+    $$ = ColumnType{Type: string($1) + " " + string($2)}
+    $$.Length = $3.Length
+    $$.Scale = $3.Scale
+  }
 | FLOAT_TYPE float_length_opt
   {
     $$ = ColumnType{Type: string($1)}
@@ -866,13 +1693,13 @@ time_type:
   {
     $$ = ColumnType{Type: string($1)}
   }
-| TIME length_opt
+| TIME length_opt time_zone_opt
   {
-    $$ = ColumnType{Type: string($1), Length: $2}
+    $$ = ColumnType{Type: string($1), Length: $2, Timezone: $3}
   }
-| TIMESTAMP length_opt
+| TIMESTAMP length_opt time_zone_opt
   {
-    $$ = ColumnType{Type: string($1), Length: $2}
+    $$ = ColumnType{Type: string($1), Length: $2, Timezone: $3}
   }
 | DATETIME length_opt
   {
@@ -883,6 +1710,34 @@ time_type:
     $$ = ColumnType{Type: string($1)}
   }
 
+/* PostgreSQL's WITH TIME ZONE or WITHOUT TIME ZONE, which is the default */
+time_zone_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| time_zone
+  {
+    $$ = $1
+  }
+
+time_zone:
+  WITH TIME ID
+  {
+    if NewColIdent(string($3)).Lowered() != "zone" {
+      yylex.Error("expected WITH TIME ZONE, but got: WITH TIME " + string($3))
+      return 1
+    }
+    $$ = BoolVal(true)
+  }
+| WITHOUT TIME ID
+  {
+    if NewColIdent(string($3)).Lowered() != "zone" {
+      yylex.Error("expected WITHOUT TIME ZONE, but got: WITHOUT TIME " + string($3))
+      return 1
+    }
+    $$ = BoolVal(false)
+  }
+
 bool_type:
   BOOL
   {
@@ -969,6 +1824,11 @@ spatial_type:
   {
     $$ = ColumnType{Type: string($1)}
   }
+| GEOMETRY geometry_type_modifier
+  {
+    $$ = $2
+    $$.Type = string($1)
+  }
 | POINT
   {
     $$ = ColumnType{Type: string($1)}
@@ -1009,6 +1869,86 @@ enum_values:
     $$ = append($1, "'" + string($3) + "'")
   }
 
+// PostgreSQL's type names following `::`
+typecast_type:
+  ID length_opt
+  {
+    $$ = ColumnType{Type: NewColIdent(string($1)).Lowered(), Length: $2}
+  }
+| typecast_type_keyword length_opt %prec END_OF_TYPECAST_TYPE
+  {
+    $$ = ColumnType{Type: string($1), Length: $2}
+  }
+| typecast_type_keyword length_opt time_zone
+  {
+    $$ = ColumnType{Type: string($1), Length: $2, Timezone: $3}
+  }
+| CHARACTER VARYING length_opt
+  {
+    $$ = ColumnType{Type: "character varying", Length: $3}
+  }
+| DOUBLE PRECISION
+  {
+    $$ = ColumnType{Type: "double precision"}
+  }
+| ID '.' sql_id
+  {
+    $$ = ColumnType{Type: NewColIdent(string($1)).Lowered() + "." + $3.Lowered()}
+  }
+| typecast_type '[' ']'
+  {
+    $$ = $1
+    $$.Array = BoolVal(true)
+  }
+
+/* PostgreSQL's array type. Its dimensions and sizes are ignored by PostgreSQL, and not kept. */
+array_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| array_bounds
+  {
+    $$ = $1
+  }
+| ARRAY
+  {
+    $$ = BoolVal(true)
+  }
+| ARRAY '[' INTEGRAL ']'
+  {
+    $$ = BoolVal(true)
+  }
+
+array_bounds:
+  '[' ']'
+  {
+    $$ = BoolVal(true)
+  }
+| '[' INTEGRAL ']'
+  {
+    $$ = BoolVal(true)
+  }
+| array_bounds '[' ']'
+| array_bounds '[' INTEGRAL ']'
+
+typecast_type_keyword:
+  BIGINT
+| BOOLEAN
+| CHAR
+| DATE
+| DECIMAL
+| FLOAT_TYPE
+| INT
+| INTEGER
+| JSON
+| NUMERIC
+| REAL
+| SMALLINT
+| TEXT
+| TIME
+| TIMESTAMP
+| VARCHAR
+
 length_opt:
   {
     $$ = nil
@@ -1078,6 +2018,10 @@ charset_opt:
   {
     $$ = string($3)
   }
+| CHARSET ID
+  {
+    $$ = string($2)
+  }
 
 collate_opt:
   {
@@ -1097,6 +2041,183 @@ index_definition:
   {
     $$ = &IndexDefinition{Info: $1, Columns: $3}
   }
+| index_info '(' index_column_list ')' deferrability
+  {
+    $$ = &IndexDefinition{Info: $1, Columns: $3, Deferrable: $5}
+  }
+
+foreign_key_definition:
+  FOREIGN KEY '(' column_list ')' REFERENCES table_name '(' column_list ')'
+  {
+    $$ = &ForeignKeyDefinition{IndexColumns: $4, ReferenceName: $7, ReferenceColumns: $9}
+  }
+| FOREIGN KEY sql_id '(' column_list ')' REFERENCES table_name '(' column_list ')'
+  {
+    $$ = &ForeignKeyDefinition{IndexName: $3, IndexColumns: $5, ReferenceName: $8, ReferenceColumns: $10}
+  }
+| CONSTRAINT sql_id FOREIGN KEY '(' column_list ')' REFERENCES table_name '(' column_list ')'
+  {
+    $$ = &ForeignKeyDefinition{ConstraintName: $2, IndexColumns: $6, ReferenceName: $9, ReferenceColumns: $11}
+  }
+| CONSTRAINT sql_id FOREIGN KEY sql_id '(' column_list ')' REFERENCES table_name '(' column_list ')'
+  {
+    $$ = &ForeignKeyDefinition{ConstraintName: $2, IndexName: $5, IndexColumns: $7, ReferenceName: $10, ReferenceColumns: $12}
+  }
+| foreign_key_definition ON DELETE reference_option
+  {
+    $1.OnDelete = $4
+    $$ = $1
+  }
+| foreign_key_definition ON UPDATE reference_option
+  {
+    $1.OnUpdate = $4
+    $$ = $1
+  }
+| foreign_key_definition deferrability
+  {
+    $1.Deferrable = $2
+    $$ = $1
+  }
+
+check_definition:
+  CHECK '(' expression ')'
+  {
+    $$ = &CheckDefinition{Expr: $3}
+  }
+| CONSTRAINT sql_id CHECK '(' expression ')'
+  {
+    $$ = &CheckDefinition{ConstraintName: $2, Expr: $5}
+  }
+
+/* MariaDB's `PERIOD FOR SYSTEM_TIME (start, end)`. PERIOD is not a keyword to avoid conflicts with column definitions */
+period_definition:
+  ID FOR sql_id '(' sql_id ',' sql_id ')'
+  {
+    if NewColIdent(string($1)).Lowered() != "period" {
+      yylex.Error("expected PERIOD FOR, but got: " + string($1))
+      return 1
+    }
+    $$ = &PeriodDefinition{Name: $3, Start: $5, End: $7}
+  }
+
+/* EXCLUDE is not a keyword to avoid conflicts with column definitions */
+exclusion_definition:
+  ID USING sql_id '(' exclusion_element_list ')' exclusion_where_opt
+  {
+    if NewColIdent(string($1)).Lowered() != "exclude" {
+      yylex.Error("expected EXCLUDE, but got: " + string($1))
+      return 1
+    }
+    $$ = &ExclusionDefinition{IndexType: $3, Elements: $5, Where: $7}
+  }
+| CONSTRAINT sql_id ID USING sql_id '(' exclusion_element_list ')' exclusion_where_opt
+  {
+    if NewColIdent(string($3)).Lowered() != "exclude" {
+      yylex.Error("expected EXCLUDE, but got: " + string($3))
+      return 1
+    }
+    $$ = &ExclusionDefinition{ConstraintName: $2, IndexType: $5, Elements: $7, Where: $9}
+  }
+| exclusion_definition deferrability
+  {
+    $1.Deferrable = $2
+    $$ = $1
+  }
+
+/* PostgreSQL's constraint attributes like `DEFERRABLE INITIALLY DEFERRED`, which are not keywords */
+deferrability:
+  deferrability_words %prec END_OF_DEFERRABILITY
+  {
+    deferrable, err := normalizeDeferrability($1)
+    if err != nil {
+      yylex.Error(err.Error())
+      return 1
+    }
+    $$ = deferrable
+  }
+
+deferrability_words:
+  ID
+  {
+    $$ = []string{NewColIdent(string($1)).Lowered()}
+  }
+| NOT ID
+  {
+    $$ = []string{"not", NewColIdent(string($2)).Lowered()}
+  }
+| deferrability_words ID
+  {
+    $$ = append($1, NewColIdent(string($2)).Lowered())
+  }
+
+exclusion_element_list:
+  exclusion_element
+  {
+    $$ = []ExclusionElement{$1}
+  }
+| exclusion_element_list ',' exclusion_element
+  {
+    $$ = append($1, $3)
+  }
+
+exclusion_element:
+  sql_id WITH exclusion_operator
+  {
+    $$ = ExclusionElement{Column: $1, Operator: $3}
+  }
+
+exclusion_where_opt:
+  {
+    $$ = nil
+  }
+| WHERE '(' expression ')'
+  {
+    $$ = $3
+  }
+
+exclusion_operator:
+  '='
+  {
+    $$ = "="
+  }
+| AND
+  {
+    $$ = "&&"
+  }
+| NE
+  {
+    $$ = "<>"
+  }
+| '<'
+  {
+    $$ = "<"
+  }
+| '>'
+  {
+    $$ = ">"
+  }
+
+reference_option:
+  RESTRICT
+  {
+    $$ = NewColIdent(string($1))
+  }
+| CASCADE
+  {
+    $$ = NewColIdent(string($1))
+  }
+| SET NULL
+  {
+    $$ = NewColIdent(string($1) + " " + string($2))
+  }
+| SET DEFAULT
+  {
+    $$ = NewColIdent(string($1) + " " + string($2))
+  }
+| NO ACTION
+  {
+    $$ = NewColIdent(string($1) + " " + string($2))
+  }
 
 index_option_list:
   index_option
@@ -1120,7 +2241,50 @@ index_option:
   }
 | COMMENT_KEYWORD STRING
   {
-    $$ = &IndexOption{Name: string($1), Value: NewStrVal($2)}
+    $$ = &IndexOption{Name: string($1), Value: newScannedStrVal(yylex, $2)}
+  }
+| WITH ID ID
+  {
+    if NewColIdent(string($2)).Lowered() != "parser" {
+      yylex.Error("expected WITH PARSER, but got: " + string($2))
+      return 1
+    }
+    $$ = &IndexOption{Name: "with parser", Using: string($3)}
+  }
+| VISIBLE
+  {
+    $$ = &IndexOption{Name: string($1)}
+  }
+| INVISIBLE
+  {
+    $$ = &IndexOption{Name: string($1)}
+  }
+
+/* MySQL's visibility of an index */
+invisible_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| VISIBLE
+  {
+    $$ = BoolVal(false)
+  }
+| INVISIBLE
+  {
+    $$ = BoolVal(true)
+  }
+
+fulltext_parser_opt:
+  {
+    $$ = ""
+  }
+| WITH ID ID
+  {
+    if NewColIdent(string($2)).Lowered() != "parser" {
+      yylex.Error("expected WITH PARSER, but got: " + string($2))
+      return 1
+    }
+    $$ = string($3)
   }
 
 equal_opt:
@@ -1142,6 +2306,14 @@ index_info:
   {
     $$ = &IndexInfo{Type: string($1) + " " + string($2), Name: NewColIdent(string($3)), Spatial: true, Unique: false}
   }
+| SPATIAL index_or_key
+  {
+    $$ = &IndexInfo{Type: string($1) + " " + string($2), Spatial: true}
+  }
+| SPATIAL
+  {
+    $$ = &IndexInfo{Type: string($1), Spatial: true}
+  }
 | UNIQUE index_or_key ID
   {
     $$ = &IndexInfo{Type: string($1) + " " + string($2), Name: NewColIdent(string($3)), Unique: true}
@@ -1154,6 +2326,30 @@ index_info:
   {
     $$ = &IndexInfo{Type: string($1), Name: NewColIdent(string($2)), Unique: false}
   }
+| FULLTEXT index_or_key ID
+  {
+    $$ = &IndexInfo{Type: string($1) + " " + string($2), Name: NewColIdent(string($3)), Fulltext: true}
+  }
+| FULLTEXT index_or_key
+  {
+    $$ = &IndexInfo{Type: string($1) + " " + string($2), Fulltext: true}
+  }
+| FULLTEXT
+  {
+    $$ = &IndexInfo{Type: string($1), Fulltext: true}
+  }
+| UNIQUE
+  {
+    $$ = &IndexInfo{Type: string($1), Unique: true}
+  }
+| CONSTRAINT sql_id UNIQUE
+  {
+    $$ = &IndexInfo{Type: string($3), Name: $2, Unique: true, Constraint: true}
+  }
+| CONSTRAINT sql_id UNIQUE index_or_key
+  {
+    $$ = &IndexInfo{Type: string($3) + " " + string($4), Name: $2, Unique: true, Constraint: true}
+  }
 
 index_or_key:
     INDEX
@@ -1166,30 +2362,96 @@ index_or_key:
   }
 
 index_column_list:
-  index_column
+  index_column_with_order
   {
     $$ = []*IndexColumn{$1}
   }
-| index_column_list ',' index_column
+| index_column_list ',' index_column_with_order
   {
     $$ = append($$, $3)
   }
 
+index_column_with_order:
+  index_column asc_desc_opt nulls_order_opt
+  {
+    $1.Direction = $2
+    $1.NullsOrder = $3
+    $$ = $1
+  }
+
+/* PostgreSQL's INCLUDE of a covering index */
+index_include_opt:
+  {
+    $$ = nil
+  }
+| ID '(' column_list ')'
+  {
+    if NewColIdent(string($1)).Lowered() != "include" {
+      yylex.Error("expected INCLUDE, but got: " + string($1))
+      return 1
+    }
+    $$ = $3
+  }
+
+/* PostgreSQL's TABLESPACE of an index */
+tablespace_opt:
+  {
+    $$ = ""
+  }
+| TABLESPACE sql_id
+  {
+    $$ = $2.String()
+  }
+
+/* PostgreSQL's NULLS FIRST or NULLS LAST */
+nulls_order_opt:
+  {
+    $$ = ""
+  }
+| ID ID
+  {
+    nulls := NewColIdent(string($1)).Lowered()
+    order := NewColIdent(string($2)).Lowered()
+    if nulls != "nulls" || (order != "first" && order != "last") {
+      yylex.Error("expected NULLS FIRST or NULLS LAST, but got: " + string($1) + " " + string($2))
+      return 1
+    }
+    $$ = nulls + " " + order
+  }
+
 index_column:
-  sql_id length_opt
+  sql_id
   {
-      $$ = &IndexColumn{Column: $1, Length: $2}
+    $$ = &IndexColumn{Column: $1}
+  }
+/* MySQL's prefix length like `name(10)`, or PostgreSQL's expression like `lower(email)` */
+| sql_id openb select_expression_list_opt closeb
+  {
+    column := &IndexColumn{Expr: &FuncExpr{Name: $1, Exprs: $3}}
+    if yylex.(*Tokenizer).mode == ParserModeMysql && len($3) == 1 {
+      if expr, ok := $3[0].(*AliasedExpr); ok {
+        if val, ok := expr.Expr.(*SQLVal); ok && val.Type == IntVal {
+          column = &IndexColumn{Column: $1, Length: val}
+        }
+      }
+    }
+    $$ = column
+  }
+| openb expression closeb
+  {
+    $$ = &IndexColumn{Expr: $2}
   }
 
 table_option_list:
+  %prec END_OF_TABLE_OPTIONS
   {
     $$ = ""
   }
-| table_option
+| table_option %prec END_OF_TABLE_OPTIONS
   {
     $$ = " " + string($1)
   }
-| table_option_list ',' table_option
+| table_option_list ',' table_option %prec END_OF_TABLE_OPTIONS
   {
     $$ = string($1) + ", " + string($3)
   }
@@ -1217,7 +2479,7 @@ table_opt_value:
   }
 | STRING
   {
-    $$ = "'" + string($1) + "'"
+    $$ = String(newScannedStrVal(yylex, $1))
   }
 | INTEGRAL
   {
@@ -1229,7 +2491,7 @@ alter_statement:
   {
     $$ = &DDL{Action: AlterStr, Table: $4, NewName: $4}
   }
-| ALTER ignore_opt TABLE table_name ADD unique_opt alter_object_type_index sql_id '(' column_list ')'
+| ALTER ignore_opt TABLE table_name ADD unique_opt alter_object_type_index sql_id '(' index_column_list ')' invisible_opt
   {
     $$ = &DDL{
         Action: AddIndexStr,
@@ -1239,11 +2501,41 @@ alter_statement:
           Name: $8,
           Unique: bool($6),
           Primary: false,
+          Invisible: bool($12),
         },
         IndexCols: $10,
       }
   }
-| ALTER ignore_opt TABLE ONLY table_name ADD CONSTRAINT sql_id PRIMARY KEY '(' column_list ')'
+| ALTER ignore_opt TABLE table_name ADD FULLTEXT alter_object_type_index sql_id '(' index_column_list ')' fulltext_parser_opt invisible_opt
+  {
+    $$ = &DDL{
+        Action: AddIndexStr,
+        Table: $4,
+        NewName: $4,
+        IndexSpec: &IndexSpec{
+          Name: $8,
+          Fulltext: true,
+          Parser: $12,
+          Invisible: bool($13),
+        },
+        IndexCols: $10,
+      }
+  }
+| ALTER ignore_opt TABLE table_name ADD SPATIAL alter_object_type_index sql_id '(' index_column_list ')' invisible_opt
+  {
+    $$ = &DDL{
+        Action: AddIndexStr,
+        Table: $4,
+        NewName: $4,
+        IndexSpec: &IndexSpec{
+          Name: $8,
+          Spatial: true,
+          Invisible: bool($12),
+        },
+        IndexCols: $10,
+      }
+  }
+| ALTER ignore_opt TABLE ONLY table_name ADD CONSTRAINT sql_id PRIMARY KEY '(' index_column_list ')'
   {
     $$ = &DDL{
         Action: AddPrimaryKeyStr,
@@ -1257,6 +2549,80 @@ alter_statement:
         IndexCols: $12,
       }
   }
+| ALTER ignore_opt TABLE table_name ADD CONSTRAINT sql_id UNIQUE '(' index_column_list ')'
+  {
+    $$ = &DDL{
+        Action: AddIndexStr,
+        Table: $4,
+        NewName: $4,
+        IndexSpec: &IndexSpec{
+          Name: $7,
+          Unique: true,
+          Constraint: true,
+        },
+        IndexCols: $10,
+      }
+  }
+| ALTER ignore_opt TABLE table_name ADD CONSTRAINT sql_id UNIQUE '(' index_column_list ')' deferrability
+  {
+    $$ = &DDL{
+        Action: AddIndexStr,
+        Table: $4,
+        NewName: $4,
+        IndexSpec: &IndexSpec{
+          Name: $7,
+          Unique: true,
+          Constraint: true,
+          Deferrable: $12,
+        },
+        IndexCols: $10,
+      }
+  }
+| ALTER ignore_opt TABLE ONLY table_name ADD CONSTRAINT sql_id UNIQUE '(' index_column_list ')'
+  {
+    $$ = &DDL{
+        Action: AddIndexStr,
+        Table: $5,
+        NewName: $5,
+        IndexSpec: &IndexSpec{
+          Name: $8,
+          Unique: true,
+          Constraint: true,
+        },
+        IndexCols: $11,
+      }
+  }
+| ALTER ignore_opt TABLE ONLY table_name ADD CONSTRAINT sql_id UNIQUE '(' index_column_list ')' deferrability
+  {
+    $$ = &DDL{
+        Action: AddIndexStr,
+        Table: $5,
+        NewName: $5,
+        IndexSpec: &IndexSpec{
+          Name: $8,
+          Unique: true,
+          Constraint: true,
+          Deferrable: $13,
+        },
+        IndexCols: $11,
+      }
+  }
+| ALTER ignore_opt TABLE table_name ADD foreign_key_definition
+  {
+    $$ = &DDL{Action: AddForeignKeyStr, Table: $4, NewName: $4, ForeignKey: $6}
+  }
+| ALTER ignore_opt TABLE ONLY table_name ADD foreign_key_definition
+  {
+    $$ = &DDL{Action: AddForeignKeyStr, Table: $5, NewName: $5, ForeignKey: $7}
+  }
+| ALTER ignore_opt TABLE table_name ADD exclusion_definition
+  {
+    $$ = &DDL{Action: AddExclusionStr, Table: $4, NewName: $4, Exclusion: $6}
+  }
+| ALTER ignore_opt TABLE ONLY table_name ADD exclusion_definition
+  {
+    $$ = &DDL{Action: AddExclusionStr, Table: $5, NewName: $5, Exclusion: $7}
+  }
 | ALTER ignore_opt TABLE table_name ADD alter_object_type_rest force_eof
   {
     $$ = &DDL{Action: AlterStr, Table: $4, NewName: $4}
@@ -1298,14 +2664,81 @@ alter_statement:
     // Rename an index can just be an alter
     $$ = &DDL{Action: AlterStr, Table: $4, NewName: $4}
   }
+| ALTER ignore_opt TABLE table_name ALTER COLUMN sql_id ADD identity_definition
+  {
+    $$ = &DDL{Action: AlterColumnStr, Table: $4, NewName: $4, AlterColumn: &AlterColumnSpec{Column: $7, Identity: $9}}
+  }
+| ALTER ignore_opt TABLE ONLY table_name ALTER COLUMN sql_id ADD identity_definition
+  {
+    $$ = &DDL{Action: AlterColumnStr, Table: $5, NewName: $5, AlterColumn: &AlterColumnSpec{Column: $8, Identity: $10}}
+  }
+| ALTER ignore_opt TABLE table_name ALTER COLUMN sql_id SET DEFAULT sequence_nextval
+  {
+    $$ = &DDL{Action: AlterColumnStr, Table: $4, NewName: $4, AlterColumn: &AlterColumnSpec{Column: $7, DefaultNextval: $10}}
+  }
+| ALTER ignore_opt TABLE ONLY table_name ALTER COLUMN sql_id SET DEFAULT sequence_nextval
+  {
+    $$ = &DDL{Action: AlterColumnStr, Table: $5, NewName: $5, AlterColumn: &AlterColumnSpec{Column: $8, DefaultNextval: $11}}
+  }
+| ALTER ignore_opt TABLE table_name row_level_security_action ID LEVEL ID
+  {
+    if NewColIdent(string($6)).Lowered() != "row" || NewColIdent(string($8)).Lowered() != "security" {
+      yylex.Error("expected ROW LEVEL SECURITY, but got: " + string($6))
+      return 1
+    }
+    $$ = &DDL{Action: RowLevelSecurityStr, Table: $4, NewName: $4, RowLevelSecurity: $5}
+  }
+| ALTER ignore_opt TABLE ONLY table_name row_level_security_action ID LEVEL ID
+  {
+    if NewColIdent(string($7)).Lowered() != "row" || NewColIdent(string($9)).Lowered() != "security" {
+      yylex.Error("expected ROW LEVEL SECURITY, but got: " + string($7))
+      return 1
+    }
+    $$ = &DDL{Action: RowLevelSecurityStr, Table: $5, NewName: $5, RowLevelSecurity: $6}
+  }
 | ALTER VIEW table_name ddl_force_eof
   {
     $$ = &DDL{Action: AlterStr, Table: $3.ToViewName(), NewName: $3.ToViewName()}
   }
+| ALTER ID table_name sequence_option_list
+  {
+    if NewColIdent(string($2)).Lowered() != "sequence" {
+      yylex.Error("expected SEQUENCE, but got: " + string($2))
+      return 1
+    }
+    $$ = &DDL{Action: AlterSequenceStr, Table: $3, SequenceSpec: $4}
+  }
 | ALTER ignore_opt TABLE table_name partition_operation
   {
     $$ = &DDL{Action: AlterStr, Table: $4, PartitionSpec: $5}
   }
+/* For PostgreSQL */
+| ALTER ignore_opt TABLE table_name ID PARTITION table_name partition_bound
+  {
+    if NewColIdent(string($5)).Lowered() != "attach" {
+      yylex.Error("expected ATTACH PARTITION, but got: " + string($5))
+      return 1
+    }
+    $$ = &DDL{
+        Action: AttachPartitionStr,
+        Table: $4,
+        NewName: $4,
+        PartitionSpec: &PartitionSpec{Action: AttachPartitionStr, Table: $7, Bound: $8},
+      }
+  }
+| ALTER ignore_opt TABLE ONLY table_name ID PARTITION table_name partition_bound
+  {
+    if NewColIdent(string($6)).Lowered() != "attach" {
+      yylex.Error("expected ATTACH PARTITION, but got: " + string($6))
+      return 1
+    }
+    $$ = &DDL{
+        Action: AttachPartitionStr,
+        Table: $5,
+        NewName: $5,
+        PartitionSpec: &PartitionSpec{Action: AttachPartitionStr, Table: $8, Bound: $9},
+      }
+  }
 
 alter_object_type:
   COLUMN
@@ -1326,7 +2759,6 @@ alter_object_type_index:
 
 alter_object_type_rest:
   COLUMN
-| FOREIGN
 | FULLTEXT
 | ID
 | PRIMARY
@@ -1350,13 +2782,35 @@ partition_definitions:
   }
 
 partition_definition:
-  PARTITION sql_id VALUES LESS THAN openb value_expression closeb
+  PARTITION sql_id VALUES LESS THAN openb value_expression closeb partition_definition_options
+  {
+    $$ = &PartitionDefinition{Name: $2, Limit: $7, Options: $9}
+  }
+| PARTITION sql_id VALUES LESS THAN openb value_expression ',' expression_list closeb partition_definition_options
   {
-    $$ = &PartitionDefinition{Name: $2, Limit: $7}
+    $$ = &PartitionDefinition{Name: $2, Limit: append(ValTuple{$7}, $9...), Options: $11}
   }
-| PARTITION sql_id VALUES LESS THAN openb MAXVALUE closeb
+| PARTITION sql_id VALUES LESS THAN openb MAXVALUE closeb partition_definition_options
   {
-    $$ = &PartitionDefinition{Name: $2, Maxvalue: true}
+    $$ = &PartitionDefinition{Name: $2, Maxvalue: true, Options: $9}
+  }
+| PARTITION sql_id VALUES LESS THAN MAXVALUE partition_definition_options
+  {
+    $$ = &PartitionDefinition{Name: $2, Maxvalue: true, Options: $7}
+  }
+| PARTITION sql_id VALUES IN openb expression_list closeb partition_definition_options
+  {
+    $$ = &PartitionDefinition{Name: $2, In: $6, Options: $8}
+  }
+
+// Options like `ENGINE = InnoDB` are just kept as a string like table options.
+partition_definition_options:
+  {
+    $$ = ""
+  }
+| partition_definition_options sql_id equal_opt table_opt_value
+  {
+    $$ = $1 + " " + $2.String() + " = " + $4
   }
 
 rename_statement:
@@ -1374,10 +2828,22 @@ drop_statement:
     }
     $$ = &DDL{Action: DropStr, Table: $4, IfExists: exists}
   }
-| DROP INDEX ID ON table_name ddl_force_eof
+| DROP INDEX exists_opt sql_id ON table_name ddl_force_eof
   {
-    // Change this to an alter statement
-    $$ = &DDL{Action: AlterStr, Table: $5, NewName: $5}
+    var exists bool
+    if $3 != 0 {
+      exists = true
+    }
+    $$ = &DDL{Action: DropIndexStr, Table: $6, IfExists: exists, IndexSpec: &IndexSpec{Name: $4}}
+  }
+/* For PostgreSQL */
+| DROP INDEX exists_opt sql_id
+  {
+    var exists bool
+    if $3 != 0 {
+      exists = true
+    }
+    $$ = &DDL{Action: DropIndexStr, IfExists: exists, IndexSpec: &IndexSpec{Name: $4}}
   }
 | DROP VIEW exists_opt table_name ddl_force_eof
   {
@@ -1393,18 +2859,167 @@ drop_statement:
   }
 | DROP SCHEMA exists_opt ID
   {
-    $$ = &DBDDL{Action: DropStr, DBName: string($4)}
+    $$ = &DBDDL{Action: DropStr, DBName: string($4)}
+  }
+
+truncate_statement:
+  TRUNCATE TABLE table_name
+  {
+    $$ = &DDL{Action: TruncateStr, Table: $3}
+  }
+| TRUNCATE table_name
+  {
+    $$ = &DDL{Action: TruncateStr, Table: $2}
+  }
+/* For PostgreSQL */
+grant_statement:
+  GRANT grant_spec TO grantee_list grant_option_opt
+  {
+    $2.Action = GrantStr
+    $2.GrantSpec.Grantees = $4
+    $2.GrantSpec.WithGrantOption = bool($5)
+    $$ = $2
+  }
+| REVOKE grant_spec FROM grantee_list
+  {
+    $2.Action = RevokeStr
+    $2.GrantSpec.Grantees = $4
+    $$ = $2
+  }
+
+grant_spec:
+  privilege_list ON table_name
+  {
+    $$ = &DDL{Table: $3, GrantSpec: &GrantSpec{Privileges: $1, ObjectType: "table"}}
+  }
+| privilege_list ON grant_object_type table_name
+  {
+    $$ = &DDL{Table: $4, GrantSpec: &GrantSpec{Privileges: $1, ObjectType: $3}}
+  }
+
+grant_object_type:
+  TABLE
+  {
+    $$ = "table"
+  }
+| ID
+  {
+    if NewColIdent(string($1)).Lowered() != "sequence" {
+      yylex.Error("expected TABLE or SEQUENCE, but got: " + string($1))
+      return 1
+    }
+    $$ = "sequence"
+  }
+
+privilege_list:
+  privilege
+  {
+    $$ = []string{$1}
+  }
+| privilege_list ',' privilege
+  {
+    $$ = append($1, $3)
+  }
+
+privilege:
+  ALL
+  {
+    $$ = "all"
+  }
+| ALL ID
+  {
+    if NewColIdent(string($2)).Lowered() != "privileges" {
+      yylex.Error("expected ALL PRIVILEGES, but got: " + string($2))
+      return 1
+    }
+    $$ = "all"
+  }
+| SELECT
+  {
+    $$ = "select"
+  }
+| INSERT
+  {
+    $$ = "insert"
+  }
+| UPDATE
+  {
+    $$ = "update"
+  }
+| DELETE
+  {
+    $$ = "delete"
+  }
+| TRUNCATE
+  {
+    $$ = "truncate"
+  }
+| REFERENCES
+  {
+    $$ = "references"
+  }
+| TRIGGER
+  {
+    $$ = "trigger"
+  }
+| ID
+  {
+    $$ = NewColIdent(string($1)).Lowered()
+  }
+/* USAGE is reserved by MySQL */
+| UNUSED
+  {
+    if NewColIdent(string($1)).Lowered() != "usage" {
+      yylex.Error("unexpected privilege: " + string($1))
+      return 1
+    }
+    $$ = "usage"
+  }
+
+grantee_list:
+  sql_id
+  {
+    $$ = []string{$1.Lowered()}
+  }
+| grantee_list ',' sql_id
+  {
+    $$ = append($1, $3.Lowered())
+  }
+
+grant_option_opt:
+  {
+    $$ = BoolVal(false)
+  }
+/* OPTION is reserved by MySQL */
+| WITH GRANT UNUSED
+  {
+    if NewColIdent(string($3)).Lowered() != "option" {
+      yylex.Error("expected WITH GRANT OPTION, but got: " + string($3))
+      return 1
+    }
+    $$ = BoolVal(true)
+  }
+
+comment_statement:
+  COMMENT_KEYWORD ON TABLE table_name IS comment_value
+  {
+    $$ = &DDL{Action: CommentStr, Table: $4, CommentSpec: &CommentSpec{Comment: $6}}
+  }
+| COMMENT_KEYWORD ON COLUMN column_name IS comment_value
+  {
+    $$ = &DDL{Action: CommentStr, Table: $4.Qualifier, CommentSpec: &CommentSpec{Column: $4.Name, Comment: $6}}
   }
 
-truncate_statement:
-  TRUNCATE TABLE table_name
+comment_value:
+  STRING
   {
-    $$ = &DDL{Action: TruncateStr, Table: $3}
+    $$ = newScannedStrVal(yylex, $1)
   }
-| TRUNCATE table_name
+| NULL
   {
-    $$ = &DDL{Action: TruncateStr, Table: $2}
+    $$ = nil
   }
+
 analyze_statement:
   ANALYZE TABLE table_name
   {
@@ -1746,6 +3361,7 @@ select_expression:
   }
 
 as_ci_opt:
+  %prec NO_ALIAS
   {
     $$ = ColIdent{}
   }
@@ -1812,6 +3428,12 @@ table_name as_opt_id index_hint_list
     $$ = &AliasedTableExpr{Expr:$1, Partitions: $4, As: $6, Hints: $7}
   }
 
+column_list_opt:
+  {
+    $$ = nil
+  }
+| column_list
+
 column_list:
   sql_id
   {
@@ -1881,6 +3503,7 @@ as_opt:
   { $$ = struct{}{} }
 
 as_opt_id:
+  %prec NO_ALIAS
   {
     $$ = NewTableIdent("")
   }
@@ -2061,6 +3684,14 @@ condition:
   {
     $$ = &ComparisonExpr{Left: $1, Operator: NotInStr, Right: $4}
   }
+| value_expression compare ANY openb value_expression closeb
+  {
+    $$ = &ComparisonExpr{Left: $1, Operator: $2, Right: &QuantifiedExpr{Quantifier: AnyStr, Expr: $5}}
+  }
+| value_expression compare ALL openb value_expression closeb
+  {
+    $$ = &ComparisonExpr{Left: $1, Operator: $2, Right: &QuantifiedExpr{Quantifier: AllStr, Expr: $5}}
+  }
 | value_expression LIKE value_expression like_escape_opt
   {
     $$ = &ComparisonExpr{Left: $1, Operator: LikeStr, Right: $3, Escape: $4}
@@ -2218,6 +3849,10 @@ value_expression:
   {
     $$ = &BinaryExpr{Left: $1, Operator: BitXorStr, Right: $3}
   }
+| value_expression CONCAT value_expression
+  {
+    $$ = &BinaryExpr{Left: $1, Operator: ConcatStr, Right: $3}
+  }
 | value_expression '+' value_expression
   {
     $$ = &BinaryExpr{Left: $1, Operator: PlusStr, Right: $3}
@@ -2266,6 +3901,11 @@ value_expression:
   {
     $$ = &CollateExpr{Expr: $1, Charset: $3}
   }
+| value_expression TYPECAST typecast_type
+  {
+    typ := $3
+    $$ = &TypeCastExpr{Expr: $1, Type: &typ}
+  }
 | BINARY value_expression %prec UNARY
   {
     $$ = &UnaryExpr{Operator: BinaryStr, Expr: $2}
@@ -2274,6 +3914,14 @@ value_expression:
   {
     $$ = &UnaryExpr{Operator: UBinaryStr, Expr: $2}
   }
+| UNDERSCORE_CHARSET STRING
+  {
+    $$ = &IntroducerExpr{CharacterSet: string($1), Expr: newScannedStrVal(yylex, $2)}
+  }
+| ARRAY '[' expression_list ']'
+  {
+    $$ = &ArrayConstructor{Elements: $3}
+  }
 | '+'  value_expression %prec UNARY
   {
     if num, ok := $2.(*SQLVal); ok && num.Type == IntVal {
@@ -2437,6 +4085,10 @@ function_call_nonkeyword:
   {
     $$ = &FuncExpr{Name:NewColIdent("current_date")}
   }
+| CURRENT_USER func_datetime_precision_opt
+  {
+    $$ = &FuncExpr{Name:NewColIdent("current_user")}
+  }
   // curtime
 | CURRENT_TIME func_datetime_precision_opt
   {
@@ -2617,7 +4269,7 @@ column_name:
 value:
   STRING
   {
-    $$ = NewStrVal($1)
+    $$ = newScannedStrVal(yylex, $1)
   }
 | HEX
   {
@@ -2906,7 +4558,7 @@ charset_value:
   }
 | STRING
   {
-    $$ = NewStrVal($1)
+    $$ = newScannedStrVal(yylex, $1)
   }
 | DEFAULT
   {
@@ -3013,20 +4665,26 @@ reserved_table_id:
 reserved_keyword:
   ADD
 | AND
+| ANY
 | AS
 | ASC
 | AUTO_INCREMENT
+| BEFORE
 | BETWEEN
 | BINARY
 | BY
+| CASCADE
 | CASE
+| CHECK
 | COLLATE
+| CONSTRAINT
 | CONVERT
 | CREATE
 | CROSS
 | CURRENT_DATE
 | CURRENT_TIME
 | CURRENT_TIMESTAMP
+| CURRENT_USER
 | SUBSTR
 | SUBSTRING
 | DATABASE
@@ -3038,6 +4696,7 @@ reserved_keyword:
 | DISTINCT
 | DIV
 | DROP
+| EACH
 | ELSE
 | END
 | ESCAPE
@@ -3078,9 +4737,11 @@ reserved_keyword:
 | OR
 | ORDER
 | OUTER
+| REFERENCES
 | REGEXP
 | RENAME
 | REPLACE
+| RESTRICT
 | RIGHT
 | SCHEMA
 | SELECT
@@ -3113,9 +4774,13 @@ reserved_keyword:
   Sorted alphabetically
 */
 non_reserved_keyword:
-  AGAINST
+  ACTION
+| AGAINST
+| ALWAYS
+| ARRAY
 | BEGIN
 | BIGINT
+| BIGSERIAL
 | BIT
 | BLOB
 | BOOL
@@ -3126,22 +4791,27 @@ non_reserved_keyword:
 | COMMENT_KEYWORD
 | COMMIT
 | COMMITTED
-| CONSTRAINT
+| CONCURRENTLY
 | DATE
 | DATETIME
 | DECIMAL
+| DOMAIN
 | DOUBLE
 | DUPLICATE
 | ENUM
+| EXECUTE
 | EXPANSION
 | FLOAT_TYPE
 | FOREIGN
 | FULLTEXT
+| GENERATED
 | GEOMETRY
 | GEOMETRYCOLLECTION
 | GLOBAL
+| INHERITS
 | INT
 | INTEGER
+| INVISIBLE
 | ISOLATION
 | JSON
 | KEYS
@@ -3162,6 +4832,7 @@ non_reserved_keyword:
 | MULTIPOLYGON
 | NAMES
 | NCHAR
+| NO
 | NUMERIC
 | OFFSET
 | OPTIMIZE
@@ -3177,14 +4848,20 @@ non_reserved_keyword:
 | REPAIR
 | REPEATABLE
 | ROLLBACK
+| SERIAL
 | SESSION
 | SERIALIZABLE
+| SERVER
 | SHARE
 | SIGNED
 | SMALLINT
+| SMALLSERIAL
 | SPATIAL
+| SRID
 | START
 | STATUS
+| STORED
+| TABLESPACE
 | TEXT
 | THAN
 | TIME
@@ -3202,14 +4879,17 @@ non_reserved_keyword:
 | VARCHAR
 | VARYING
 | VARIABLES
-| VIEW
+| VIEW %prec VIEW_AS_NAME
 | VINDEX
 | VINDEXES
+| VIRTUAL
+| VISIBLE
 | VITESS_KEYSPACES
 | VITESS_SHARDS
 | VITESS_TABLETS
 | VSCHEMA_TABLES
 | WITH
+| WITHOUT
 | WRITE
 | YEAR
 | ZEROFILL
@@ -3234,6 +4914,414 @@ force_eof:
   forceEOF(yylex)
 }
 
+// PostgreSQL's WITH [NO] DATA of CREATE MATERIALIZED VIEW. Return true for WITH NO DATA.
+with_data_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| WITH ID
+  {
+    if NewColIdent(string($2)).Lowered() != "data" {
+      yylex.Error("expected WITH DATA, but got: WITH " + string($2))
+      return 1
+    }
+    $$ = BoolVal(false)
+  }
+| WITH NO ID
+  {
+    if NewColIdent(string($3)).Lowered() != "data" {
+      yylex.Error("expected WITH NO DATA, but got: WITH NO " + string($3))
+      return 1
+    }
+    $$ = BoolVal(true)
+  }
+
+// The rest of CREATE FUNCTION after its name, which is parsed by parseFunctionDefinition.
+function_definition:
+  '('
+  {
+    spec, err := parseFunctionDefinition(skipToEnd(yylex), yylex.(*Tokenizer).mode)
+    if err != nil {
+      yylex.Error(err.Error())
+      return 1
+    }
+    $$ = spec
+  }
+
+// Options of PostgreSQL's CREATE SEQUENCE and ALTER SEQUENCE
+row_level_security_action:
+  ID
+  {
+    switch action := NewColIdent(string($1)).Lowered(); action {
+    case "enable", "disable":
+      $$ = action
+    default:
+      yylex.Error("expected ENABLE or DISABLE, but got: " + string($1))
+      return 1
+    }
+  }
+| FORCE
+  {
+    $$ = "force"
+  }
+| NO FORCE
+  {
+    $$ = "no force"
+  }
+
+policy_option_list:
+  {
+    $$ = &PolicySpec{Permissive: "permissive", Command: "all", Roles: []string{}}
+  }
+| policy_option_list AS ID
+  {
+    switch permissive := NewColIdent(string($3)).Lowered(); permissive {
+    case "permissive", "restrictive":
+      $1.Permissive = permissive
+    default:
+      yylex.Error("expected PERMISSIVE or RESTRICTIVE, but got: " + string($3))
+      return 1
+    }
+    $$ = $1
+  }
+| policy_option_list FOR policy_command
+  {
+    $1.Command = $3
+    $$ = $1
+  }
+| policy_option_list TO grantee_list
+  {
+    $1.Roles = $3
+    $$ = $1
+  }
+| policy_option_list USING '(' expression ')'
+  {
+    $1.Using = $4
+    $$ = $1
+  }
+| policy_option_list WITH CHECK '(' expression ')'
+  {
+    $1.WithCheck = $5
+    $$ = $1
+  }
+
+policy_command:
+  ALL
+  {
+    $$ = "all"
+  }
+| SELECT
+  {
+    $$ = "select"
+  }
+| INSERT
+  {
+    $$ = "insert"
+  }
+| UPDATE
+  {
+    $$ = "update"
+  }
+| DELETE
+  {
+    $$ = "delete"
+  }
+
+/* Options of PostgreSQL's CREATE EXTENSION, which are kept only to format them */
+extension_option_list:
+  {
+    $$ = []string{}
+  }
+| extension_option_list WITH
+  {
+    $$ = $1
+  }
+| extension_option_list SCHEMA table_id
+  {
+    $$ = append($1, "schema " + $3.String())
+  }
+| extension_option_list CASCADE
+  {
+    $$ = append($1, "cascade")
+  }
+
+/* TYPE and VERSION of PostgreSQL's CREATE SERVER */
+server_option_list:
+  {
+    $$ = &ForeignSpec{}
+  }
+| server_option_list ID STRING
+  {
+    switch NewColIdent(string($2)).Lowered() {
+    case "type":
+      $1.Type = newScannedStrVal(yylex, $3)
+    case "version":
+      $1.Version = newScannedStrVal(yylex, $3)
+    default:
+      yylex.Error("unexpected option of SERVER: " + string($2))
+      return 1
+    }
+    $$ = $1
+  }
+
+/* OPTIONS of PostgreSQL's foreign data, whose names are often keywords like USER */
+foreign_options_opt:
+  {
+    $$ = nil
+  }
+| ID '(' foreign_option_list ')'
+  {
+    if NewColIdent(string($1)).Lowered() != "options" {
+      yylex.Error("expected OPTIONS, but got: " + string($1))
+      return 1
+    }
+    $$ = $3
+  }
+
+foreign_option_list:
+  foreign_option
+  {
+    $$ = ForeignOptions{$1}
+  }
+| foreign_option_list ',' foreign_option
+  {
+    $$ = append($1, $3)
+  }
+
+foreign_option:
+  reserved_sql_id STRING
+  {
+    $$ = &ForeignOption{Name: $1, Value: newScannedStrVal(yylex, $2)}
+  }
+
+sequence_option_list:
+  {
+    $$ = &SequenceSpec{}
+  }
+| sequence_option_list AS int_type
+  {
+    $1.Type = NewColIdent($3.Type).Lowered()
+    $$ = $1
+  }
+| sequence_option_list ID sequence_value
+  {
+    switch NewColIdent(string($2)).Lowered() {
+    case "increment":
+      $1.IncrementBy = $3
+    case "minvalue":
+      $1.MinValue = $3
+    case "cache":
+      $1.Cache = $3
+    default:
+      yylex.Error("unexpected option of SEQUENCE: " + string($2))
+      return 1
+    }
+    $$ = $1
+  }
+| sequence_option_list ID BY sequence_value
+  {
+    if NewColIdent(string($2)).Lowered() != "increment" {
+      yylex.Error("expected INCREMENT BY, but got: " + string($2) + " BY")
+      return 1
+    }
+    $1.IncrementBy = $4
+    $$ = $1
+  }
+| sequence_option_list ID BY column_name
+  {
+    if NewColIdent(string($2)).Lowered() != "owned" {
+      yylex.Error("expected OWNED BY, but got: " + string($2) + " BY")
+      return 1
+    }
+    $1.OwnedBy = $4
+    $$ = $1
+  }
+| sequence_option_list ID
+  {
+    if NewColIdent(string($2)).Lowered() != "cycle" {
+      yylex.Error("unexpected option of SEQUENCE: " + string($2))
+      return 1
+    }
+    $1.Cycle = "cycle"
+    $$ = $1
+  }
+| sequence_option_list NO ID
+  {
+    switch NewColIdent(string($3)).Lowered() {
+    case "minvalue":
+      $1.NoMinValue = true
+    case "cycle":
+      $1.Cycle = "no cycle"
+    default:
+      yylex.Error("unexpected option of SEQUENCE: NO " + string($3))
+      return 1
+    }
+    $$ = $1
+  }
+| sequence_option_list MAXVALUE sequence_value
+  {
+    $1.MaxValue = $3
+    $$ = $1
+  }
+| sequence_option_list NO MAXVALUE
+  {
+    $1.NoMaxValue = true
+    $$ = $1
+  }
+| sequence_option_list START sequence_value
+  {
+    $1.StartWith = $3
+    $$ = $1
+  }
+| sequence_option_list START WITH sequence_value
+  {
+    $1.StartWith = $4
+    $$ = $1
+  }
+
+sequence_value:
+  INTEGRAL
+  {
+    $$ = string($1)
+  }
+| '-' INTEGRAL
+  {
+    $$ = "-" + string($2)
+  }
+
+// BEFORE, AFTER or PostgreSQL's INSTEAD OF
+trigger_time:
+  BEFORE
+  {
+    $$ = "before"
+  }
+| ID
+  {
+    if NewColIdent(string($1)).Lowered() != "after" {
+      yylex.Error("expected BEFORE or AFTER, but got: " + string($1))
+      return 1
+    }
+    $$ = "after"
+  }
+| ID ID
+  {
+    if NewColIdent(string($1)).Lowered() != "instead" || NewColIdent(string($2)).Lowered() != "of" {
+      yylex.Error("expected INSTEAD OF, but got: " + string($1) + " " + string($2))
+      return 1
+    }
+    $$ = "instead of"
+  }
+
+// PostgreSQL's trigger can have multiple events like INSERT OR UPDATE.
+trigger_event_list:
+  trigger_event
+  {
+    $$ = []string{$1}
+  }
+| trigger_event_list OR trigger_event
+  {
+    $$ = append($1, $3)
+  }
+
+trigger_event:
+  INSERT
+  {
+    $$ = "insert"
+  }
+| UPDATE
+  {
+    $$ = "update"
+  }
+| UPDATE ID column_list
+  {
+    if NewColIdent(string($2)).Lowered() != "of" {
+      yylex.Error("expected UPDATE OF, but got: UPDATE " + string($2))
+      return 1
+    }
+    $$ = "update of "
+    for i, column := range $3 {
+      if i > 0 {
+        $$ += ", "
+      }
+      $$ += String(column)
+    }
+  }
+| DELETE
+  {
+    $$ = "delete"
+  }
+| TRUNCATE
+  {
+    $$ = "truncate"
+  }
+
+// FOR [EACH] { ROW | STATEMENT }. PostgreSQL's default is FOR EACH STATEMENT.
+trigger_for_each_opt:
+  {
+    $$ = ""
+  }
+| FOR ID
+  {
+    $$ = NewColIdent(string($2)).Lowered()
+    if $$ != "row" && $$ != "statement" {
+      yylex.Error("expected FOR ROW or FOR STATEMENT, but got: FOR " + string($2))
+      return 1
+    }
+  }
+| FOR EACH ID
+  {
+    $$ = NewColIdent(string($3)).Lowered()
+    if $$ != "row" && $$ != "statement" {
+      yylex.Error("expected FOR EACH ROW or FOR EACH STATEMENT, but got: FOR EACH " + string($3))
+      return 1
+    }
+  }
+
+trigger_body:
+  /* For MySQL. The trigger body is not parsed, but kept as it is. */
+  trigger_body_start
+  {
+    $$ = &TriggerSpec{Body: skipToEnd(yylex)}
+  }
+/* For PostgreSQL */
+| trigger_execute
+  {
+    $$ = &TriggerSpec{Execute: $1}
+  }
+| WHEN openb expression closeb trigger_execute
+  {
+    $$ = &TriggerSpec{When: $3, Execute: $5}
+  }
+
+// The first token of MySQL's trigger body. Routine keywords like CALL, DECLARE, LOOP and WHILE are UNUSED.
+trigger_body_start:
+  BEGIN
+| SET
+| INSERT
+| UPDATE
+| DELETE
+| REPLACE
+| SELECT
+| IF
+| CASE
+| UNUSED
+| ID
+
+// EXECUTE FUNCTION, or EXECUTE PROCEDURE of PostgreSQL 10 or older
+trigger_execute:
+  EXECUTE ID function_call_generic
+  {
+    if NewColIdent(string($2)).Lowered() != "function" {
+      yylex.Error("expected EXECUTE FUNCTION or EXECUTE PROCEDURE, but got: EXECUTE " + string($2))
+      return 1
+    }
+    $$ = $3.(*FuncExpr)
+  }
+| EXECUTE PROCEDURE function_call_generic
+  {
+    $$ = $3.(*FuncExpr)
+  }
+
 ddl_force_eof:
   {
     forceEOF(yylex)
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/k0kubun/sqldef/sqlparser/dependency/bytes2"
 	"github.com/k0kubun/sqldef/sqlparser/dependency/sqltypes"
@@ -45,6 +46,7 @@ type Tokenizer struct {
 	lastChar       uint16
 	Position       int
 	lastToken      []byte
+	tokenStart     int // The offset of the last token in buf
 	LastError      error
 	posVarIndex    int
 	ParseTree      Statement
@@ -54,6 +56,9 @@ type Tokenizer struct {
 	specialComment *Tokenizer
 	mode           ParserMode
 
+	// Lowercase unquoted identifiers as PostgreSQL folds them. Quoted ones are kept as they are.
+	FoldIdentifiers bool
+
 	buf     []byte
 	bufPos  int
 	bufSize int
@@ -91,20 +96,25 @@ func NewTokenizer(r io.Reader) *Tokenizer {
 // in identifiers. See the docs for each grammar to determine which one to put it into.
 var keywords = map[string]int{
 	"accessible":          UNUSED,
+	"action":              ACTION,
 	"add":                 ADD,
 	"against":             AGAINST,
 	"all":                 ALL,
+	"any":                 ANY,
 	"alter":               ALTER,
+	"always":              ALWAYS,
 	"analyze":             ANALYZE,
+	"array":               ARRAY,
 	"and":                 AND,
 	"as":                  AS,
 	"asc":                 ASC,
 	"asensitive":          UNUSED,
 	"auto_increment":      AUTO_INCREMENT,
-	"before":              UNUSED,
+	"before":              BEFORE,
 	"begin":               BEGIN,
 	"between":             BETWEEN,
 	"bigint":              BIGINT,
+	"bigserial":           BIGSERIAL,
 	"binary":              BINARY,
 	"_binary":             UNDERSCORE_BINARY,
 	"bit":                 BIT,
@@ -114,19 +124,20 @@ var keywords = map[string]int{
 	"both":                UNUSED,
 	"by":                  BY,
 	"call":                UNUSED,
-	"cascade":             UNUSED,
+	"cascade":             CASCADE,
 	"case":                CASE,
 	"cast":                CAST,
 	"change":              UNUSED,
 	"char":                CHAR,
 	"character":           CHARACTER,
 	"charset":             CHARSET,
-	"check":               UNUSED,
+	"check":               CHECK,
 	"collate":             COLLATE,
 	"column":              COLUMN,
 	"comment":             COMMENT_KEYWORD,
 	"committed":           COMMITTED,
 	"commit":              COMMIT,
+	"concurrently":        CONCURRENTLY,
 	"condition":           UNUSED,
 	"constraint":          CONSTRAINT,
 	"continue":            UNUSED,
@@ -138,7 +149,7 @@ var keywords = map[string]int{
 	"current_date":        CURRENT_DATE,
 	"current_time":        CURRENT_TIME,
 	"current_timestamp":   CURRENT_TIMESTAMP,
-	"current_user":        UNUSED,
+	"current_user":        CURRENT_USER,
 	"cursor":              UNUSED,
 	"database":            DATABASE,
 	"databases":           DATABASES,
@@ -148,7 +159,7 @@ var keywords = map[string]int{
 	"day_second":          UNUSED,
 	"date":                DATE,
 	"datetime":            DATETIME,
-	"dec":                 UNUSED,
+	"dec":                 DECIMAL,
 	"decimal":             DECIMAL,
 	"declare":             UNUSED,
 	"default":             DEFAULT,
@@ -160,10 +171,11 @@ var keywords = map[string]int{
 	"distinct":            DISTINCT,
 	"distinctrow":         UNUSED,
 	"div":                 DIV,
+	"domain":              DOMAIN,
 	"double":              DOUBLE,
 	"drop":                DROP,
 	"duplicate":           DUPLICATE,
-	"each":                UNUSED,
+	"each":                EACH,
 	"else":                ELSE,
 	"elseif":              UNUSED,
 	"enclosed":            UNUSED,
@@ -171,6 +183,7 @@ var keywords = map[string]int{
 	"enum":                ENUM,
 	"escape":              ESCAPE,
 	"escaped":             UNUSED,
+	"execute":             EXECUTE,
 	"exists":              EXISTS,
 	"exit":                UNUSED,
 	"explain":             EXPLAIN,
@@ -179,20 +192,20 @@ var keywords = map[string]int{
 	"false":               FALSE,
 	"fetch":               UNUSED,
 	"float":               FLOAT_TYPE,
-	"float4":              UNUSED,
-	"float8":              UNUSED,
+	"float4":              FLOAT_TYPE,
+	"float8":              FLOAT_TYPE,
 	"for":                 FOR,
 	"force":               FORCE,
 	"foreign":             FOREIGN,
 	"from":                FROM,
 	"full":                FULL,
 	"fulltext":            FULLTEXT,
-	"generated":           UNUSED,
+	"generated":           GENERATED,
 	"geometry":            GEOMETRY,
 	"geometrycollection":  GEOMETRYCOLLECTION,
 	"get":                 UNUSED,
 	"global":              GLOBAL,
-	"grant":               UNUSED,
+	"grant":               GRANT,
 	"group":               GROUP,
 	"group_concat":        GROUP_CONCAT,
 	"having":              HAVING,
@@ -204,20 +217,22 @@ var keywords = map[string]int{
 	"ignore":              IGNORE,
 	"in":                  IN,
 	"index":               INDEX,
+	"inherits":            INHERITS,
 	"infile":              UNUSED,
 	"inout":               UNUSED,
 	"inner":               INNER,
 	"insensitive":         UNUSED,
 	"insert":              INSERT,
 	"int":                 INT,
-	"int1":                UNUSED,
-	"int2":                UNUSED,
-	"int3":                UNUSED,
-	"int4":                UNUSED,
-	"int8":                UNUSED,
+	"int1":                TINYINT,
+	"int2":                SMALLINT,
+	"int3":                MEDIUMINT,
+	"int4":                INT,
+	"int8":                BIGINT,
 	"integer":             INTEGER,
 	"interval":            INTERVAL,
 	"into":                INTO,
+	"invisible":           INVISIBLE,
 	"io_after_gtids":      UNUSED,
 	"is":                  IS,
 	"isolation":           ISOLATION,
@@ -268,6 +283,7 @@ var keywords = map[string]int{
 	"natural":             NATURAL,
 	"nchar":               NCHAR,
 	"next":                NEXT,
+	"no":                  NO,
 	"not":                 NOT,
 	"no_write_to_binlog":  UNUSED,
 	"null":                NULL,
@@ -287,7 +303,7 @@ var keywords = map[string]int{
 	"partition":           PARTITION,
 	"point":               POINT,
 	"polygon":             POLYGON,
-	"precision":           UNUSED,
+	"precision":           PRECISION,
 	"primary":             PRIMARY,
 	"processlist":         PROCESSLIST,
 	"procedure":           PROCEDURE,
@@ -297,7 +313,7 @@ var keywords = map[string]int{
 	"reads":               UNUSED,
 	"read_write":          UNUSED,
 	"real":                REAL,
-	"references":          UNUSED,
+	"references":          REFERENCES,
 	"regexp":              REGEXP,
 	"release":             UNUSED,
 	"rename":              RENAME,
@@ -308,9 +324,9 @@ var keywords = map[string]int{
 	"replace":             REPLACE,
 	"require":             UNUSED,
 	"resignal":            UNUSED,
-	"restrict":            UNUSED,
+	"restrict":            RESTRICT,
 	"return":              UNUSED,
-	"revoke":              UNUSED,
+	"revoke":              REVOKE,
 	"right":               RIGHT,
 	"rlike":               REGEXP,
 	"rollback":            ROLLBACK,
@@ -320,7 +336,9 @@ var keywords = map[string]int{
 	"select":              SELECT,
 	"sensitive":           UNUSED,
 	"separator":           SEPARATOR,
+	"serial":              SERIAL,
 	"serializable":        SERIALIZABLE,
+	"server":              SERVER,
 	"session":             SESSION,
 	"set":                 SET,
 	"share":               SHARE,
@@ -328,6 +346,7 @@ var keywords = map[string]int{
 	"signal":              UNUSED,
 	"signed":              SIGNED,
 	"smallint":            SMALLINT,
+	"smallserial":         SMALLSERIAL,
 	"spatial":             SPATIAL,
 	"specific":            UNUSED,
 	"sql":                 UNUSED,
@@ -339,15 +358,17 @@ var keywords = map[string]int{
 	"sql_calc_found_rows": UNUSED,
 	"sql_no_cache":        SQL_NO_CACHE,
 	"sql_small_result":    UNUSED,
+	"srid":                SRID,
 	"ssl":                 UNUSED,
 	"start":               START,
 	"starting":            UNUSED,
 	"status":              STATUS,
-	"stored":              UNUSED,
+	"stored":              STORED,
 	"straight_join":       STRAIGHT_JOIN,
 	"stream":              STREAM,
 	"table":               TABLE,
 	"tables":              TABLES,
+	"tablespace":          TABLESPACE,
 	"terminated":          UNUSED,
 	"text":                TEXT,
 	"than":                THAN,
@@ -382,7 +403,8 @@ var keywords = map[string]int{
 	"varchar":             VARCHAR,
 	"varcharacter":        UNUSED,
 	"varying":             VARYING,
-	"virtual":             UNUSED,
+	"virtual":             VIRTUAL,
+	"visible":             VISIBLE,
 	"vindex":              VINDEX,
 	"vindexes":            VINDEXES,
 	"view":                VIEW,
@@ -394,6 +416,7 @@ var keywords = map[string]int{
 	"where":               WHERE,
 	"while":               UNUSED,
 	"with":                WITH,
+	"without":             WITHOUT,
 	"write":               WRITE,
 	"xor":                 UNUSED,
 	"year":                YEAR,
@@ -437,6 +460,13 @@ func (tkn *Tokenizer) Lex(lval *yySymType) int {
 	return typ
 }
 
+// skipToEnd returns the rest of the statement from the start of the last token, and makes the lexer return EOF.
+func (tkn *Tokenizer) skipToEnd() string {
+	tkn.ForceEOF = true
+	rest := string(tkn.buf[tkn.tokenStart:tkn.bufSize])
+	return strings.TrimSuffix(strings.TrimSpace(rest), ";")
+}
+
 // Error is called by go yacc if there's a parsing error.
 func (tkn *Tokenizer) Error(err string) {
 	buf := &bytes2.Buffer{}
@@ -478,6 +508,7 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 	}
 
 	tkn.skipBlank()
+	tkn.tokenStart = tkn.Position - 1
 	switch ch := tkn.lastChar; {
 	case isLetter(ch):
 		tkn.next()
@@ -493,6 +524,12 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 				return tkn.scanBitLiteral()
 			}
 		}
+		if (ch == 'E' || ch == 'e') && tkn.mode == ParserModePostgres {
+			if tkn.lastChar == '\'' {
+				tkn.next()
+				return tkn.scanEscapedString('\'', STRING, '\\')
+			}
+		}
 		isDbSystemVariable := false
 		if ch == '@' && tkn.lastChar == '@' {
 			isDbSystemVariable = true
@@ -501,6 +538,14 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 	case isDigit(ch):
 		return tkn.scanNumber(false)
 	case ch == ':':
+		if tkn.mode == ParserModePostgres {
+			tkn.next()
+			if tkn.lastChar == ':' {
+				tkn.next()
+				return TYPECAST, nil
+			}
+			return int(ch), nil
+		}
 		return tkn.scanBindVar()
 	case ch == ';' && tkn.multi:
 		return 0, nil
@@ -511,6 +556,11 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 			return 0, nil
 		case '=', ',', ';', '(', ')', '+', '*', '%', '^', '~':
 			return int(ch), nil
+		case '[', ']':
+			if tkn.mode == ParserModePostgres {
+				return int(ch), nil
+			}
+			return LEX_ERROR, []byte{byte(ch)}
 		case '&':
 			if tkn.lastChar == '&' {
 				tkn.next()
@@ -520,6 +570,9 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 		case '|':
 			if tkn.lastChar == '|' {
 				tkn.next()
+				if tkn.mode == ParserModePostgres {
+					return CONCAT, nil
+				}
 				return OR, nil
 			}
 			return int(ch), nil
@@ -610,6 +663,11 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 			} else {
 				return tkn.scanString(ch, STRING)
 			}
+		case '$':
+			if tkn.mode == ParserModePostgres {
+				return tkn.scanDollarQuotedString()
+			}
+			return LEX_ERROR, []byte{byte(ch)}
 		default:
 			if tkn.mode == ParserModeMysql && ch == '`' {
 				return tkn.scanLiteralIdentifier()
@@ -648,8 +706,12 @@ func (tkn *Tokenizer) scanIdentifier(firstByte byte, isDbSystemVariable bool) (i
 	if keywordID, found := keywords[loweredStr]; found {
 		return keywordID, lowered
 	}
+	// A character set introducer like _utf8mb4 is followed by a string without spaces in dumped schemas.
+	if firstByte == '_' && tkn.lastChar == '\'' {
+		return UNDERSCORE_CHARSET, lowered
+	}
 	// dual must always be case-insensitive
-	if loweredStr == "dual" {
+	if loweredStr == "dual" || tkn.FoldIdentifiers {
 		return ID, lowered
 	}
 	return ID, buffer.Bytes()
@@ -792,6 +854,17 @@ exit:
 }
 
 func (tkn *Tokenizer) scanString(delim uint16, typ int) (int, []byte) {
+	// PostgreSQL's standard_conforming_strings treats backslashes literally.
+	escape := uint16('\\')
+	if tkn.mode == ParserModePostgres {
+		escape = eofChar
+	}
+	return tkn.scanEscapedString(delim, typ, escape)
+}
+
+// scanEscapedString scans a string whose characters may be escaped by `escape`. PostgreSQL's `E'...'` string
+// always allows backslash escapes.
+func (tkn *Tokenizer) scanEscapedString(delim uint16, typ int, escape uint16) (int, []byte) {
 	var buffer bytes2.Buffer
 	for {
 		ch := tkn.lastChar
@@ -800,14 +873,14 @@ func (tkn *Tokenizer) scanString(delim uint16, typ int) (int, []byte) {
 			return LEX_ERROR, buffer.Bytes()
 		}
 
-		if ch != delim && ch != '\\' {
+		if ch != delim && ch != escape {
 			buffer.WriteByte(byte(ch))
 
 			// Scan ahead to the next interesting character.
 			start := tkn.bufPos
 			for ; tkn.bufPos < tkn.bufSize; tkn.bufPos++ {
 				ch = uint16(tkn.buf[tkn.bufPos])
-				if ch == delim || ch == '\\' {
+				if ch == delim || ch == escape {
 					break
 				}
 			}
@@ -827,7 +900,7 @@ func (tkn *Tokenizer) scanString(delim uint16, typ int) (int, []byte) {
 		}
 		tkn.next() // Read one past the delim or escape character.
 
-		if ch == '\\' {
+		if ch == escape {
 			if tkn.lastChar == eofChar {
 				// String terminates mid escape character.
 				return LEX_ERROR, buffer.Bytes()
@@ -850,6 +923,33 @@ func (tkn *Tokenizer) scanString(delim uint16, typ int) (int, []byte) {
 	return typ, buffer.Bytes()
 }
 
+// scanDollarQuotedString scans PostgreSQL's `$tag$ ... $tag$` string. The tag may be empty.
+func (tkn *Tokenizer) scanDollarQuotedString() (int, []byte) {
+	tag := &bytes2.Buffer{}
+	tag.WriteByte('$')
+	for isLetter(tkn.lastChar) || isDigit(tkn.lastChar) {
+		tkn.consumeNext(tag)
+	}
+	if tkn.lastChar != '$' {
+		return LEX_ERROR, tag.Bytes()
+	}
+	tkn.consumeNext(tag)
+	delim := tag.Bytes()
+
+	buffer := &bytes2.Buffer{}
+	for {
+		if tkn.lastChar == eofChar {
+			// Unterminated string.
+			return LEX_ERROR, buffer.Bytes()
+		}
+		tkn.consumeNext(buffer)
+		if bytes.HasSuffix(buffer.Bytes(), delim) {
+			break
+		}
+	}
+	return STRING, buffer.Bytes()[:buffer.Len()-len(delim)]
+}
+
 func (tkn *Tokenizer) scanCommentType1(prefix string) (int, []byte) {
 	buffer := &bytes2.Buffer{}
 	buffer.WriteString(prefix)
@@ -189,3 +189,114 @@ func TestSplitStatement(t *testing.T) {
 		}
 	}
 }
+
+func TestPostgresString(t *testing.T) {
+	testcases := []struct {
+		in   string
+		id   int
+		want string
+	}{{
+		in:   "'a\\'",
+		id:   STRING,
+		want: "a\\",
+	}, {
+		in:   "'a''b'",
+		id:   STRING,
+		want: "a'b",
+	}, {
+		in:   "$$a;'b'$$",
+		id:   STRING,
+		want: "a;'b'",
+	}, {
+		in:   "$tag$a$$b$tag$",
+		id:   STRING,
+		want: "a$$b",
+	}, {
+		in:   "$tag$a$$b",
+		id:   LEX_ERROR,
+		want: "a$$b",
+	}, {
+		in:   "$tag",
+		id:   LEX_ERROR,
+		want: "$tag",
+	}, {
+		in:   "E'a\\'b'",
+		id:   STRING,
+		want: "a'b",
+	}, {
+		in:   "e'a\\\\b\\n'",
+		id:   STRING,
+		want: "a\\b\n",
+	}, {
+		in:   "E'a\\'",
+		id:   LEX_ERROR,
+		want: "a'",
+	}, {
+		in:   "E",
+		id:   ID,
+		want: "E",
+	}}
+
+	for _, tcase := range testcases {
+		id, got := NewStringTokenizer(tcase.in, ParserModePostgres).Scan()
+		if tcase.id != id || string(got) != tcase.want {
+			t.Errorf("Scan(%q) = (%s, %q), want (%s, %q)", tcase.in, tokenName(id), got, tokenName(tcase.id), tcase.want)
+		}
+	}
+}
+
+func TestPostgresStringFormat(t *testing.T) {
+	testcases := []struct {
+		in   string
+		want string
+	}{{
+		in:   "select 'x\\y' from t",
+		want: "select 'x\\y' from t",
+	}, {
+		in:   "select 'it''s' from t",
+		want: "select 'it''s' from t",
+	}, {
+		in:   "select E'it\\'s \\\\' from t",
+		want: "select 'it''s \\' from t",
+	}}
+
+	for _, tcase := range testcases {
+		stmt, err := ParseWithMode(tcase.in, ParserModePostgres)
+		if err != nil {
+			t.Errorf("ParseWithMode(%q): %v", tcase.in, err)
+			continue
+		}
+		if got := String(stmt); got != tcase.want {
+			t.Errorf("String(ParseWithMode(%q)) = %q, want %q", tcase.in, got, tcase.want)
+		}
+	}
+}
+
+func TestFoldIdentifiers(t *testing.T) {
+	testcases := []struct {
+		in   string
+		id   int
+		want string
+	}{{
+		in:   "Users",
+		id:   ID,
+		want: "users",
+	}, {
+		in:   `"Users"`,
+		id:   ID,
+		want: "Users",
+	}, {
+		in:   "'Users'",
+		id:   STRING,
+		want: "Users",
+	}}
+
+	for _, tcase := range testcases {
+		tkn := NewStringTokenizer(tcase.in, ParserModePostgres)
+		tkn.FoldIdentifiers = true
+		id, got := tkn.Scan()
+		if tcase.id != id || string(got) != tcase.want {
+			t.Errorf("Scan(%q) = (%s, %q), want (%s, %q)", tcase.in, tokenName(id), got, tokenName(tcase.id), tcase.want)
+		}
+	}
+}